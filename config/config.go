@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
+	"time"
 
 	"blog-go/ent"
 	"blog-go/ent/migrate"
@@ -26,6 +28,12 @@ type Config struct {
 	DBName      string
 	ServerPort  string
 	JWTSecret   string
+	// 定时发布任务的最长检查间隔
+	PublishInterval time.Duration
+	// 信任的反向代理（IP 或 CIDR），只有来自这些地址的请求才读取 X-Forwarded-For
+	TrustedProxies []string
+	// 由云平台设置的客户端IP请求头（如 CF-Connecting-IP），设置后优先使用
+	TrustedPlatform string
 }
 
 // LoadConfig 从环境变量加载配置
@@ -39,6 +47,10 @@ func LoadConfig() *Config {
 		DBName:      os.Getenv("DB_NAME"),
 		ServerPort:  os.Getenv("PORT"),
 		JWTSecret:   os.Getenv("JWT_SECRET"),
+
+		PublishInterval: getEnvDuration("PUBLISH_INTERVAL", 30*time.Second),
+		TrustedProxies:  getEnvList("TRUSTED_PROXIES"),
+		TrustedPlatform: os.Getenv("TRUSTED_PLATFORM"),
 	}
 }
 
@@ -72,6 +84,11 @@ func InitEntClient() (*ent.Client, error) {
 		return nil, fmt.Errorf("连接数据库失败: %w", err)
 	}
 
+	// 自动迁移前的数据迁移
+	if err := MigrateBeforeSchema(context.Background(), db); err != nil {
+		return nil, fmt.Errorf("数据迁移失败: %w", err)
+	}
+
 	// 创建ent驱动
 	drv := entsql.OpenDB(dialect.Postgres, db)
 
@@ -87,6 +104,11 @@ func InitEntClient() (*ent.Client, error) {
 		return nil, fmt.Errorf("创建schema失败: %w", err)
 	}
 
+	// 自动迁移后的数据迁移
+	if err := MigrateAfterSchema(context.Background(), db); err != nil {
+		return nil, fmt.Errorf("数据迁移失败: %w", err)
+	}
+
 	log.Println("ent客户端初始化成功")
 	return client, nil
 }
@@ -98,3 +120,28 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// getEnvList 获取逗号分隔的环境变量，忽略空项
+func getEnvList(key string) []string {
+	var list []string
+	for _, v := range strings.Split(os.Getenv(key), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// getEnvDuration 获取时长类型的环境变量（如 30s、5m），解析失败时返回默认值
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("环境变量 %s 格式错误，使用默认值 %v", key, defaultValue)
+		return defaultValue
+	}
+	return d
+}
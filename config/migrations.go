@@ -0,0 +1,149 @@
+package config
+
+import (
+	"context"
+	"database/sql"
+	"log"
+)
+
+// MigrateBeforeSchema 在 ent 自动迁移之前执行的数据迁移
+//
+// 自动迁移会按 schema 添加新列，新列的初始值需要从旧列计算时，必须在自动迁移添加该列之前在这里完成。
+// main.go 中的自动迁移没有启用 WithDropColumn，旧列（如 comments.approved）会保留在表中。
+func MigrateBeforeSchema(ctx context.Context, db *sql.DB) error {
+	if err := migrateCommentStatus(ctx, db); err != nil {
+		return err
+	}
+	if err := migrateEmailVerified(ctx, db); err != nil {
+		return err
+	}
+	return migrateUserRoles(ctx, db)
+}
+
+// migrateCommentStatus 将评论的 approved 布尔列转换为 status 状态列
+func migrateCommentStatus(ctx context.Context, db *sql.DB) error {
+	hasApproved, err := columnExists(ctx, db, "comments", "approved")
+	if err != nil || !hasApproved {
+		return err
+	}
+	hasStatus, err := columnExists(ctx, db, "comments", "status")
+	if err != nil || hasStatus {
+		return err
+	}
+
+	// 被标记为垃圾或被审核链拒绝的未通过评论分别迁移为 spam / rejected
+	status := "CASE WHEN approved THEN 'approved'"
+	if ok, err := columnExists(ctx, db, "comments", "spam_label"); err != nil {
+		return err
+	} else if ok {
+		status += " WHEN spam_label = 'spam' THEN 'spam'"
+	}
+	if ok, err := columnExists(ctx, db, "comments", "moderation_action"); err != nil {
+		return err
+	} else if ok {
+		status += " WHEN moderation_action = 'reject' THEN 'rejected'"
+	}
+	status += " ELSE 'pending' END"
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `ALTER TABLE comments ADD COLUMN status varchar NOT NULL DEFAULT 'pending'`); err != nil {
+		tx.Rollback()
+		return err
+	}
+	res, err := tx.ExecContext(ctx, `UPDATE comments SET status = `+status)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	n, _ := res.RowsAffected()
+	log.Printf("已将 %d 条评论的审核状态迁移为 status 列", n)
+	return nil
+}
+
+// migrateEmailVerified 添加邮箱验证时间列，已有用户由管理员创建，视为已验证
+func migrateEmailVerified(ctx context.Context, db *sql.DB) error {
+	hasUsers, err := columnExists(ctx, db, "users", "email")
+	if err != nil || !hasUsers {
+		return err
+	}
+	hasVerified, err := columnExists(ctx, db, "users", "email_verified_at")
+	if err != nil || hasVerified {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `ALTER TABLE users ADD COLUMN email_verified_at timestamp with time zone NULL`); err != nil {
+		tx.Rollback()
+		return err
+	}
+	res, err := tx.ExecContext(ctx, `UPDATE users SET email_verified_at = created_at`)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	n, _ := res.RowsAffected()
+	log.Printf("已将 %d 个已有用户标记为邮箱已验证", n)
+	return nil
+}
+
+// MigrateAfterSchema 在 ent 自动迁移之后执行的数据迁移，用于填充自动迁移新增的列
+func MigrateAfterSchema(ctx context.Context, db *sql.DB) error {
+	return migratePostOwners(ctx, db)
+}
+
+// migratePostOwners 为没有记录创建者的旧文章补全 owner：作者名与用户名完全一致，且用户注册早于文章创建
+//
+// 作者名是可以随意填写的署名，之后注册的同名用户不能因此获得旧文章的编辑权限。
+func migratePostOwners(ctx context.Context, db *sql.DB) error {
+	res, err := db.ExecContext(ctx, `
+		UPDATE posts SET user_posts = users.id
+		FROM users
+		WHERE posts.user_posts IS NULL
+			AND posts.author = users.username
+			AND users.created_at <= posts.created_at`)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		log.Printf("已为 %d 篇旧文章补全创建者", n)
+	}
+	return nil
+}
+
+func columnExists(ctx context.Context, db *sql.DB, table, column string) (bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM information_schema.columns
+			WHERE table_schema = current_schema() AND table_name = $1 AND column_name = $2
+		)`, table, column).Scan(&exists)
+	return exists, err
+}
+
+// migrateUserRoles 旧的默认角色 user 改为 commenter
+func migrateUserRoles(ctx context.Context, db *sql.DB) error {
+	hasRole, err := columnExists(ctx, db, "users", "role")
+	if err != nil || !hasRole {
+		return err
+	}
+	res, err := db.ExecContext(ctx, `UPDATE users SET role = 'commenter' WHERE role = 'user'`)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		log.Printf("已将 %d 个用户的角色从 user 改为 commenter", n)
+	}
+	return nil
+}
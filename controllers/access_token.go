@@ -0,0 +1,177 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/accesstoken"
+	"blog-go/ent/user"
+	"blog-go/services"
+	"blog-go/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetAccessTokens 获取当前用户未撤销的个人访问令牌（不包含令牌原文）
+func (c *UserController) GetAccessTokens(ctx *gin.Context) {
+	uid := ctx.GetInt("userID")
+	if uid == 0 {
+		utils.RespondError(ctx, http.StatusUnauthorized, "未授权访问")
+		return
+	}
+
+	tokens, err := c.client.AccessToken.Query().
+		Where(
+			accesstoken.HasUserWith(user.IDEQ(uid)),
+			accesstoken.RevokedAtIsNil(),
+		).
+		Order(ent.Desc(accesstoken.FieldCreatedAt)).
+		All(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "获取访问令牌失败")
+		return
+	}
+
+	list := make([]gin.H, 0, len(tokens))
+	for _, t := range tokens {
+		list = append(list, accessTokenResponse(t))
+	}
+	utils.RespondSuccess(ctx, list)
+}
+
+// CreateAccessToken 创建个人访问令牌，令牌原文只在创建时返回一次
+//
+// scopes 为权限名称（如 post:create、post:publish），只能包含当前角色拥有的权限；
+// expires_in 为有效期（如 720h），不填时永不过期。
+func (c *UserController) CreateAccessToken(ctx *gin.Context) {
+	u, err := c.client.User.Query().
+		Where(user.UsernameEQ(ctx.GetString("username"))).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			utils.RespondError(ctx, http.StatusUnauthorized, "未授权访问")
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, "数据库查询错误")
+		return
+	}
+
+	// 访问令牌不经过两步验证，未完成必需的绑定前不能创建
+	if services.TwoFactorSetupPending(u) {
+		utils.RespondError(ctx, http.StatusForbidden, "请先启用两步验证")
+		return
+	}
+
+	var input struct {
+		Name      string   `json:"name" binding:"required"`
+		Scopes    []string `json:"scopes" binding:"required"`
+		ExpiresIn string   `json:"expires_in"`
+	}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	input.Name = strings.TrimSpace(input.Name)
+	if input.Name == "" || len([]rune(input.Name)) > 64 {
+		utils.RespondError(ctx, http.StatusBadRequest, "令牌名称不能为空且不能超过64个字符")
+		return
+	}
+	if len(input.Scopes) == 0 {
+		utils.RespondError(ctx, http.StatusBadRequest, "至少需要一个权限")
+		return
+	}
+	for _, scope := range input.Scopes {
+		perm := services.Permission(scope)
+		if !services.ValidPermission(perm) {
+			utils.RespondError(ctx, http.StatusBadRequest, "无效的权限: "+scope)
+			return
+		}
+		if !services.HasPermission(u.Role, perm) {
+			utils.RespondError(ctx, http.StatusForbidden, "当前角色没有权限: "+scope)
+			return
+		}
+	}
+
+	var expiresAt *time.Time
+	if input.ExpiresIn != "" {
+		d, err := time.ParseDuration(input.ExpiresIn)
+		if err != nil || d <= 0 {
+			utils.RespondError(ctx, http.StatusBadRequest, "无效的有效期")
+			return
+		}
+		t := time.Now().Add(d)
+		expiresAt = &t
+	}
+
+	token, hash, prefix, err := services.NewAccessToken()
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "生成访问令牌失败")
+		return
+	}
+	t, err := c.client.AccessToken.Create().
+		SetName(input.Name).
+		SetTokenHash(hash).
+		SetPrefix(prefix).
+		SetScopes(input.Scopes).
+		SetNillableExpiresAt(expiresAt).
+		SetUser(u).
+		SetCreatedAt(time.Now()).
+		Save(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "创建访问令牌失败: "+err.Error())
+		return
+	}
+
+	data := accessTokenResponse(t)
+	data["token"] = token
+	utils.RespondSuccess(ctx, data)
+}
+
+// RevokeAccessToken 撤销个人访问令牌，立即失效
+func (c *UserController) RevokeAccessToken(ctx *gin.Context) {
+	uid := ctx.GetInt("userID")
+	if uid == 0 {
+		utils.RespondError(ctx, http.StatusUnauthorized, "未授权访问")
+		return
+	}
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的令牌ID")
+		return
+	}
+
+	n, err := c.client.AccessToken.Update().
+		Where(
+			accesstoken.IDEQ(id),
+			accesstoken.HasUserWith(user.IDEQ(uid)),
+			accesstoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "撤销访问令牌失败")
+		return
+	}
+	if n == 0 {
+		utils.RespondError(ctx, http.StatusNotFound, "访问令牌不存在")
+		return
+	}
+	utils.RespondSuccess(ctx, gin.H{"message": "访问令牌已撤销"})
+}
+
+func accessTokenResponse(t *ent.AccessToken) gin.H {
+	return gin.H{
+		"id":           t.ID,
+		"name":         t.Name,
+		"prefix":       t.Prefix,
+		"scopes":       t.Scopes,
+		"expires_at":   t.ExpiresAt,
+		"last_used_at": t.LastUsedAt,
+		"last_used_ip": t.LastUsedIP,
+		"created_at":   t.CreatedAt,
+	}
+}
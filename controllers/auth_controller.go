@@ -0,0 +1,284 @@
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/services"
+	"blog-go/utils"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// 第三方登录 state 的签名用途
+	oauthStatePurpose = "oauth-state"
+	// 从跳转授权到回调的最长时间
+	oauthStateTTL = 10 * time.Minute
+	// 保存 PKCE code_verifier 的 cookie
+	oauthVerifierCookie = "oauth_verifier"
+	// 保存与 state 绑定的随机数的 cookie，防止登录 CSRF
+	oauthNonceCookie = "oauth_nonce"
+	// 刷新令牌 cookie，只在调用 /api/auth 下的接口时携带
+	refreshTokenCookie = "refresh_token"
+	refreshTokenPath   = "/api/auth"
+)
+
+// oauthState 跳转第三方平台时携带的状态，签名后放入 state 参数
+//
+// NonceHash 为 nonce cookie 的哈希，回调时只有发起登录的浏览器才能通过校验。
+type oauthState struct {
+	Provider  string `json:"p"`
+	ReturnURL string `json:"r"`
+	NonceHash string `json:"n"`
+	ExpiresAt int64  `json:"e"`
+}
+
+type AuthController struct {
+	client    *ent.Client
+	providers *services.OAuthRegistry
+	sessions  *services.SessionManager
+	secret    string
+}
+
+func NewAuthController(client *ent.Client, secret string, sessions *services.SessionManager) *AuthController {
+	return &AuthController{
+		client:    client,
+		providers: services.NewOAuthRegistryFromEnv(),
+		sessions:  sessions,
+		secret:    secret,
+	}
+}
+
+// GetOAuthProviders 获取已启用的第三方登录平台
+func (c *AuthController) GetOAuthProviders(ctx *gin.Context) {
+	list := []gin.H{}
+	for _, p := range c.providers.Providers() {
+		list = append(list, gin.H{"name": p.Name, "title": p.Title})
+	}
+	utils.RespondSuccess(ctx, list)
+}
+
+// OAuthLogin 跳转到第三方平台授权
+func (c *AuthController) OAuthLogin(ctx *gin.Context) {
+	provider, ok := c.providers.Provider(ctx.Param("provider"))
+	if !ok {
+		utils.RespondError(ctx, http.StatusNotFound, "不支持的登录方式")
+		return
+	}
+
+	// 只允许跳转回 ALLOWED_ORIGINS 中的前端域名，未指定时跳转到前端首页
+	returnURL, ok := utils.SafeRedirectURL(ctx.Query("returnUrl"))
+	if !ok {
+		utils.RespondError(ctx, http.StatusBadRequest, "不允许的跳转地址")
+		return
+	}
+
+	nonce := oauth2.GenerateVerifier()
+	payload, _ := json.Marshal(oauthState{
+		Provider:  provider.Name,
+		ReturnURL: returnURL,
+		NonceHash: oauthNonceHash(nonce),
+		ExpiresAt: time.Now().Add(oauthStateTTL).Unix(),
+	})
+	state := utils.SignValue(c.secret, oauthStatePurpose, string(payload))
+
+	// code_verifier 只保存在浏览器的 HttpOnly cookie 中，回调时用于换取token
+	verifier := oauth2.GenerateVerifier()
+	authURL, err := provider.AuthCodeURL(ctx.Request.Context(), state, verifier)
+	if err != nil {
+		log.Printf("[OAuth] %s 获取授权地址失败: %v", provider.Name, err)
+		utils.RespondError(ctx, http.StatusBadGateway, "登录服务暂不可用，请稍后重试")
+		return
+	}
+	setOAuthCookie(ctx, oauthVerifierCookie, verifier, int(oauthStateTTL.Seconds()))
+	setOAuthCookie(ctx, oauthNonceCookie, nonce, int(oauthStateTTL.Seconds()))
+	ctx.Redirect(http.StatusTemporaryRedirect, authURL)
+}
+
+// OAuthCallback 处理第三方平台回调：校验 state，换取token，创建或关联用户后跳转回前端
+func (c *AuthController) OAuthCallback(ctx *gin.Context) {
+	provider, ok := c.providers.Provider(ctx.Param("provider"))
+	if !ok {
+		utils.RespondError(ctx, http.StatusNotFound, "不支持的登录方式")
+		return
+	}
+
+	// 登录流程的 cookie 只能使用一次
+	nonce, _ := ctx.Cookie(oauthNonceCookie)
+	verifier, _ := ctx.Cookie(oauthVerifierCookie)
+	setOAuthCookie(ctx, oauthNonceCookie, "", -1)
+	setOAuthCookie(ctx, oauthVerifierCookie, "", -1)
+
+	state, ok := c.verifyOAuthState(ctx.Query("state"), provider.Name, nonce)
+	if !ok || verifier == "" {
+		utils.RespondError(ctx, http.StatusBadRequest, "登录请求无效或已过期，请重新登录")
+		return
+	}
+	// 跳转地址在发起登录时已校验，允许列表变更后再次确认
+	returnURL, ok := utils.SafeRedirectURL(state.ReturnURL)
+	if !ok {
+		utils.RespondError(ctx, http.StatusBadRequest, "不允许的跳转地址")
+		return
+	}
+	// 用户拒绝授权或平台返回错误时没有授权码，带上错误码跳转回前端
+	if oauthErr := ctx.Query("error"); oauthErr != "" {
+		log.Printf("[OAuth] %s 授权失败: %s %s", provider.Name, oauthErr, ctx.Query("error_description"))
+		ctx.Redirect(http.StatusTemporaryRedirect, withQuery(returnURL, "oauth_error", oauthErr))
+		return
+	}
+
+	// 创建带超时的上下文
+	timeoutCtx, cancel := context.WithTimeout(ctx.Request.Context(), 30*time.Second)
+	defer cancel()
+
+	token, err := provider.Exchange(timeoutCtx, ctx.Query("code"), verifier)
+	if err != nil {
+		log.Printf("[OAuth] %s 获取token失败: %v", provider.Name, err)
+		utils.RespondError(ctx, http.StatusInternalServerError, "获取"+provider.Title+" token失败，请稍后重试")
+		return
+	}
+
+	profile, err := provider.Profile(timeoutCtx, token)
+	if err != nil {
+		log.Printf("[OAuth] %s 获取用户信息失败: %v", provider.Name, err)
+		utils.RespondError(ctx, http.StatusInternalServerError, "获取"+provider.Title+"用户信息失败，请稍后重试")
+		return
+	}
+
+	u, err := services.LinkOAuthIdentity(timeoutCtx, c.client, profile)
+	if err != nil {
+		log.Printf("[OAuth] %s 关联账号失败: %v", provider.Name, err)
+		utils.RespondError(ctx, http.StatusInternalServerError, "登录失败，请稍后重试")
+		return
+	}
+
+	// 启用两步验证时不创建会话，挑战令牌写入 HttpOnly cookie 后跳转回前端，由前端提交验证码到 /auth/login/2fa
+	if u.TotpEnabledAt != nil {
+		setOAuthCookie(ctx, loginChallengeCookie, newLoginChallenge(c.secret, u), int(loginChallengeTTL.Seconds()))
+		ctx.Redirect(http.StatusTemporaryRedirect, withQuery(returnURL, "two_factor", "1"))
+		return
+	}
+
+	// 创建会话，访问令牌和刷新令牌写入 cookie
+	pair, err := c.sessions.Issue(timeoutCtx, u, ctx.ClientIP(), ctx.Request.UserAgent())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "生成token失败，请稍后重试")
+		return
+	}
+	setSessionCookies(ctx, pair)
+
+	// 重定向到前端回调页面
+	ctx.Redirect(http.StatusTemporaryRedirect, returnURL)
+}
+
+// verifyOAuthState 校验 state 的签名、平台、有效期以及与 nonce cookie 的绑定
+func (c *AuthController) verifyOAuthState(token, provider, nonce string) (oauthState, bool) {
+	var state oauthState
+	payload, ok := utils.VerifySignedValue(c.secret, oauthStatePurpose, token)
+	if !ok || json.Unmarshal([]byte(payload), &state) != nil {
+		return state, false
+	}
+	if state.Provider != provider || time.Now().Unix() > state.ExpiresAt {
+		return state, false
+	}
+	if nonce == "" || subtle.ConstantTimeCompare([]byte(oauthNonceHash(nonce)), []byte(state.NonceHash)) != 1 {
+		return state, false
+	}
+	return state, true
+}
+
+func oauthNonceHash(nonce string) string {
+	sum := sha256.Sum256([]byte(nonce))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// setOAuthCookie 设置登录流程中使用的临时 cookie（仅 API 域名，允许从第三方平台跳转回来时携带）
+func setOAuthCookie(ctx *gin.Context, name, value string, maxAge int) {
+	http.SetCookie(ctx.Writer, &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     "/",
+		MaxAge:   maxAge,
+		Secure:   ctx.Request.TLS != nil || ctx.GetHeader("X-Forwarded-Proto") == "https",
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// cookieDomain 登录相关 cookie 的域名，取自 COOKIE_DOMAIN（如 .example.com 允许所有子域共享），未配置时只对当前域名有效
+func cookieDomain() string {
+	return utils.GetEnv("COOKIE_DOMAIN", "")
+}
+
+// setAuthCookie 设置登录 cookie，域名见 cookieDomain，maxAge 为负数时清除
+func setAuthCookie(ctx *gin.Context, token string, maxAge int) {
+	domain := cookieDomain()
+	ctx.SetCookie(
+		"auth_token", // cookie名称
+		token,        // token值
+		maxAge,       // 过期时间
+		"/",          // 路径
+		domain,       // 域名
+		true,         // 仅HTTPS
+		true,         // HTTP-only
+	)
+	// 追加 SameSite=None，Domain 相同
+	ctx.Writer.Header().Add("Set-Cookie", (&http.Cookie{
+		Name:     "auth_token",
+		Value:    token,
+		Path:     "/",
+		Domain:   domain,
+		MaxAge:   maxAge,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteNoneMode,
+	}).String())
+}
+
+// setSessionCookies 登录或刷新后写入访问令牌和刷新令牌 cookie
+func setSessionCookies(ctx *gin.Context, pair *services.TokenPair) {
+	setAuthCookie(ctx, pair.AccessToken, int(pair.AccessTTL.Seconds()))
+	setRefreshCookie(ctx, pair.RefreshToken, int(pair.RefreshTTL.Seconds()))
+}
+
+// clearSessionCookies 退出登录时清除令牌 cookie
+func clearSessionCookies(ctx *gin.Context) {
+	setAuthCookie(ctx, "", -1)
+	setRefreshCookie(ctx, "", -1)
+}
+
+// setRefreshCookie 设置刷新令牌 cookie，与登录 cookie 使用相同的域名和 SameSite 设置
+func setRefreshCookie(ctx *gin.Context, token string, maxAge int) {
+	http.SetCookie(ctx.Writer, &http.Cookie{
+		Name:     refreshTokenCookie,
+		Value:    token,
+		Path:     refreshTokenPath,
+		Domain:   cookieDomain(),
+		MaxAge:   maxAge,
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteNoneMode,
+	})
+}
+
+// withQuery 在跳转地址上追加查询参数
+func withQuery(rawURL, key, value string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	q := u.Query()
+	q.Set(key, value)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
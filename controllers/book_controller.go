@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -11,6 +12,7 @@ import (
 
 	"blog-go/ent"
 	"blog-go/ent/book"
+	"blog-go/services"
 
 	"github.com/disintegration/imaging"
 	"github.com/gin-gonic/gin"
@@ -102,6 +104,10 @@ func (c *BookController) CreateBook(ctx *gin.Context) {
 		return
 	}
 
+	if err := services.IndexBook(ctx, c.client, b); err != nil {
+		log.Printf("[Search] 更新图书索引失败: id=%d err=%v", b.ID, err)
+	}
+
 	ctx.JSON(http.StatusCreated, b)
 }
 
@@ -297,6 +303,10 @@ func (c *BookController) UpdateBook(ctx *gin.Context) {
 		return
 	}
 
+	if err := services.IndexBook(ctx, c.client, updatedBook); err != nil {
+		log.Printf("[Search] 更新图书索引失败: id=%d err=%v", updatedBook.ID, err)
+	}
+
 	ctx.JSON(http.StatusOK, updatedBook)
 }
 
@@ -333,6 +343,10 @@ func (c *BookController) DeleteBook(ctx *gin.Context) {
 		return
 	}
 
+	if err := services.RemoveSearchDocuments(ctx, c.client, services.SearchTypeBook, id); err != nil {
+		log.Printf("[Search] 删除图书索引失败: id=%d err=%v", id, err)
+	}
+
 	ctx.JSON(http.StatusOK, gin.H{"message": "图书已删除"})
 }
 
@@ -351,6 +365,7 @@ func (c *BookController) BatchDeleteBooks(ctx *gin.Context) {
 	userID := ctx.GetInt("user_id")
 
 	// 检查权限并删除
+	var deleted []int
 	for _, id := range input.IDs {
 		book, err := c.client.Book.Query().
 			Where(book.ID(id)).
@@ -367,7 +382,13 @@ func (c *BookController) BatchDeleteBooks(ctx *gin.Context) {
 		}
 
 		// 删除图书
-		c.client.Book.DeleteOne(book).Exec(ctx)
+		if err := c.client.Book.DeleteOne(book).Exec(ctx); err == nil {
+			deleted = append(deleted, id)
+		}
+	}
+
+	if err := services.RemoveSearchDocuments(ctx, c.client, services.SearchTypeBook, deleted...); err != nil {
+		log.Printf("[Search] 删除图书索引失败: ids=%v err=%v", deleted, err)
 	}
 
 	ctx.JSON(http.StatusOK, gin.H{"message": "批量删除成功"})
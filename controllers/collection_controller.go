@@ -2,12 +2,14 @@ package controllers
 
 import (
 	"context"
+	"log"
 	"net/http"
 	"strconv"
 	"time"
 
 	"blog-go/ent"
 	"blog-go/ent/collection"
+	"blog-go/services"
 	"blog-go/utils"
 
 	"github.com/gin-gonic/gin"
@@ -59,6 +61,9 @@ func (c *CollectionController) CreateCollection(ctx *gin.Context) {
 		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
+	if err := services.IndexCollection(context.Background(), c.client, item); err != nil {
+		log.Printf("[Search] 更新收藏索引失败: id=%d err=%v", item.ID, err)
+	}
 	utils.RespondSuccess(ctx, item)
 }
 
@@ -105,6 +110,9 @@ func (c *CollectionController) UpdateCollection(ctx *gin.Context) {
 		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
+	if err := services.IndexCollection(context.Background(), c.client, item); err != nil {
+		log.Printf("[Search] 更新收藏索引失败: id=%d err=%v", item.ID, err)
+	}
 	utils.RespondSuccess(ctx, item)
 }
 
@@ -119,5 +127,8 @@ func (c *CollectionController) DeleteCollection(ctx *gin.Context) {
 		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
+	if err := services.RemoveSearchDocuments(context.Background(), c.client, services.SearchTypeCollection, id); err != nil {
+		log.Printf("[Search] 删除收藏索引失败: id=%d err=%v", id, err)
+	}
 	utils.RespondSuccess(ctx, gin.H{"message": "条目已删除"})
 }
@@ -2,10 +2,9 @@ package controllers
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
+	"database/sql"
+	"log"
 	"net/http"
-	"net/url"
 	"strconv"
 	"time"
 
@@ -13,30 +12,79 @@ import (
 	"blog-go/ent/comment"
 	"blog-go/ent/post"
 	"blog-go/ent/user"
+	"blog-go/services"
 	"blog-go/utils"
 
 	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt"
-	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/github"
 )
 
 type CommentController struct {
-	client            *ent.Client
-	githubOAuthConfig *oauth2.Config
+	client         *ent.Client
+	moderator      services.Moderator
+	spamClassifier *services.SpamClassifier
+	notifier       *services.Notifier
+	editSecret     string
+	editWindow     time.Duration
+	reactions      []string
 }
 
-func NewCommentController(client *ent.Client) *CommentController {
+func NewCommentController(client *ent.Client, notifier *services.Notifier) *CommentController {
+	moderator, err := services.NewModeratorFromEnv(client)
+	if err != nil {
+		// 审核配置有误时所有评论转人工审核
+		log.Printf("[Moderation] 评论审核配置错误，所有评论将转人工审核: %v", err)
+		moderator = services.NewChainModerator(services.ModerationHold)
+	}
+	editWindow, err := time.ParseDuration(utils.GetEnv("COMMENT_EDIT_WINDOW", "15m"))
+	if err != nil {
+		editWindow = 15 * time.Minute
+	}
 	return &CommentController{
-		client: client,
-		githubOAuthConfig: &oauth2.Config{
-			ClientID:     utils.GetEnv("GITHUB_CLIENT_ID", ""),
-			ClientSecret: utils.GetEnv("GITHUB_CLIENT_SECRET", ""),
-			RedirectURL:  utils.GetEnv("GITHUB_REDIRECT_URL", "http://localhost:3000/api/auth/github/callback"),
-			Scopes:       []string{"user:email"},
-			Endpoint:     github.Endpoint,
-		},
+		client:         client,
+		moderator:      moderator,
+		spamClassifier: services.NewSpamClassifier(client, 1),
+		notifier:       notifier,
+		editSecret:     utils.GetEnv("COMMENT_EDIT_SECRET", utils.GetEnv("JWT_SECRET", "your-secret-key")),
+		editWindow:     editWindow,
+		reactions:      parseReactionSet(utils.GetEnv("COMMENT_REACTIONS", defaultCommentReactions)),
+	}
+}
+
+// CommentDTO 公开接口返回的评论，不包含邮箱和审核原因、垃圾评分等审核信息
+type CommentDTO struct {
+	ID        int            `json:"id"`
+	ParentID  *int           `json:"parent_id,omitempty"`
+	Author    string         `json:"author"`
+	Website   string         `json:"website,omitempty"`
+	Avatar    string         `json:"avatar,omitempty"`
+	Content   string         `json:"content"`
+	Status    comment.Status `json:"status"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	EditedAt  *time.Time     `json:"edited_at,omitempty"`
+}
+
+func toCommentDTO(cm *ent.Comment) CommentDTO {
+	return CommentDTO{
+		ID:        cm.ID,
+		ParentID:  cm.ParentID,
+		Author:    cm.Author,
+		Website:   cm.Website,
+		Avatar:    cm.Avatar,
+		Content:   cm.Content,
+		Status:    cm.Status,
+		CreatedAt: cm.CreatedAt,
+		UpdatedAt: cm.UpdatedAt,
+		EditedAt:  cm.EditedAt,
+	}
+}
+
+func toCommentDTOs(comments []*ent.Comment) []CommentDTO {
+	list := make([]CommentDTO, 0, len(comments))
+	for _, cm := range comments {
+		list = append(list, toCommentDTO(cm))
 	}
+	return list
 }
 
 // GetComments 获取文章评论
@@ -47,35 +95,26 @@ func (c *CommentController) GetComments(ctx *gin.Context) {
 		return
 	}
 
-	// 检查文章是否存在
-	exists, err := c.client.Post.
-		Query().
-		Where(post.IDEQ(postID)).
-		Exist(context.Background())
-	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
-		return
-	}
-	if !exists {
-		utils.RespondError(ctx, http.StatusNotFound, "无效的文章ID")
+	// 检查文章是否存在，未发布的文章与文章详情一样只对能编辑的用户可见
+	if !requireVisiblePost(ctx, c.client, postID) {
 		return
 	}
 
-	// 获取评论
+	// 获取评论，sort 可选 newest（默认）、oldest、top（按表情数量）
 	comments, err := c.client.Comment.
 		Query().
 		Where(
 			comment.HasPostWith(post.IDEQ(postID)),
-			comment.ApprovedEQ(true),
+			comment.StatusEQ(comment.StatusApproved),
 		).
-		Order(ent.Desc(comment.FieldCreatedAt)).
+		Order(commentOrder(ctx.Query("sort"))...).
 		All(context.Background())
 	if err != nil {
 		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	utils.RespondSuccess(ctx, comments)
+	utils.RespondSuccess(ctx, toCommentDTOs(comments))
 }
 
 // AddComment 添加评论
@@ -139,33 +178,36 @@ func (c *CommentController) AddComment(ctx *gin.Context) {
 	}
 
 	// 如果是已登录用户
+	var u *ent.User
 	if username != "" {
-		u, err := c.client.User.Query().
+		u, err = c.client.User.Query().
 			Where(user.UsernameEQ(username)).
 			Only(context.Background())
 		if err == nil {
-			commentBuilder.SetUser(u)
-			// 如果是管理员或作者，自动审核评论
-			if u.Role == "admin" || p.Author == u.Username {
-				commentBuilder.SetApproved(true)
-			} else {
-				// 普通用户：百度内容安全自动审核
-				conclusion, err := utils.BaiduTextCensor(input.Content, utils.GetEnv("BAIDU_API_KEY", ""), utils.GetEnv("BAIDU_SECRET_KEY", ""))
-				autoApproved := false
-				if err == nil && conclusion == "合规" {
-					autoApproved = true
-				}
-				commentBuilder.SetApproved(autoApproved)
+			// 未验证邮箱的账号不能以登录身份评论
+			if u.EmailVerifiedAt == nil {
+				ctx.JSON(http.StatusForbidden, gin.H{"code": 1, "message": "请先验证邮箱后再评论", "data": nil})
+				return
 			}
+			commentBuilder.SetUser(u)
+		} else {
+			u = nil
 		}
-	} else {
-		// 匿名评论：百度内容安全自动审核
-		conclusion, err := utils.BaiduTextCensor(input.Content, utils.GetEnv("BAIDU_API_KEY", ""), utils.GetEnv("BAIDU_SECRET_KEY", ""))
-		autoApproved := false
-		if err == nil && conclusion == "合规" {
-			autoApproved = true
-		}
-		commentBuilder.SetApproved(autoApproved)
+	}
+
+	// 管理员或作者的评论自动通过，其余评论经过审核链
+	result := c.moderateComment(ctx, u, p, services.ModerationInput{
+		Content: input.Content,
+		Author:  input.Author,
+		Email:   input.Email,
+		Website: input.Website,
+	})
+	commentBuilder.
+		SetStatus(commentStatusForModeration(result.Action)).
+		SetModerationAction(comment.ModerationAction(result.Action)).
+		SetNillableSpamScore(result.Score)
+	if result.Reason != "" {
+		commentBuilder.SetModerationReason(result.Reason)
 	}
 
 	if input.ParentID != nil {
@@ -175,7 +217,7 @@ func (c *CommentController) AddComment(ctx *gin.Context) {
 			ctx.JSON(http.StatusBadRequest, gin.H{"code": 1, "message": "父评论不存在", "data": nil})
 			return
 		}
-		if depth >= 5 {
+		if depth >= services.MaxCommentDepth {
 			ctx.JSON(http.StatusBadRequest, gin.H{"code": 1, "message": "回复嵌套层级过深", "data": nil})
 			return
 		}
@@ -183,17 +225,27 @@ func (c *CommentController) AddComment(ctx *gin.Context) {
 	}
 
 	// 保存评论
-	comment, err := commentBuilder.Save(context.Background())
+	com, err := commentBuilder.Save(context.Background())
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"code": 1, "message": "添加评论失败: " + err.Error(), "data": nil})
 		return
 	}
 
 	msg := "评论已提交，等待审核"
-	if comment.Approved {
+	if com.Status == comment.StatusApproved {
+		c.notifier.NotifyComment(com.ID)
 		msg = "评论已发布"
-	}
-	ctx.JSON(http.StatusCreated, gin.H{"code": 0, "message": msg, "data": comment})
+	} else if com.Status == comment.StatusRejected {
+		msg = "评论未通过审核"
+	}
+	// 匿名评论者凭编辑令牌在编辑时限内修改评论
+	ctx.JSON(http.StatusCreated, gin.H{
+		"code":           0,
+		"message":        msg,
+		"data":           toCommentDTO(com),
+		"edit_token":     c.commentEditToken(com.ID),
+		"editable_until": com.CreatedAt.Add(c.editWindow),
+	})
 }
 
 // DeleteComment 删除评论
@@ -217,6 +269,7 @@ func (c *CommentController) DeleteComment(ctx *gin.Context) {
 		Query().
 		Where(comment.IDEQ(commentID)).
 		WithPost().
+		WithUser().
 		Only(context.Background())
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -236,20 +289,22 @@ func (c *CommentController) DeleteComment(ctx *gin.Context) {
 		return
 	}
 
-	// 检查权限：只有管理员、文章作者或评论作者可以删除评论
-	if u.Role != "admin" {
-		// 检查是否为文章作者
-		p := com.Edges.Post
+	// 检查权限：只有评论审核者、文章作者或评论作者可以删除评论
+	canModerate := userHasPermission(ctx, u, services.PermCommentModerate)
+	if !canModerate {
+		// 检查是否为文章创建者
 		isPostAuthor := false
-		if p != nil {
-			if p.Author == u.Username {
-				isPostAuthor = true
+		if p := com.Edges.Post; p != nil {
+			isPostAuthor, err = ownsPost(context.Background(), c.client, p.ID, u)
+			if err != nil {
+				ctx.JSON(http.StatusInternalServerError, gin.H{"code": 1, "message": err.Error(), "data": nil})
+				return
 			}
 		}
 
 		// 检查是否为评论作者
 		isCommentAuthor := false
-		if com.Edges.User != nil && com.Edges.User.Username == username {
+		if com.Edges.User != nil && com.Edges.User.ID == u.ID {
 			isCommentAuthor = true
 		}
 
@@ -259,6 +314,10 @@ func (c *CommentController) DeleteComment(ctx *gin.Context) {
 		}
 	}
 
+	if canModerate {
+		c.trainDeletedComment(ctx, com)
+	}
+
 	// 删除评论（级联删除所有子评论）
 	err = deleteCommentWithChildren(c.client, commentID)
 	if err != nil {
@@ -269,14 +328,8 @@ func (c *CommentController) DeleteComment(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, gin.H{"code": 0, "message": "评论已删除", "data": nil})
 }
 
-// ApproveComment 审核评论
-func (c *CommentController) ApproveComment(ctx *gin.Context) {
-	commentID, err := strconv.Atoi(ctx.Param("id"))
-	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "无效的评论ID"})
-		return
-	}
-
+// GetPendingComments 获取待审核评论
+func (c *CommentController) GetPendingComments(ctx *gin.Context) {
 	// 获取当前用户
 	usernameValue, exists := ctx.Get("username")
 	if !exists {
@@ -295,62 +348,22 @@ func (c *CommentController) ApproveComment(ctx *gin.Context) {
 		return
 	}
 
-	if u.Role != "admin" {
-		ctx.JSON(http.StatusForbidden, gin.H{"error": "只有管理员可以审核评论"})
-		return
-	}
-
-	// 审核评论
-	com, err := c.client.Comment.
-		UpdateOneID(commentID).
-		SetApproved(true).
-		SetUpdatedAt(time.Now()).
-		Save(context.Background())
-	if err != nil {
-		if ent.IsNotFound(err) {
-			ctx.JSON(http.StatusNotFound, gin.H{"error": "评论不存在"})
-			return
-		}
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "审核评论失败: " + err.Error()})
+	if !userHasPermission(ctx, u, services.PermCommentModerate) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "没有审核评论的权限"})
 		return
 	}
 
-	ctx.JSON(http.StatusOK, gin.H{
-		"id":       com.ID,
-		"approved": com.Approved,
-		"message":  "评论已审核通过",
-	})
-}
-
-// GetPendingComments 获取待审核评论
-func (c *CommentController) GetPendingComments(ctx *gin.Context) {
-	// 获取当前用户
-	usernameValue, exists := ctx.Get("username")
-	if !exists {
-		ctx.JSON(http.StatusUnauthorized, gin.H{"error": "未授权操作"})
-		return
-	}
-	username := usernameValue.(string)
-
-	// 检查用户是否为管理员
-	u, err := c.client.User.
-		Query().
-		Where(user.UsernameEQ(username)).
-		Only(context.Background())
+	filters, err := commentFilters(ctx)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-
-	if u.Role != "admin" {
-		ctx.JSON(http.StatusForbidden, gin.H{"error": "只有管理员可以查看待审核评论"})
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
 	// 获取所有待审核评论
 	comments, err := c.client.Comment.
 		Query().
-		Where(comment.ApprovedEQ(false)).
+		Where(comment.StatusEQ(comment.StatusPending)).
+		Where(filters...).
 		WithPost().
 		Order(ent.Desc(comment.FieldCreatedAt)).
 		All(context.Background())
@@ -387,221 +400,100 @@ func (c *CommentController) UploadCommentAvatar(ctx *gin.Context) {
 	utils.RespondSuccess(ctx, gin.H{"url": avatarURL})
 }
 
-// 获取所有评论（全局）
+// 获取所有评论（全局），支持按状态、文章、邮箱和时间范围筛选
+//
+// 没有审核权限的访客只能查看已通过的评论，不能按邮箱筛选，返回结果不包含邮箱和审核信息。
 func (c *CommentController) GetAllComments(ctx *gin.Context) {
-	comments, err := c.client.Comment.Query().Order(ent.Desc(comment.FieldCreatedAt)).All(context.Background())
-	if err != nil {
+	if _, err := loadCurrentUser(ctx, c.client); err != nil {
 		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
-	utils.RespondSuccess(ctx, comments)
-}
-
-// GitHubOAuthLogin 处理GitHub OAuth登录
-func (c *CommentController) GitHubOAuthLogin(ctx *gin.Context) {
-	returnUrl := ctx.Query("returnUrl")
-	if returnUrl == "" {
-		returnUrl = "http://localhost:3000/" // 默认首页
-	}
-	state := url.QueryEscape(returnUrl)
-	url := c.githubOAuthConfig.AuthCodeURL(state, oauth2.AccessTypeOffline)
-	ctx.Redirect(http.StatusTemporaryRedirect, url)
-}
-
-// GitHubOAuthCallback 处理GitHub OAuth回调
-func (c *CommentController) GitHubOAuthCallback(ctx *gin.Context) {
-	// 创建带超时的上下文，增加到30秒
-	timeoutCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
-	code := ctx.Query("code")
-	state := ctx.Query("state") // 这里的state就是returnUrl
-
-	// 添加重试机制
-	var token *oauth2.Token
-	var err error
-	maxRetries := 3
-	for i := 0; i < maxRetries; i++ {
-		token, err = c.githubOAuthConfig.Exchange(timeoutCtx, code)
-		if err == nil {
-			break
+	canModerate := hasPermission(ctx, services.PermCommentModerate)
+	if !canModerate {
+		if ctx.Query("email") != "" {
+			utils.RespondError(ctx, http.StatusForbidden, "没有权限按邮箱筛选评论")
+			return
 		}
-		if i < maxRetries-1 {
-			fmt.Printf("[GitHubOAuthCallback] 第%d次获取token失败，准备重试: %v\n", i+1, err)
-			time.Sleep(time.Second * time.Duration(i+1)) // 递增延迟
-			continue
+		if s := ctx.Query("status"); s != "" && s != string(comment.StatusApproved) {
+			utils.RespondError(ctx, http.StatusForbidden, "没有权限查看未通过审核的评论")
+			return
 		}
 	}
+
+	filters, err := commentFilters(ctx)
 	if err != nil {
-		fmt.Printf("[GitHubOAuthCallback] GitHub token exchange error after %d retries: %v\n", maxRetries, err)
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "获取GitHub token失败，请稍后重试"})
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
 		return
 	}
-
-	// 获取用户信息
-	client := c.githubOAuthConfig.Client(timeoutCtx, token)
-	var resp *http.Response
-	for i := 0; i < maxRetries; i++ {
-		resp, err = client.Get("https://api.github.com/user")
-		if err == nil {
-			break
-		}
-		if i < maxRetries-1 {
-			fmt.Printf("[GitHubOAuthCallback] 第%d次获取用户信息失败，准备重试: %v\n", i+1, err)
-			time.Sleep(time.Second * time.Duration(i+1))
-			continue
-		}
+	if !canModerate {
+		filters = append(filters, comment.StatusEQ(comment.StatusApproved))
 	}
-	if err != nil {
-		fmt.Printf("[GitHubOAuthCallback] 获取GitHub用户信息失败 after %d retries: %v\n", maxRetries, err)
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "获取GitHub用户信息失败，请稍后重试"})
-		return
+	if ctx.Query("status") == "" {
+		// 默认不返回回收站中的评论
+		filters = append(filters, comment.StatusNEQ(comment.StatusTrashed))
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		fmt.Printf("[GitHubOAuthCallback] GitHub API返回错误状态码: %d\n", resp.StatusCode)
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "GitHub API返回错误，请稍后重试"})
+	comments, err := c.client.Comment.Query().Where(filters...).Order(ent.Desc(comment.FieldCreatedAt)).All(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
-
-	var githubUser struct {
-		Login     string `json:"login"`
-		AvatarURL string `json:"avatar_url"`
-		Email     string `json:"email"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&githubUser); err != nil {
-		fmt.Printf("[GitHubOAuthCallback] 解析GitHub用户信息失败: %v\n", err)
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "解析GitHub用户信息失败，请稍后重试"})
+	if !canModerate {
+		utils.RespondSuccess(ctx, toCommentDTOs(comments))
 		return
 	}
+	utils.RespondSuccess(ctx, comments)
+}
 
-	// 如果邮箱为空，尝试获取邮箱
-	if githubUser.Email == "" {
-		var emailResp *http.Response
-		for i := 0; i < maxRetries; i++ {
-			emailResp, err = client.Get("https://api.github.com/user/emails")
-			if err == nil {
-				break
-			}
-			if i < maxRetries-1 {
-				fmt.Printf("[GitHubOAuthCallback] 第%d次获取GitHub邮箱失败，准备重试: %v\n", i+1, err)
-				time.Sleep(time.Second * time.Duration(i+1))
-				continue
-			}
-		}
-		if err != nil {
-			fmt.Printf("[GitHubOAuthCallback] 获取GitHub邮箱失败 after %d retries: %v\n", maxRetries, err)
-			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "获取GitHub邮箱失败，请稍后重试"})
-			return
-		}
-		defer emailResp.Body.Close()
-
-		if emailResp.StatusCode != http.StatusOK {
-			fmt.Printf("[GitHubOAuthCallback] GitHub邮箱API返回错误状态码: %d\n", emailResp.StatusCode)
-			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "GitHub邮箱API返回错误，请稍后重试"})
-			return
-		}
-
-		var emails []struct {
-			Email    string `json:"email"`
-			Primary  bool   `json:"primary"`
-			Verified bool   `json:"verified"`
-		}
-		if err := json.NewDecoder(emailResp.Body).Decode(&emails); err != nil {
-			fmt.Printf("[GitHubOAuthCallback] 解析GitHub邮箱失败: %v\n", err)
-			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "解析GitHub邮箱失败，请稍后重试"})
-			return
-		}
-
-		for _, email := range emails {
-			if email.Primary && email.Verified {
-				githubUser.Email = email.Email
-				break
-			}
-		}
-	}
+// commentDepthSQL 沿 parent_id 向上查找祖先，返回评论所在层级（根评论为0）
+const commentDepthSQL = `
+WITH RECURSIVE chain AS (
+	SELECT id, parent_id, 0 AS depth FROM comments WHERE id = $1
+	UNION ALL
+	SELECT c.id, c.parent_id, chain.depth + 1
+	FROM comments c JOIN chain ON c.id = chain.parent_id
+	WHERE chain.depth < $2
+)
+SELECT MAX(depth) FROM chain`
 
-	// 生成 JWT token，包含 username、email、avatar
-	jwtSecret := utils.GetEnv("JWT_SECRET", "your-secret-key")
-	claims := jwt.MapClaims{
-		"username": githubUser.Login,
-		"email":    githubUser.Email,
-		"avatar":   githubUser.AvatarURL,
-		"exp":      time.Now().Add(time.Hour * 24 * 7).Unix(),
+// 获取评论嵌套深度（单次递归查询）
+func getCommentDepth(client *ent.Client, parentID *int) (int, error) {
+	if parentID == nil {
+		return 0, nil
 	}
-	tokenObj := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := tokenObj.SignedString([]byte(jwtSecret))
+	rows, err := client.QueryContext(context.Background(), commentDepthSQL, *parentID, services.MaxCommentDepth)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "生成token失败，请稍后重试"})
-		return
+		return 0, err
 	}
-
-	// 设置HTTP-only cookie，Domain 改为 .toycon.cn
-	ctx.SetCookie(
-		"auth_token", // cookie名称
-		tokenString,  // token值
-		7*24*60*60,   // 过期时间：7天
-		"/",          // 路径
-		".toycon.cn", // 域名（允许所有子域共享）
-		true,         // 仅HTTPS
-		true,         // HTTP-only
-	)
-	// 追加 SameSite=None，Domain 也为 .toycon.cn
-	ctx.Writer.Header().Add("Set-Cookie", (&http.Cookie{
-		Name:     "auth_token",
-		Value:    tokenString,
-		Path:     "/",
-		Domain:   ".toycon.cn",
-		MaxAge:   7 * 24 * 60 * 60,
-		Secure:   true,
-		HttpOnly: true,
-		SameSite: http.SameSiteNoneMode,
-	}).String())
-
-	// 构建重定向URL
-	returnUrl := "http://localhost:3000/auth/callback"
-	if state != "" {
-		if u, err := url.QueryUnescape(state); err == nil {
-			returnUrl = u
+	defer rows.Close()
+	var depth sql.NullInt64
+	if rows.Next() {
+		if err := rows.Scan(&depth); err != nil {
+			return 0, err
 		}
 	}
-
-	// 重定向到前端回调页面
-	ctx.Redirect(http.StatusTemporaryRedirect, returnUrl)
-}
-
-// 递归获取评论嵌套深度
-func getCommentDepth(client *ent.Client, parentID *int) (int, error) {
-	depth := 0
-	currentID := parentID
-	for currentID != nil {
-		cmt, err := client.Comment.Get(context.Background(), *currentID)
-		if err != nil {
-			return depth, err
-		}
-		if cmt.ParentID == nil {
-			break
-		}
-		depth++
-		if depth >= 5 {
-			break
-		}
-		currentID = cmt.ParentID
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if !depth.Valid {
+		return 0, sql.ErrNoRows
 	}
-	return depth, nil
+	return int(depth.Int64), nil
 }
 
-// 递归删除评论及其所有子评论
+// 删除评论及其所有子评论（逐层收集子评论ID，查询次数与嵌套层级相关）
 func deleteCommentWithChildren(client *ent.Client, commentID int) error {
-	children, err := client.Comment.Query().Where(comment.ParentID(commentID)).All(context.Background())
-	if err != nil {
-		return err
-	}
-	for _, child := range children {
-		if err := deleteCommentWithChildren(client, child.ID); err != nil {
+	ids := []int{commentID}
+	level := []int{commentID}
+	for len(level) > 0 {
+		children, err := client.Comment.Query().
+			Where(comment.ParentIDIn(level...)).
+			IDs(context.Background())
+		if err != nil {
 			return err
 		}
+		ids = append(ids, children...)
+		level = children
 	}
-	return client.Comment.DeleteOneID(commentID).Exec(context.Background())
+	_, err := client.Comment.Delete().Where(comment.IDIn(ids...)).Exec(context.Background())
+	return err
 }
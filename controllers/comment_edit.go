@@ -0,0 +1,209 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/comment"
+	"blog-go/ent/commentrevision"
+	"blog-go/ent/user"
+	"blog-go/services"
+	"blog-go/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 评论编辑令牌的签名用途
+const commentEditPurpose = "comment-edit"
+
+// commentEditToken 生成评论的编辑令牌，创建评论时返回给评论者
+func (c *CommentController) commentEditToken(commentID int) string {
+	return utils.SignValue(c.editSecret, commentEditPurpose, strconv.Itoa(commentID))
+}
+
+// canEditComment 登录用户可编辑自己的评论，匿名评论者需持有该评论的编辑令牌
+func (c *CommentController) canEditComment(com *ent.Comment, u *ent.User, token string) bool {
+	if u != nil && com.Edges.User != nil && com.Edges.User.ID == u.ID {
+		return true
+	}
+	if token == "" {
+		return false
+	}
+	id, ok := utils.VerifySignedValue(c.editSecret, commentEditPurpose, token)
+	return ok && id == strconv.Itoa(com.ID)
+}
+
+// EditComment 评论者在编辑时限内修改自己的评论，修改后重新审核并保留历史版本
+func (c *CommentController) EditComment(ctx *gin.Context) {
+	commentID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的评论ID")
+		return
+	}
+
+	var input struct {
+		Content   string `json:"content" binding:"required"`
+		EditToken string `json:"edit_token"`
+	}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	com, err := c.client.Comment.Query().
+		Where(comment.IDEQ(commentID)).
+		WithPost().
+		WithUser().
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			utils.RespondError(ctx, http.StatusNotFound, "评论不存在")
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 获取当前登录的用户（如果有）
+	var u *ent.User
+	if username := ctx.GetString("username"); username != "" {
+		u, err = c.client.User.Query().
+			Where(user.UsernameEQ(username)).
+			Only(context.Background())
+		if err != nil && !ent.IsNotFound(err) {
+			utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+
+	// 与发表评论一致，未验证邮箱的账号不能以登录身份编辑评论
+	if u != nil && u.EmailVerifiedAt == nil {
+		utils.RespondError(ctx, http.StatusForbidden, "请先验证邮箱后再编辑评论")
+		return
+	}
+	if !c.canEditComment(com, u, input.EditToken) {
+		utils.RespondError(ctx, http.StatusForbidden, "没有权限编辑此评论")
+		return
+	}
+	if time.Since(com.CreatedAt) > c.editWindow {
+		utils.RespondError(ctx, http.StatusForbidden, "已超过评论编辑时限")
+		return
+	}
+	if com.Status != comment.StatusPending && com.Status != comment.StatusApproved {
+		utils.RespondError(ctx, http.StatusForbidden, "该评论当前不可编辑")
+		return
+	}
+	if input.Content == com.Content {
+		utils.RespondSuccess(ctx, toCommentDTO(com))
+		return
+	}
+
+	// 修改后的内容重新经过审核
+	result := c.moderateComment(ctx, u, com.Edges.Post, services.ModerationInput{
+		Content: input.Content,
+		Author:  com.Author,
+		Email:   com.Email,
+		Website: com.Website,
+	})
+	status := commentStatusForModeration(result.Action)
+
+	// 开启事务
+	tx, err := c.client.Tx(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 分类器按旧内容训练过的计数一并撤销，否则之后重新标记时会扣减从未加过的特征
+	if err := c.spamClassifier.Untrain(context.Background(), tx, com); err != nil {
+		tx.Rollback()
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	now := time.Now()
+	if err := tx.CommentRevision.Create().
+		SetCommentID(com.ID).
+		SetContent(com.Content).
+		SetCreatedAt(now).
+		Exec(context.Background()); err != nil {
+		tx.Rollback()
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	update := tx.Comment.UpdateOneID(com.ID).
+		SetContent(input.Content).
+		SetEditedAt(now).
+		SetUpdatedAt(now).
+		SetStatus(status).
+		SetModerationAction(comment.ModerationAction(result.Action)).
+		SetNillableSpamScore(result.Score).
+		ClearSpamLabel()
+	if status != com.Status {
+		update.SetPreviousStatus(comment.PreviousStatus(com.Status))
+	}
+	if result.Reason != "" {
+		update.SetModerationReason(result.Reason)
+	} else {
+		update.ClearModerationReason()
+	}
+	updated, err := update.Save(context.Background())
+	if err != nil {
+		tx.Rollback()
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 提交事务
+	if err := tx.Commit(); err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 待审核的评论修改后通过审核，与审核通过时一样发送通知
+	if com.Status == comment.StatusPending && updated.Status == comment.StatusApproved {
+		c.notifier.NotifyComment(updated.ID)
+	}
+
+	utils.RespondSuccess(ctx, toCommentDTO(updated))
+}
+
+// GetCommentRevisions 获取评论的编辑历史（仅管理员）
+func (c *CommentController) GetCommentRevisions(ctx *gin.Context) {
+	commentID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的评论ID")
+		return
+	}
+	if _, ok := c.requireCommentModerator(ctx); !ok {
+		return
+	}
+
+	com, err := c.client.Comment.Get(context.Background(), commentID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			utils.RespondError(ctx, http.StatusNotFound, "评论不存在")
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	revisions, err := c.client.CommentRevision.Query().
+		Where(commentrevision.HasCommentWith(comment.IDEQ(commentID))).
+		Order(ent.Desc(commentrevision.FieldCreatedAt)).
+		All(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	utils.RespondSuccess(ctx, gin.H{
+		"comment":   com,
+		"revisions": revisions,
+	})
+}
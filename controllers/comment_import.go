@@ -0,0 +1,87 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"blog-go/services"
+	"blog-go/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 导入文件大小上限
+const maxCommentImportSize = 64 << 20
+
+// ImportComments 从 Disqus、Waline 或 Twikoo 的导出文件导入评论（仅管理员）
+//
+// 导出文件通过表单字段 file 上传或直接作为请求体；format 指定来源格式，
+// mapping 为页面到文章ID的 JSON 映射（用于自动匹配失败的页面），dry_run=true 时只返回匹配结果。
+func (c *CommentController) ImportComments(ctx *gin.Context) {
+	if _, ok := c.requireCommentModerator(ctx); !ok {
+		return
+	}
+
+	// 必须在读取表单之前限制请求体大小，PostForm 会解析整个 multipart 请求体
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxCommentImportSize)
+	if strings.HasPrefix(ctx.ContentType(), "multipart/") {
+		if err := ctx.Request.ParseMultipartForm(32 << 20); err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				utils.RespondError(ctx, http.StatusRequestEntityTooLarge, "导入文件过大")
+				return
+			}
+			utils.RespondError(ctx, http.StatusBadRequest, "读取上传内容失败: "+err.Error())
+			return
+		}
+	}
+	format := services.ImportFormat(ctx.Query("format"))
+	if format == "" {
+		format = services.ImportFormat(ctx.PostForm("format"))
+	}
+	if format == "" {
+		utils.RespondError(ctx, http.StatusBadRequest, "请指定导入格式")
+		return
+	}
+
+	var body io.Reader = ctx.Request.Body
+	if file, err := ctx.FormFile("file"); err == nil {
+		f, err := file.Open()
+		if err != nil {
+			utils.RespondError(ctx, http.StatusBadRequest, "读取导入文件失败: "+err.Error())
+			return
+		}
+		defer f.Close()
+		body = f
+	}
+
+	var opts services.CommentImportOptions
+	if mapping := ctx.PostForm("mapping"); mapping != "" {
+		if err := json.Unmarshal([]byte(mapping), &opts.Mapping); err != nil {
+			utils.RespondError(ctx, http.StatusBadRequest, "无效的文章映射: "+err.Error())
+			return
+		}
+	}
+	dryRun := ctx.Query("dry_run")
+	if dryRun == "" {
+		dryRun = ctx.PostForm("dry_run")
+	}
+	opts.DryRun = dryRun == "true"
+
+	comments, err := services.ParseCommentExport(format, body)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "解析导入文件失败: "+err.Error())
+		return
+	}
+
+	report, err := services.ImportComments(ctx.Request.Context(), c.client, format, comments, opts)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "导入评论失败: "+err.Error())
+		return
+	}
+
+	utils.RespondSuccess(ctx, report)
+}
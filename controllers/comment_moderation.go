@@ -0,0 +1,363 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/comment"
+	"blog-go/ent/post"
+	"blog-go/ent/predicate"
+	"blog-go/ent/user"
+	"blog-go/services"
+	"blog-go/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 批量操作一次最多处理的评论数
+const maxBulkComments = 500
+
+// commentStatusForModeration 审核链结论对应的评论状态
+func commentStatusForModeration(action services.ModerationAction) comment.Status {
+	switch action {
+	case services.ModerationApprove:
+		return comment.StatusApproved
+	case services.ModerationReject:
+		return comment.StatusRejected
+	default:
+		return comment.StatusPending
+	}
+}
+
+// moderateComment 有审核权限的用户或文章创建者的评论自动通过，其余评论经过审核链
+func (c *CommentController) moderateComment(ctx *gin.Context, u *ent.User, p *ent.Post, input services.ModerationInput) services.ModerationResult {
+	if u != nil {
+		if userHasPermission(ctx, u, services.PermCommentModerate) {
+			return services.ModerationResult{Action: services.ModerationApprove, Reason: "管理员或作者评论"}
+		}
+		// 查询失败时按普通评论经过审核链
+		if owned, err := ownsPost(ctx.Request.Context(), c.client, p.ID, u); err == nil && owned {
+			return services.ModerationResult{Action: services.ModerationApprove, Reason: "管理员或作者评论"}
+		}
+	}
+	input.PostID = p.ID
+	input.IP = ctx.ClientIP()
+	result, err := c.moderator.Moderate(ctx.Request.Context(), input)
+	if err != nil {
+		return services.ModerationResult{Action: services.ModerationHold, Reason: "审核失败: " + err.Error()}
+	}
+	return result
+}
+
+// requireCommentModerator 检查当前用户是否有审核评论的权限，失败时已写入响应
+func (c *CommentController) requireCommentModerator(ctx *gin.Context) (*ent.User, bool) {
+	if u := currentUser(ctx); u != nil && userHasPermission(ctx, u, services.PermCommentModerate) {
+		return u, true
+	}
+	username := ctx.GetString("username")
+	if username == "" {
+		utils.RespondError(ctx, http.StatusUnauthorized, "未授权操作")
+		return nil, false
+	}
+	u, err := c.client.User.Query().
+		Where(user.UsernameEQ(username)).
+		Only(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return nil, false
+	}
+	if !userHasPermission(ctx, u, services.PermCommentModerate) {
+		utils.RespondError(ctx, http.StatusForbidden, "没有审核评论的权限")
+		return nil, false
+	}
+	return u, true
+}
+
+// transitionComments 批量变更评论状态，target 返回每条评论的目标状态
+//
+// 按 (原状态, 目标状态) 分组更新，原状态记录到 previous_status 以便撤销；
+// 审核通过会训练分类器并在首次通过时发送通知，标记垃圾会训练分类器。
+func (c *CommentController) transitionComments(ctx context.Context, comments []*ent.Comment, target func(*ent.Comment) comment.Status) ([]*ent.Comment, error) {
+	type transition struct{ from, to comment.Status }
+	groups := map[transition][]*ent.Comment{}
+	for _, com := range comments {
+		to := target(com)
+		if to == com.Status {
+			continue
+		}
+		t := transition{from: com.Status, to: to}
+		groups[t] = append(groups[t], com)
+	}
+
+	// 所有分组在同一事务中更新，批量操作要么全部生效，要么全部不生效
+	tx, err := c.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for t, list := range groups {
+		ids := make([]int, 0, len(list))
+		for _, com := range list {
+			ids = append(ids, com.ID)
+		}
+		// 条件更新，避免覆盖并发的状态变更
+		_, err := tx.Comment.Update().
+			Where(comment.IDIn(ids...), comment.StatusEQ(t.from)).
+			SetStatus(t.to).
+			SetPreviousStatus(comment.PreviousStatus(t.from)).
+			SetUpdatedAt(now).
+			Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	// 提交后再更新内存中的评论并发送通知、训练分类器
+	var changed []*ent.Comment
+	for t, list := range groups {
+		for _, com := range list {
+			from := comment.PreviousStatus(t.from)
+			com.Status = t.to
+			com.PreviousStatus = &from
+			com.UpdatedAt = now
+			changed = append(changed, com)
+		}
+	}
+
+	for _, com := range changed {
+		switch com.Status {
+		case comment.StatusApproved:
+			if *com.PreviousStatus == comment.PreviousStatusPending {
+				c.notifier.NotifyComment(com.ID)
+			}
+			if err := c.spamClassifier.Train(ctx, com, false); err != nil {
+				log.Printf("[Spam] 训练垃圾评论分类器失败: id=%d err=%v", com.ID, err)
+			}
+		case comment.StatusSpam:
+			if err := c.spamClassifier.Train(ctx, com, true); err != nil {
+				log.Printf("[Spam] 训练垃圾评论分类器失败: id=%d err=%v", com.ID, err)
+			}
+		}
+	}
+	return changed, nil
+}
+
+// restoreTarget 撤销上一次状态变更，没有记录时恢复为待审核
+func restoreTarget(com *ent.Comment) comment.Status {
+	if com.PreviousStatus != nil {
+		return comment.Status(*com.PreviousStatus)
+	}
+	return comment.StatusPending
+}
+
+// moderateOne 变更单条评论的状态
+func (c *CommentController) moderateOne(ctx *gin.Context, target func(*ent.Comment) comment.Status, message string) {
+	commentID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的评论ID")
+		return
+	}
+	if _, ok := c.requireCommentModerator(ctx); !ok {
+		return
+	}
+
+	com, err := c.client.Comment.Get(context.Background(), commentID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			utils.RespondError(ctx, http.StatusNotFound, "评论不存在")
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if _, err := c.transitionComments(context.Background(), []*ent.Comment{com}, target); err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "更新评论状态失败: "+err.Error())
+		return
+	}
+
+	utils.RespondSuccess(ctx, gin.H{
+		"id":              com.ID,
+		"status":          com.Status,
+		"previous_status": com.PreviousStatus,
+		"approved":        com.Status == comment.StatusApproved,
+		"message":         message,
+	})
+}
+
+func toStatus(status comment.Status) func(*ent.Comment) comment.Status {
+	return func(*ent.Comment) comment.Status { return status }
+}
+
+// ApproveComment 审核通过评论
+func (c *CommentController) ApproveComment(ctx *gin.Context) {
+	c.moderateOne(ctx, toStatus(comment.StatusApproved), "评论已审核通过")
+}
+
+// RejectComment 拒绝评论
+func (c *CommentController) RejectComment(ctx *gin.Context) {
+	c.moderateOne(ctx, toStatus(comment.StatusRejected), "评论已拒绝")
+}
+
+// MarkCommentSpam 将评论标记为垃圾评论（取消发布并训练分类器）
+func (c *CommentController) MarkCommentSpam(ctx *gin.Context) {
+	c.moderateOne(ctx, toStatus(comment.StatusSpam), "评论已标记为垃圾评论")
+}
+
+// TrashComment 将评论移入回收站
+func (c *CommentController) TrashComment(ctx *gin.Context) {
+	c.moderateOne(ctx, toStatus(comment.StatusTrashed), "评论已移入回收站")
+}
+
+// RestoreComment 撤销评论的上一次状态变更
+func (c *CommentController) RestoreComment(ctx *gin.Context) {
+	c.moderateOne(ctx, restoreTarget, "评论状态已恢复")
+}
+
+// BulkModerateComments 批量审核评论
+//
+// action 可选 approve、reject、spam、trash、restore（撤销上一次变更）和 delete（永久删除）。
+func (c *CommentController) BulkModerateComments(ctx *gin.Context) {
+	var input struct {
+		IDs    []int  `json:"ids" binding:"required"`
+		Action string `json:"action" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(input.IDs) == 0 || len(input.IDs) > maxBulkComments {
+		utils.RespondError(ctx, http.StatusBadRequest, "评论数量必须在1到"+strconv.Itoa(maxBulkComments)+"之间")
+		return
+	}
+
+	var target func(*ent.Comment) comment.Status
+	switch input.Action {
+	case "approve":
+		target = toStatus(comment.StatusApproved)
+	case "reject":
+		target = toStatus(comment.StatusRejected)
+	case "spam":
+		target = toStatus(comment.StatusSpam)
+	case "trash":
+		target = toStatus(comment.StatusTrashed)
+	case "restore":
+		target = restoreTarget
+	case "delete":
+	default:
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的操作")
+		return
+	}
+
+	if _, ok := c.requireCommentModerator(ctx); !ok {
+		return
+	}
+
+	comments, err := c.client.Comment.Query().
+		Where(comment.IDIn(input.IDs...)).
+		All(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if input.Action == "delete" {
+		for _, com := range comments {
+			c.trainDeletedComment(ctx, com)
+			if err := deleteCommentWithChildren(c.client, com.ID); err != nil {
+				utils.RespondError(ctx, http.StatusInternalServerError, "删除评论失败: "+err.Error())
+				return
+			}
+		}
+		utils.RespondSuccess(ctx, gin.H{"action": input.Action, "affected": len(comments)})
+		return
+	}
+
+	changed, err := c.transitionComments(context.Background(), comments, target)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "更新评论状态失败: "+err.Error())
+		return
+	}
+	ids := make([]int, 0, len(changed))
+	for _, com := range changed {
+		ids = append(ids, com.ID)
+	}
+	utils.RespondSuccess(ctx, gin.H{"action": input.Action, "affected": len(changed), "ids": ids})
+}
+
+// trainDeletedComment 审核者删除未通过审核的评论（或显式 ?spam=true）视为垃圾评论，用于训练分类器
+func (c *CommentController) trainDeletedComment(ctx *gin.Context, com *ent.Comment) {
+	if com.Status == comment.StatusApproved && ctx.Query("spam") != "true" {
+		return
+	}
+	if err := c.spamClassifier.Train(context.Background(), com, true); err != nil {
+		log.Printf("[Spam] 训练垃圾评论分类器失败: id=%d err=%v", com.ID, err)
+	}
+}
+
+// commentFilters 解析评论列表的筛选条件：status（可逗号分隔多个）、post_id、email、from、to
+func commentFilters(ctx *gin.Context) ([]predicate.Comment, error) {
+	var filters []predicate.Comment
+
+	if s := ctx.Query("status"); s != "" {
+		var statuses []comment.Status
+		for _, v := range strings.Split(s, ",") {
+			status := comment.Status(strings.TrimSpace(v))
+			if err := comment.StatusValidator(status); err != nil {
+				return nil, errors.New("无效的评论状态: " + v)
+			}
+			statuses = append(statuses, status)
+		}
+		filters = append(filters, comment.StatusIn(statuses...))
+	}
+	if s := ctx.Query("post_id"); s != "" {
+		postID, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, errors.New("无效的文章ID")
+		}
+		filters = append(filters, comment.HasPostWith(post.IDEQ(postID)))
+	}
+	if email := strings.TrimSpace(ctx.Query("email")); email != "" {
+		filters = append(filters, comment.EmailEqualFold(email))
+	}
+	if s := ctx.Query("from"); s != "" {
+		from, _, err := parseDateParam(s)
+		if err != nil {
+			return nil, errors.New("无效的开始时间")
+		}
+		filters = append(filters, comment.CreatedAtGTE(from))
+	}
+	if s := ctx.Query("to"); s != "" {
+		to, dateOnly, err := parseDateParam(s)
+		if err != nil {
+			return nil, errors.New("无效的结束时间")
+		}
+		// 只有日期时包含当天
+		if dateOnly {
+			to = to.AddDate(0, 0, 1)
+			filters = append(filters, comment.CreatedAtLT(to))
+		} else {
+			filters = append(filters, comment.CreatedAtLTE(to))
+		}
+	}
+	return filters, nil
+}
+
+// parseDateParam 解析 2006-01-02 或 RFC3339 格式的时间参数
+func parseDateParam(s string) (time.Time, bool, error) {
+	if t, err := time.ParseInLocation("2006-01-02", s, time.Local); err == nil {
+		return t, true, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	return t, false, err
+}
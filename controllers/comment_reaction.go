@@ -0,0 +1,276 @@
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/comment"
+	"blog-go/ent/commentreaction"
+	"blog-go/ent/user"
+	"blog-go/utils"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gin-gonic/gin"
+)
+
+// 默认可用的评论表情
+const defaultCommentReactions = "👍,❤️,😄,🎉,😕,👀"
+
+// parseReactionSet 解析 COMMENT_REACTIONS 配置的表情列表
+func parseReactionSet(s string) []string {
+	var set []string
+	for _, e := range strings.Split(s, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			set = append(set, e)
+		}
+	}
+	return set
+}
+
+// commentOrder 评论排序：newest（默认）、oldest 或 top（按表情数量）
+func commentOrder(sort string) []comment.OrderOption {
+	switch sort {
+	case "oldest":
+		return []comment.OrderOption{ent.Asc(comment.FieldCreatedAt), ent.Asc(comment.FieldID)}
+	case "top":
+		return []comment.OrderOption{
+			comment.ByReactionsCount(sql.OrderDesc()),
+			ent.Desc(comment.FieldCreatedAt),
+			ent.Asc(comment.FieldID),
+		}
+	default:
+		return []comment.OrderOption{ent.Desc(comment.FieldCreatedAt), ent.Asc(comment.FieldID)}
+	}
+}
+
+// reactionActor 当前表态者：登录用户按用户ID，匿名访客按IP和UA生成的指纹
+func (c *CommentController) reactionActor(ctx *gin.Context) (string, *ent.User, error) {
+	if username := ctx.GetString("username"); username != "" {
+		u, err := c.client.User.Query().
+			Where(user.UsernameEQ(username)).
+			Only(context.Background())
+		if err == nil {
+			return "user:" + strconv.Itoa(u.ID), u, nil
+		}
+		if !ent.IsNotFound(err) {
+			return "", nil, err
+		}
+	}
+	sum := sha256.Sum256([]byte(c.editSecret + "|" + ctx.ClientIP() + "|" + ctx.Request.UserAgent()))
+	return "anon:" + hex.EncodeToString(sum[:16]), nil, nil
+}
+
+// reactionSummary 统计评论的表情数量以及当前表态者的表情（每组评论一次分组查询）
+func (c *CommentController) reactionSummary(ctx context.Context, actor string, ids []int) (map[int]map[string]int, map[int]string, error) {
+	counts := map[int]map[string]int{}
+	mine := map[int]string{}
+	if len(ids) == 0 {
+		return counts, mine, nil
+	}
+
+	var rows []struct {
+		CommentID int    `json:"comment_id"`
+		Emoji     string `json:"emoji"`
+		Count     int    `json:"count"`
+	}
+	err := c.client.CommentReaction.Query().
+		Where(commentreaction.CommentIDIn(ids...)).
+		GroupBy(commentreaction.FieldCommentID, commentreaction.FieldEmoji).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, r := range rows {
+		if counts[r.CommentID] == nil {
+			counts[r.CommentID] = map[string]int{}
+		}
+		counts[r.CommentID][r.Emoji] = r.Count
+	}
+
+	if actor != "" {
+		own, err := c.client.CommentReaction.Query().
+			Where(
+				commentreaction.CommentIDIn(ids...),
+				commentreaction.ActorEQ(actor),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, r := range own {
+			mine[r.CommentID] = r.Emoji
+		}
+	}
+	return counts, mine, nil
+}
+
+// attachReactions 为评论树中的所有节点填充表情统计
+func (c *CommentController) attachReactions(ctx *gin.Context, roots []*CommentNode) error {
+	var all []*CommentNode
+	var walk func([]*CommentNode)
+	walk = func(nodes []*CommentNode) {
+		for _, n := range nodes {
+			all = append(all, n)
+			walk(n.Replies)
+		}
+	}
+	walk(roots)
+
+	ids := make([]int, 0, len(all))
+	for _, n := range all {
+		ids = append(ids, n.ID)
+	}
+	actor, _, err := c.reactionActor(ctx)
+	if err != nil {
+		return err
+	}
+	counts, mine, err := c.reactionSummary(context.Background(), actor, ids)
+	if err != nil {
+		return err
+	}
+	for _, n := range all {
+		if counts[n.ID] != nil {
+			n.Reactions = counts[n.ID]
+		}
+		n.MyReaction = mine[n.ID]
+	}
+	return nil
+}
+
+// getReactableComment 获取可以表态的评论（仅已公开的评论）
+func (c *CommentController) getReactableComment(ctx *gin.Context) (*ent.Comment, bool) {
+	commentID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的评论ID")
+		return nil, false
+	}
+	com, err := c.client.Comment.Query().
+		Where(comment.IDEQ(commentID), comment.StatusEQ(comment.StatusApproved)).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			utils.RespondError(ctx, http.StatusNotFound, "评论不存在")
+			return nil, false
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return nil, false
+	}
+	return com, true
+}
+
+// respondReactions 返回评论最新的表情统计
+func (c *CommentController) respondReactions(ctx *gin.Context, commentID int, actor string) {
+	counts, mine, err := c.reactionSummary(context.Background(), actor, []int{commentID})
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	reactions := counts[commentID]
+	if reactions == nil {
+		reactions = map[string]int{}
+	}
+	utils.RespondSuccess(ctx, gin.H{
+		"id":          commentID,
+		"reactions":   reactions,
+		"my_reaction": mine[commentID],
+	})
+}
+
+// SetCommentReaction 对评论表态，每人每条评论只保留一个表情（再次表态会替换）
+func (c *CommentController) SetCommentReaction(ctx *gin.Context) {
+	var input struct {
+		Emoji string `json:"emoji" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	allowed := false
+	for _, e := range c.reactions {
+		if e == input.Emoji {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		utils.RespondError(ctx, http.StatusBadRequest, "不支持的表情")
+		return
+	}
+
+	com, ok := c.getReactableComment(ctx)
+	if !ok {
+		return
+	}
+	actor, u, err := c.reactionActor(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	n, err := c.client.CommentReaction.Update().
+		Where(
+			commentreaction.CommentIDEQ(com.ID),
+			commentreaction.ActorEQ(actor),
+		).
+		SetEmoji(input.Emoji).
+		Save(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if n == 0 {
+		builder := c.client.CommentReaction.Create().
+			SetCommentID(com.ID).
+			SetEmoji(input.Emoji).
+			SetActor(actor).
+			SetCreatedAt(time.Now())
+		if u != nil {
+			builder.SetUser(u)
+		}
+		// 并发重复提交时唯一索引冲突，视为已表态
+		if err := builder.Exec(context.Background()); err != nil && !ent.IsConstraintError(err) {
+			utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+
+	c.respondReactions(ctx, com.ID, actor)
+}
+
+// RemoveCommentReaction 取消对评论的表态
+func (c *CommentController) RemoveCommentReaction(ctx *gin.Context) {
+	com, ok := c.getReactableComment(ctx)
+	if !ok {
+		return
+	}
+	actor, _, err := c.reactionActor(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	_, err = c.client.CommentReaction.Delete().
+		Where(
+			commentreaction.CommentIDEQ(com.ID),
+			commentreaction.ActorEQ(actor),
+		).
+		Exec(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	c.respondReactions(ctx, com.ID, actor)
+}
+
+// GetCommentReactionSet 获取可用的表情列表
+func (c *CommentController) GetCommentReactionSet(ctx *gin.Context) {
+	utils.RespondSuccess(ctx, c.reactions)
+}
@@ -0,0 +1,284 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/comment"
+	"blog-go/ent/post"
+	"blog-go/services"
+	"blog-go/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lib/pq"
+)
+
+// 评论树节点（不返回邮箱）
+type CommentNode struct {
+	ID         int            `json:"id"`
+	ParentID   *int           `json:"parent_id"`
+	Author     string         `json:"author"`
+	Website    string         `json:"website,omitempty"`
+	Avatar     string         `json:"avatar,omitempty"`
+	Content    string         `json:"content"`
+	CreatedAt  time.Time      `json:"created_at"`
+	EditedAt   *time.Time     `json:"edited_at,omitempty"`
+	Depth      int            `json:"depth"`
+	ReplyCount int            `json:"reply_count"`
+	Replies    []*CommentNode `json:"replies"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+	Reactions  map[string]int `json:"reactions"`
+	MyReaction string         `json:"my_reaction,omitempty"`
+}
+
+func toCommentNode(cm *ent.Comment, depth int) *CommentNode {
+	return &CommentNode{
+		ID:        cm.ID,
+		ParentID:  cm.ParentID,
+		Author:    cm.Author,
+		Website:   cm.Website,
+		Avatar:    cm.Avatar,
+		Content:   cm.Content,
+		CreatedAt: cm.CreatedAt,
+		EditedAt:  cm.EditedAt,
+		Depth:     depth,
+		Replies:   []*CommentNode{},
+		Reactions: map[string]int{},
+	}
+}
+
+// childWindowSQL 按父评论分组，每组取前 $2 条已审核回复，并返回每组回复总数
+const childWindowSQL = `
+SELECT id, parent_id, total FROM (
+	SELECT id, parent_id,
+		ROW_NUMBER() OVER (PARTITION BY parent_id ORDER BY id) AS rn,
+		COUNT(*) OVER (PARTITION BY parent_id) AS total
+	FROM comments
+	WHERE status = 'approved' AND parent_id = ANY($1)
+) t
+WHERE rn <= $2`
+
+// expandCommentTree 逐层加载回复，每层固定两次查询，查询次数不超过 2*MaxCommentDepth
+//
+// 每个节点最多加载 replyLimit 条回复，剩余回复通过 NextCursor 调用 GetCommentReplies 继续加载。
+func (c *CommentController) expandCommentTree(ctx context.Context, level []*CommentNode, replyLimit int) error {
+	for len(level) > 0 && level[0].Depth < services.MaxCommentDepth {
+		byID := make(map[int]*CommentNode, len(level))
+		parentIDs := make([]int64, 0, len(level))
+		for _, n := range level {
+			byID[n.ID] = n
+			parentIDs = append(parentIDs, int64(n.ID))
+		}
+
+		rows, err := c.client.QueryContext(ctx, childWindowSQL, pq.Array(parentIDs), replyLimit)
+		if err != nil {
+			return err
+		}
+		var childIDs []int
+		for rows.Next() {
+			var id, parentID, total int
+			if err := rows.Scan(&id, &parentID, &total); err != nil {
+				rows.Close()
+				return err
+			}
+			childIDs = append(childIDs, id)
+			byID[parentID].ReplyCount = total
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		if len(childIDs) == 0 {
+			return nil
+		}
+
+		// 两次查询之间状态可能变化，只取仍然已审核的回复
+		children, err := c.client.Comment.Query().
+			Where(comment.IDIn(childIDs...), comment.StatusEQ(comment.StatusApproved)).
+			Order(ent.Asc(comment.FieldID)).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		next := make([]*CommentNode, 0, len(children))
+		for _, cm := range children {
+			parent := byID[*cm.ParentID]
+			node := toCommentNode(cm, parent.Depth+1)
+			parent.Replies = append(parent.Replies, node)
+			next = append(next, node)
+		}
+		for _, n := range level {
+			if len(n.Replies) > 0 && len(n.Replies) < n.ReplyCount {
+				n.NextCursor = strconv.Itoa(n.Replies[len(n.Replies)-1].ID)
+			}
+		}
+		level = next
+	}
+	return nil
+}
+
+// parseReplyLimit 每个评论下默认加载的回复数量
+func parseReplyLimit(ctx *gin.Context) int {
+	replies, _ := strconv.Atoi(ctx.DefaultQuery("replies", "3"))
+	if replies < 1 || replies > 50 {
+		replies = 3
+	}
+	return replies
+}
+
+// GetCommentTree 获取文章评论树（根评论分页，每个评论下加载有限条回复）
+func (c *CommentController) GetCommentTree(ctx *gin.Context) {
+	postID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的文章ID")
+		return
+	}
+
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if page < 1 {
+		page = 1
+	}
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if limit < 1 || limit > 50 {
+		limit = 10
+	}
+	replyLimit := parseReplyLimit(ctx)
+
+	// 未发布的文章与文章详情一样只对能编辑的用户可见
+	if !requireVisiblePost(ctx, c.client, postID) {
+		return
+	}
+
+	query := c.client.Comment.Query().
+		Where(
+			comment.HasPostWith(post.IDEQ(postID)),
+			comment.ParentIDIsNil(),
+			comment.StatusEQ(comment.StatusApproved),
+		)
+
+	total, err := query.Clone().Count(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	roots, err := query.
+		Order(commentOrder(ctx.Query("sort"))...).
+		Offset((page - 1) * limit).
+		Limit(limit).
+		All(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	nodes := make([]*CommentNode, 0, len(roots))
+	for _, cm := range roots {
+		nodes = append(nodes, toCommentNode(cm, 0))
+	}
+	if err := c.expandCommentTree(context.Background(), nodes, replyLimit); err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := c.attachReactions(ctx, nodes); err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	utils.RespondSuccess(ctx, gin.H{
+		"comments": nodes,
+		"total":    total,
+		"page":     page,
+		"limit":    limit,
+	})
+}
+
+// GetCommentReplies 继续加载某条评论的回复，cursor 为上次返回的 next_cursor
+func (c *CommentController) GetCommentReplies(ctx *gin.Context) {
+	commentID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的评论ID")
+		return
+	}
+	after := 0
+	if cursor := ctx.Query("cursor"); cursor != "" {
+		if after, err = strconv.Atoi(cursor); err != nil {
+			utils.RespondError(ctx, http.StatusBadRequest, "无效的游标")
+			return
+		}
+	}
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if limit < 1 || limit > 50 {
+		limit = 10
+	}
+	replyLimit := parseReplyLimit(ctx)
+
+	parent, err := c.client.Comment.Query().
+		Where(comment.IDEQ(commentID), comment.StatusEQ(comment.StatusApproved)).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			utils.RespondError(ctx, http.StatusNotFound, "评论不存在")
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	postID, err := parent.QueryPost().OnlyID(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if !requireVisiblePost(ctx, c.client, postID) {
+		return
+	}
+
+	depth, err := getCommentDepth(c.client, &parent.ID)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 多取一条用于判断是否还有更多回复
+	replies, err := c.client.Comment.Query().
+		Where(
+			comment.ParentIDEQ(parent.ID),
+			comment.StatusEQ(comment.StatusApproved),
+			comment.IDGT(after),
+		).
+		Order(ent.Asc(comment.FieldID)).
+		Limit(limit + 1).
+		All(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	nextCursor := ""
+	if len(replies) > limit {
+		replies = replies[:limit]
+		nextCursor = strconv.Itoa(replies[len(replies)-1].ID)
+	}
+
+	nodes := make([]*CommentNode, 0, len(replies))
+	for _, cm := range replies {
+		nodes = append(nodes, toCommentNode(cm, depth+1))
+	}
+	if err := c.expandCommentTree(context.Background(), nodes, replyLimit); err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := c.attachReactions(ctx, nodes); err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	utils.RespondSuccess(ctx, gin.H{
+		"replies":     nodes,
+		"next_cursor": nextCursor,
+	})
+}
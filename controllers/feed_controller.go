@@ -0,0 +1,446 @@
+package controllers
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/post"
+	"blog-go/ent/tag"
+	"blog-go/services"
+	"blog-go/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type FeedController struct {
+	client      *ent.Client
+	siteURL     string
+	title       string
+	description string
+	limit       int
+	fullContent bool
+}
+
+func NewFeedController(client *ent.Client) *FeedController {
+	limit, err := strconv.Atoi(utils.GetEnv("FEED_LIMIT", "20"))
+	if err != nil || limit < 1 {
+		limit = 20
+	}
+	return &FeedController{
+		client:      client,
+		siteURL:     strings.TrimRight(utils.GetEnv("SITE_URL", "http://localhost:3000"), "/"),
+		title:       utils.GetEnv("SITE_TITLE", "Blog"),
+		description: utils.GetEnv("SITE_DESCRIPTION", ""),
+		limit:       limit,
+		fullContent: utils.GetEnv("FEED_MODE", "excerpt") == "full",
+	}
+}
+
+// feedData 生成订阅源所需的数据
+type feedData struct {
+	title       string
+	link        string
+	selfURL     string
+	description string
+	updated     time.Time
+	full        bool
+	posts       []*ent.Post
+	tag         *ent.Tag
+}
+
+// RSS 2.0 结构
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	Content string     `xml:"xmlns:content,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	AtomLink      rssLink   `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string     `xml:"title"`
+	Link        string     `xml:"link"`
+	GUID        rssGUID    `xml:"guid"`
+	PubDate     string     `xml:"pubDate,omitempty"`
+	Author      string     `xml:"author,omitempty"`
+	Categories  []string   `xml:"category"`
+	Description string     `xml:"description"`
+	Content     *cdataText `xml:"content:encoded,omitempty"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type cdataText struct {
+	Value string `xml:",cdata"`
+}
+
+// Atom 结构
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	ID       string      `xml:"id"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntry struct {
+	Title      string         `xml:"title"`
+	ID         string         `xml:"id"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published,omitempty"`
+	Updated    string         `xml:"updated"`
+	Author     atomAuthor     `xml:"author"`
+	Categories []atomCategory `xml:"category"`
+	Summary    atomText       `xml:"summary"`
+	Content    *atomText      `xml:"content,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// JSON Feed 1.1 结构
+type jsonFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url"`
+	FeedURL     string         `json:"feed_url"`
+	Description string         `json:"description,omitempty"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonFeedItem struct {
+	ID            string           `json:"id"`
+	URL           string           `json:"url"`
+	Title         string           `json:"title"`
+	Summary       string           `json:"summary,omitempty"`
+	ContentHTML   string           `json:"content_html,omitempty"`
+	ContentText   string           `json:"content_text,omitempty"`
+	Image         string           `json:"image,omitempty"`
+	DatePublished string           `json:"date_published,omitempty"`
+	DateModified  string           `json:"date_modified,omitempty"`
+	Authors       []jsonFeedAuthor `json:"authors,omitempty"`
+	Tags          []string         `json:"tags,omitempty"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+// RSS 全站RSS 2.0订阅
+func (c *FeedController) RSS(ctx *gin.Context) {
+	c.serveFeed(ctx, "", c.renderRSS, "application/rss+xml; charset=utf-8")
+}
+
+// Atom 全站Atom订阅
+func (c *FeedController) Atom(ctx *gin.Context) {
+	c.serveFeed(ctx, "", c.renderAtom, "application/atom+xml; charset=utf-8")
+}
+
+// JSONFeed 全站JSON Feed订阅
+func (c *FeedController) JSONFeed(ctx *gin.Context) {
+	c.serveFeed(ctx, "", c.renderJSON, "application/feed+json; charset=utf-8")
+}
+
+// TagRSS 标签RSS 2.0订阅
+func (c *FeedController) TagRSS(ctx *gin.Context) {
+	c.serveFeed(ctx, ctx.Param("slug"), c.renderRSS, "application/rss+xml; charset=utf-8")
+}
+
+// TagAtom 标签Atom订阅
+func (c *FeedController) TagAtom(ctx *gin.Context) {
+	c.serveFeed(ctx, ctx.Param("slug"), c.renderAtom, "application/atom+xml; charset=utf-8")
+}
+
+// TagJSONFeed 标签JSON Feed订阅
+func (c *FeedController) TagJSONFeed(ctx *gin.Context) {
+	c.serveFeed(ctx, ctx.Param("slug"), c.renderJSON, "application/feed+json; charset=utf-8")
+}
+
+// serveFeed 查询文章、处理条件请求并输出订阅源
+func (c *FeedController) serveFeed(ctx *gin.Context, tagSlug string, render func(*feedData) ([]byte, error), contentType string) {
+	data := &feedData{
+		title:       c.title,
+		link:        c.siteURL + "/",
+		selfURL:     c.siteURL + ctx.Request.URL.Path,
+		description: c.description,
+		full:        c.fullContent,
+	}
+	// ?mode=full 输出全文，?mode=excerpt 只输出摘要
+	switch ctx.Query("mode") {
+	case "full":
+		data.full = true
+	case "excerpt":
+		data.full = false
+	}
+	if data.full {
+		data.selfURL += "?mode=full"
+	}
+
+	query := c.client.Post.Query().
+		Where(post.PublishedEQ(true))
+
+	if tagSlug != "" {
+		t, err := c.client.Tag.Query().Where(tag.SlugEQ(tagSlug)).Only(context.Background())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				utils.RespondError(ctx, http.StatusNotFound, "标签不存在")
+				return
+			}
+			utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+			return
+		}
+		data.tag = t
+		data.title = c.title + " - " + t.Name
+		data.link = c.siteURL + "/tags/" + url.PathEscape(t.Slug)
+		query = query.Where(post.HasTagsWith(tag.IDEQ(t.ID)))
+	}
+
+	posts, err := query.
+		WithTags().
+		Order(ent.Desc(post.FieldPublishedAt), ent.Desc(post.FieldCreatedAt)).
+		Limit(c.limit).
+		All(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	data.posts = posts
+
+	// 以最近的更新时间作为订阅源更新时间
+	for _, p := range posts {
+		if t := services.PostLastModified(p); t.After(data.updated) {
+			data.updated = t
+		}
+	}
+	if data.updated.IsZero() {
+		data.updated = time.Now()
+	}
+	data.updated = data.updated.UTC().Truncate(time.Second)
+
+	// 条件请求：ETag 由文章ID、更新时间和输出模式计算
+	h := sha1.New()
+	fmt.Fprintf(h, "%s|%s|%t", ctx.Request.URL.Path, contentType, data.full)
+	for _, p := range posts {
+		fmt.Fprintf(h, "|%d:%d", p.ID, services.PostLastModified(p).Unix())
+	}
+	etag := `"` + hex.EncodeToString(h.Sum(nil)) + `"`
+	lastModified := data.updated.Format(http.TimeFormat)
+
+	ctx.Header("ETag", etag)
+	ctx.Header("Last-Modified", lastModified)
+	ctx.Header("Cache-Control", "public, max-age=300")
+
+	if match := ctx.GetHeader("If-None-Match"); match != "" {
+		if match == etag || match == "*" {
+			ctx.Status(http.StatusNotModified)
+			return
+		}
+	} else if since := ctx.GetHeader("If-Modified-Since"); since != "" {
+		if t, err := http.ParseTime(since); err == nil && !data.updated.After(t) {
+			ctx.Status(http.StatusNotModified)
+			return
+		}
+	}
+
+	body, err := render(data)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "生成订阅源失败: "+err.Error())
+		return
+	}
+	ctx.Data(http.StatusOK, contentType, body)
+}
+
+func (c *FeedController) renderRSS(data *feedData) ([]byte, error) {
+	feed := rssFeed{
+		Version: "2.0",
+		Atom:    "http://www.w3.org/2005/Atom",
+		Content: "http://purl.org/rss/1.0/modules/content/",
+		Channel: rssChannel{
+			Title:         data.title,
+			Link:          data.link,
+			Description:   data.description,
+			AtomLink:      rssLink{Href: data.selfURL, Rel: "self", Type: "application/rss+xml"},
+			LastBuildDate: data.updated.Format(time.RFC1123Z),
+		},
+	}
+	for _, p := range data.posts {
+		link := c.postURL(p)
+		item := rssItem{
+			Title:       p.Title,
+			Link:        link,
+			GUID:        rssGUID{IsPermaLink: true, Value: link},
+			Categories:  feedTagNames(p),
+			Description: p.Excerpt,
+		}
+		if p.PublishedAt != nil {
+			item.PubDate = p.PublishedAt.Format(time.RFC1123Z)
+		}
+		if data.full {
+			item.Content = &cdataText{Value: c.postHTML(p)}
+		}
+		feed.Channel.Items = append(feed.Channel.Items, item)
+	}
+	return marshalXML(feed)
+}
+
+func (c *FeedController) renderAtom(data *feedData) ([]byte, error) {
+	feed := atomFeed{
+		Title:    data.title,
+		Subtitle: data.description,
+		ID:       data.link,
+		Updated:  data.updated.Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: data.link, Rel: "alternate", Type: "text/html"},
+			{Href: data.selfURL, Rel: "self", Type: "application/atom+xml"},
+		},
+	}
+	for _, p := range data.posts {
+		link := c.postURL(p)
+		entry := atomEntry{
+			Title:   p.Title,
+			ID:      link,
+			Link:    atomLink{Href: link, Rel: "alternate", Type: "text/html"},
+			Updated: services.PostLastModified(p).UTC().Format(time.RFC3339),
+			Author:  atomAuthor{Name: p.Author},
+			Summary: atomText{Type: "text", Value: p.Excerpt},
+		}
+		if p.PublishedAt != nil {
+			entry.Published = p.PublishedAt.UTC().Format(time.RFC3339)
+		}
+		for _, name := range feedTagNames(p) {
+			entry.Categories = append(entry.Categories, atomCategory{Term: name})
+		}
+		if data.full {
+			entry.Content = &atomText{Type: "html", Value: c.postHTML(p)}
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+	return marshalXML(feed)
+}
+
+func (c *FeedController) renderJSON(data *feedData) ([]byte, error) {
+	feed := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       data.title,
+		HomePageURL: data.link,
+		FeedURL:     data.selfURL,
+		Description: data.description,
+		Items:       []jsonFeedItem{},
+	}
+	for _, p := range data.posts {
+		link := c.postURL(p)
+		item := jsonFeedItem{
+			ID:           link,
+			URL:          link,
+			Title:        p.Title,
+			Summary:      p.Excerpt,
+			Image:        c.absoluteURL(p.CoverImage),
+			DateModified: services.PostLastModified(p).UTC().Format(time.RFC3339),
+			Authors:      []jsonFeedAuthor{{Name: p.Author}},
+			Tags:         feedTagNames(p),
+		}
+		if p.PublishedAt != nil {
+			item.DatePublished = p.PublishedAt.UTC().Format(time.RFC3339)
+		}
+		if data.full {
+			item.ContentHTML = c.postHTML(p)
+		} else {
+			item.ContentText = p.Excerpt
+		}
+		feed.Items = append(feed.Items, item)
+	}
+	return json.MarshalIndent(feed, "", "  ")
+}
+
+// postURL 文章的前端绝对地址，优先使用slug
+func (c *FeedController) postURL(p *ent.Post) string {
+	return c.siteURL + services.PostPath(p)
+}
+
+// postHTML 文章HTML，站内相对链接转换为绝对地址
+func (c *FeedController) postHTML(p *ent.Post) string {
+	html := ""
+	if p.ContentHTML != nil {
+		html = *p.ContentHTML
+	} else if rendered, _, err := utils.RenderMarkdown(p.Content); err == nil {
+		html = rendered
+	}
+	// 协议相对地址（//cdn...）已经是完整地址，原样保留；Replacer 按参数顺序优先匹配
+	replacer := strings.NewReplacer(
+		`src="//`, `src="//`,
+		`href="//`, `href="//`,
+		`src="/`, `src="`+c.siteURL+"/",
+		`href="/`, `href="`+c.siteURL+"/",
+	)
+	return replacer.Replace(html)
+}
+
+// absoluteURL 将站内相对地址转换为绝对地址
+func (c *FeedController) absoluteURL(u string) string {
+	if u == "" || strings.HasPrefix(u, "http://") || strings.HasPrefix(u, "https://") || strings.HasPrefix(u, "//") {
+		return u
+	}
+	return c.siteURL + "/" + strings.TrimLeft(u, "/")
+}
+
+func feedTagNames(p *ent.Post) []string {
+	names := make([]string, 0, len(p.Edges.Tags))
+	for _, t := range p.Edges.Tags {
+		names = append(names, t.Name)
+	}
+	return names
+}
+
+func marshalXML(v any) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
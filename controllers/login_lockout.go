@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// respondLoginLocked 账号或 IP 处于锁定期时的响应，Retry-After 为剩余秒数
+func respondLoginLocked(ctx *gin.Context, d time.Duration) {
+	seconds := int(math.Ceil(d.Seconds()))
+	ctx.Header("Retry-After", strconv.Itoa(seconds))
+	wait := strconv.Itoa(int(math.Ceil(d.Minutes()))) + " 分钟"
+	if seconds < 60 {
+		wait = strconv.Itoa(seconds) + " 秒"
+	}
+	utils.RespondError(ctx, http.StatusTooManyRequests, "登录失败次数过多，请 "+wait+"后再试")
+}
+
+// GetLoginLockouts 查看当前因登录失败被锁定的账号和 IP（管理员）
+func (c *UserController) GetLoginLockouts(ctx *gin.Context) {
+	users, err := c.loginGuard.LockedAccounts(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "获取锁定记录失败")
+		return
+	}
+	accounts := make([]gin.H, 0, len(users))
+	for _, u := range users {
+		accounts = append(accounts, gin.H{
+			"id":                   u.ID,
+			"username":             u.Username,
+			"email":                u.Email,
+			"failed_login_count":   u.FailedLoginCount,
+			"last_failed_login_at": u.LastFailedLoginAt,
+			"locked_until":         u.LockedUntil,
+		})
+	}
+
+	ips := make([]gin.H, 0)
+	for _, b := range c.loginGuard.BlockedIPs() {
+		ips = append(ips, gin.H{
+			"ip":                   b.Key,
+			"failed_login_count":   b.Failures,
+			"last_failed_login_at": b.LastFailure,
+			"locked_until":         b.BlockedUntil,
+		})
+	}
+	utils.RespondSuccess(ctx, gin.H{"accounts": accounts, "ips": ips})
+}
+
+// UnlockUser 解除账号的登录锁定并清除失败计数（管理员）
+func (c *UserController) UnlockUser(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的用户ID")
+		return
+	}
+	if err := c.loginGuard.UnlockAccount(context.Background(), id); err != nil {
+		if ent.IsNotFound(err) {
+			utils.RespondError(ctx, http.StatusNotFound, "用户不存在")
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, "解除锁定失败")
+		return
+	}
+	utils.RespondSuccess(ctx, gin.H{"message": "已解除锁定"})
+}
+
+// UnlockIP 解除 IP 的登录锁定（管理员）
+func (c *UserController) UnlockIP(ctx *gin.Context) {
+	ip := net.ParseIP(ctx.Param("ip"))
+	if ip == nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的IP地址")
+		return
+	}
+	if !c.loginGuard.UnlockIP(ip.String()) {
+		utils.RespondError(ctx, http.StatusNotFound, "该IP没有登录失败记录")
+		return
+	}
+	utils.RespondSuccess(ctx, gin.H{"message": "已解除锁定"})
+}
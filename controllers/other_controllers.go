@@ -74,12 +74,6 @@ func DeleteComment(db *sql.DB) gin.HandlerFunc {
 }
 
 // 用户相关控制器
-func RegisterUser(db *sql.DB) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		c.JSON(http.StatusCreated, gin.H{"message": "用户注册"})
-	}
-}
-
 func LoginUser(db *sql.DB) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{"message": "用户登录", "token": "sample-jwt-token"})
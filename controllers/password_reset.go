@@ -0,0 +1,207 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/accesstoken"
+	"blog-go/ent/passwordreset"
+	"blog-go/ent/user"
+	"blog-go/utils"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var errInvalidResetToken = errors.New("重置链接无效或已过期")
+
+// ForgotPassword 申请重置密码，向注册邮箱发送一次性的重置链接
+//
+// 无论邮箱是否注册都返回相同结果，避免被用来探测账号；按邮箱和 IP 限制申请频率。
+func (c *UserController) ForgotPassword(ctx *gin.Context) {
+	var input struct {
+		Email string `json:"email" binding:"required,email"`
+	}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	email := strings.ToLower(strings.TrimSpace(input.Email))
+
+	if !c.forgotByIP.Allow(ctx.ClientIP()) || !c.forgotByEmail.Allow(email) {
+		utils.RespondError(ctx, http.StatusTooManyRequests, "请求过于频繁，请稍后再试")
+		return
+	}
+
+	sent := gin.H{"message": "如果该邮箱已注册，重置密码邮件已发送"}
+	u, err := c.client.User.Query().
+		Where(user.EmailEqualFold(email)).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			utils.RespondSuccess(ctx, sent)
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, "数据库查询错误")
+		return
+	}
+	// 第三方登录生成的占位邮箱无法收信
+	if strings.HasSuffix(u.Email, ".invalid") {
+		utils.RespondSuccess(ctx, sent)
+		return
+	}
+
+	token, err := utils.RandomToken(32)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "生成重置令牌失败")
+		return
+	}
+	now := time.Now()
+	if err := c.client.PasswordReset.Create().
+		SetTokenHash(utils.HashToken(token)).
+		SetExpiresAt(now.Add(c.resetTTL)).
+		SetIP(ctx.ClientIP()).
+		SetUser(u).
+		SetCreatedAt(now).
+		Exec(context.Background()); err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "生成重置令牌失败")
+		return
+	}
+
+	if err := c.notifier.SendPasswordReset(u, token, c.resetTTL); err != nil {
+		log.Printf("[PasswordReset] 发送重置邮件失败: %v", err)
+	}
+	utils.RespondSuccess(ctx, sent)
+}
+
+// ResetPassword 使用重置令牌设置新密码
+//
+// 令牌只能使用一次，成功后该用户的其他重置令牌和所有已登录的会话都会失效。
+func (c *UserController) ResetPassword(ctx *gin.Context) {
+	if !c.resetByIP.Allow(ctx.ClientIP()) {
+		utils.RespondError(ctx, http.StatusTooManyRequests, "请求过于频繁，请稍后再试")
+		return
+	}
+
+	var input struct {
+		Token    string `json:"token" binding:"required"`
+		Password string `json:"password" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(input.Password) < minPasswordLength {
+		utils.RespondError(ctx, http.StatusBadRequest, "密码长度不能少于"+strconv.Itoa(minPasswordLength)+"位")
+		return
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "密码加密失败")
+		return
+	}
+
+	uid, err := c.consumePasswordReset(context.Background(), input.Token, string(hashedPassword))
+	if err != nil {
+		if errors.Is(err, errInvalidResetToken) {
+			utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, "重置密码失败: "+err.Error())
+		return
+	}
+
+	// 注销所有设备上的登录
+	if err := c.sessions.RevokeAll(context.Background(), uid, 0); err != nil {
+		log.Printf("[PasswordReset] 注销会话失败: user=%d err=%v", uid, err)
+	}
+	clearSessionCookies(ctx)
+	utils.RespondSuccess(ctx, gin.H{"message": "密码已重置，请使用新密码登录"})
+}
+
+// consumePasswordReset 在事务中使用重置令牌并更新密码，返回用户ID
+func (c *UserController) consumePasswordReset(ctx context.Context, token, hashedPassword string) (int, error) {
+	tx, err := c.client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	reset, err := tx.PasswordReset.Query().
+		Where(
+			passwordreset.TokenHashEQ(utils.HashToken(token)),
+			passwordreset.UsedAtIsNil(),
+			passwordreset.ExpiresAtGT(now),
+		).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return 0, errInvalidResetToken
+		}
+		return 0, err
+	}
+	u := reset.Edges.User
+
+	// 条件更新保证并发请求中只有一个成功使用该令牌
+	n, err := tx.PasswordReset.Update().
+		Where(passwordreset.IDEQ(reset.ID), passwordreset.UsedAtIsNil()).
+		SetUsedAt(now).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if n == 0 {
+		tx.Rollback()
+		return 0, errInvalidResetToken
+	}
+	// 同一用户其他未使用的重置令牌一并作废
+	if err := tx.PasswordReset.Update().
+		Where(
+			passwordreset.HasUserWith(user.IDEQ(u.ID)),
+			passwordreset.UsedAtIsNil(),
+		).
+		SetUsedAt(now).
+		Exec(ctx); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	// 新密码生效后清除登录失败计数，解除锁定
+	update := tx.User.UpdateOne(u).
+		SetPassword(hashedPassword).
+		SetPasswordChangedAt(now).
+		SetFailedLoginCount(0).
+		ClearLastFailedLoginAt().
+		ClearLockedUntil().
+		SetUpdatedAt(now)
+	// 能收到重置邮件说明邮箱属于该用户
+	if u.EmailVerifiedAt == nil {
+		update.SetEmailVerifiedAt(now)
+	}
+	if err := update.Exec(ctx); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	// 个人访问令牌不经过会话校验，重置密码时一并吊销
+	if err := tx.AccessToken.Update().
+		Where(
+			accesstoken.HasUserWith(user.IDEQ(u.ID)),
+			accesstoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(now).
+		Exec(ctx); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	return u.ID, tx.Commit()
+}
@@ -4,13 +4,19 @@ import (
 	"context"
 	"log"
 	"net/http"
+	"net/url"
+	"path"
 	"strconv"
 	"time"
 
 	"blog-go/ent"
 	"blog-go/ent/comment"
 	"blog-go/ent/post"
+	"blog-go/ent/postslughistory"
+	"blog-go/ent/predicate"
 	"blog-go/ent/tag"
+	"blog-go/models"
+	"blog-go/services"
 	"blog-go/utils"
 
 	"github.com/gin-gonic/gin"
@@ -26,20 +32,24 @@ func NewPostController(client *ent.Client) *PostController {
 
 // 定义用于前端的文章结构体
 type PostDTO struct {
-	ID          int        `json:"id"`
-	Title       string     `json:"title"`
-	Content     string     `json:"content"`
-	Excerpt     string     `json:"excerpt"`
-	CoverImage  string     `json:"cover_image"`
-	Published   bool       `json:"published"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
-	PublishedAt *time.Time `json:"published_at,omitempty"`
-	Views       int        `json:"views"`
-	AuthorType  string     `json:"author_type"`
-	Author      string     `json:"author"`
-	Tags        any        `json:"tags"`
-	Comments    any        `json:"comments,omitempty"`
+	ID          int              `json:"id"`
+	Title       string           `json:"title"`
+	Slug        string           `json:"slug"`
+	Content     string           `json:"content"`
+	ContentHTML string           `json:"content_html,omitempty"`
+	Toc         []models.TocItem `json:"toc,omitempty"`
+	Excerpt     string           `json:"excerpt"`
+	CoverImage  string           `json:"cover_image"`
+	Published   bool             `json:"published"`
+	Scheduled   bool             `json:"scheduled"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+	PublishedAt *time.Time       `json:"published_at,omitempty"`
+	Views       int              `json:"views"`
+	AuthorType  string           `json:"author_type"`
+	Author      string           `json:"author"`
+	Tags        any              `json:"tags"`
+	Comments    any              `json:"comments,omitempty"`
 }
 
 // GetPosts 获取所有文章（支持分页、搜索、排序和标签过滤，统一响应结构）
@@ -66,10 +76,33 @@ func (c *PostController) GetPosts(ctx *gin.Context) {
 	}
 	offset := (page - 1) * limit
 
+	if _, err := loadCurrentUser(ctx, c.client); err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "数据库错误")
+		return
+	}
+	// 没有编辑权限的访客只能看到已发布的文章
+	if !hasPermission(ctx, services.PermPostEdit) {
+		switch status {
+		case "all", "published":
+			status = "published"
+		default:
+			utils.RespondError(ctx, http.StatusForbidden, "没有权限查看未发布的文章")
+			return
+		}
+	}
+	if status == "scheduled" && !hasPermission(ctx, services.PermPostPublish) {
+		utils.RespondError(ctx, http.StatusForbidden, "没有权限查看定时发布的文章")
+		return
+	}
+
 	query := c.client.Post.Query()
 
 	// 状态过滤
-	if status != "all" {
+	switch status {
+	case "all":
+	case "scheduled":
+		query = query.Where(post.ScheduledEQ(true), post.PublishedEQ(false))
+	default:
 		published := status == "published"
 		query = query.Where(post.PublishedEQ(published))
 	}
@@ -136,10 +169,12 @@ func (c *PostController) GetPosts(ctx *gin.Context) {
 		postList = append(postList, PostDTO{
 			ID:          p.ID,
 			Title:       p.Title,
+			Slug:        p.Slug,
 			Content:     p.Content,
 			Excerpt:     p.Excerpt,
 			CoverImage:  p.CoverImage,
 			Published:   p.Published,
+			Scheduled:   p.Scheduled,
 			CreatedAt:   p.CreatedAt,
 			UpdatedAt:   p.UpdatedAt,
 			PublishedAt: p.PublishedAt,
@@ -169,12 +204,53 @@ func (c *PostController) GetPost(ctx *gin.Context) {
 		return
 	}
 
+	c.respondPostDetail(ctx, post.IDEQ(id))
+}
+
+// GetPostBySlug 通过slug获取单篇文章，旧slug返回301重定向到新地址
+func (c *PostController) GetPostBySlug(ctx *gin.Context) {
+	slug := ctx.Param("slug")
+	if slug == "" {
+		utils.RespondErrorWithCode(ctx, http.StatusBadRequest, "无效的文章slug")
+		return
+	}
+
+	exists, err := c.client.Post.Query().Where(post.SlugEQ(slug)).Exist(context.Background())
+	if err != nil {
+		utils.RespondErrorWithCode(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if exists {
+		c.respondPostDetail(ctx, post.SlugEQ(slug))
+		return
+	}
+
+	// 查找历史slug
+	history, err := c.client.PostSlugHistory.Query().
+		Where(postslughistory.SlugEQ(slug)).
+		WithPost().
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			utils.RespondErrorWithCode(ctx, http.StatusNotFound, "文章不存在")
+			return
+		}
+		utils.RespondErrorWithCode(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	target := path.Join(path.Dir(ctx.Request.URL.Path), url.PathEscape(history.Edges.Post.Slug))
+	ctx.Redirect(http.StatusMovedPermanently, target)
+}
+
+// respondPostDetail 查询文章详情、增加浏览量并返回
+func (c *PostController) respondPostDetail(ctx *gin.Context, where predicate.Post) {
 	p, err := c.client.Post.
 		Query().
-		Where(post.IDEQ(id)).
+		Where(where).
 		WithTags().
 		WithComments(func(q *ent.CommentQuery) {
-			q.Where(comment.HasPostWith(post.PublishedEQ(true))).
+			q.Where(comment.StatusEQ(comment.StatusApproved)).
 				Order(ent.Desc(comment.FieldCreatedAt))
 		}).
 		First(context.Background())
@@ -188,6 +264,27 @@ func (c *PostController) GetPost(ctx *gin.Context) {
 		return
 	}
 
+	// 草稿和定时发布的文章只有能编辑该文章的用户可以查看，其他人看到的是不存在
+	if !p.Published {
+		visible, err := canEditPost(ctx, c.client, p.ID)
+		if err != nil {
+			utils.RespondErrorWithCode(ctx, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if !visible {
+			utils.RespondErrorWithCode(ctx, http.StatusNotFound, "无效的文章ID")
+			return
+		}
+	}
+
+	// 尚未渲染过的旧文章即时渲染一次
+	contentHTML, toc := "", p.Toc
+	if p.ContentHTML != nil {
+		contentHTML = *p.ContentHTML
+	} else if contentHTML, toc, err = utils.RenderMarkdown(p.Content); err != nil {
+		log.Printf("Error rendering markdown: %v", err)
+	}
+
 	// 增加浏览量
 	_, err = c.client.Post.
 		UpdateOne(p).
@@ -202,10 +299,14 @@ func (c *PostController) GetPost(ctx *gin.Context) {
 	postDetail := PostDTO{
 		ID:          p.ID,
 		Title:       p.Title,
+		Slug:        p.Slug,
 		Content:     p.Content,
+		ContentHTML: contentHTML,
+		Toc:         toc,
 		Excerpt:     p.Excerpt,
 		CoverImage:  p.CoverImage,
 		Published:   p.Published,
+		Scheduled:   p.Scheduled,
 		CreatedAt:   p.CreatedAt,
 		UpdatedAt:   p.UpdatedAt,
 		PublishedAt: p.PublishedAt,
@@ -213,7 +314,7 @@ func (c *PostController) GetPost(ctx *gin.Context) {
 		AuthorType:  string(p.AuthorType),
 		Author:      p.Author,
 		Tags:        p.Edges.Tags,
-		Comments:    p.Edges.Comments,
+		Comments:    toCommentDTOs(p.Edges.Comments),
 	}
 
 	utils.RespondSuccess(ctx, postDetail)
@@ -222,20 +323,38 @@ func (c *PostController) GetPost(ctx *gin.Context) {
 // CreatePost 创建文章
 func (c *PostController) CreatePost(ctx *gin.Context) {
 	var input struct {
-		Title      string   `json:"title" binding:"required"`
-		Content    string   `json:"content" binding:"required"`
-		Excerpt    string   `json:"excerpt" binding:"required"`
-		CoverImage string   `json:"coverImage"`
-		Published  bool     `json:"published"`
-		Tags       []string `json:"tags"`
-		Author     string   `json:"author" binding:"required"`
-		AuthorType string   `json:"authorType" binding:"required"`
+		Title       string     `json:"title" binding:"required"`
+		Slug        string     `json:"slug"`
+		Content     string     `json:"content" binding:"required"`
+		Excerpt     string     `json:"excerpt" binding:"required"`
+		CoverImage  string     `json:"coverImage"`
+		Published   bool       `json:"published"`
+		PublishedAt *time.Time `json:"publishedAt"`
+		Tags        []string   `json:"tags"`
+		Author      string     `json:"author" binding:"required"`
+		AuthorType  string     `json:"authorType" binding:"required"`
 	}
 
 	if err := ctx.ShouldBindJSON(&input); err != nil {
 		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
 		return
 	}
+	if (input.Published || input.PublishedAt != nil) && !hasPermission(ctx, services.PermPostPublish) {
+		utils.RespondError(ctx, http.StatusForbidden, "没有发布文章的权限")
+		return
+	}
+	// 草稿只能指定未来的发布时间（定时发布），过去的时间只对直接发布的文章有意义
+	if !input.Published && input.PublishedAt != nil && !input.PublishedAt.After(time.Now()) {
+		utils.RespondError(ctx, http.StatusBadRequest, "未发布的文章不能指定过去的发布时间")
+		return
+	}
+
+	// 渲染Markdown
+	contentHTML, toc, err := utils.RenderMarkdown(input.Content)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "渲染文章内容失败: "+err.Error())
+		return
+	}
 
 	// 开启事务
 	tx, err := c.client.Tx(context.Background())
@@ -244,24 +363,49 @@ func (c *PostController) CreatePost(ctx *gin.Context) {
 		return
 	}
 
+	// 生成slug，未指定时根据标题生成
+	slugSource := input.Slug
+	if slugSource == "" {
+		slugSource = input.Title
+	}
+	slug, err := services.UniquePostSlug(context.Background(), tx.Client(), slugSource, 0)
+	if err != nil {
+		tx.Rollback()
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
 	// 创建文章
 	builder := tx.Post.Create().
 		SetTitle(input.Title).
+		SetSlug(slug).
 		SetContent(input.Content).
+		SetContentHTML(contentHTML).
+		SetToc(toc).
 		SetExcerpt(input.Excerpt).
 		SetPublished(input.Published).
 		SetCreatedAt(time.Now()).
 		SetUpdatedAt(time.Now()).
 		SetAuthorType(post.AuthorType(input.AuthorType)).
 		SetAuthor(input.Author)
+	if u := currentUser(ctx); u != nil {
+		builder.SetOwner(u)
+	}
 
 	// 设置封面图片
 	if input.CoverImage != "" {
 		builder.SetCoverImage(input.CoverImage)
 	}
 
-	// 设置发布时间
-	if input.Published {
+	// 设置发布时间，指定未来时间时改为定时发布
+	switch {
+	case input.PublishedAt != nil && input.PublishedAt.After(time.Now()):
+		builder.SetPublished(false).
+			SetScheduled(true).
+			SetPublishedAt(*input.PublishedAt)
+	case input.PublishedAt != nil && input.Published:
+		builder.SetPublishedAt(*input.PublishedAt)
+	case input.Published:
 		builder.SetPublishedAt(time.Now())
 	}
 
@@ -272,6 +416,13 @@ func (c *PostController) CreatePost(ctx *gin.Context) {
 		return
 	}
 
+	// 记录初始版本
+	if _, err := recordPostRevision(context.Background(), tx, p, ctx.GetString("username"), nil); err != nil {
+		tx.Rollback()
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
 	// 处理标签
 	if len(input.Tags) > 0 {
 		for _, tagName := range input.Tags {
@@ -318,6 +469,7 @@ func (c *PostController) CreatePost(ctx *gin.Context) {
 		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
+	services.InvalidateSitemap()
 
 	// 返回创建的文章
 	result, err := c.client.Post.
@@ -331,6 +483,11 @@ func (c *PostController) CreatePost(ctx *gin.Context) {
 		return
 	}
 
+	// 更新检索索引（失败时只记录日志，重启时会重建）
+	if err := services.IndexPost(context.Background(), c.client, result); err != nil {
+		log.Printf("[Search] 更新文章索引失败: id=%d err=%v", result.ID, err)
+	}
+
 	utils.RespondSuccess(ctx, result)
 }
 
@@ -343,14 +500,16 @@ func (c *PostController) UpdatePost(ctx *gin.Context) {
 	}
 
 	var input struct {
-		Title      string   `json:"title"`
-		Content    string   `json:"content"`
-		Excerpt    string   `json:"excerpt"`
-		CoverImage string   `json:"coverImage"`
-		Published  *bool    `json:"published"`
-		Tags       []string `json:"tags"`
-		Author     string   `json:"author"`
-		AuthorType string   `json:"authorType"`
+		Title       string     `json:"title"`
+		Slug        string     `json:"slug"`
+		Content     string     `json:"content"`
+		Excerpt     string     `json:"excerpt"`
+		CoverImage  string     `json:"coverImage"`
+		Published   *bool      `json:"published"`
+		PublishedAt *time.Time `json:"publishedAt"`
+		Tags        []string   `json:"tags"`
+		Author      string     `json:"author"`
+		AuthorType  string     `json:"authorType"`
 	}
 
 	if err := ctx.ShouldBindJSON(&input); err != nil {
@@ -369,6 +528,16 @@ func (c *PostController) UpdatePost(ctx *gin.Context) {
 		return
 	}
 
+	// 作者只能修改自己的文章，修改发布状态需要发布权限
+	if !requirePostAccess(ctx, c.client, p.ID, services.PermPostEditAny) {
+		return
+	}
+	if ((input.Published != nil && *input.Published != p.Published) || input.PublishedAt != nil) &&
+		!hasPermission(ctx, services.PermPostPublish) {
+		utils.RespondError(ctx, http.StatusForbidden, "没有发布文章的权限")
+		return
+	}
+
 	// 开启事务
 	tx, err := c.client.Tx(context.Background())
 	if err != nil {
@@ -383,7 +552,29 @@ func (c *PostController) UpdatePost(ctx *gin.Context) {
 		builder.SetTitle(input.Title)
 	}
 	if input.Content != "" {
-		builder.SetContent(input.Content)
+		contentHTML, toc, err := utils.RenderMarkdown(input.Content)
+		if err != nil {
+			tx.Rollback()
+			utils.RespondError(ctx, http.StatusInternalServerError, "渲染文章内容失败: "+err.Error())
+			return
+		}
+		builder.SetContent(input.Content).
+			SetContentHTML(contentHTML).
+			SetToc(toc)
+	}
+
+	// 显式指定slug、标题变更或旧文章没有slug时重新生成，旧slug记入历史
+	slugSource := input.Slug
+	if slugSource == "" && ((input.Title != "" && input.Title != p.Title) || p.Slug == "") {
+		slugSource = input.Title
+		if slugSource == "" {
+			slugSource = p.Title
+		}
+	}
+	if err := updatePostSlug(context.Background(), tx, p, builder, slugSource); err != nil {
+		tx.Rollback()
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
 	}
 	if input.Excerpt != "" {
 		builder.SetExcerpt(input.Excerpt)
@@ -392,12 +583,20 @@ func (c *PostController) UpdatePost(ctx *gin.Context) {
 		builder.SetCoverImage(input.CoverImage)
 	}
 	if input.Published != nil {
-		builder.SetPublished(*input.Published)
+		// 手动发布或撤回都会取消定时发布
+		builder.SetPublished(*input.Published).SetScheduled(false)
 		// 如果从未发布变为发布，设置发布时间
 		if *input.Published && !p.Published {
 			builder.SetPublishedAt(time.Now())
 		}
 	}
+	if input.PublishedAt != nil {
+		builder.SetPublishedAt(*input.PublishedAt)
+		// 指定未来时间则转为定时发布
+		if input.PublishedAt.After(time.Now()) {
+			builder.SetPublished(false).SetScheduled(true)
+		}
+	}
 	if input.AuthorType != "" {
 		builder.SetAuthorType(post.AuthorType(input.AuthorType))
 	}
@@ -405,6 +604,13 @@ func (c *PostController) UpdatePost(ctx *gin.Context) {
 		builder.SetAuthor(input.Author)
 	}
 
+	// 旧文章没有历史版本时，先保存修改前的内容
+	if err := ensureBaseRevision(context.Background(), tx, p); err != nil {
+		tx.Rollback()
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
 	// 保存更新
 	updated, err := builder.Save(context.Background())
 	if err != nil {
@@ -413,6 +619,15 @@ func (c *PostController) UpdatePost(ctx *gin.Context) {
 		return
 	}
 
+	// 标题、正文或摘要有变化时记录新版本
+	if postContentChanged(p, updated) {
+		if _, err := recordPostRevision(context.Background(), tx, updated, ctx.GetString("username"), nil); err != nil {
+			tx.Rollback()
+			utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+
 	// 如果有提供标签，则更新标签
 	if input.Tags != nil {
 		// 获取当前文章标签
@@ -485,6 +700,7 @@ func (c *PostController) UpdatePost(ctx *gin.Context) {
 		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
+	services.InvalidateSitemap()
 
 	// 返回更新后的文章
 	result, err := c.client.Post.
@@ -498,9 +714,30 @@ func (c *PostController) UpdatePost(ctx *gin.Context) {
 		return
 	}
 
+	// 更新检索索引（失败时只记录日志，重启时会重建）
+	if err := services.IndexPost(context.Background(), c.client, result); err != nil {
+		log.Printf("[Search] 更新文章索引失败: id=%d err=%v", result.ID, err)
+	}
+
 	utils.RespondSuccess(ctx, result)
 }
 
+// updatePostSlug 根据 slugSource 重新生成文章的slug，旧slug记入历史；slugSource 为空时保留原slug
+func updatePostSlug(ctx context.Context, tx *ent.Tx, p *ent.Post, builder *ent.PostUpdateOne, slugSource string) error {
+	if slugSource == "" {
+		return nil
+	}
+	slug, err := services.UniquePostSlug(ctx, tx.Client(), slugSource, p.ID)
+	if err != nil {
+		return err
+	}
+	if err := services.RecordPostSlugChange(ctx, tx.Client(), p, slug); err != nil {
+		return err
+	}
+	builder.SetSlug(slug)
+	return nil
+}
+
 // DeletePost 删除文章
 func (c *PostController) DeletePost(ctx *gin.Context) {
 	id, err := strconv.Atoi(ctx.Param("id"))
@@ -508,6 +745,9 @@ func (c *PostController) DeletePost(ctx *gin.Context) {
 		utils.RespondError(ctx, http.StatusBadRequest, "无效的文章ID")
 		return
 	}
+	if !requirePostAccess(ctx, c.client, id, services.PermPostDeleteAny) {
+		return
+	}
 
 	// 开启事务
 	tx, err := c.client.Tx(context.Background())
@@ -558,6 +798,48 @@ func (c *PostController) DeletePost(ctx *gin.Context) {
 		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
+	services.InvalidateSitemap()
+
+	if err := services.RemoveSearchDocuments(context.Background(), c.client, services.SearchTypePost, id); err != nil {
+		log.Printf("[Search] 删除文章索引失败: id=%d err=%v", id, err)
+	}
 
 	utils.RespondSuccess(ctx, gin.H{"message": "文章删除成功"})
 }
+
+// GetScheduledPosts 获取即将定时发布的文章
+func (c *PostController) GetScheduledPosts(ctx *gin.Context) {
+	posts, err := c.client.Post.Query().
+		Where(
+			post.ScheduledEQ(true),
+			post.PublishedEQ(false),
+		).
+		WithTags().
+		Order(ent.Asc(post.FieldPublishedAt)).
+		All(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	list := make([]PostDTO, 0, len(posts))
+	for _, p := range posts {
+		list = append(list, PostDTO{
+			ID:          p.ID,
+			Title:       p.Title,
+			Slug:        p.Slug,
+			Excerpt:     p.Excerpt,
+			CoverImage:  p.CoverImage,
+			Published:   p.Published,
+			Scheduled:   p.Scheduled,
+			CreatedAt:   p.CreatedAt,
+			UpdatedAt:   p.UpdatedAt,
+			PublishedAt: p.PublishedAt,
+			Views:       p.Views,
+			AuthorType:  string(p.AuthorType),
+			Author:      p.Author,
+			Tags:        p.Edges.Tags,
+		})
+	}
+	utils.RespondSuccess(ctx, list)
+}
@@ -0,0 +1,334 @@
+package controllers
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/post"
+	"blog-go/ent/postrevision"
+	"blog-go/services"
+	"blog-go/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 文章历史版本结构体（列表不返回正文）
+type PostRevisionDTO struct {
+	ID           int       `json:"id"`
+	Version      int       `json:"version"`
+	Title        string    `json:"title"`
+	Excerpt      string    `json:"excerpt"`
+	Content      string    `json:"content,omitempty"`
+	Editor       string    `json:"editor,omitempty"`
+	RestoredFrom *int      `json:"restored_from,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func toPostRevisionDTO(r *ent.PostRevision, withContent bool) PostRevisionDTO {
+	dto := PostRevisionDTO{
+		ID:           r.ID,
+		Version:      r.Version,
+		Title:        r.Title,
+		Excerpt:      r.Excerpt,
+		Editor:       r.Editor,
+		RestoredFrom: r.RestoredFrom,
+		CreatedAt:    r.CreatedAt,
+	}
+	if withContent {
+		dto.Content = r.Content
+	}
+	return dto
+}
+
+// recordPostRevision 在事务内为文章当前内容写入一个新版本
+func recordPostRevision(ctx context.Context, tx *ent.Tx, p *ent.Post, editor string, restoredFrom *int) (*ent.PostRevision, error) {
+	version := 1
+	last, err := tx.PostRevision.Query().
+		Where(postrevision.HasPostWith(post.IDEQ(p.ID))).
+		Order(ent.Desc(postrevision.FieldVersion)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if last != nil {
+		version = last.Version + 1
+	}
+
+	builder := tx.PostRevision.Create().
+		SetPostID(p.ID).
+		SetVersion(version).
+		SetTitle(p.Title).
+		SetContent(p.Content).
+		SetExcerpt(p.Excerpt).
+		SetNillableRestoredFrom(restoredFrom).
+		SetCreatedAt(time.Now())
+	if editor != "" {
+		builder.SetEditor(editor)
+	}
+	return builder.Save(ctx)
+}
+
+// ensureBaseRevision 旧文章没有任何版本时，先把修改前的内容存为第一个版本
+func ensureBaseRevision(ctx context.Context, tx *ent.Tx, p *ent.Post) error {
+	exists, err := tx.PostRevision.Query().
+		Where(postrevision.HasPostWith(post.IDEQ(p.ID))).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+	_, err = recordPostRevision(ctx, tx, p, "", nil)
+	return err
+}
+
+// postContentChanged 判断标题、正文或摘要是否发生变化
+func postContentChanged(before, after *ent.Post) bool {
+	return before.Title != after.Title ||
+		before.Content != after.Content ||
+		before.Excerpt != after.Excerpt
+}
+
+// getPostRevision 获取属于指定文章的版本
+func (c *PostController) getPostRevision(postID, revisionID int) (*ent.PostRevision, error) {
+	return c.client.PostRevision.Query().
+		Where(
+			postrevision.IDEQ(revisionID),
+			postrevision.HasPostWith(post.IDEQ(postID)),
+		).
+		Only(context.Background())
+}
+
+// GetPostRevisions 获取文章的历史版本列表
+func (c *PostController) GetPostRevisions(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的文章ID")
+		return
+	}
+	// 历史版本包含草稿和定时发布文章的内容，只有能编辑该文章的用户可以查看
+	if !requirePostAccess(ctx, c.client, id, services.PermPostEditAny) {
+		return
+	}
+
+	exists, err := c.client.Post.Query().Where(post.IDEQ(id)).Exist(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if !exists {
+		utils.RespondError(ctx, http.StatusNotFound, "文章不存在")
+		return
+	}
+
+	revisions, err := c.client.PostRevision.Query().
+		Where(postrevision.HasPostWith(post.IDEQ(id))).
+		Order(ent.Desc(postrevision.FieldVersion)).
+		All(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	list := make([]PostRevisionDTO, 0, len(revisions))
+	for _, r := range revisions {
+		list = append(list, toPostRevisionDTO(r, false))
+	}
+	utils.RespondSuccess(ctx, list)
+}
+
+// GetPostRevision 获取单个历史版本（包含正文）
+func (c *PostController) GetPostRevision(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的文章ID")
+		return
+	}
+	if !requirePostAccess(ctx, c.client, id, services.PermPostEditAny) {
+		return
+	}
+	revisionID, err := strconv.Atoi(ctx.Param("revisionId"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的版本ID")
+		return
+	}
+
+	r, err := c.getPostRevision(id, revisionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			utils.RespondError(ctx, http.StatusNotFound, "版本不存在")
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, toPostRevisionDTO(r, true))
+}
+
+// DiffPostRevisions 比较两个版本的差异，未指定 to 时与文章当前内容比较
+func (c *PostController) DiffPostRevisions(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的文章ID")
+		return
+	}
+	if !requirePostAccess(ctx, c.client, id, services.PermPostEditAny) {
+		return
+	}
+	fromID, err := strconv.Atoi(ctx.Query("from"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的起始版本ID")
+		return
+	}
+
+	from, err := c.getPostRevision(id, fromID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			utils.RespondError(ctx, http.StatusNotFound, "版本不存在")
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	var toTitle, toExcerpt, toContent string
+	var toVersion any = "current"
+	if toStr := ctx.Query("to"); toStr != "" {
+		toID, err := strconv.Atoi(toStr)
+		if err != nil {
+			utils.RespondError(ctx, http.StatusBadRequest, "无效的目标版本ID")
+			return
+		}
+		to, err := c.getPostRevision(id, toID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				utils.RespondError(ctx, http.StatusNotFound, "版本不存在")
+				return
+			}
+			utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+			return
+		}
+		toTitle, toExcerpt, toContent = to.Title, to.Excerpt, to.Content
+		toVersion = to.Version
+	} else {
+		p, err := c.client.Post.Get(context.Background(), id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				utils.RespondError(ctx, http.StatusNotFound, "文章不存在")
+				return
+			}
+			utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+			return
+		}
+		toTitle, toExcerpt, toContent = p.Title, p.Excerpt, p.Content
+	}
+
+	utils.RespondSuccess(ctx, gin.H{
+		"from":    from.Version,
+		"to":      toVersion,
+		"title":   utils.DiffLines(from.Title, toTitle),
+		"excerpt": utils.DiffLines(from.Excerpt, toExcerpt),
+		"content": utils.DiffLines(from.Content, toContent),
+	})
+}
+
+// RestorePostRevision 将文章恢复为指定历史版本的内容
+func (c *PostController) RestorePostRevision(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的文章ID")
+		return
+	}
+	revisionID, err := strconv.Atoi(ctx.Param("revisionId"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的版本ID")
+		return
+	}
+	if !requirePostAccess(ctx, c.client, id, services.PermPostEditAny) {
+		return
+	}
+
+	r, err := c.getPostRevision(id, revisionID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			utils.RespondError(ctx, http.StatusNotFound, "版本不存在")
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	contentHTML, toc, err := utils.RenderMarkdown(r.Content)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "渲染文章内容失败: "+err.Error())
+		return
+	}
+
+	// 开启事务
+	tx, err := c.client.Tx(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	p, err := tx.Post.Get(context.Background(), id)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			utils.RespondError(ctx, http.StatusNotFound, "文章不存在")
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	builder := tx.Post.UpdateOne(p).
+		SetTitle(r.Title).
+		SetContent(r.Content).
+		SetContentHTML(contentHTML).
+		SetToc(toc).
+		SetExcerpt(r.Excerpt).
+		SetUpdatedAt(time.Now())
+	// 与编辑文章一致，标题变化时重新生成slug，旧slug记入历史
+	slugSource := ""
+	if r.Title != p.Title || p.Slug == "" {
+		slugSource = r.Title
+	}
+	if err := updatePostSlug(context.Background(), tx, p, builder, slugSource); err != nil {
+		tx.Rollback()
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	updated, err := builder.Save(context.Background())
+	if err != nil {
+		tx.Rollback()
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 恢复操作本身也记录为一个新版本，保证可以再次撤销
+	revision, err := recordPostRevision(context.Background(), tx, updated, ctx.GetString("username"), &r.Version)
+	if err != nil {
+		tx.Rollback()
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// 提交事务
+	if err := tx.Commit(); err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	services.InvalidateSitemap()
+
+	if err := services.IndexPost(context.Background(), c.client, updated); err != nil {
+		log.Printf("[Search] 更新文章索引失败: id=%d err=%v", updated.ID, err)
+	}
+
+	utils.RespondSuccess(ctx, gin.H{
+		"post":     updated,
+		"revision": toPostRevisionDTO(revision, false),
+	})
+}
@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"blog-go/ent"
+	"blog-go/services"
+	"blog-go/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SearchController struct {
+	client *ent.Client
+}
+
+func NewSearchController(client *ent.Client) *SearchController {
+	return &SearchController{client: client}
+}
+
+// 搜索结果
+type SearchResultDTO struct {
+	Type    string  `json:"type"`
+	ID      int     `json:"id"`
+	Title   string  `json:"title"`
+	Snippet string  `json:"snippet"`
+	Rank    float64 `json:"rank"`
+}
+
+// 搜索结果片段前后保留的字符数
+const searchSnippetRadius = 60
+
+// Search 全文搜索文章、图书和收藏（按相关度排序，返回高亮片段和类型统计）
+func (c *SearchController) Search(ctx *gin.Context) {
+	q := strings.TrimSpace(ctx.Query("q"))
+	docType := ctx.Query("type")
+
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	if page < 1 {
+		page = 1
+	}
+	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
+	if limit < 1 || limit > 50 {
+		limit = 10
+	}
+	offset := (page - 1) * limit
+
+	switch docType {
+	case "", services.SearchTypePost, services.SearchTypeBook, services.SearchTypeCollection:
+	default:
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的搜索类型")
+		return
+	}
+
+	tsText := utils.SearchText(q)
+	if tsText == "" {
+		utils.RespondError(ctx, http.StatusBadRequest, "请输入搜索关键词")
+		return
+	}
+
+	// 各类型命中数量
+	facets := gin.H{
+		services.SearchTypePost:       0,
+		services.SearchTypeBook:       0,
+		services.SearchTypeCollection: 0,
+	}
+	rows, err := c.client.QueryContext(context.Background(), `
+		SELECT doc_type, COUNT(*)
+		FROM search_documents
+		WHERE visible AND vector @@ plainto_tsquery('simple', $1)
+		GROUP BY doc_type`, tsText)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	total := 0
+	for rows.Next() {
+		var t string
+		var n int
+		if err := rows.Scan(&t, &n); err != nil {
+			rows.Close()
+			utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+			return
+		}
+		facets[t] = n
+		if docType == "" || docType == t {
+			total += n
+		}
+	}
+	rows.Close()
+
+	// 按相关度排序分页查询
+	rows, err = c.client.QueryContext(context.Background(), `
+		SELECT doc_type, doc_id, title, COALESCE(content, ''), ts_rank(vector, query) AS rank
+		FROM search_documents, plainto_tsquery('simple', $1) AS query
+		WHERE visible AND vector @@ query AND ($2 = '' OR doc_type = $2)
+		ORDER BY rank DESC, updated_at DESC
+		LIMIT $3 OFFSET $4`, tsText, docType, limit, offset)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer rows.Close()
+
+	terms := utils.SearchTerms(q)
+	results := []SearchResultDTO{}
+	for rows.Next() {
+		var r SearchResultDTO
+		var content string
+		if err := rows.Scan(&r.Type, &r.ID, &r.Title, &content, &r.Rank); err != nil {
+			utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+			return
+		}
+		r.Title = utils.Highlight(r.Title, terms)
+		r.Snippet = utils.Snippet(content, terms, searchSnippetRadius)
+		results = append(results, r)
+	}
+	if err := rows.Err(); err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	utils.RespondSuccess(ctx, gin.H{
+		"results": results,
+		"facets":  facets,
+		"total":   total,
+		"page":    page,
+		"limit":   limit,
+	})
+}
@@ -0,0 +1,183 @@
+package controllers
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/services"
+	"blog-go/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SitemapController struct {
+	client         *ent.Client
+	siteURL        string
+	robotsFile     string
+	robotsDisallow []string
+}
+
+func NewSitemapController(client *ent.Client) *SitemapController {
+	var disallow []string
+	for _, p := range strings.Split(utils.GetEnv("ROBOTS_DISALLOW", "/api/,/admin/"), ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			disallow = append(disallow, p)
+		}
+	}
+	return &SitemapController{
+		client:         client,
+		siteURL:        strings.TrimRight(utils.GetEnv("SITE_URL", "http://localhost:3000"), "/"),
+		robotsFile:     os.Getenv("ROBOTS_FILE"),
+		robotsDisallow: disallow,
+	}
+}
+
+const sitemapNamespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+type sitemapURLSet struct {
+	XMLName xml.Name          `xml:"urlset"`
+	Xmlns   string            `xml:"xmlns,attr"`
+	URLs    []sitemapURLEntry `xml:"url"`
+}
+
+type sitemapURLEntry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name            `xml:"sitemapindex"`
+	Xmlns    string              `xml:"xmlns,attr"`
+	Sitemaps []sitemapIndexEntry `xml:"sitemap"`
+}
+
+type sitemapIndexEntry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// Sitemap 站点地图，地址数量超过上限时返回 sitemap 索引
+func (c *SitemapController) Sitemap(ctx *gin.Context) {
+	urls, builtAt, err := services.SitemapURLs(context.Background(), c.client, c.siteURL)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if len(urls) <= services.SitemapMaxURLs {
+		c.writeURLSet(ctx, urls, builtAt)
+		return
+	}
+
+	index := sitemapIndex{Xmlns: sitemapNamespace}
+	for page := 1; (page-1)*services.SitemapMaxURLs < len(urls); page++ {
+		index.Sitemaps = append(index.Sitemaps, sitemapIndexEntry{
+			Loc:     c.siteURL + "/sitemaps/" + strconv.Itoa(page) + ".xml",
+			LastMod: latestLastMod(sitemapPage(urls, page)),
+		})
+	}
+	writeSitemapXML(ctx, index, builtAt)
+}
+
+// SitemapPage 分页的站点地图，路径形如 /sitemaps/1.xml
+func (c *SitemapController) SitemapPage(ctx *gin.Context) {
+	page, err := strconv.Atoi(strings.TrimSuffix(ctx.Param("page"), ".xml"))
+	if err != nil || page < 1 {
+		utils.RespondError(ctx, http.StatusNotFound, "站点地图不存在")
+		return
+	}
+
+	urls, builtAt, err := services.SitemapURLs(context.Background(), c.client, c.siteURL)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	pageURLs := sitemapPage(urls, page)
+	if len(pageURLs) == 0 {
+		utils.RespondError(ctx, http.StatusNotFound, "站点地图不存在")
+		return
+	}
+	c.writeURLSet(ctx, pageURLs, builtAt)
+}
+
+// Robots robots.txt，配置了 ROBOTS_FILE 时直接返回该文件内容
+func (c *SitemapController) Robots(ctx *gin.Context) {
+	if c.robotsFile != "" {
+		body, err := os.ReadFile(c.robotsFile)
+		if err == nil {
+			ctx.Data(http.StatusOK, "text/plain; charset=utf-8", body)
+			return
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("User-agent: *\n")
+	if len(c.robotsDisallow) == 0 {
+		sb.WriteString("Disallow:\n")
+	}
+	for _, p := range c.robotsDisallow {
+		sb.WriteString("Disallow: " + p + "\n")
+	}
+	sb.WriteString("\nSitemap: " + c.siteURL + "/sitemap.xml\n")
+	ctx.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(sb.String()))
+}
+
+func (c *SitemapController) writeURLSet(ctx *gin.Context, urls []services.SitemapURL, builtAt time.Time) {
+	set := sitemapURLSet{Xmlns: sitemapNamespace}
+	for _, u := range urls {
+		entry := sitemapURLEntry{Loc: u.Loc}
+		if !u.LastMod.IsZero() {
+			entry.LastMod = u.LastMod.UTC().Format(time.RFC3339)
+		}
+		set.URLs = append(set.URLs, entry)
+	}
+	writeSitemapXML(ctx, set, builtAt)
+}
+
+func writeSitemapXML(ctx *gin.Context, v any, builtAt time.Time) {
+	body, err := marshalXML(v)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "生成站点地图失败: "+err.Error())
+		return
+	}
+	ctx.Header("Last-Modified", builtAt.Format(http.TimeFormat))
+	ctx.Header("Cache-Control", "public, max-age=600")
+	ctx.Data(http.StatusOK, "application/xml; charset=utf-8", body)
+}
+
+// sitemapPage 返回第 page 页（从1开始）的地址
+func sitemapPage(urls []services.SitemapURL, page int) []services.SitemapURL {
+	// 先检查页码范围，过大的页码相乘会溢出
+	if page < 1 || page > len(urls)/services.SitemapMaxURLs+1 {
+		return nil
+	}
+	start := (page - 1) * services.SitemapMaxURLs
+	if start >= len(urls) {
+		return nil
+	}
+	end := start + services.SitemapMaxURLs
+	if end > len(urls) {
+		end = len(urls)
+	}
+	return urls[start:end]
+}
+
+func latestLastMod(urls []services.SitemapURL) string {
+	var latest time.Time
+	for _, u := range urls {
+		if u.LastMod.After(latest) {
+			latest = u.LastMod
+		}
+	}
+	if latest.IsZero() {
+		return ""
+	}
+	return latest.UTC().Format(time.RFC3339)
+}
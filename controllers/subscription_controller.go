@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"html/template"
+	"net/http"
+
+	"blog-go/services"
+	"blog-go/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SubscriptionController struct {
+	notifier *services.Notifier
+}
+
+func NewSubscriptionController(notifier *services.Notifier) *SubscriptionController {
+	return &SubscriptionController{notifier: notifier}
+}
+
+var unsubscribeConfirmPage = template.Must(template.New("unsubscribe").Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>退订评论通知</title></head>
+<body><form method="post">
+<p>确定要为 {{.Email}} 退订评论通知邮件吗？</p>
+<input type="hidden" name="token" value="{{.Token}}">
+<input type="hidden" name="confirm" value="1">
+<button type="submit">确认退订</button>
+</form></body></html>`))
+
+var unsubscribedPage = template.Must(template.New("unsubscribed").Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>退订成功</title></head>
+<body><p>{{.}} 已退订评论通知邮件。</p></body></html>`))
+
+// UnsubscribeConfirm 邮件中的退订链接，只显示确认页面，避免邮件安全扫描访问链接时误退订
+func (c *SubscriptionController) UnsubscribeConfirm(ctx *gin.Context) {
+	token := ctx.Query("token")
+	email, err := c.notifier.UnsubscribeEmail(token)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	ctx.Status(http.StatusOK)
+	ctx.Header("Content-Type", "text/html; charset=utf-8")
+	unsubscribeConfirmPage.Execute(ctx.Writer, gin.H{"Email": email, "Token": token})
+}
+
+// Unsubscribe 退订评论通知：确认页面提交的表单，或邮件客户端的一键退订（RFC 8058）
+func (c *SubscriptionController) Unsubscribe(ctx *gin.Context) {
+	token := ctx.Query("token")
+	if token == "" {
+		token = ctx.PostForm("token")
+	}
+	email, err := c.notifier.Unsubscribe(context.Background(), token)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidUnsubscribeToken) {
+			utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if ctx.PostForm("confirm") != "" {
+		ctx.Status(http.StatusOK)
+		ctx.Header("Content-Type", "text/html; charset=utf-8")
+		unsubscribedPage.Execute(ctx.Writer, email)
+		return
+	}
+	utils.RespondSuccess(ctx, gin.H{"email": email, "message": "已退订评论通知"})
+}
@@ -0,0 +1,377 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/recoverycode"
+	"blog-go/ent/user"
+	"blog-go/services"
+	"blog-go/utils"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	loginChallengePurpose = "login-2fa"
+	// 输入密码后提交两步验证码的时限
+	loginChallengeTTL = 5 * time.Minute
+	// 第三方登录回调时保存挑战令牌的 cookie，避免令牌出现在跳转地址中
+	loginChallengeCookie = "two_factor_challenge"
+)
+
+type loginChallenge struct {
+	UserID    int   `json:"uid"`
+	IssuedAt  int64 `json:"iat"`
+	ExpiresAt int64 `json:"exp"`
+}
+
+// newLoginChallenge 密码（或第三方登录）验证通过后签发的挑战令牌，只证明第一步已完成
+func newLoginChallenge(secret string, u *ent.User) string {
+	now := time.Now()
+	payload, _ := json.Marshal(loginChallenge{
+		UserID:    u.ID,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(loginChallengeTTL).Unix(),
+	})
+	return utils.SignValue(secret, loginChallengePurpose, string(payload))
+}
+
+func verifyLoginChallenge(secret, token string) (loginChallenge, bool) {
+	var challenge loginChallenge
+	payload, ok := utils.VerifySignedValue(secret, loginChallengePurpose, token)
+	if !ok || json.Unmarshal([]byte(payload), &challenge) != nil {
+		return challenge, false
+	}
+	return challenge, time.Now().Unix() <= challenge.ExpiresAt
+}
+
+// LoginTwoFactor 两步验证登录的第二步：提交挑战令牌和动态验证码（或恢复码），通过后创建会话
+//
+// 第三方登录的挑战令牌保存在 cookie 中，此时请求体可以不带 challenge。
+func (c *UserController) LoginTwoFactor(ctx *gin.Context) {
+	var input struct {
+		Challenge string `json:"challenge"`
+		Code      string `json:"code" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	if input.Challenge == "" {
+		input.Challenge, _ = ctx.Cookie(loginChallengeCookie)
+	}
+
+	challenge, ok := verifyLoginChallenge(c.secret, input.Challenge)
+	if !ok {
+		utils.RespondError(ctx, http.StatusUnauthorized, "登录已过期，请重新输入密码")
+		return
+	}
+	if !c.twoFactorByUser.Allow(strconv.Itoa(challenge.UserID)) {
+		utils.RespondError(ctx, http.StatusTooManyRequests, "尝试次数过多，请稍后再试")
+		return
+	}
+
+	u, err := c.client.User.Get(context.Background(), challenge.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			utils.RespondError(ctx, http.StatusUnauthorized, "登录已过期，请重新输入密码")
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, "数据库查询错误")
+		return
+	}
+	// 签发挑战后修改过密码，需要重新输入密码
+	if u.PasswordChangedAt != nil && u.PasswordChangedAt.Unix() > challenge.IssuedAt {
+		utils.RespondError(ctx, http.StatusUnauthorized, "登录已过期，请重新输入密码")
+		return
+	}
+
+	usedRecovery, err := services.VerifyTwoFactor(context.Background(), c.client, u, input.Code)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidTwoFactorCode) {
+			utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, "验证失败")
+		return
+	}
+	if usedRecovery {
+		c.notifyRecoveryCodeUsed(u)
+	}
+	setOAuthCookie(ctx, loginChallengeCookie, "", -1)
+	c.completeLogin(ctx, u)
+}
+
+// GetTwoFactorStatus 当前用户的两步验证状态
+func (c *UserController) GetTwoFactorStatus(ctx *gin.Context) {
+	u, ok := c.sessionUser(ctx)
+	if !ok {
+		return
+	}
+	remaining, err := services.RemainingRecoveryCodes(context.Background(), c.client, u.ID)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "数据库查询错误")
+		return
+	}
+	utils.RespondSuccess(ctx, gin.H{
+		"enabled":                  u.TotpEnabledAt != nil,
+		"enabled_at":               u.TotpEnabledAt,
+		"required":                 services.TwoFactorRequired(u),
+		"recovery_codes_remaining": remaining,
+	})
+}
+
+// SetupTwoFactor 生成新的 TOTP 密钥并返回绑定地址，需调用 ConfirmTwoFactor 确认后才会启用
+//
+// 与修改密码一样需要提交当前密码，避免被盗用的会话绑定攻击者自己的验证器。
+func (c *UserController) SetupTwoFactor(ctx *gin.Context) {
+	u, ok := c.sessionUser(ctx)
+	if !ok {
+		return
+	}
+	var input struct {
+		CurrentPassword string `json:"currentPassword" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "请输入当前密码")
+		return
+	}
+	if u.TotpEnabledAt != nil {
+		utils.RespondError(ctx, http.StatusConflict, "已启用两步验证，如需更换请先停用")
+		return
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(input.CurrentPassword)); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "当前密码不正确")
+		return
+	}
+
+	secret, err := utils.NewTOTPSecret()
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "生成密钥失败")
+		return
+	}
+	if err := c.client.User.UpdateOne(u).
+		SetTotpSecret(secret).
+		SetTotpLastStep(0).
+		Exec(context.Background()); err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "保存密钥失败")
+		return
+	}
+
+	utils.RespondSuccess(ctx, gin.H{
+		"secret":           secret,
+		"provisioning_uri": utils.TOTPURI(utils.GetEnv("SITE_TITLE", "Blog"), u.Email, secret),
+	})
+}
+
+// ConfirmTwoFactor 提交验证器显示的验证码确认绑定，启用两步验证并返回恢复码（只显示一次）
+func (c *UserController) ConfirmTwoFactor(ctx *gin.Context) {
+	u, ok := c.sessionUser(ctx)
+	if !ok {
+		return
+	}
+	var input struct {
+		Code string `json:"code" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	if u.TotpEnabledAt != nil {
+		utils.RespondError(ctx, http.StatusConflict, "已启用两步验证")
+		return
+	}
+	if u.TotpSecret == "" {
+		utils.RespondError(ctx, http.StatusBadRequest, "请先生成两步验证密钥")
+		return
+	}
+	step, ok := utils.VerifyTOTP(u.TotpSecret, input.Code, time.Now(), 0)
+	if !ok {
+		utils.RespondError(ctx, http.StatusBadRequest, "验证码不正确，请确认手机时间准确后重试")
+		return
+	}
+
+	bg := context.Background()
+	tx, err := c.client.Tx(bg)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "启用两步验证失败")
+		return
+	}
+	// 绑定期间密钥可能被重新生成，只启用与验证码匹配的那个密钥
+	n, err := tx.User.Update().
+		Where(
+			user.IDEQ(u.ID),
+			user.TotpSecretEQ(u.TotpSecret),
+			user.TotpEnabledAtIsNil(),
+		).
+		SetTotpEnabledAt(time.Now()).
+		SetTotpLastStep(step).
+		Save(bg)
+	if err != nil || n == 0 {
+		tx.Rollback()
+		utils.RespondError(ctx, http.StatusConflict, "两步验证密钥已变更，请重新绑定")
+		return
+	}
+	codes, err := services.NewRecoveryCodes(bg, tx, u.ID)
+	if err != nil {
+		tx.Rollback()
+		utils.RespondError(ctx, http.StatusInternalServerError, "生成恢复码失败")
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "启用两步验证失败")
+		return
+	}
+
+	utils.RespondSuccess(ctx, gin.H{
+		"message":        "两步验证已启用，请妥善保存恢复码",
+		"recovery_codes": codes,
+	})
+}
+
+// DisableTwoFactor 停用两步验证，需要提交当前验证码或恢复码
+func (c *UserController) DisableTwoFactor(ctx *gin.Context) {
+	u, ok := c.sessionUser(ctx)
+	if !ok {
+		return
+	}
+	var input struct {
+		Code string `json:"code" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	if u.TotpEnabledAt == nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "未启用两步验证")
+		return
+	}
+	if services.TwoFactorRequired(u) {
+		utils.RespondError(ctx, http.StatusForbidden, "管理员账号必须启用两步验证")
+		return
+	}
+	if !c.verifyTwoFactorInput(ctx, u, input.Code) {
+		return
+	}
+
+	bg := context.Background()
+	tx, err := c.client.Tx(bg)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "停用两步验证失败")
+		return
+	}
+	if err := tx.User.UpdateOne(u).
+		ClearTotpSecret().
+		ClearTotpEnabledAt().
+		SetTotpLastStep(0).
+		Exec(bg); err != nil {
+		tx.Rollback()
+		utils.RespondError(ctx, http.StatusInternalServerError, "停用两步验证失败")
+		return
+	}
+	if _, err := tx.RecoveryCode.Delete().
+		Where(recoverycode.HasUserWith(user.IDEQ(u.ID))).
+		Exec(bg); err != nil {
+		tx.Rollback()
+		utils.RespondError(ctx, http.StatusInternalServerError, "停用两步验证失败")
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "停用两步验证失败")
+		return
+	}
+	utils.RespondSuccess(ctx, gin.H{"message": "两步验证已停用"})
+}
+
+// RegenerateRecoveryCodes 重新生成恢复码，旧的恢复码立即失效
+func (c *UserController) RegenerateRecoveryCodes(ctx *gin.Context) {
+	u, ok := c.sessionUser(ctx)
+	if !ok {
+		return
+	}
+	var input struct {
+		Code string `json:"code" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	if u.TotpEnabledAt == nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "未启用两步验证")
+		return
+	}
+	if !c.verifyTwoFactorInput(ctx, u, input.Code) {
+		return
+	}
+
+	bg := context.Background()
+	tx, err := c.client.Tx(bg)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "生成恢复码失败")
+		return
+	}
+	codes, err := services.NewRecoveryCodes(bg, tx, u.ID)
+	if err != nil {
+		tx.Rollback()
+		utils.RespondError(ctx, http.StatusInternalServerError, "生成恢复码失败")
+		return
+	}
+	if err := tx.Commit(); err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "生成恢复码失败")
+		return
+	}
+	utils.RespondSuccess(ctx, gin.H{"recovery_codes": codes})
+}
+
+// verifyTwoFactorInput 校验已登录用户提交的验证码，失败时已写入响应
+func (c *UserController) verifyTwoFactorInput(ctx *gin.Context, u *ent.User, code string) bool {
+	if !c.twoFactorByUser.Allow(strconv.Itoa(u.ID)) {
+		utils.RespondError(ctx, http.StatusTooManyRequests, "尝试次数过多，请稍后再试")
+		return false
+	}
+	if _, err := services.VerifyTwoFactor(context.Background(), c.client, u, code); err != nil {
+		if errors.Is(err, services.ErrInvalidTwoFactorCode) {
+			utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+			return false
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, "验证失败")
+		return false
+	}
+	return true
+}
+
+// notifyRecoveryCodeUsed 使用恢复码登录后发送提醒邮件
+func (c *UserController) notifyRecoveryCodeUsed(u *ent.User) {
+	remaining, err := services.RemainingRecoveryCodes(context.Background(), c.client, u.ID)
+	if err != nil {
+		log.Printf("[TwoFactor] 查询恢复码失败: %v", err)
+		return
+	}
+	if err := c.notifier.SendRecoveryCodeUsed(u, remaining); err != nil {
+		log.Printf("[TwoFactor] 发送恢复码提醒失败: %v", err)
+	}
+}
+
+// sessionUser 查询当前登录用户，失败时已写入响应
+func (c *UserController) sessionUser(ctx *gin.Context) (*ent.User, bool) {
+	u, err := c.client.User.Query().
+		Where(user.UsernameEQ(ctx.GetString("username"))).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			utils.RespondError(ctx, http.StatusUnauthorized, "未授权访问")
+			return nil, false
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, "数据库查询错误")
+		return nil, false
+	}
+	return u, true
+}
@@ -2,13 +2,15 @@ package controllers
 
 import (
 	"context"
-	"errors"
+	"log"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"blog-go/ent"
 	"blog-go/ent/user"
+	"blog-go/services"
 	"blog-go/utils"
 
 	"github.com/gin-gonic/gin"
@@ -17,12 +19,49 @@ import (
 )
 
 type UserController struct {
-	client *ent.Client
-	secret string
+	client   *ent.Client
+	secret   string
+	notifier *services.Notifier
+	sessions *services.SessionManager
+	// 注册模式：closed / open / invite
+	registration string
+	// 邀请码默认有效期
+	inviteTTL time.Duration
+	// 重置密码链接有效期，申请和使用重置令牌的频率限制
+	resetTTL      time.Duration
+	forgotByEmail *utils.RateLimiter
+	forgotByIP    *utils.RateLimiter
+	resetByIP     *utils.RateLimiter
+	// 两步验证登录按用户限制尝试次数
+	twoFactorByUser *utils.RateLimiter
+	// 登录失败计数和临时锁定
+	loginGuard *services.LoginGuard
 }
 
-func NewUserController(client *ent.Client, secret string) *UserController {
-	return &UserController{client: client, secret: secret}
+func NewUserController(client *ent.Client, secret string, notifier *services.Notifier, sessions *services.SessionManager) *UserController {
+	inviteTTL, err := time.ParseDuration(utils.GetEnv("INVITE_TTL", "168h"))
+	if err != nil {
+		inviteTTL = 7 * 24 * time.Hour
+	}
+	resetTTL, err := time.ParseDuration(utils.GetEnv("PASSWORD_RESET_TTL", "1h"))
+	if err != nil {
+		resetTTL = time.Hour
+	}
+	return &UserController{
+		client:        client,
+		secret:        secret,
+		notifier:      notifier,
+		sessions:      sessions,
+		registration:  strings.ToLower(utils.GetEnv("REGISTRATION_MODE", registrationClosed)),
+		inviteTTL:     inviteTTL,
+		resetTTL:      resetTTL,
+		forgotByEmail: utils.NewRateLimiter(3, time.Hour),
+		forgotByIP:    utils.NewRateLimiter(10, time.Hour),
+		resetByIP:     utils.NewRateLimiter(10, time.Hour),
+
+		twoFactorByUser: utils.NewRateLimiter(5, loginChallengeTTL),
+		loginGuard:      services.NewLoginGuardFromEnv(client, notifier),
+	}
 }
 
 // LoginUser 用户登录
@@ -37,42 +76,76 @@ func (c *UserController) LoginUser(ctx *gin.Context) {
 		return
 	}
 
-	// 查找用户
+	// 查找用户，邮箱不存在时按相同流程处理，响应内容和耗时都与密码错误一致
 	u, err := c.client.User.Query().
 		Where(user.EmailEQ(input.Email)).
 		Only(context.Background())
 	if err != nil {
-		if ent.IsNotFound(err) {
-			utils.RespondError(ctx, http.StatusUnauthorized, "邮箱或密码不正确")
+		if !ent.IsNotFound(err) {
+			utils.RespondError(ctx, http.StatusInternalServerError, "数据库查询错误")
 			return
 		}
+		u = nil
+	}
+
+	// 先把本次尝试计为失败，连续失败次数过多的账号或 IP 在锁定期内直接拒绝，不再校验密码
+	attempt, d, err := c.loginGuard.Reserve(context.Background(), u, input.Email, ctx.ClientIP())
+	if err != nil {
 		utils.RespondError(ctx, http.StatusInternalServerError, "数据库查询错误")
 		return
 	}
+	if d > 0 {
+		respondLoginLocked(ctx, d)
+		return
+	}
 
 	// 验证密码
-	if err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(input.Password)); err != nil {
+	if !c.loginGuard.CheckPassword(u, input.Password) {
+		c.loginGuard.Fail(attempt)
 		utils.RespondError(ctx, http.StatusUnauthorized, "邮箱或密码不正确")
 		return
 	}
+	if err := c.loginGuard.Succeed(context.Background(), attempt); err != nil {
+		log.Printf("[LoginGuard] 清除失败计数出错: %v", err)
+	}
+
+	// 启用两步验证时先返回短期挑战令牌，通过 /auth/login/2fa 提交验证码后才创建会话
+	if u.TotpEnabledAt != nil {
+		utils.RespondSuccess(ctx, gin.H{
+			"two_factor_required": true,
+			"challenge":           newLoginChallenge(c.secret, u),
+			"expires_in":          int(loginChallengeTTL.Seconds()),
+		})
+		return
+	}
+	c.completeLogin(ctx, u)
+}
 
-	// 生成JWT令牌
-	token, err := c.generateToken(u.ID)
+// completeLogin 创建会话，签发短期访问令牌和刷新令牌
+func (c *UserController) completeLogin(ctx *gin.Context, u *ent.User) {
+	pair, err := c.sessions.Issue(context.Background(), u, ctx.ClientIP(), ctx.Request.UserAgent())
 	if err != nil {
 		utils.RespondError(ctx, http.StatusInternalServerError, "生成令牌失败")
 		return
 	}
+	setSessionCookies(ctx, pair)
 
 	utils.RespondSuccess(ctx, gin.H{
-		"token": token,
+		"token":         pair.AccessToken,
+		"refresh_token": pair.RefreshToken,
+		"expires_in":    int(pair.AccessTTL.Seconds()),
+		// 必须启用两步验证但尚未绑定时，需要权限的接口都会被拒绝，前端应引导用户完成绑定
+		"two_factor_setup_required": services.TwoFactorSetupPending(u),
 		"user": gin.H{
-			"id":       u.ID,
-			"username": u.Username,
-			"email":    u.Email,
-			"avatar":   u.Avatar,
-			"bio":      u.Bio,
-			"nickname": u.Nickname,
-			"role":     u.Role,
+			"id":             u.ID,
+			"username":       u.Username,
+			"email":          u.Email,
+			"avatar":         u.Avatar,
+			"bio":            u.Bio,
+			"nickname":       u.Nickname,
+			"role":           u.Role,
+			"email_verified": u.EmailVerifiedAt != nil,
+			"two_factor":     u.TotpEnabledAt != nil,
 		},
 	})
 }
@@ -262,50 +335,15 @@ func (c *UserController) UploadAvatar(ctx *gin.Context) {
 	utils.RespondSuccess(ctx, gin.H{"avatar": avatarURL})
 }
 
-// generateToken 生成JWT令牌
-func (c *UserController) generateToken(userID int) (string, error) {
-	if c.secret == "" {
-		return "", errors.New("JWT密钥未设置")
-	}
-
-	// 创建JWT声明
-	claims := jwt.MapClaims{
-		"userID": userID,
-		"exp":    time.Now().Add(time.Hour * 24 * 7).Unix(), // 令牌有效期7天
-	}
-
-	// 创建令牌
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-	// 签名令牌
-	tokenString, err := token.SignedString([]byte(c.secret))
-	if err != nil {
-		return "", err
-	}
-
-	return tokenString, nil
-}
-
-// LogoutUser 退出登录，清除 cookie
+// LogoutUser 退出登录，注销当前会话并清除 cookie
+//
+// 优先按刷新令牌查找会话，访问令牌过期后也能正常退出。
 func (c *UserController) LogoutUser(ctx *gin.Context) {
-	ctx.SetCookie(
-		"auth_token",
-		"",
-		-1, // 立即过期
-		"/",
-		".toycon.cn",
-		true,
-		true,
-	)
-	ctx.Writer.Header().Add("Set-Cookie", (&http.Cookie{
-		Name:     "auth_token",
-		Value:    "",
-		Path:     "/",
-		Domain:   ".toycon.cn",
-		MaxAge:   -1,
-		Secure:   true,
-		HttpOnly: true,
-		SameSite: http.SameSiteNoneMode,
-	}).String())
+	if s, err := c.sessions.SessionForRefreshToken(context.Background(), refreshTokenFromRequest(ctx)); err == nil {
+		c.sessions.Revoke(context.Background(), s.ID)
+	} else if sid, ok := c.accessTokenSession(ctx); ok {
+		c.sessions.Revoke(context.Background(), sid)
+	}
+	clearSessionCookies(ctx) // 立即过期
 	ctx.JSON(http.StatusOK, gin.H{"message": "退出登录成功"})
 }
@@ -0,0 +1,409 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"html/template"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/invitation"
+	"blog-go/ent/user"
+	"blog-go/services"
+	"blog-go/utils"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 注册模式（REGISTRATION_MODE）
+const (
+	registrationClosed = "closed"
+	registrationOpen   = "open"
+	registrationInvite = "invite"
+)
+
+// 密码最短长度
+const minPasswordLength = 8
+
+// 用户名只允许字母、数字、下划线和连字符
+var usernamePattern = regexp.MustCompile(`^[\p{L}\p{N}_-]{2,32}$`)
+
+var errInvalidInvitation = errors.New("邀请码无效或已过期")
+
+var emailVerifiedPage = template.Must(template.New("verified").Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>邮箱验证成功</title></head>
+<body><p>{{.}} 已验证成功，现在可以登录并发表评论了。</p></body></html>`))
+
+// RegisterUser 注册账号
+//
+// REGISTRATION_MODE 为 open 时开放注册，invite 时需要有效的邀请码，默认 closed 不允许注册。
+// 注册后发送邮箱验证邮件，验证前不能以登录身份评论。
+func (c *UserController) RegisterUser(ctx *gin.Context) {
+	if c.registration != registrationOpen && c.registration != registrationInvite {
+		utils.RespondError(ctx, http.StatusForbidden, "暂未开放注册")
+		return
+	}
+
+	var input struct {
+		Username   string `json:"username" binding:"required"`
+		Email      string `json:"email" binding:"required,email"`
+		Password   string `json:"password" binding:"required"`
+		Nickname   string `json:"nickname"`
+		InviteCode string `json:"invite_code"`
+	}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	input.Username = strings.TrimSpace(input.Username)
+	input.Email = strings.TrimSpace(input.Email)
+
+	if !usernamePattern.MatchString(input.Username) {
+		utils.RespondError(ctx, http.StatusBadRequest, "用户名只能包含字母、数字、下划线和连字符，长度为2-32")
+		return
+	}
+	if len(input.Password) < minPasswordLength {
+		utils.RespondError(ctx, http.StatusBadRequest, "密码长度不能少于"+strconv.Itoa(minPasswordLength)+"位")
+		return
+	}
+	if c.registration == registrationInvite && input.InviteCode == "" {
+		utils.RespondError(ctx, http.StatusForbidden, "需要邀请码才能注册")
+		return
+	}
+
+	if msg, err := c.checkAccountAvailable(input.Username, input.Email); err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "数据库查询错误")
+		return
+	} else if msg != "" {
+		utils.RespondError(ctx, http.StatusBadRequest, msg)
+		return
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "密码加密失败")
+		return
+	}
+
+	u, err := c.createRegisteredUser(context.Background(), input.Username, input.Email, string(hashedPassword), input.Nickname, input.InviteCode)
+	if err != nil {
+		switch {
+		case errors.Is(err, errInvalidInvitation):
+			utils.RespondError(ctx, http.StatusForbidden, err.Error())
+		case ent.IsConstraintError(err):
+			utils.RespondError(ctx, http.StatusBadRequest, "用户名或邮箱已被使用")
+		default:
+			utils.RespondError(ctx, http.StatusInternalServerError, "注册失败: "+err.Error())
+		}
+		return
+	}
+
+	if err := c.notifier.SendEmailVerification(u); err != nil {
+		log.Printf("[Register] 发送验证邮件失败: %v", err)
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"code":    0,
+		"message": "注册成功，请查收验证邮件",
+		"data": gin.H{
+			"id":             u.ID,
+			"username":       u.Username,
+			"email":          u.Email,
+			"nickname":       u.Nickname,
+			"role":           u.Role,
+			"email_verified": false,
+		},
+	})
+}
+
+// checkAccountAvailable 检查用户名和邮箱是否已被使用（不区分大小写），已被使用时返回提示
+func (c *UserController) checkAccountAvailable(username, email string) (string, error) {
+	taken, err := c.client.User.Query().Where(user.UsernameEqualFold(username)).Exist(context.Background())
+	if err != nil {
+		return "", err
+	}
+	if taken {
+		return "该用户名已被使用", nil
+	}
+	taken, err = c.client.User.Query().Where(user.EmailEqualFold(email)).Exist(context.Background())
+	if err != nil {
+		return "", err
+	}
+	if taken {
+		return "该邮箱已被注册", nil
+	}
+	return "", nil
+}
+
+// createRegisteredUser 创建用户，仅邀请模式下在同一事务中使用邀请码
+func (c *UserController) createRegisteredUser(ctx context.Context, username, email, password, nickname, inviteCode string) (*ent.User, error) {
+	tx, err := c.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	u, err := tx.User.Create().
+		SetUsername(username).
+		SetEmail(email).
+		SetPassword(password).
+		SetNickname(nickname).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if c.registration == registrationInvite {
+		// 条件更新保证邀请码只能被使用一次
+		n, err := tx.Invitation.Update().
+			Where(
+				invitation.CodeHashEQ(utils.HashToken(inviteCode)),
+				invitation.UsedAtIsNil(),
+				invitation.Or(invitation.ExpiresAtIsNil(), invitation.ExpiresAtGT(now)),
+				invitation.Or(invitation.EmailIsNil(), invitation.EmailEqualFold(email)),
+			).
+			SetUsedAt(now).
+			SetInvitee(u).
+			Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if n == 0 {
+			tx.Rollback()
+			return nil, errInvalidInvitation
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// VerifyEmail 通过邮件中的签名链接验证邮箱（GET 返回页面，POST 供前端调用）
+func (c *UserController) VerifyEmail(ctx *gin.Context) {
+	token := ctx.Query("token")
+	if ctx.Request.Method == http.MethodPost && token == "" {
+		var input struct {
+			Token string `json:"token"`
+		}
+		ctx.ShouldBindJSON(&input)
+		token = input.Token
+	}
+
+	u, err := c.notifier.VerifyEmail(context.Background(), token)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidVerificationToken) {
+			utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if ctx.Request.Method == http.MethodGet {
+		ctx.Status(http.StatusOK)
+		ctx.Header("Content-Type", "text/html; charset=utf-8")
+		emailVerifiedPage.Execute(ctx.Writer, u.Email)
+		return
+	}
+	utils.RespondSuccess(ctx, gin.H{"email": u.Email, "email_verified": true})
+}
+
+// ResendVerification 重新发送当前用户的邮箱验证邮件
+func (c *UserController) ResendVerification(ctx *gin.Context) {
+	u, err := c.client.User.Query().
+		Where(user.UsernameEQ(ctx.GetString("username"))).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			utils.RespondError(ctx, http.StatusUnauthorized, "未授权访问")
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, "数据库查询错误")
+		return
+	}
+	if u.EmailVerifiedAt != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "邮箱已验证")
+		return
+	}
+	if err := c.notifier.SendEmailVerification(u); err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "发送验证邮件失败")
+		return
+	}
+	utils.RespondSuccess(ctx, gin.H{"message": "验证邮件已发送"})
+}
+
+// requireAdmin 检查当前用户是否有管理用户的权限，失败时已写入响应
+func (c *UserController) requireAdmin(ctx *gin.Context) (*ent.User, bool) {
+	if u := currentUser(ctx); u != nil && userHasPermission(ctx, u, services.PermUserManage) {
+		return u, true
+	}
+	u, err := c.client.User.Query().
+		Where(user.UsernameEQ(ctx.GetString("username"))).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			utils.RespondError(ctx, http.StatusUnauthorized, "未授权操作")
+			return nil, false
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return nil, false
+	}
+	if !userHasPermission(ctx, u, services.PermUserManage) {
+		utils.RespondError(ctx, http.StatusForbidden, "没有管理用户的权限")
+		return nil, false
+	}
+	return u, true
+}
+
+// CreateInvitation 创建邀请码，指定邮箱时可同时发送邀请邮件
+//
+// 邀请码只在创建时返回一次，数据库中只保存哈希。
+func (c *UserController) CreateInvitation(ctx *gin.Context) {
+	admin, ok := c.requireAdmin(ctx)
+	if !ok {
+		return
+	}
+
+	var input struct {
+		Email string `json:"email"`
+		// 有效期，如 72h，0 表示永不过期，默认使用 INVITE_TTL
+		ExpiresIn string `json:"expires_in"`
+		SendEmail bool   `json:"send_email"`
+	}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	input.Email = strings.TrimSpace(input.Email)
+	if input.SendEmail && input.Email == "" {
+		utils.RespondError(ctx, http.StatusBadRequest, "发送邀请邮件需要填写邮箱")
+		return
+	}
+
+	ttl := c.inviteTTL
+	if input.ExpiresIn != "" {
+		d, err := time.ParseDuration(input.ExpiresIn)
+		if err != nil {
+			utils.RespondError(ctx, http.StatusBadRequest, "无效的有效期")
+			return
+		}
+		ttl = d
+	}
+	var expiresAt *time.Time
+	if ttl > 0 {
+		t := time.Now().Add(ttl)
+		expiresAt = &t
+	}
+
+	code, err := utils.RandomToken(18)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "生成邀请码失败")
+		return
+	}
+
+	create := c.client.Invitation.Create().
+		SetCodeHash(utils.HashToken(code)).
+		SetNillableExpiresAt(expiresAt).
+		SetCreator(admin).
+		SetCreatedAt(time.Now())
+	if input.Email != "" {
+		create.SetEmail(input.Email)
+	}
+	inv, err := create.Save(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "创建邀请码失败: "+err.Error())
+		return
+	}
+
+	if input.SendEmail {
+		inviter := admin.Nickname
+		if inviter == "" {
+			inviter = admin.Username
+		}
+		if err := c.notifier.SendInvitation(input.Email, code, inviter, expiresAt); err != nil {
+			log.Printf("[Invitation] 发送邀请邮件失败: %v", err)
+		}
+	}
+
+	data := invitationResponse(inv)
+	data["code"] = code
+	utils.RespondSuccess(ctx, data)
+}
+
+// GetInvitations 获取所有邀请码及使用情况
+func (c *UserController) GetInvitations(ctx *gin.Context) {
+	if _, ok := c.requireAdmin(ctx); !ok {
+		return
+	}
+
+	invitations, err := c.client.Invitation.Query().
+		WithCreator().
+		WithInvitee().
+		Order(ent.Desc(invitation.FieldCreatedAt)).
+		All(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "获取邀请码失败")
+		return
+	}
+
+	list := make([]gin.H, 0, len(invitations))
+	for _, inv := range invitations {
+		list = append(list, invitationResponse(inv))
+	}
+	utils.RespondSuccess(ctx, list)
+}
+
+// DeleteInvitation 删除未使用的邀请码
+func (c *UserController) DeleteInvitation(ctx *gin.Context) {
+	if _, ok := c.requireAdmin(ctx); !ok {
+		return
+	}
+
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的邀请码ID")
+		return
+	}
+
+	n, err := c.client.Invitation.Delete().
+		Where(invitation.IDEQ(id), invitation.UsedAtIsNil()).
+		Exec(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "删除邀请码失败")
+		return
+	}
+	if n == 0 {
+		utils.RespondError(ctx, http.StatusNotFound, "邀请码不存在或已被使用")
+		return
+	}
+	utils.RespondSuccess(ctx, gin.H{"message": "邀请码已删除"})
+}
+
+func invitationResponse(inv *ent.Invitation) gin.H {
+	data := gin.H{
+		"id":         inv.ID,
+		"email":      inv.Email,
+		"expires_at": inv.ExpiresAt,
+		"used_at":    inv.UsedAt,
+		"created_at": inv.CreatedAt,
+	}
+	if inv.Edges.Creator != nil {
+		data["creator"] = inv.Edges.Creator.Username
+	}
+	if inv.Edges.Invitee != nil {
+		data["invitee"] = inv.Edges.Invitee.Username
+	}
+	return data
+}
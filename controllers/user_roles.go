@@ -0,0 +1,90 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"blog-go/ent"
+	"blog-go/ent/user"
+	"blog-go/services"
+	"blog-go/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetRoles 获取所有角色及其权限
+func (c *UserController) GetRoles(ctx *gin.Context) {
+	list := []gin.H{}
+	for _, role := range services.Roles() {
+		list = append(list, gin.H{"role": role, "permissions": services.RolePermissions(role)})
+	}
+	utils.RespondSuccess(ctx, list)
+}
+
+// GetUsers 获取用户列表，可按角色筛选
+func (c *UserController) GetUsers(ctx *gin.Context) {
+	query := c.client.User.Query().Order(ent.Asc(user.FieldID))
+	if role := ctx.Query("role"); role != "" {
+		query.Where(user.RoleEQ(role))
+	}
+	users, err := query.All(context.Background())
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "获取用户列表失败")
+		return
+	}
+
+	list := make([]gin.H, 0, len(users))
+	for _, u := range users {
+		list = append(list, gin.H{
+			"id":             u.ID,
+			"username":       u.Username,
+			"email":          u.Email,
+			"nickname":       u.Nickname,
+			"role":           u.Role,
+			"email_verified": u.EmailVerifiedAt != nil,
+			"created_at":     u.CreatedAt,
+		})
+	}
+	utils.RespondSuccess(ctx, list)
+}
+
+// UpdateUserRole 修改用户角色，立即生效
+//
+// 不能修改自己的角色，避免管理员误操作后无法再管理站点。
+func (c *UserController) UpdateUserRole(ctx *gin.Context) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的用户ID")
+		return
+	}
+
+	var input struct {
+		Role string `json:"role" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	if !services.ValidRole(input.Role) {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的角色")
+		return
+	}
+	if u := currentUser(ctx); u != nil && u.ID == id {
+		utils.RespondError(ctx, http.StatusBadRequest, "不能修改自己的角色")
+		return
+	}
+
+	u, err := c.client.User.UpdateOneID(id).
+		SetRole(input.Role).
+		Save(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			utils.RespondError(ctx, http.StatusNotFound, "用户不存在")
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, "修改角色失败")
+		return
+	}
+	utils.RespondSuccess(ctx, gin.H{"id": u.ID, "username": u.Username, "role": u.Role})
+}
@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"blog-go/ent"
+	"blog-go/services"
+	"blog-go/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// RefreshToken 使用刷新令牌换取新的访问令牌，刷新令牌同时更换
+func (c *UserController) RefreshToken(ctx *gin.Context) {
+	pair, err := c.sessions.Refresh(context.Background(), refreshTokenFromRequest(ctx), ctx.ClientIP(), ctx.Request.UserAgent())
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidRefreshToken) {
+			clearSessionCookies(ctx)
+			utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, "刷新令牌失败")
+		return
+	}
+	setSessionCookies(ctx, pair)
+
+	utils.RespondSuccess(ctx, gin.H{
+		"token":         pair.AccessToken,
+		"refresh_token": pair.RefreshToken,
+		"expires_in":    int(pair.AccessTTL.Seconds()),
+	})
+}
+
+// GetSessions 获取当前用户已登录的设备
+func (c *UserController) GetSessions(ctx *gin.Context) {
+	uid := ctx.GetInt("userID")
+	if uid == 0 {
+		utils.RespondError(ctx, http.StatusUnauthorized, "未授权访问")
+		return
+	}
+
+	sessions, err := c.sessions.ActiveSessions(context.Background(), uid)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "获取登录设备失败")
+		return
+	}
+
+	current := ctx.GetInt("sessionID")
+	list := make([]gin.H, 0, len(sessions))
+	for _, s := range sessions {
+		list = append(list, gin.H{
+			"id":           s.ID,
+			"device":       s.Device,
+			"ip":           s.IP,
+			"user_agent":   s.UserAgent,
+			"created_at":   s.CreatedAt,
+			"last_used_at": s.LastUsedAt,
+			"current":      s.ID == current,
+		})
+	}
+	utils.RespondSuccess(ctx, list)
+}
+
+// RevokeSession 注销当前用户的某个登录设备
+func (c *UserController) RevokeSession(ctx *gin.Context) {
+	uid := ctx.GetInt("userID")
+	if uid == 0 {
+		utils.RespondError(ctx, http.StatusUnauthorized, "未授权访问")
+		return
+	}
+	sessionID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "无效的会话ID")
+		return
+	}
+
+	if err := c.sessions.RevokeUserSession(context.Background(), uid, sessionID); err != nil {
+		if ent.IsNotFound(err) {
+			utils.RespondError(ctx, http.StatusNotFound, "会话不存在")
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, "注销会话失败")
+		return
+	}
+	if sessionID == ctx.GetInt("sessionID") {
+		clearSessionCookies(ctx)
+	}
+	utils.RespondSuccess(ctx, gin.H{"message": "已注销该设备"})
+}
+
+// RevokeOtherSessions 注销当前设备以外的所有登录设备
+func (c *UserController) RevokeOtherSessions(ctx *gin.Context) {
+	uid := ctx.GetInt("userID")
+	if uid == 0 {
+		utils.RespondError(ctx, http.StatusUnauthorized, "未授权访问")
+		return
+	}
+	if err := c.sessions.RevokeAll(context.Background(), uid, ctx.GetInt("sessionID")); err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, "注销会话失败")
+		return
+	}
+	utils.RespondSuccess(ctx, gin.H{"message": "已注销其他设备"})
+}
+
+// refreshTokenFromRequest 从 cookie 或请求体中获取刷新令牌（非浏览器客户端使用请求体）
+func refreshTokenFromRequest(ctx *gin.Context) string {
+	if token, err := ctx.Cookie(refreshTokenCookie); err == nil && token != "" {
+		return token
+	}
+	var input struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+	ctx.ShouldBindJSON(&input)
+	return input.RefreshToken
+}
+
+// accessTokenSession 从访问令牌中读取会话ID，只校验签名，已过期的令牌同样可用于退出登录
+func (c *UserController) accessTokenSession(ctx *gin.Context) (int, bool) {
+	token := strings.TrimPrefix(ctx.GetHeader("Authorization"), "Bearer ")
+	if token == "" || token == ctx.GetHeader("Authorization") {
+		var err error
+		if token, err = ctx.Cookie("auth_token"); err != nil {
+			return 0, false
+		}
+	}
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(c.secret), nil
+	}, jwt.WithoutClaimsValidation(), jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
+		return 0, false
+	}
+	sid, ok := claims["sid"].(float64)
+	return int(sid), ok
+}
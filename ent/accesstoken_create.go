@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/accesstoken"
+	"blog-go/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AccessTokenCreate is the builder for creating a AccessToken entity.
+type AccessTokenCreate struct {
+	config
+	mutation *AccessTokenMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (atc *AccessTokenCreate) SetName(s string) *AccessTokenCreate {
+	atc.mutation.SetName(s)
+	return atc
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (atc *AccessTokenCreate) SetTokenHash(s string) *AccessTokenCreate {
+	atc.mutation.SetTokenHash(s)
+	return atc
+}
+
+// SetPrefix sets the "prefix" field.
+func (atc *AccessTokenCreate) SetPrefix(s string) *AccessTokenCreate {
+	atc.mutation.SetPrefix(s)
+	return atc
+}
+
+// SetScopes sets the "scopes" field.
+func (atc *AccessTokenCreate) SetScopes(s []string) *AccessTokenCreate {
+	atc.mutation.SetScopes(s)
+	return atc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (atc *AccessTokenCreate) SetExpiresAt(t time.Time) *AccessTokenCreate {
+	atc.mutation.SetExpiresAt(t)
+	return atc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (atc *AccessTokenCreate) SetNillableExpiresAt(t *time.Time) *AccessTokenCreate {
+	if t != nil {
+		atc.SetExpiresAt(*t)
+	}
+	return atc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (atc *AccessTokenCreate) SetLastUsedAt(t time.Time) *AccessTokenCreate {
+	atc.mutation.SetLastUsedAt(t)
+	return atc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (atc *AccessTokenCreate) SetNillableLastUsedAt(t *time.Time) *AccessTokenCreate {
+	if t != nil {
+		atc.SetLastUsedAt(*t)
+	}
+	return atc
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (atc *AccessTokenCreate) SetLastUsedIP(s string) *AccessTokenCreate {
+	atc.mutation.SetLastUsedIP(s)
+	return atc
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (atc *AccessTokenCreate) SetNillableLastUsedIP(s *string) *AccessTokenCreate {
+	if s != nil {
+		atc.SetLastUsedIP(*s)
+	}
+	return atc
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (atc *AccessTokenCreate) SetRevokedAt(t time.Time) *AccessTokenCreate {
+	atc.mutation.SetRevokedAt(t)
+	return atc
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (atc *AccessTokenCreate) SetNillableRevokedAt(t *time.Time) *AccessTokenCreate {
+	if t != nil {
+		atc.SetRevokedAt(*t)
+	}
+	return atc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (atc *AccessTokenCreate) SetCreatedAt(t time.Time) *AccessTokenCreate {
+	atc.mutation.SetCreatedAt(t)
+	return atc
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (atc *AccessTokenCreate) SetUserID(id int) *AccessTokenCreate {
+	atc.mutation.SetUserID(id)
+	return atc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (atc *AccessTokenCreate) SetUser(u *User) *AccessTokenCreate {
+	return atc.SetUserID(u.ID)
+}
+
+// Mutation returns the AccessTokenMutation object of the builder.
+func (atc *AccessTokenCreate) Mutation() *AccessTokenMutation {
+	return atc.mutation
+}
+
+// Save creates the AccessToken in the database.
+func (atc *AccessTokenCreate) Save(ctx context.Context) (*AccessToken, error) {
+	return withHooks(ctx, atc.sqlSave, atc.mutation, atc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (atc *AccessTokenCreate) SaveX(ctx context.Context) *AccessToken {
+	v, err := atc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atc *AccessTokenCreate) Exec(ctx context.Context) error {
+	_, err := atc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atc *AccessTokenCreate) ExecX(ctx context.Context) {
+	if err := atc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atc *AccessTokenCreate) check() error {
+	if _, ok := atc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AccessToken.name"`)}
+	}
+	if v, ok := atc.mutation.Name(); ok {
+		if err := accesstoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AccessToken.name": %w`, err)}
+		}
+	}
+	if _, ok := atc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "AccessToken.token_hash"`)}
+	}
+	if v, ok := atc.mutation.TokenHash(); ok {
+		if err := accesstoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "AccessToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := atc.mutation.Prefix(); !ok {
+		return &ValidationError{Name: "prefix", err: errors.New(`ent: missing required field "AccessToken.prefix"`)}
+	}
+	if _, ok := atc.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "AccessToken.scopes"`)}
+	}
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccessToken.created_at"`)}
+	}
+	if _, ok := atc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "AccessToken.user"`)}
+	}
+	return nil
+}
+
+func (atc *AccessTokenCreate) sqlSave(ctx context.Context) (*AccessToken, error) {
+	if err := atc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := atc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, atc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	atc.mutation.id = &_node.ID
+	atc.mutation.done = true
+	return _node, nil
+}
+
+func (atc *AccessTokenCreate) createSpec() (*AccessToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccessToken{config: atc.config}
+		_spec = sqlgraph.NewCreateSpec(accesstoken.Table, sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt))
+	)
+	if value, ok := atc.mutation.Name(); ok {
+		_spec.SetField(accesstoken.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := atc.mutation.TokenHash(); ok {
+		_spec.SetField(accesstoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := atc.mutation.Prefix(); ok {
+		_spec.SetField(accesstoken.FieldPrefix, field.TypeString, value)
+		_node.Prefix = value
+	}
+	if value, ok := atc.mutation.Scopes(); ok {
+		_spec.SetField(accesstoken.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := atc.mutation.ExpiresAt(); ok {
+		_spec.SetField(accesstoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := atc.mutation.LastUsedAt(); ok {
+		_spec.SetField(accesstoken.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := atc.mutation.LastUsedIP(); ok {
+		_spec.SetField(accesstoken.FieldLastUsedIP, field.TypeString, value)
+		_node.LastUsedIP = value
+	}
+	if value, ok := atc.mutation.RevokedAt(); ok {
+		_spec.SetField(accesstoken.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := atc.mutation.CreatedAt(); ok {
+		_spec.SetField(accesstoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := atc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accesstoken.UserTable,
+			Columns: []string{accesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_access_tokens = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AccessTokenCreateBulk is the builder for creating many AccessToken entities in bulk.
+type AccessTokenCreateBulk struct {
+	config
+	err      error
+	builders []*AccessTokenCreate
+}
+
+// Save creates the AccessToken entities in the database.
+func (atcb *AccessTokenCreateBulk) Save(ctx context.Context) ([]*AccessToken, error) {
+	if atcb.err != nil {
+		return nil, atcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(atcb.builders))
+	nodes := make([]*AccessToken, len(atcb.builders))
+	mutators := make([]Mutator, len(atcb.builders))
+	for i := range atcb.builders {
+		func(i int, root context.Context) {
+			builder := atcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccessTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, atcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, atcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, atcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atcb *AccessTokenCreateBulk) SaveX(ctx context.Context) []*AccessToken {
+	v, err := atcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atcb *AccessTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := atcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atcb *AccessTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := atcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,696 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/accesstoken"
+	"blog-go/ent/predicate"
+	"blog-go/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// AccessTokenUpdate is the builder for updating AccessToken entities.
+type AccessTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccessTokenMutation
+}
+
+// Where appends a list predicates to the AccessTokenUpdate builder.
+func (atu *AccessTokenUpdate) Where(ps ...predicate.AccessToken) *AccessTokenUpdate {
+	atu.mutation.Where(ps...)
+	return atu
+}
+
+// SetName sets the "name" field.
+func (atu *AccessTokenUpdate) SetName(s string) *AccessTokenUpdate {
+	atu.mutation.SetName(s)
+	return atu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (atu *AccessTokenUpdate) SetNillableName(s *string) *AccessTokenUpdate {
+	if s != nil {
+		atu.SetName(*s)
+	}
+	return atu
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (atu *AccessTokenUpdate) SetTokenHash(s string) *AccessTokenUpdate {
+	atu.mutation.SetTokenHash(s)
+	return atu
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (atu *AccessTokenUpdate) SetNillableTokenHash(s *string) *AccessTokenUpdate {
+	if s != nil {
+		atu.SetTokenHash(*s)
+	}
+	return atu
+}
+
+// SetPrefix sets the "prefix" field.
+func (atu *AccessTokenUpdate) SetPrefix(s string) *AccessTokenUpdate {
+	atu.mutation.SetPrefix(s)
+	return atu
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (atu *AccessTokenUpdate) SetNillablePrefix(s *string) *AccessTokenUpdate {
+	if s != nil {
+		atu.SetPrefix(*s)
+	}
+	return atu
+}
+
+// SetScopes sets the "scopes" field.
+func (atu *AccessTokenUpdate) SetScopes(s []string) *AccessTokenUpdate {
+	atu.mutation.SetScopes(s)
+	return atu
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (atu *AccessTokenUpdate) AppendScopes(s []string) *AccessTokenUpdate {
+	atu.mutation.AppendScopes(s)
+	return atu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (atu *AccessTokenUpdate) SetExpiresAt(t time.Time) *AccessTokenUpdate {
+	atu.mutation.SetExpiresAt(t)
+	return atu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (atu *AccessTokenUpdate) SetNillableExpiresAt(t *time.Time) *AccessTokenUpdate {
+	if t != nil {
+		atu.SetExpiresAt(*t)
+	}
+	return atu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (atu *AccessTokenUpdate) ClearExpiresAt() *AccessTokenUpdate {
+	atu.mutation.ClearExpiresAt()
+	return atu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (atu *AccessTokenUpdate) SetLastUsedAt(t time.Time) *AccessTokenUpdate {
+	atu.mutation.SetLastUsedAt(t)
+	return atu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (atu *AccessTokenUpdate) SetNillableLastUsedAt(t *time.Time) *AccessTokenUpdate {
+	if t != nil {
+		atu.SetLastUsedAt(*t)
+	}
+	return atu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (atu *AccessTokenUpdate) ClearLastUsedAt() *AccessTokenUpdate {
+	atu.mutation.ClearLastUsedAt()
+	return atu
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (atu *AccessTokenUpdate) SetLastUsedIP(s string) *AccessTokenUpdate {
+	atu.mutation.SetLastUsedIP(s)
+	return atu
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (atu *AccessTokenUpdate) SetNillableLastUsedIP(s *string) *AccessTokenUpdate {
+	if s != nil {
+		atu.SetLastUsedIP(*s)
+	}
+	return atu
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (atu *AccessTokenUpdate) ClearLastUsedIP() *AccessTokenUpdate {
+	atu.mutation.ClearLastUsedIP()
+	return atu
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (atu *AccessTokenUpdate) SetRevokedAt(t time.Time) *AccessTokenUpdate {
+	atu.mutation.SetRevokedAt(t)
+	return atu
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (atu *AccessTokenUpdate) SetNillableRevokedAt(t *time.Time) *AccessTokenUpdate {
+	if t != nil {
+		atu.SetRevokedAt(*t)
+	}
+	return atu
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (atu *AccessTokenUpdate) ClearRevokedAt() *AccessTokenUpdate {
+	atu.mutation.ClearRevokedAt()
+	return atu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (atu *AccessTokenUpdate) SetCreatedAt(t time.Time) *AccessTokenUpdate {
+	atu.mutation.SetCreatedAt(t)
+	return atu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (atu *AccessTokenUpdate) SetNillableCreatedAt(t *time.Time) *AccessTokenUpdate {
+	if t != nil {
+		atu.SetCreatedAt(*t)
+	}
+	return atu
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (atu *AccessTokenUpdate) SetUserID(id int) *AccessTokenUpdate {
+	atu.mutation.SetUserID(id)
+	return atu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (atu *AccessTokenUpdate) SetUser(u *User) *AccessTokenUpdate {
+	return atu.SetUserID(u.ID)
+}
+
+// Mutation returns the AccessTokenMutation object of the builder.
+func (atu *AccessTokenUpdate) Mutation() *AccessTokenMutation {
+	return atu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (atu *AccessTokenUpdate) ClearUser() *AccessTokenUpdate {
+	atu.mutation.ClearUser()
+	return atu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (atu *AccessTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, atu.sqlSave, atu.mutation, atu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atu *AccessTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := atu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (atu *AccessTokenUpdate) Exec(ctx context.Context) error {
+	_, err := atu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atu *AccessTokenUpdate) ExecX(ctx context.Context) {
+	if err := atu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atu *AccessTokenUpdate) check() error {
+	if v, ok := atu.mutation.Name(); ok {
+		if err := accesstoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AccessToken.name": %w`, err)}
+		}
+	}
+	if v, ok := atu.mutation.TokenHash(); ok {
+		if err := accesstoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "AccessToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := atu.mutation.UserID(); atu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AccessToken.user"`)
+	}
+	return nil
+}
+
+func (atu *AccessTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := atu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accesstoken.Table, accesstoken.Columns, sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt))
+	if ps := atu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atu.mutation.Name(); ok {
+		_spec.SetField(accesstoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := atu.mutation.TokenHash(); ok {
+		_spec.SetField(accesstoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := atu.mutation.Prefix(); ok {
+		_spec.SetField(accesstoken.FieldPrefix, field.TypeString, value)
+	}
+	if value, ok := atu.mutation.Scopes(); ok {
+		_spec.SetField(accesstoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := atu.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesstoken.FieldScopes, value)
+		})
+	}
+	if value, ok := atu.mutation.ExpiresAt(); ok {
+		_spec.SetField(accesstoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if atu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(accesstoken.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := atu.mutation.LastUsedAt(); ok {
+		_spec.SetField(accesstoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if atu.mutation.LastUsedAtCleared() {
+		_spec.ClearField(accesstoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := atu.mutation.LastUsedIP(); ok {
+		_spec.SetField(accesstoken.FieldLastUsedIP, field.TypeString, value)
+	}
+	if atu.mutation.LastUsedIPCleared() {
+		_spec.ClearField(accesstoken.FieldLastUsedIP, field.TypeString)
+	}
+	if value, ok := atu.mutation.RevokedAt(); ok {
+		_spec.SetField(accesstoken.FieldRevokedAt, field.TypeTime, value)
+	}
+	if atu.mutation.RevokedAtCleared() {
+		_spec.ClearField(accesstoken.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := atu.mutation.CreatedAt(); ok {
+		_spec.SetField(accesstoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if atu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accesstoken.UserTable,
+			Columns: []string{accesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := atu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accesstoken.UserTable,
+			Columns: []string{accesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, atu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accesstoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	atu.mutation.done = true
+	return n, nil
+}
+
+// AccessTokenUpdateOne is the builder for updating a single AccessToken entity.
+type AccessTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccessTokenMutation
+}
+
+// SetName sets the "name" field.
+func (atuo *AccessTokenUpdateOne) SetName(s string) *AccessTokenUpdateOne {
+	atuo.mutation.SetName(s)
+	return atuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (atuo *AccessTokenUpdateOne) SetNillableName(s *string) *AccessTokenUpdateOne {
+	if s != nil {
+		atuo.SetName(*s)
+	}
+	return atuo
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (atuo *AccessTokenUpdateOne) SetTokenHash(s string) *AccessTokenUpdateOne {
+	atuo.mutation.SetTokenHash(s)
+	return atuo
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (atuo *AccessTokenUpdateOne) SetNillableTokenHash(s *string) *AccessTokenUpdateOne {
+	if s != nil {
+		atuo.SetTokenHash(*s)
+	}
+	return atuo
+}
+
+// SetPrefix sets the "prefix" field.
+func (atuo *AccessTokenUpdateOne) SetPrefix(s string) *AccessTokenUpdateOne {
+	atuo.mutation.SetPrefix(s)
+	return atuo
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (atuo *AccessTokenUpdateOne) SetNillablePrefix(s *string) *AccessTokenUpdateOne {
+	if s != nil {
+		atuo.SetPrefix(*s)
+	}
+	return atuo
+}
+
+// SetScopes sets the "scopes" field.
+func (atuo *AccessTokenUpdateOne) SetScopes(s []string) *AccessTokenUpdateOne {
+	atuo.mutation.SetScopes(s)
+	return atuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (atuo *AccessTokenUpdateOne) AppendScopes(s []string) *AccessTokenUpdateOne {
+	atuo.mutation.AppendScopes(s)
+	return atuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (atuo *AccessTokenUpdateOne) SetExpiresAt(t time.Time) *AccessTokenUpdateOne {
+	atuo.mutation.SetExpiresAt(t)
+	return atuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (atuo *AccessTokenUpdateOne) SetNillableExpiresAt(t *time.Time) *AccessTokenUpdateOne {
+	if t != nil {
+		atuo.SetExpiresAt(*t)
+	}
+	return atuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (atuo *AccessTokenUpdateOne) ClearExpiresAt() *AccessTokenUpdateOne {
+	atuo.mutation.ClearExpiresAt()
+	return atuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (atuo *AccessTokenUpdateOne) SetLastUsedAt(t time.Time) *AccessTokenUpdateOne {
+	atuo.mutation.SetLastUsedAt(t)
+	return atuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (atuo *AccessTokenUpdateOne) SetNillableLastUsedAt(t *time.Time) *AccessTokenUpdateOne {
+	if t != nil {
+		atuo.SetLastUsedAt(*t)
+	}
+	return atuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (atuo *AccessTokenUpdateOne) ClearLastUsedAt() *AccessTokenUpdateOne {
+	atuo.mutation.ClearLastUsedAt()
+	return atuo
+}
+
+// SetLastUsedIP sets the "last_used_ip" field.
+func (atuo *AccessTokenUpdateOne) SetLastUsedIP(s string) *AccessTokenUpdateOne {
+	atuo.mutation.SetLastUsedIP(s)
+	return atuo
+}
+
+// SetNillableLastUsedIP sets the "last_used_ip" field if the given value is not nil.
+func (atuo *AccessTokenUpdateOne) SetNillableLastUsedIP(s *string) *AccessTokenUpdateOne {
+	if s != nil {
+		atuo.SetLastUsedIP(*s)
+	}
+	return atuo
+}
+
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (atuo *AccessTokenUpdateOne) ClearLastUsedIP() *AccessTokenUpdateOne {
+	atuo.mutation.ClearLastUsedIP()
+	return atuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (atuo *AccessTokenUpdateOne) SetRevokedAt(t time.Time) *AccessTokenUpdateOne {
+	atuo.mutation.SetRevokedAt(t)
+	return atuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (atuo *AccessTokenUpdateOne) SetNillableRevokedAt(t *time.Time) *AccessTokenUpdateOne {
+	if t != nil {
+		atuo.SetRevokedAt(*t)
+	}
+	return atuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (atuo *AccessTokenUpdateOne) ClearRevokedAt() *AccessTokenUpdateOne {
+	atuo.mutation.ClearRevokedAt()
+	return atuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (atuo *AccessTokenUpdateOne) SetCreatedAt(t time.Time) *AccessTokenUpdateOne {
+	atuo.mutation.SetCreatedAt(t)
+	return atuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (atuo *AccessTokenUpdateOne) SetNillableCreatedAt(t *time.Time) *AccessTokenUpdateOne {
+	if t != nil {
+		atuo.SetCreatedAt(*t)
+	}
+	return atuo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (atuo *AccessTokenUpdateOne) SetUserID(id int) *AccessTokenUpdateOne {
+	atuo.mutation.SetUserID(id)
+	return atuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (atuo *AccessTokenUpdateOne) SetUser(u *User) *AccessTokenUpdateOne {
+	return atuo.SetUserID(u.ID)
+}
+
+// Mutation returns the AccessTokenMutation object of the builder.
+func (atuo *AccessTokenUpdateOne) Mutation() *AccessTokenMutation {
+	return atuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (atuo *AccessTokenUpdateOne) ClearUser() *AccessTokenUpdateOne {
+	atuo.mutation.ClearUser()
+	return atuo
+}
+
+// Where appends a list predicates to the AccessTokenUpdate builder.
+func (atuo *AccessTokenUpdateOne) Where(ps ...predicate.AccessToken) *AccessTokenUpdateOne {
+	atuo.mutation.Where(ps...)
+	return atuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (atuo *AccessTokenUpdateOne) Select(field string, fields ...string) *AccessTokenUpdateOne {
+	atuo.fields = append([]string{field}, fields...)
+	return atuo
+}
+
+// Save executes the query and returns the updated AccessToken entity.
+func (atuo *AccessTokenUpdateOne) Save(ctx context.Context) (*AccessToken, error) {
+	return withHooks(ctx, atuo.sqlSave, atuo.mutation, atuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atuo *AccessTokenUpdateOne) SaveX(ctx context.Context) *AccessToken {
+	node, err := atuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (atuo *AccessTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := atuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atuo *AccessTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := atuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atuo *AccessTokenUpdateOne) check() error {
+	if v, ok := atuo.mutation.Name(); ok {
+		if err := accesstoken.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AccessToken.name": %w`, err)}
+		}
+	}
+	if v, ok := atuo.mutation.TokenHash(); ok {
+		if err := accesstoken.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "AccessToken.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := atuo.mutation.UserID(); atuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "AccessToken.user"`)
+	}
+	return nil
+}
+
+func (atuo *AccessTokenUpdateOne) sqlSave(ctx context.Context) (_node *AccessToken, err error) {
+	if err := atuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accesstoken.Table, accesstoken.Columns, sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt))
+	id, ok := atuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccessToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := atuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accesstoken.FieldID)
+		for _, f := range fields {
+			if !accesstoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accesstoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := atuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atuo.mutation.Name(); ok {
+		_spec.SetField(accesstoken.FieldName, field.TypeString, value)
+	}
+	if value, ok := atuo.mutation.TokenHash(); ok {
+		_spec.SetField(accesstoken.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := atuo.mutation.Prefix(); ok {
+		_spec.SetField(accesstoken.FieldPrefix, field.TypeString, value)
+	}
+	if value, ok := atuo.mutation.Scopes(); ok {
+		_spec.SetField(accesstoken.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := atuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, accesstoken.FieldScopes, value)
+		})
+	}
+	if value, ok := atuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(accesstoken.FieldExpiresAt, field.TypeTime, value)
+	}
+	if atuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(accesstoken.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := atuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(accesstoken.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if atuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(accesstoken.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := atuo.mutation.LastUsedIP(); ok {
+		_spec.SetField(accesstoken.FieldLastUsedIP, field.TypeString, value)
+	}
+	if atuo.mutation.LastUsedIPCleared() {
+		_spec.ClearField(accesstoken.FieldLastUsedIP, field.TypeString)
+	}
+	if value, ok := atuo.mutation.RevokedAt(); ok {
+		_spec.SetField(accesstoken.FieldRevokedAt, field.TypeTime, value)
+	}
+	if atuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(accesstoken.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := atuo.mutation.CreatedAt(); ok {
+		_spec.SetField(accesstoken.FieldCreatedAt, field.TypeTime, value)
+	}
+	if atuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accesstoken.UserTable,
+			Columns: []string{accesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := atuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accesstoken.UserTable,
+			Columns: []string{accesstoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AccessToken{config: atuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, atuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accesstoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	atuo.mutation.done = true
+	return _node, nil
+}
@@ -11,13 +11,27 @@ import (
 
 	"blog-go/ent/migrate"
 
+	"blog-go/ent/accesstoken"
 	"blog-go/ent/book"
 	"blog-go/ent/collection"
 	"blog-go/ent/comment"
+	"blog-go/ent/commentreaction"
+	"blog-go/ent/commentrevision"
+	"blog-go/ent/emailsubscription"
 	"blog-go/ent/friend"
 	"blog-go/ent/hitokoto"
+	"blog-go/ent/identity"
 	"blog-go/ent/image"
+	"blog-go/ent/invitation"
+	"blog-go/ent/passwordreset"
 	"blog-go/ent/post"
+	"blog-go/ent/postrevision"
+	"blog-go/ent/postslughistory"
+	"blog-go/ent/recoverycode"
+	"blog-go/ent/searchdocument"
+	"blog-go/ent/session"
+	"blog-go/ent/spamcorpus"
+	"blog-go/ent/spamtoken"
 	"blog-go/ent/tag"
 	"blog-go/ent/user"
 
@@ -25,6 +39,8 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -32,20 +48,48 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AccessToken is the client for interacting with the AccessToken builders.
+	AccessToken *AccessTokenClient
 	// Book is the client for interacting with the Book builders.
 	Book *BookClient
 	// Collection is the client for interacting with the Collection builders.
 	Collection *CollectionClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
+	// CommentReaction is the client for interacting with the CommentReaction builders.
+	CommentReaction *CommentReactionClient
+	// CommentRevision is the client for interacting with the CommentRevision builders.
+	CommentRevision *CommentRevisionClient
+	// EmailSubscription is the client for interacting with the EmailSubscription builders.
+	EmailSubscription *EmailSubscriptionClient
 	// Friend is the client for interacting with the Friend builders.
 	Friend *FriendClient
 	// Hitokoto is the client for interacting with the Hitokoto builders.
 	Hitokoto *HitokotoClient
+	// Identity is the client for interacting with the Identity builders.
+	Identity *IdentityClient
 	// Image is the client for interacting with the Image builders.
 	Image *ImageClient
+	// Invitation is the client for interacting with the Invitation builders.
+	Invitation *InvitationClient
+	// PasswordReset is the client for interacting with the PasswordReset builders.
+	PasswordReset *PasswordResetClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
+	// PostRevision is the client for interacting with the PostRevision builders.
+	PostRevision *PostRevisionClient
+	// PostSlugHistory is the client for interacting with the PostSlugHistory builders.
+	PostSlugHistory *PostSlugHistoryClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
+	// SearchDocument is the client for interacting with the SearchDocument builders.
+	SearchDocument *SearchDocumentClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// SpamCorpus is the client for interacting with the SpamCorpus builders.
+	SpamCorpus *SpamCorpusClient
+	// SpamToken is the client for interacting with the SpamToken builders.
+	SpamToken *SpamTokenClient
 	// Tag is the client for interacting with the Tag builders.
 	Tag *TagClient
 	// User is the client for interacting with the User builders.
@@ -61,13 +105,27 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AccessToken = NewAccessTokenClient(c.config)
 	c.Book = NewBookClient(c.config)
 	c.Collection = NewCollectionClient(c.config)
 	c.Comment = NewCommentClient(c.config)
+	c.CommentReaction = NewCommentReactionClient(c.config)
+	c.CommentRevision = NewCommentRevisionClient(c.config)
+	c.EmailSubscription = NewEmailSubscriptionClient(c.config)
 	c.Friend = NewFriendClient(c.config)
 	c.Hitokoto = NewHitokotoClient(c.config)
+	c.Identity = NewIdentityClient(c.config)
 	c.Image = NewImageClient(c.config)
+	c.Invitation = NewInvitationClient(c.config)
+	c.PasswordReset = NewPasswordResetClient(c.config)
 	c.Post = NewPostClient(c.config)
+	c.PostRevision = NewPostRevisionClient(c.config)
+	c.PostSlugHistory = NewPostSlugHistoryClient(c.config)
+	c.RecoveryCode = NewRecoveryCodeClient(c.config)
+	c.SearchDocument = NewSearchDocumentClient(c.config)
+	c.Session = NewSessionClient(c.config)
+	c.SpamCorpus = NewSpamCorpusClient(c.config)
+	c.SpamToken = NewSpamTokenClient(c.config)
 	c.Tag = NewTagClient(c.config)
 	c.User = NewUserClient(c.config)
 }
@@ -160,17 +218,31 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		Book:       NewBookClient(cfg),
-		Collection: NewCollectionClient(cfg),
-		Comment:    NewCommentClient(cfg),
-		Friend:     NewFriendClient(cfg),
-		Hitokoto:   NewHitokotoClient(cfg),
-		Image:      NewImageClient(cfg),
-		Post:       NewPostClient(cfg),
-		Tag:        NewTagClient(cfg),
-		User:       NewUserClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		AccessToken:       NewAccessTokenClient(cfg),
+		Book:              NewBookClient(cfg),
+		Collection:        NewCollectionClient(cfg),
+		Comment:           NewCommentClient(cfg),
+		CommentReaction:   NewCommentReactionClient(cfg),
+		CommentRevision:   NewCommentRevisionClient(cfg),
+		EmailSubscription: NewEmailSubscriptionClient(cfg),
+		Friend:            NewFriendClient(cfg),
+		Hitokoto:          NewHitokotoClient(cfg),
+		Identity:          NewIdentityClient(cfg),
+		Image:             NewImageClient(cfg),
+		Invitation:        NewInvitationClient(cfg),
+		PasswordReset:     NewPasswordResetClient(cfg),
+		Post:              NewPostClient(cfg),
+		PostRevision:      NewPostRevisionClient(cfg),
+		PostSlugHistory:   NewPostSlugHistoryClient(cfg),
+		RecoveryCode:      NewRecoveryCodeClient(cfg),
+		SearchDocument:    NewSearchDocumentClient(cfg),
+		Session:           NewSessionClient(cfg),
+		SpamCorpus:        NewSpamCorpusClient(cfg),
+		SpamToken:         NewSpamTokenClient(cfg),
+		Tag:               NewTagClient(cfg),
+		User:              NewUserClient(cfg),
 	}, nil
 }
 
@@ -188,24 +260,38 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:        ctx,
-		config:     cfg,
-		Book:       NewBookClient(cfg),
-		Collection: NewCollectionClient(cfg),
-		Comment:    NewCommentClient(cfg),
-		Friend:     NewFriendClient(cfg),
-		Hitokoto:   NewHitokotoClient(cfg),
-		Image:      NewImageClient(cfg),
-		Post:       NewPostClient(cfg),
-		Tag:        NewTagClient(cfg),
-		User:       NewUserClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		AccessToken:       NewAccessTokenClient(cfg),
+		Book:              NewBookClient(cfg),
+		Collection:        NewCollectionClient(cfg),
+		Comment:           NewCommentClient(cfg),
+		CommentReaction:   NewCommentReactionClient(cfg),
+		CommentRevision:   NewCommentRevisionClient(cfg),
+		EmailSubscription: NewEmailSubscriptionClient(cfg),
+		Friend:            NewFriendClient(cfg),
+		Hitokoto:          NewHitokotoClient(cfg),
+		Identity:          NewIdentityClient(cfg),
+		Image:             NewImageClient(cfg),
+		Invitation:        NewInvitationClient(cfg),
+		PasswordReset:     NewPasswordResetClient(cfg),
+		Post:              NewPostClient(cfg),
+		PostRevision:      NewPostRevisionClient(cfg),
+		PostSlugHistory:   NewPostSlugHistoryClient(cfg),
+		RecoveryCode:      NewRecoveryCodeClient(cfg),
+		SearchDocument:    NewSearchDocumentClient(cfg),
+		Session:           NewSessionClient(cfg),
+		SpamCorpus:        NewSpamCorpusClient(cfg),
+		SpamToken:         NewSpamTokenClient(cfg),
+		Tag:               NewTagClient(cfg),
+		User:              NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Book.
+//		AccessToken.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -228,8 +314,11 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Book, c.Collection, c.Comment, c.Friend, c.Hitokoto, c.Image, c.Post, c.Tag,
-		c.User,
+		c.AccessToken, c.Book, c.Collection, c.Comment, c.CommentReaction,
+		c.CommentRevision, c.EmailSubscription, c.Friend, c.Hitokoto, c.Identity,
+		c.Image, c.Invitation, c.PasswordReset, c.Post, c.PostRevision,
+		c.PostSlugHistory, c.RecoveryCode, c.SearchDocument, c.Session, c.SpamCorpus,
+		c.SpamToken, c.Tag, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -239,8 +328,11 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Book, c.Collection, c.Comment, c.Friend, c.Hitokoto, c.Image, c.Post, c.Tag,
-		c.User,
+		c.AccessToken, c.Book, c.Collection, c.Comment, c.CommentReaction,
+		c.CommentRevision, c.EmailSubscription, c.Friend, c.Hitokoto, c.Identity,
+		c.Image, c.Invitation, c.PasswordReset, c.Post, c.PostRevision,
+		c.PostSlugHistory, c.RecoveryCode, c.SearchDocument, c.Session, c.SpamCorpus,
+		c.SpamToken, c.Tag, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -249,20 +341,48 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AccessTokenMutation:
+		return c.AccessToken.mutate(ctx, m)
 	case *BookMutation:
 		return c.Book.mutate(ctx, m)
 	case *CollectionMutation:
 		return c.Collection.mutate(ctx, m)
 	case *CommentMutation:
 		return c.Comment.mutate(ctx, m)
+	case *CommentReactionMutation:
+		return c.CommentReaction.mutate(ctx, m)
+	case *CommentRevisionMutation:
+		return c.CommentRevision.mutate(ctx, m)
+	case *EmailSubscriptionMutation:
+		return c.EmailSubscription.mutate(ctx, m)
 	case *FriendMutation:
 		return c.Friend.mutate(ctx, m)
 	case *HitokotoMutation:
 		return c.Hitokoto.mutate(ctx, m)
+	case *IdentityMutation:
+		return c.Identity.mutate(ctx, m)
 	case *ImageMutation:
 		return c.Image.mutate(ctx, m)
+	case *InvitationMutation:
+		return c.Invitation.mutate(ctx, m)
+	case *PasswordResetMutation:
+		return c.PasswordReset.mutate(ctx, m)
 	case *PostMutation:
 		return c.Post.mutate(ctx, m)
+	case *PostRevisionMutation:
+		return c.PostRevision.mutate(ctx, m)
+	case *PostSlugHistoryMutation:
+		return c.PostSlugHistory.mutate(ctx, m)
+	case *RecoveryCodeMutation:
+		return c.RecoveryCode.mutate(ctx, m)
+	case *SearchDocumentMutation:
+		return c.SearchDocument.mutate(ctx, m)
+	case *SessionMutation:
+		return c.Session.mutate(ctx, m)
+	case *SpamCorpusMutation:
+		return c.SpamCorpus.mutate(ctx, m)
+	case *SpamTokenMutation:
+		return c.SpamToken.mutate(ctx, m)
 	case *TagMutation:
 		return c.Tag.mutate(ctx, m)
 	case *UserMutation:
@@ -272,6 +392,155 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// AccessTokenClient is a client for the AccessToken schema.
+type AccessTokenClient struct {
+	config
+}
+
+// NewAccessTokenClient returns a client for the AccessToken from the given config.
+func NewAccessTokenClient(c config) *AccessTokenClient {
+	return &AccessTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `accesstoken.Hooks(f(g(h())))`.
+func (c *AccessTokenClient) Use(hooks ...Hook) {
+	c.hooks.AccessToken = append(c.hooks.AccessToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `accesstoken.Intercept(f(g(h())))`.
+func (c *AccessTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AccessToken = append(c.inters.AccessToken, interceptors...)
+}
+
+// Create returns a builder for creating a AccessToken entity.
+func (c *AccessTokenClient) Create() *AccessTokenCreate {
+	mutation := newAccessTokenMutation(c.config, OpCreate)
+	return &AccessTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AccessToken entities.
+func (c *AccessTokenClient) CreateBulk(builders ...*AccessTokenCreate) *AccessTokenCreateBulk {
+	return &AccessTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AccessTokenClient) MapCreateBulk(slice any, setFunc func(*AccessTokenCreate, int)) *AccessTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AccessTokenCreateBulk{err: fmt.Errorf("calling to AccessTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AccessTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AccessTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AccessToken.
+func (c *AccessTokenClient) Update() *AccessTokenUpdate {
+	mutation := newAccessTokenMutation(c.config, OpUpdate)
+	return &AccessTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AccessTokenClient) UpdateOne(at *AccessToken) *AccessTokenUpdateOne {
+	mutation := newAccessTokenMutation(c.config, OpUpdateOne, withAccessToken(at))
+	return &AccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AccessTokenClient) UpdateOneID(id int) *AccessTokenUpdateOne {
+	mutation := newAccessTokenMutation(c.config, OpUpdateOne, withAccessTokenID(id))
+	return &AccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AccessToken.
+func (c *AccessTokenClient) Delete() *AccessTokenDelete {
+	mutation := newAccessTokenMutation(c.config, OpDelete)
+	return &AccessTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AccessTokenClient) DeleteOne(at *AccessToken) *AccessTokenDeleteOne {
+	return c.DeleteOneID(at.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AccessTokenClient) DeleteOneID(id int) *AccessTokenDeleteOne {
+	builder := c.Delete().Where(accesstoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AccessTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for AccessToken.
+func (c *AccessTokenClient) Query() *AccessTokenQuery {
+	return &AccessTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAccessToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AccessToken entity by its id.
+func (c *AccessTokenClient) Get(ctx context.Context, id int) (*AccessToken, error) {
+	return c.Query().Where(accesstoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AccessTokenClient) GetX(ctx context.Context, id int) *AccessToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a AccessToken.
+func (c *AccessTokenClient) QueryUser(at *AccessToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := at.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(accesstoken.Table, accesstoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, accesstoken.UserTable, accesstoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(at.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AccessTokenClient) Hooks() []Hook {
+	return c.hooks.AccessToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *AccessTokenClient) Interceptors() []Interceptor {
+	return c.inters.AccessToken
+}
+
+func (c *AccessTokenClient) mutate(ctx context.Context, m *AccessTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AccessTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AccessTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AccessTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AccessTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AccessToken mutation op: %q", m.Op())
+	}
+}
+
 // BookClient is a client for the Book schema.
 type BookClient struct {
 	config
@@ -742,6 +1011,38 @@ func (c *CommentClient) QueryChildren(co *Comment) *CommentQuery {
 	return query
 }
 
+// QueryRevisions queries the revisions edge of a Comment.
+func (c *CommentClient) QueryRevisions(co *Comment) *CommentRevisionQuery {
+	query := (&CommentRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(commentrevision.Table, commentrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, comment.RevisionsTable, comment.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReactions queries the reactions edge of a Comment.
+func (c *CommentClient) QueryReactions(co *Comment) *CommentReactionQuery {
+	query := (&CommentReactionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, id),
+			sqlgraph.To(commentreaction.Table, commentreaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, comment.ReactionsTable, comment.ReactionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CommentClient) Hooks() []Hook {
 	return c.hooks.Comment
@@ -767,107 +1068,107 @@ func (c *CommentClient) mutate(ctx context.Context, m *CommentMutation) (Value,
 	}
 }
 
-// FriendClient is a client for the Friend schema.
-type FriendClient struct {
+// CommentReactionClient is a client for the CommentReaction schema.
+type CommentReactionClient struct {
 	config
 }
 
-// NewFriendClient returns a client for the Friend from the given config.
-func NewFriendClient(c config) *FriendClient {
-	return &FriendClient{config: c}
+// NewCommentReactionClient returns a client for the CommentReaction from the given config.
+func NewCommentReactionClient(c config) *CommentReactionClient {
+	return &CommentReactionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `friend.Hooks(f(g(h())))`.
-func (c *FriendClient) Use(hooks ...Hook) {
-	c.hooks.Friend = append(c.hooks.Friend, hooks...)
+// A call to `Use(f, g, h)` equals to `commentreaction.Hooks(f(g(h())))`.
+func (c *CommentReactionClient) Use(hooks ...Hook) {
+	c.hooks.CommentReaction = append(c.hooks.CommentReaction, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `friend.Intercept(f(g(h())))`.
-func (c *FriendClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Friend = append(c.inters.Friend, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `commentreaction.Intercept(f(g(h())))`.
+func (c *CommentReactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CommentReaction = append(c.inters.CommentReaction, interceptors...)
 }
 
-// Create returns a builder for creating a Friend entity.
-func (c *FriendClient) Create() *FriendCreate {
-	mutation := newFriendMutation(c.config, OpCreate)
-	return &FriendCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CommentReaction entity.
+func (c *CommentReactionClient) Create() *CommentReactionCreate {
+	mutation := newCommentReactionMutation(c.config, OpCreate)
+	return &CommentReactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Friend entities.
-func (c *FriendClient) CreateBulk(builders ...*FriendCreate) *FriendCreateBulk {
-	return &FriendCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CommentReaction entities.
+func (c *CommentReactionClient) CreateBulk(builders ...*CommentReactionCreate) *CommentReactionCreateBulk {
+	return &CommentReactionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FriendClient) MapCreateBulk(slice any, setFunc func(*FriendCreate, int)) *FriendCreateBulk {
+func (c *CommentReactionClient) MapCreateBulk(slice any, setFunc func(*CommentReactionCreate, int)) *CommentReactionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FriendCreateBulk{err: fmt.Errorf("calling to FriendClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CommentReactionCreateBulk{err: fmt.Errorf("calling to CommentReactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FriendCreate, rv.Len())
+	builders := make([]*CommentReactionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FriendCreateBulk{config: c.config, builders: builders}
+	return &CommentReactionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Friend.
-func (c *FriendClient) Update() *FriendUpdate {
-	mutation := newFriendMutation(c.config, OpUpdate)
-	return &FriendUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CommentReaction.
+func (c *CommentReactionClient) Update() *CommentReactionUpdate {
+	mutation := newCommentReactionMutation(c.config, OpUpdate)
+	return &CommentReactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FriendClient) UpdateOne(f *Friend) *FriendUpdateOne {
-	mutation := newFriendMutation(c.config, OpUpdateOne, withFriend(f))
-	return &FriendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CommentReactionClient) UpdateOne(cr *CommentReaction) *CommentReactionUpdateOne {
+	mutation := newCommentReactionMutation(c.config, OpUpdateOne, withCommentReaction(cr))
+	return &CommentReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FriendClient) UpdateOneID(id int) *FriendUpdateOne {
-	mutation := newFriendMutation(c.config, OpUpdateOne, withFriendID(id))
-	return &FriendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CommentReactionClient) UpdateOneID(id int) *CommentReactionUpdateOne {
+	mutation := newCommentReactionMutation(c.config, OpUpdateOne, withCommentReactionID(id))
+	return &CommentReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Friend.
-func (c *FriendClient) Delete() *FriendDelete {
-	mutation := newFriendMutation(c.config, OpDelete)
-	return &FriendDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CommentReaction.
+func (c *CommentReactionClient) Delete() *CommentReactionDelete {
+	mutation := newCommentReactionMutation(c.config, OpDelete)
+	return &CommentReactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FriendClient) DeleteOne(f *Friend) *FriendDeleteOne {
-	return c.DeleteOneID(f.ID)
+func (c *CommentReactionClient) DeleteOne(cr *CommentReaction) *CommentReactionDeleteOne {
+	return c.DeleteOneID(cr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FriendClient) DeleteOneID(id int) *FriendDeleteOne {
-	builder := c.Delete().Where(friend.ID(id))
+func (c *CommentReactionClient) DeleteOneID(id int) *CommentReactionDeleteOne {
+	builder := c.Delete().Where(commentreaction.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FriendDeleteOne{builder}
+	return &CommentReactionDeleteOne{builder}
 }
 
-// Query returns a query builder for Friend.
-func (c *FriendClient) Query() *FriendQuery {
-	return &FriendQuery{
+// Query returns a query builder for CommentReaction.
+func (c *CommentReactionClient) Query() *CommentReactionQuery {
+	return &CommentReactionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFriend},
+		ctx:    &QueryContext{Type: TypeCommentReaction},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Friend entity by its id.
-func (c *FriendClient) Get(ctx context.Context, id int) (*Friend, error) {
-	return c.Query().Where(friend.ID(id)).Only(ctx)
+// Get returns a CommentReaction entity by its id.
+func (c *CommentReactionClient) Get(ctx context.Context, id int) (*CommentReaction, error) {
+	return c.Query().Where(commentreaction.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FriendClient) GetX(ctx context.Context, id int) *Friend {
+func (c *CommentReactionClient) GetX(ctx context.Context, id int) *CommentReaction {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -875,132 +1176,164 @@ func (c *FriendClient) GetX(ctx context.Context, id int) *Friend {
 	return obj
 }
 
+// QueryComment queries the comment edge of a CommentReaction.
+func (c *CommentReactionClient) QueryComment(cr *CommentReaction) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(commentreaction.Table, commentreaction.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, commentreaction.CommentTable, commentreaction.CommentColumn),
+		)
+		fromV = sqlgraph.Neighbors(cr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a CommentReaction.
+func (c *CommentReactionClient) QueryUser(cr *CommentReaction) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(commentreaction.Table, commentreaction.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, commentreaction.UserTable, commentreaction.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(cr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *FriendClient) Hooks() []Hook {
-	return c.hooks.Friend
+func (c *CommentReactionClient) Hooks() []Hook {
+	return c.hooks.CommentReaction
 }
 
 // Interceptors returns the client interceptors.
-func (c *FriendClient) Interceptors() []Interceptor {
-	return c.inters.Friend
+func (c *CommentReactionClient) Interceptors() []Interceptor {
+	return c.inters.CommentReaction
 }
 
-func (c *FriendClient) mutate(ctx context.Context, m *FriendMutation) (Value, error) {
+func (c *CommentReactionClient) mutate(ctx context.Context, m *CommentReactionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FriendCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CommentReactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FriendUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CommentReactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FriendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CommentReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FriendDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CommentReactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Friend mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CommentReaction mutation op: %q", m.Op())
 	}
 }
 
-// HitokotoClient is a client for the Hitokoto schema.
-type HitokotoClient struct {
+// CommentRevisionClient is a client for the CommentRevision schema.
+type CommentRevisionClient struct {
 	config
 }
 
-// NewHitokotoClient returns a client for the Hitokoto from the given config.
-func NewHitokotoClient(c config) *HitokotoClient {
-	return &HitokotoClient{config: c}
+// NewCommentRevisionClient returns a client for the CommentRevision from the given config.
+func NewCommentRevisionClient(c config) *CommentRevisionClient {
+	return &CommentRevisionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `hitokoto.Hooks(f(g(h())))`.
-func (c *HitokotoClient) Use(hooks ...Hook) {
-	c.hooks.Hitokoto = append(c.hooks.Hitokoto, hooks...)
+// A call to `Use(f, g, h)` equals to `commentrevision.Hooks(f(g(h())))`.
+func (c *CommentRevisionClient) Use(hooks ...Hook) {
+	c.hooks.CommentRevision = append(c.hooks.CommentRevision, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `hitokoto.Intercept(f(g(h())))`.
-func (c *HitokotoClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Hitokoto = append(c.inters.Hitokoto, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `commentrevision.Intercept(f(g(h())))`.
+func (c *CommentRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CommentRevision = append(c.inters.CommentRevision, interceptors...)
 }
 
-// Create returns a builder for creating a Hitokoto entity.
-func (c *HitokotoClient) Create() *HitokotoCreate {
-	mutation := newHitokotoMutation(c.config, OpCreate)
-	return &HitokotoCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CommentRevision entity.
+func (c *CommentRevisionClient) Create() *CommentRevisionCreate {
+	mutation := newCommentRevisionMutation(c.config, OpCreate)
+	return &CommentRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Hitokoto entities.
-func (c *HitokotoClient) CreateBulk(builders ...*HitokotoCreate) *HitokotoCreateBulk {
-	return &HitokotoCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CommentRevision entities.
+func (c *CommentRevisionClient) CreateBulk(builders ...*CommentRevisionCreate) *CommentRevisionCreateBulk {
+	return &CommentRevisionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *HitokotoClient) MapCreateBulk(slice any, setFunc func(*HitokotoCreate, int)) *HitokotoCreateBulk {
+func (c *CommentRevisionClient) MapCreateBulk(slice any, setFunc func(*CommentRevisionCreate, int)) *CommentRevisionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &HitokotoCreateBulk{err: fmt.Errorf("calling to HitokotoClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CommentRevisionCreateBulk{err: fmt.Errorf("calling to CommentRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*HitokotoCreate, rv.Len())
+	builders := make([]*CommentRevisionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &HitokotoCreateBulk{config: c.config, builders: builders}
+	return &CommentRevisionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Hitokoto.
-func (c *HitokotoClient) Update() *HitokotoUpdate {
-	mutation := newHitokotoMutation(c.config, OpUpdate)
-	return &HitokotoUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CommentRevision.
+func (c *CommentRevisionClient) Update() *CommentRevisionUpdate {
+	mutation := newCommentRevisionMutation(c.config, OpUpdate)
+	return &CommentRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *HitokotoClient) UpdateOne(h *Hitokoto) *HitokotoUpdateOne {
-	mutation := newHitokotoMutation(c.config, OpUpdateOne, withHitokoto(h))
-	return &HitokotoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CommentRevisionClient) UpdateOne(cr *CommentRevision) *CommentRevisionUpdateOne {
+	mutation := newCommentRevisionMutation(c.config, OpUpdateOne, withCommentRevision(cr))
+	return &CommentRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *HitokotoClient) UpdateOneID(id int) *HitokotoUpdateOne {
-	mutation := newHitokotoMutation(c.config, OpUpdateOne, withHitokotoID(id))
-	return &HitokotoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CommentRevisionClient) UpdateOneID(id int) *CommentRevisionUpdateOne {
+	mutation := newCommentRevisionMutation(c.config, OpUpdateOne, withCommentRevisionID(id))
+	return &CommentRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Hitokoto.
-func (c *HitokotoClient) Delete() *HitokotoDelete {
-	mutation := newHitokotoMutation(c.config, OpDelete)
-	return &HitokotoDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CommentRevision.
+func (c *CommentRevisionClient) Delete() *CommentRevisionDelete {
+	mutation := newCommentRevisionMutation(c.config, OpDelete)
+	return &CommentRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *HitokotoClient) DeleteOne(h *Hitokoto) *HitokotoDeleteOne {
-	return c.DeleteOneID(h.ID)
+func (c *CommentRevisionClient) DeleteOne(cr *CommentRevision) *CommentRevisionDeleteOne {
+	return c.DeleteOneID(cr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *HitokotoClient) DeleteOneID(id int) *HitokotoDeleteOne {
-	builder := c.Delete().Where(hitokoto.ID(id))
+func (c *CommentRevisionClient) DeleteOneID(id int) *CommentRevisionDeleteOne {
+	builder := c.Delete().Where(commentrevision.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &HitokotoDeleteOne{builder}
+	return &CommentRevisionDeleteOne{builder}
 }
 
-// Query returns a query builder for Hitokoto.
-func (c *HitokotoClient) Query() *HitokotoQuery {
-	return &HitokotoQuery{
+// Query returns a query builder for CommentRevision.
+func (c *CommentRevisionClient) Query() *CommentRevisionQuery {
+	return &CommentRevisionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeHitokoto},
+		ctx:    &QueryContext{Type: TypeCommentRevision},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Hitokoto entity by its id.
-func (c *HitokotoClient) Get(ctx context.Context, id int) (*Hitokoto, error) {
-	return c.Query().Where(hitokoto.ID(id)).Only(ctx)
+// Get returns a CommentRevision entity by its id.
+func (c *CommentRevisionClient) Get(ctx context.Context, id int) (*CommentRevision, error) {
+	return c.Query().Where(commentrevision.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *HitokotoClient) GetX(ctx context.Context, id int) *Hitokoto {
+func (c *CommentRevisionClient) GetX(ctx context.Context, id int) *CommentRevision {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1008,132 +1341,2117 @@ func (c *HitokotoClient) GetX(ctx context.Context, id int) *Hitokoto {
 	return obj
 }
 
+// QueryComment queries the comment edge of a CommentRevision.
+func (c *CommentRevisionClient) QueryComment(cr *CommentRevision) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(commentrevision.Table, commentrevision.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, commentrevision.CommentTable, commentrevision.CommentColumn),
+		)
+		fromV = sqlgraph.Neighbors(cr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *HitokotoClient) Hooks() []Hook {
-	return c.hooks.Hitokoto
+func (c *CommentRevisionClient) Hooks() []Hook {
+	return c.hooks.CommentRevision
 }
 
 // Interceptors returns the client interceptors.
-func (c *HitokotoClient) Interceptors() []Interceptor {
-	return c.inters.Hitokoto
+func (c *CommentRevisionClient) Interceptors() []Interceptor {
+	return c.inters.CommentRevision
 }
 
-func (c *HitokotoClient) mutate(ctx context.Context, m *HitokotoMutation) (Value, error) {
+func (c *CommentRevisionClient) mutate(ctx context.Context, m *CommentRevisionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&HitokotoCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CommentRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&HitokotoUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CommentRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&HitokotoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CommentRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&HitokotoDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CommentRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Hitokoto mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CommentRevision mutation op: %q", m.Op())
 	}
 }
 
-// ImageClient is a client for the Image schema.
+// EmailSubscriptionClient is a client for the EmailSubscription schema.
+type EmailSubscriptionClient struct {
+	config
+}
+
+// NewEmailSubscriptionClient returns a client for the EmailSubscription from the given config.
+func NewEmailSubscriptionClient(c config) *EmailSubscriptionClient {
+	return &EmailSubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emailsubscription.Hooks(f(g(h())))`.
+func (c *EmailSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.EmailSubscription = append(c.hooks.EmailSubscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emailsubscription.Intercept(f(g(h())))`.
+func (c *EmailSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailSubscription = append(c.inters.EmailSubscription, interceptors...)
+}
+
+// Create returns a builder for creating a EmailSubscription entity.
+func (c *EmailSubscriptionClient) Create() *EmailSubscriptionCreate {
+	mutation := newEmailSubscriptionMutation(c.config, OpCreate)
+	return &EmailSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmailSubscription entities.
+func (c *EmailSubscriptionClient) CreateBulk(builders ...*EmailSubscriptionCreate) *EmailSubscriptionCreateBulk {
+	return &EmailSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmailSubscriptionClient) MapCreateBulk(slice any, setFunc func(*EmailSubscriptionCreate, int)) *EmailSubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmailSubscriptionCreateBulk{err: fmt.Errorf("calling to EmailSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmailSubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmailSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmailSubscription.
+func (c *EmailSubscriptionClient) Update() *EmailSubscriptionUpdate {
+	mutation := newEmailSubscriptionMutation(c.config, OpUpdate)
+	return &EmailSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmailSubscriptionClient) UpdateOne(es *EmailSubscription) *EmailSubscriptionUpdateOne {
+	mutation := newEmailSubscriptionMutation(c.config, OpUpdateOne, withEmailSubscription(es))
+	return &EmailSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmailSubscriptionClient) UpdateOneID(id int) *EmailSubscriptionUpdateOne {
+	mutation := newEmailSubscriptionMutation(c.config, OpUpdateOne, withEmailSubscriptionID(id))
+	return &EmailSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmailSubscription.
+func (c *EmailSubscriptionClient) Delete() *EmailSubscriptionDelete {
+	mutation := newEmailSubscriptionMutation(c.config, OpDelete)
+	return &EmailSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmailSubscriptionClient) DeleteOne(es *EmailSubscription) *EmailSubscriptionDeleteOne {
+	return c.DeleteOneID(es.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmailSubscriptionClient) DeleteOneID(id int) *EmailSubscriptionDeleteOne {
+	builder := c.Delete().Where(emailsubscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailSubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for EmailSubscription.
+func (c *EmailSubscriptionClient) Query() *EmailSubscriptionQuery {
+	return &EmailSubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmailSubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmailSubscription entity by its id.
+func (c *EmailSubscriptionClient) Get(ctx context.Context, id int) (*EmailSubscription, error) {
+	return c.Query().Where(emailsubscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailSubscriptionClient) GetX(ctx context.Context, id int) *EmailSubscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EmailSubscriptionClient) Hooks() []Hook {
+	return c.hooks.EmailSubscription
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmailSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.EmailSubscription
+}
+
+func (c *EmailSubscriptionClient) mutate(ctx context.Context, m *EmailSubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmailSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmailSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmailSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmailSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmailSubscription mutation op: %q", m.Op())
+	}
+}
+
+// FriendClient is a client for the Friend schema.
+type FriendClient struct {
+	config
+}
+
+// NewFriendClient returns a client for the Friend from the given config.
+func NewFriendClient(c config) *FriendClient {
+	return &FriendClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `friend.Hooks(f(g(h())))`.
+func (c *FriendClient) Use(hooks ...Hook) {
+	c.hooks.Friend = append(c.hooks.Friend, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `friend.Intercept(f(g(h())))`.
+func (c *FriendClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Friend = append(c.inters.Friend, interceptors...)
+}
+
+// Create returns a builder for creating a Friend entity.
+func (c *FriendClient) Create() *FriendCreate {
+	mutation := newFriendMutation(c.config, OpCreate)
+	return &FriendCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Friend entities.
+func (c *FriendClient) CreateBulk(builders ...*FriendCreate) *FriendCreateBulk {
+	return &FriendCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FriendClient) MapCreateBulk(slice any, setFunc func(*FriendCreate, int)) *FriendCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FriendCreateBulk{err: fmt.Errorf("calling to FriendClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FriendCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FriendCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Friend.
+func (c *FriendClient) Update() *FriendUpdate {
+	mutation := newFriendMutation(c.config, OpUpdate)
+	return &FriendUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FriendClient) UpdateOne(f *Friend) *FriendUpdateOne {
+	mutation := newFriendMutation(c.config, OpUpdateOne, withFriend(f))
+	return &FriendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FriendClient) UpdateOneID(id int) *FriendUpdateOne {
+	mutation := newFriendMutation(c.config, OpUpdateOne, withFriendID(id))
+	return &FriendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Friend.
+func (c *FriendClient) Delete() *FriendDelete {
+	mutation := newFriendMutation(c.config, OpDelete)
+	return &FriendDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FriendClient) DeleteOne(f *Friend) *FriendDeleteOne {
+	return c.DeleteOneID(f.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FriendClient) DeleteOneID(id int) *FriendDeleteOne {
+	builder := c.Delete().Where(friend.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FriendDeleteOne{builder}
+}
+
+// Query returns a query builder for Friend.
+func (c *FriendClient) Query() *FriendQuery {
+	return &FriendQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFriend},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Friend entity by its id.
+func (c *FriendClient) Get(ctx context.Context, id int) (*Friend, error) {
+	return c.Query().Where(friend.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FriendClient) GetX(ctx context.Context, id int) *Friend {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FriendClient) Hooks() []Hook {
+	return c.hooks.Friend
+}
+
+// Interceptors returns the client interceptors.
+func (c *FriendClient) Interceptors() []Interceptor {
+	return c.inters.Friend
+}
+
+func (c *FriendClient) mutate(ctx context.Context, m *FriendMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FriendCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FriendUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FriendUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FriendDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Friend mutation op: %q", m.Op())
+	}
+}
+
+// HitokotoClient is a client for the Hitokoto schema.
+type HitokotoClient struct {
+	config
+}
+
+// NewHitokotoClient returns a client for the Hitokoto from the given config.
+func NewHitokotoClient(c config) *HitokotoClient {
+	return &HitokotoClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `hitokoto.Hooks(f(g(h())))`.
+func (c *HitokotoClient) Use(hooks ...Hook) {
+	c.hooks.Hitokoto = append(c.hooks.Hitokoto, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `hitokoto.Intercept(f(g(h())))`.
+func (c *HitokotoClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Hitokoto = append(c.inters.Hitokoto, interceptors...)
+}
+
+// Create returns a builder for creating a Hitokoto entity.
+func (c *HitokotoClient) Create() *HitokotoCreate {
+	mutation := newHitokotoMutation(c.config, OpCreate)
+	return &HitokotoCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Hitokoto entities.
+func (c *HitokotoClient) CreateBulk(builders ...*HitokotoCreate) *HitokotoCreateBulk {
+	return &HitokotoCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *HitokotoClient) MapCreateBulk(slice any, setFunc func(*HitokotoCreate, int)) *HitokotoCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &HitokotoCreateBulk{err: fmt.Errorf("calling to HitokotoClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*HitokotoCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &HitokotoCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Hitokoto.
+func (c *HitokotoClient) Update() *HitokotoUpdate {
+	mutation := newHitokotoMutation(c.config, OpUpdate)
+	return &HitokotoUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *HitokotoClient) UpdateOne(h *Hitokoto) *HitokotoUpdateOne {
+	mutation := newHitokotoMutation(c.config, OpUpdateOne, withHitokoto(h))
+	return &HitokotoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *HitokotoClient) UpdateOneID(id int) *HitokotoUpdateOne {
+	mutation := newHitokotoMutation(c.config, OpUpdateOne, withHitokotoID(id))
+	return &HitokotoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Hitokoto.
+func (c *HitokotoClient) Delete() *HitokotoDelete {
+	mutation := newHitokotoMutation(c.config, OpDelete)
+	return &HitokotoDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *HitokotoClient) DeleteOne(h *Hitokoto) *HitokotoDeleteOne {
+	return c.DeleteOneID(h.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *HitokotoClient) DeleteOneID(id int) *HitokotoDeleteOne {
+	builder := c.Delete().Where(hitokoto.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &HitokotoDeleteOne{builder}
+}
+
+// Query returns a query builder for Hitokoto.
+func (c *HitokotoClient) Query() *HitokotoQuery {
+	return &HitokotoQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeHitokoto},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Hitokoto entity by its id.
+func (c *HitokotoClient) Get(ctx context.Context, id int) (*Hitokoto, error) {
+	return c.Query().Where(hitokoto.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *HitokotoClient) GetX(ctx context.Context, id int) *Hitokoto {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *HitokotoClient) Hooks() []Hook {
+	return c.hooks.Hitokoto
+}
+
+// Interceptors returns the client interceptors.
+func (c *HitokotoClient) Interceptors() []Interceptor {
+	return c.inters.Hitokoto
+}
+
+func (c *HitokotoClient) mutate(ctx context.Context, m *HitokotoMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&HitokotoCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&HitokotoUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&HitokotoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&HitokotoDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Hitokoto mutation op: %q", m.Op())
+	}
+}
+
+// IdentityClient is a client for the Identity schema.
+type IdentityClient struct {
+	config
+}
+
+// NewIdentityClient returns a client for the Identity from the given config.
+func NewIdentityClient(c config) *IdentityClient {
+	return &IdentityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `identity.Hooks(f(g(h())))`.
+func (c *IdentityClient) Use(hooks ...Hook) {
+	c.hooks.Identity = append(c.hooks.Identity, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `identity.Intercept(f(g(h())))`.
+func (c *IdentityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Identity = append(c.inters.Identity, interceptors...)
+}
+
+// Create returns a builder for creating a Identity entity.
+func (c *IdentityClient) Create() *IdentityCreate {
+	mutation := newIdentityMutation(c.config, OpCreate)
+	return &IdentityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Identity entities.
+func (c *IdentityClient) CreateBulk(builders ...*IdentityCreate) *IdentityCreateBulk {
+	return &IdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdentityClient) MapCreateBulk(slice any, setFunc func(*IdentityCreate, int)) *IdentityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdentityCreateBulk{err: fmt.Errorf("calling to IdentityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdentityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdentityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Identity.
+func (c *IdentityClient) Update() *IdentityUpdate {
+	mutation := newIdentityMutation(c.config, OpUpdate)
+	return &IdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdentityClient) UpdateOne(i *Identity) *IdentityUpdateOne {
+	mutation := newIdentityMutation(c.config, OpUpdateOne, withIdentity(i))
+	return &IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *IdentityClient) UpdateOneID(id int) *IdentityUpdateOne {
+	mutation := newIdentityMutation(c.config, OpUpdateOne, withIdentityID(id))
+	return &IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Identity.
+func (c *IdentityClient) Delete() *IdentityDelete {
+	mutation := newIdentityMutation(c.config, OpDelete)
+	return &IdentityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *IdentityClient) DeleteOne(i *Identity) *IdentityDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *IdentityClient) DeleteOneID(id int) *IdentityDeleteOne {
+	builder := c.Delete().Where(identity.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &IdentityDeleteOne{builder}
+}
+
+// Query returns a query builder for Identity.
+func (c *IdentityClient) Query() *IdentityQuery {
+	return &IdentityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdentity},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Identity entity by its id.
+func (c *IdentityClient) Get(ctx context.Context, id int) (*Identity, error) {
+	return c.Query().Where(identity.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IdentityClient) GetX(ctx context.Context, id int) *Identity {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Identity.
+func (c *IdentityClient) QueryUser(i *Identity) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(identity.Table, identity.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, identity.UserTable, identity.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IdentityClient) Hooks() []Hook {
+	return c.hooks.Identity
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdentityClient) Interceptors() []Interceptor {
+	return c.inters.Identity
+}
+
+func (c *IdentityClient) mutate(ctx context.Context, m *IdentityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdentityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdentityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdentityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdentityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Identity mutation op: %q", m.Op())
+	}
+}
+
+// ImageClient is a client for the Image schema.
 type ImageClient struct {
 	config
 }
 
-// NewImageClient returns a client for the Image from the given config.
-func NewImageClient(c config) *ImageClient {
-	return &ImageClient{config: c}
+// NewImageClient returns a client for the Image from the given config.
+func NewImageClient(c config) *ImageClient {
+	return &ImageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `image.Hooks(f(g(h())))`.
+func (c *ImageClient) Use(hooks ...Hook) {
+	c.hooks.Image = append(c.hooks.Image, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `image.Intercept(f(g(h())))`.
+func (c *ImageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Image = append(c.inters.Image, interceptors...)
+}
+
+// Create returns a builder for creating a Image entity.
+func (c *ImageClient) Create() *ImageCreate {
+	mutation := newImageMutation(c.config, OpCreate)
+	return &ImageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Image entities.
+func (c *ImageClient) CreateBulk(builders ...*ImageCreate) *ImageCreateBulk {
+	return &ImageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ImageClient) MapCreateBulk(slice any, setFunc func(*ImageCreate, int)) *ImageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ImageCreateBulk{err: fmt.Errorf("calling to ImageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ImageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ImageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Image.
+func (c *ImageClient) Update() *ImageUpdate {
+	mutation := newImageMutation(c.config, OpUpdate)
+	return &ImageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ImageClient) UpdateOne(i *Image) *ImageUpdateOne {
+	mutation := newImageMutation(c.config, OpUpdateOne, withImage(i))
+	return &ImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ImageClient) UpdateOneID(id int) *ImageUpdateOne {
+	mutation := newImageMutation(c.config, OpUpdateOne, withImageID(id))
+	return &ImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Image.
+func (c *ImageClient) Delete() *ImageDelete {
+	mutation := newImageMutation(c.config, OpDelete)
+	return &ImageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ImageClient) DeleteOne(i *Image) *ImageDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ImageClient) DeleteOneID(id int) *ImageDeleteOne {
+	builder := c.Delete().Where(image.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ImageDeleteOne{builder}
+}
+
+// Query returns a query builder for Image.
+func (c *ImageClient) Query() *ImageQuery {
+	return &ImageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeImage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Image entity by its id.
+func (c *ImageClient) Get(ctx context.Context, id int) (*Image, error) {
+	return c.Query().Where(image.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ImageClient) GetX(ctx context.Context, id int) *Image {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUploadedBy queries the uploaded_by edge of a Image.
+func (c *ImageClient) QueryUploadedBy(i *Image) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(image.Table, image.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, image.UploadedByTable, image.UploadedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBooks queries the books edge of a Image.
+func (c *ImageClient) QueryBooks(i *Image) *BookQuery {
+	query := (&BookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(image.Table, image.FieldID, id),
+			sqlgraph.To(book.Table, book.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, image.BooksTable, image.BooksColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ImageClient) Hooks() []Hook {
+	return c.hooks.Image
+}
+
+// Interceptors returns the client interceptors.
+func (c *ImageClient) Interceptors() []Interceptor {
+	return c.inters.Image
+}
+
+func (c *ImageClient) mutate(ctx context.Context, m *ImageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ImageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ImageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ImageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Image mutation op: %q", m.Op())
+	}
+}
+
+// InvitationClient is a client for the Invitation schema.
+type InvitationClient struct {
+	config
+}
+
+// NewInvitationClient returns a client for the Invitation from the given config.
+func NewInvitationClient(c config) *InvitationClient {
+	return &InvitationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invitation.Hooks(f(g(h())))`.
+func (c *InvitationClient) Use(hooks ...Hook) {
+	c.hooks.Invitation = append(c.hooks.Invitation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invitation.Intercept(f(g(h())))`.
+func (c *InvitationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invitation = append(c.inters.Invitation, interceptors...)
+}
+
+// Create returns a builder for creating a Invitation entity.
+func (c *InvitationClient) Create() *InvitationCreate {
+	mutation := newInvitationMutation(c.config, OpCreate)
+	return &InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Invitation entities.
+func (c *InvitationClient) CreateBulk(builders ...*InvitationCreate) *InvitationCreateBulk {
+	return &InvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvitationClient) MapCreateBulk(slice any, setFunc func(*InvitationCreate, int)) *InvitationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvitationCreateBulk{err: fmt.Errorf("calling to InvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvitationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Invitation.
+func (c *InvitationClient) Update() *InvitationUpdate {
+	mutation := newInvitationMutation(c.config, OpUpdate)
+	return &InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvitationClient) UpdateOne(i *Invitation) *InvitationUpdateOne {
+	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitation(i))
+	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvitationClient) UpdateOneID(id int) *InvitationUpdateOne {
+	mutation := newInvitationMutation(c.config, OpUpdateOne, withInvitationID(id))
+	return &InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Invitation.
+func (c *InvitationClient) Delete() *InvitationDelete {
+	mutation := newInvitationMutation(c.config, OpDelete)
+	return &InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvitationClient) DeleteOne(i *Invitation) *InvitationDeleteOne {
+	return c.DeleteOneID(i.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvitationClient) DeleteOneID(id int) *InvitationDeleteOne {
+	builder := c.Delete().Where(invitation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvitationDeleteOne{builder}
+}
+
+// Query returns a query builder for Invitation.
+func (c *InvitationClient) Query() *InvitationQuery {
+	return &InvitationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvitation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Invitation entity by its id.
+func (c *InvitationClient) Get(ctx context.Context, id int) (*Invitation, error) {
+	return c.Query().Where(invitation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvitationClient) GetX(ctx context.Context, id int) *Invitation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCreator queries the creator edge of a Invitation.
+func (c *InvitationClient) QueryCreator(i *Invitation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invitation.Table, invitation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, invitation.CreatorTable, invitation.CreatorColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvitee queries the invitee edge of a Invitation.
+func (c *InvitationClient) QueryInvitee(i *Invitation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := i.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invitation.Table, invitation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, invitation.InviteeTable, invitation.InviteeColumn),
+		)
+		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *InvitationClient) Hooks() []Hook {
+	return c.hooks.Invitation
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvitationClient) Interceptors() []Interceptor {
+	return c.inters.Invitation
+}
+
+func (c *InvitationClient) mutate(ctx context.Context, m *InvitationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Invitation mutation op: %q", m.Op())
+	}
+}
+
+// PasswordResetClient is a client for the PasswordReset schema.
+type PasswordResetClient struct {
+	config
+}
+
+// NewPasswordResetClient returns a client for the PasswordReset from the given config.
+func NewPasswordResetClient(c config) *PasswordResetClient {
+	return &PasswordResetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `passwordreset.Hooks(f(g(h())))`.
+func (c *PasswordResetClient) Use(hooks ...Hook) {
+	c.hooks.PasswordReset = append(c.hooks.PasswordReset, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `passwordreset.Intercept(f(g(h())))`.
+func (c *PasswordResetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PasswordReset = append(c.inters.PasswordReset, interceptors...)
+}
+
+// Create returns a builder for creating a PasswordReset entity.
+func (c *PasswordResetClient) Create() *PasswordResetCreate {
+	mutation := newPasswordResetMutation(c.config, OpCreate)
+	return &PasswordResetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PasswordReset entities.
+func (c *PasswordResetClient) CreateBulk(builders ...*PasswordResetCreate) *PasswordResetCreateBulk {
+	return &PasswordResetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PasswordResetClient) MapCreateBulk(slice any, setFunc func(*PasswordResetCreate, int)) *PasswordResetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PasswordResetCreateBulk{err: fmt.Errorf("calling to PasswordResetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PasswordResetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PasswordResetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PasswordReset.
+func (c *PasswordResetClient) Update() *PasswordResetUpdate {
+	mutation := newPasswordResetMutation(c.config, OpUpdate)
+	return &PasswordResetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PasswordResetClient) UpdateOne(pr *PasswordReset) *PasswordResetUpdateOne {
+	mutation := newPasswordResetMutation(c.config, OpUpdateOne, withPasswordReset(pr))
+	return &PasswordResetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PasswordResetClient) UpdateOneID(id int) *PasswordResetUpdateOne {
+	mutation := newPasswordResetMutation(c.config, OpUpdateOne, withPasswordResetID(id))
+	return &PasswordResetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PasswordReset.
+func (c *PasswordResetClient) Delete() *PasswordResetDelete {
+	mutation := newPasswordResetMutation(c.config, OpDelete)
+	return &PasswordResetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PasswordResetClient) DeleteOne(pr *PasswordReset) *PasswordResetDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PasswordResetClient) DeleteOneID(id int) *PasswordResetDeleteOne {
+	builder := c.Delete().Where(passwordreset.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PasswordResetDeleteOne{builder}
+}
+
+// Query returns a query builder for PasswordReset.
+func (c *PasswordResetClient) Query() *PasswordResetQuery {
+	return &PasswordResetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePasswordReset},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PasswordReset entity by its id.
+func (c *PasswordResetClient) Get(ctx context.Context, id int) (*PasswordReset, error) {
+	return c.Query().Where(passwordreset.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PasswordResetClient) GetX(ctx context.Context, id int) *PasswordReset {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a PasswordReset.
+func (c *PasswordResetClient) QueryUser(pr *PasswordReset) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(passwordreset.Table, passwordreset.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, passwordreset.UserTable, passwordreset.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PasswordResetClient) Hooks() []Hook {
+	return c.hooks.PasswordReset
+}
+
+// Interceptors returns the client interceptors.
+func (c *PasswordResetClient) Interceptors() []Interceptor {
+	return c.inters.PasswordReset
+}
+
+func (c *PasswordResetClient) mutate(ctx context.Context, m *PasswordResetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PasswordResetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PasswordResetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PasswordResetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PasswordResetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PasswordReset mutation op: %q", m.Op())
+	}
+}
+
+// PostClient is a client for the Post schema.
+type PostClient struct {
+	config
+}
+
+// NewPostClient returns a client for the Post from the given config.
+func NewPostClient(c config) *PostClient {
+	return &PostClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `post.Hooks(f(g(h())))`.
+func (c *PostClient) Use(hooks ...Hook) {
+	c.hooks.Post = append(c.hooks.Post, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `post.Intercept(f(g(h())))`.
+func (c *PostClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Post = append(c.inters.Post, interceptors...)
+}
+
+// Create returns a builder for creating a Post entity.
+func (c *PostClient) Create() *PostCreate {
+	mutation := newPostMutation(c.config, OpCreate)
+	return &PostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Post entities.
+func (c *PostClient) CreateBulk(builders ...*PostCreate) *PostCreateBulk {
+	return &PostCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostClient) MapCreateBulk(slice any, setFunc func(*PostCreate, int)) *PostCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostCreateBulk{err: fmt.Errorf("calling to PostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Post.
+func (c *PostClient) Update() *PostUpdate {
+	mutation := newPostMutation(c.config, OpUpdate)
+	return &PostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostClient) UpdateOne(po *Post) *PostUpdateOne {
+	mutation := newPostMutation(c.config, OpUpdateOne, withPost(po))
+	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostClient) UpdateOneID(id int) *PostUpdateOne {
+	mutation := newPostMutation(c.config, OpUpdateOne, withPostID(id))
+	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Post.
+func (c *PostClient) Delete() *PostDelete {
+	mutation := newPostMutation(c.config, OpDelete)
+	return &PostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostClient) DeleteOne(po *Post) *PostDeleteOne {
+	return c.DeleteOneID(po.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PostClient) DeleteOneID(id int) *PostDeleteOne {
+	builder := c.Delete().Where(post.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostDeleteOne{builder}
+}
+
+// Query returns a query builder for Post.
+func (c *PostClient) Query() *PostQuery {
+	return &PostQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePost},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Post entity by its id.
+func (c *PostClient) Get(ctx context.Context, id int) (*Post, error) {
+	return c.Query().Where(post.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostClient) GetX(ctx context.Context, id int) *Post {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Post.
+func (c *PostClient) QueryOwner(po *Post) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, post.OwnerTable, post.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryComments queries the comments edge of a Post.
+func (c *PostClient) QueryComments(po *Post) *CommentQuery {
+	query := (&CommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.CommentsTable, post.CommentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a Post.
+func (c *PostClient) QueryTags(po *Post) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, post.TagsTable, post.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevisions queries the revisions edge of a Post.
+func (c *PostClient) QueryRevisions(po *Post) *PostRevisionQuery {
+	query := (&PostRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(postrevision.Table, postrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.RevisionsTable, post.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySlugHistory queries the slug_history edge of a Post.
+func (c *PostClient) QuerySlugHistory(po *Post) *PostSlugHistoryQuery {
+	query := (&PostSlugHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := po.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, id),
+			sqlgraph.To(postslughistory.Table, postslughistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.SlugHistoryTable, post.SlugHistoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PostClient) Hooks() []Hook {
+	return c.hooks.Post
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostClient) Interceptors() []Interceptor {
+	return c.inters.Post
+}
+
+func (c *PostClient) mutate(ctx context.Context, m *PostMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Post mutation op: %q", m.Op())
+	}
+}
+
+// PostRevisionClient is a client for the PostRevision schema.
+type PostRevisionClient struct {
+	config
+}
+
+// NewPostRevisionClient returns a client for the PostRevision from the given config.
+func NewPostRevisionClient(c config) *PostRevisionClient {
+	return &PostRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `postrevision.Hooks(f(g(h())))`.
+func (c *PostRevisionClient) Use(hooks ...Hook) {
+	c.hooks.PostRevision = append(c.hooks.PostRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `postrevision.Intercept(f(g(h())))`.
+func (c *PostRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PostRevision = append(c.inters.PostRevision, interceptors...)
+}
+
+// Create returns a builder for creating a PostRevision entity.
+func (c *PostRevisionClient) Create() *PostRevisionCreate {
+	mutation := newPostRevisionMutation(c.config, OpCreate)
+	return &PostRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PostRevision entities.
+func (c *PostRevisionClient) CreateBulk(builders ...*PostRevisionCreate) *PostRevisionCreateBulk {
+	return &PostRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostRevisionClient) MapCreateBulk(slice any, setFunc func(*PostRevisionCreate, int)) *PostRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostRevisionCreateBulk{err: fmt.Errorf("calling to PostRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PostRevision.
+func (c *PostRevisionClient) Update() *PostRevisionUpdate {
+	mutation := newPostRevisionMutation(c.config, OpUpdate)
+	return &PostRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostRevisionClient) UpdateOne(pr *PostRevision) *PostRevisionUpdateOne {
+	mutation := newPostRevisionMutation(c.config, OpUpdateOne, withPostRevision(pr))
+	return &PostRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostRevisionClient) UpdateOneID(id int) *PostRevisionUpdateOne {
+	mutation := newPostRevisionMutation(c.config, OpUpdateOne, withPostRevisionID(id))
+	return &PostRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PostRevision.
+func (c *PostRevisionClient) Delete() *PostRevisionDelete {
+	mutation := newPostRevisionMutation(c.config, OpDelete)
+	return &PostRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostRevisionClient) DeleteOne(pr *PostRevision) *PostRevisionDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PostRevisionClient) DeleteOneID(id int) *PostRevisionDeleteOne {
+	builder := c.Delete().Where(postrevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for PostRevision.
+func (c *PostRevisionClient) Query() *PostRevisionQuery {
+	return &PostRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePostRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PostRevision entity by its id.
+func (c *PostRevisionClient) Get(ctx context.Context, id int) (*PostRevision, error) {
+	return c.Query().Where(postrevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostRevisionClient) GetX(ctx context.Context, id int) *PostRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPost queries the post edge of a PostRevision.
+func (c *PostRevisionClient) QueryPost(pr *PostRevision) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(postrevision.Table, postrevision.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, postrevision.PostTable, postrevision.PostColumn),
+		)
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PostRevisionClient) Hooks() []Hook {
+	return c.hooks.PostRevision
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostRevisionClient) Interceptors() []Interceptor {
+	return c.inters.PostRevision
+}
+
+func (c *PostRevisionClient) mutate(ctx context.Context, m *PostRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PostRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PostRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PostRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PostRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PostRevision mutation op: %q", m.Op())
+	}
+}
+
+// PostSlugHistoryClient is a client for the PostSlugHistory schema.
+type PostSlugHistoryClient struct {
+	config
+}
+
+// NewPostSlugHistoryClient returns a client for the PostSlugHistory from the given config.
+func NewPostSlugHistoryClient(c config) *PostSlugHistoryClient {
+	return &PostSlugHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `postslughistory.Hooks(f(g(h())))`.
+func (c *PostSlugHistoryClient) Use(hooks ...Hook) {
+	c.hooks.PostSlugHistory = append(c.hooks.PostSlugHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `postslughistory.Intercept(f(g(h())))`.
+func (c *PostSlugHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PostSlugHistory = append(c.inters.PostSlugHistory, interceptors...)
+}
+
+// Create returns a builder for creating a PostSlugHistory entity.
+func (c *PostSlugHistoryClient) Create() *PostSlugHistoryCreate {
+	mutation := newPostSlugHistoryMutation(c.config, OpCreate)
+	return &PostSlugHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PostSlugHistory entities.
+func (c *PostSlugHistoryClient) CreateBulk(builders ...*PostSlugHistoryCreate) *PostSlugHistoryCreateBulk {
+	return &PostSlugHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostSlugHistoryClient) MapCreateBulk(slice any, setFunc func(*PostSlugHistoryCreate, int)) *PostSlugHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostSlugHistoryCreateBulk{err: fmt.Errorf("calling to PostSlugHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostSlugHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostSlugHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PostSlugHistory.
+func (c *PostSlugHistoryClient) Update() *PostSlugHistoryUpdate {
+	mutation := newPostSlugHistoryMutation(c.config, OpUpdate)
+	return &PostSlugHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostSlugHistoryClient) UpdateOne(psh *PostSlugHistory) *PostSlugHistoryUpdateOne {
+	mutation := newPostSlugHistoryMutation(c.config, OpUpdateOne, withPostSlugHistory(psh))
+	return &PostSlugHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostSlugHistoryClient) UpdateOneID(id int) *PostSlugHistoryUpdateOne {
+	mutation := newPostSlugHistoryMutation(c.config, OpUpdateOne, withPostSlugHistoryID(id))
+	return &PostSlugHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PostSlugHistory.
+func (c *PostSlugHistoryClient) Delete() *PostSlugHistoryDelete {
+	mutation := newPostSlugHistoryMutation(c.config, OpDelete)
+	return &PostSlugHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostSlugHistoryClient) DeleteOne(psh *PostSlugHistory) *PostSlugHistoryDeleteOne {
+	return c.DeleteOneID(psh.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PostSlugHistoryClient) DeleteOneID(id int) *PostSlugHistoryDeleteOne {
+	builder := c.Delete().Where(postslughistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostSlugHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for PostSlugHistory.
+func (c *PostSlugHistoryClient) Query() *PostSlugHistoryQuery {
+	return &PostSlugHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePostSlugHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PostSlugHistory entity by its id.
+func (c *PostSlugHistoryClient) Get(ctx context.Context, id int) (*PostSlugHistory, error) {
+	return c.Query().Where(postslughistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostSlugHistoryClient) GetX(ctx context.Context, id int) *PostSlugHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPost queries the post edge of a PostSlugHistory.
+func (c *PostSlugHistoryClient) QueryPost(psh *PostSlugHistory) *PostQuery {
+	query := (&PostClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := psh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(postslughistory.Table, postslughistory.FieldID, id),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, postslughistory.PostTable, postslughistory.PostColumn),
+		)
+		fromV = sqlgraph.Neighbors(psh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PostSlugHistoryClient) Hooks() []Hook {
+	return c.hooks.PostSlugHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostSlugHistoryClient) Interceptors() []Interceptor {
+	return c.inters.PostSlugHistory
+}
+
+func (c *PostSlugHistoryClient) mutate(ctx context.Context, m *PostSlugHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PostSlugHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PostSlugHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PostSlugHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PostSlugHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PostSlugHistory mutation op: %q", m.Op())
+	}
+}
+
+// RecoveryCodeClient is a client for the RecoveryCode schema.
+type RecoveryCodeClient struct {
+	config
+}
+
+// NewRecoveryCodeClient returns a client for the RecoveryCode from the given config.
+func NewRecoveryCodeClient(c config) *RecoveryCodeClient {
+	return &RecoveryCodeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `recoverycode.Hooks(f(g(h())))`.
+func (c *RecoveryCodeClient) Use(hooks ...Hook) {
+	c.hooks.RecoveryCode = append(c.hooks.RecoveryCode, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `recoverycode.Intercept(f(g(h())))`.
+func (c *RecoveryCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RecoveryCode = append(c.inters.RecoveryCode, interceptors...)
+}
+
+// Create returns a builder for creating a RecoveryCode entity.
+func (c *RecoveryCodeClient) Create() *RecoveryCodeCreate {
+	mutation := newRecoveryCodeMutation(c.config, OpCreate)
+	return &RecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RecoveryCode entities.
+func (c *RecoveryCodeClient) CreateBulk(builders ...*RecoveryCodeCreate) *RecoveryCodeCreateBulk {
+	return &RecoveryCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RecoveryCodeClient) MapCreateBulk(slice any, setFunc func(*RecoveryCodeCreate, int)) *RecoveryCodeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RecoveryCodeCreateBulk{err: fmt.Errorf("calling to RecoveryCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RecoveryCodeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RecoveryCodeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RecoveryCode.
+func (c *RecoveryCodeClient) Update() *RecoveryCodeUpdate {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdate)
+	return &RecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RecoveryCodeClient) UpdateOne(rc *RecoveryCode) *RecoveryCodeUpdateOne {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdateOne, withRecoveryCode(rc))
+	return &RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RecoveryCodeClient) UpdateOneID(id int) *RecoveryCodeUpdateOne {
+	mutation := newRecoveryCodeMutation(c.config, OpUpdateOne, withRecoveryCodeID(id))
+	return &RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RecoveryCode.
+func (c *RecoveryCodeClient) Delete() *RecoveryCodeDelete {
+	mutation := newRecoveryCodeMutation(c.config, OpDelete)
+	return &RecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RecoveryCodeClient) DeleteOne(rc *RecoveryCode) *RecoveryCodeDeleteOne {
+	return c.DeleteOneID(rc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RecoveryCodeClient) DeleteOneID(id int) *RecoveryCodeDeleteOne {
+	builder := c.Delete().Where(recoverycode.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RecoveryCodeDeleteOne{builder}
+}
+
+// Query returns a query builder for RecoveryCode.
+func (c *RecoveryCodeClient) Query() *RecoveryCodeQuery {
+	return &RecoveryCodeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRecoveryCode},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RecoveryCode entity by its id.
+func (c *RecoveryCodeClient) Get(ctx context.Context, id int) (*RecoveryCode, error) {
+	return c.Query().Where(recoverycode.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RecoveryCodeClient) GetX(ctx context.Context, id int) *RecoveryCode {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a RecoveryCode.
+func (c *RecoveryCodeClient) QueryUser(rc *RecoveryCode) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(recoverycode.Table, recoverycode.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, recoverycode.UserTable, recoverycode.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RecoveryCodeClient) Hooks() []Hook {
+	return c.hooks.RecoveryCode
+}
+
+// Interceptors returns the client interceptors.
+func (c *RecoveryCodeClient) Interceptors() []Interceptor {
+	return c.inters.RecoveryCode
+}
+
+func (c *RecoveryCodeClient) mutate(ctx context.Context, m *RecoveryCodeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RecoveryCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RecoveryCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RecoveryCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RecoveryCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RecoveryCode mutation op: %q", m.Op())
+	}
+}
+
+// SearchDocumentClient is a client for the SearchDocument schema.
+type SearchDocumentClient struct {
+	config
+}
+
+// NewSearchDocumentClient returns a client for the SearchDocument from the given config.
+func NewSearchDocumentClient(c config) *SearchDocumentClient {
+	return &SearchDocumentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `image.Hooks(f(g(h())))`.
-func (c *ImageClient) Use(hooks ...Hook) {
-	c.hooks.Image = append(c.hooks.Image, hooks...)
+// A call to `Use(f, g, h)` equals to `searchdocument.Hooks(f(g(h())))`.
+func (c *SearchDocumentClient) Use(hooks ...Hook) {
+	c.hooks.SearchDocument = append(c.hooks.SearchDocument, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `image.Intercept(f(g(h())))`.
-func (c *ImageClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Image = append(c.inters.Image, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `searchdocument.Intercept(f(g(h())))`.
+func (c *SearchDocumentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SearchDocument = append(c.inters.SearchDocument, interceptors...)
 }
 
-// Create returns a builder for creating a Image entity.
-func (c *ImageClient) Create() *ImageCreate {
-	mutation := newImageMutation(c.config, OpCreate)
-	return &ImageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SearchDocument entity.
+func (c *SearchDocumentClient) Create() *SearchDocumentCreate {
+	mutation := newSearchDocumentMutation(c.config, OpCreate)
+	return &SearchDocumentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Image entities.
-func (c *ImageClient) CreateBulk(builders ...*ImageCreate) *ImageCreateBulk {
-	return &ImageCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SearchDocument entities.
+func (c *SearchDocumentClient) CreateBulk(builders ...*SearchDocumentCreate) *SearchDocumentCreateBulk {
+	return &SearchDocumentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ImageClient) MapCreateBulk(slice any, setFunc func(*ImageCreate, int)) *ImageCreateBulk {
+func (c *SearchDocumentClient) MapCreateBulk(slice any, setFunc func(*SearchDocumentCreate, int)) *SearchDocumentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ImageCreateBulk{err: fmt.Errorf("calling to ImageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SearchDocumentCreateBulk{err: fmt.Errorf("calling to SearchDocumentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SearchDocumentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SearchDocumentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SearchDocument.
+func (c *SearchDocumentClient) Update() *SearchDocumentUpdate {
+	mutation := newSearchDocumentMutation(c.config, OpUpdate)
+	return &SearchDocumentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SearchDocumentClient) UpdateOne(sd *SearchDocument) *SearchDocumentUpdateOne {
+	mutation := newSearchDocumentMutation(c.config, OpUpdateOne, withSearchDocument(sd))
+	return &SearchDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SearchDocumentClient) UpdateOneID(id int) *SearchDocumentUpdateOne {
+	mutation := newSearchDocumentMutation(c.config, OpUpdateOne, withSearchDocumentID(id))
+	return &SearchDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SearchDocument.
+func (c *SearchDocumentClient) Delete() *SearchDocumentDelete {
+	mutation := newSearchDocumentMutation(c.config, OpDelete)
+	return &SearchDocumentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SearchDocumentClient) DeleteOne(sd *SearchDocument) *SearchDocumentDeleteOne {
+	return c.DeleteOneID(sd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SearchDocumentClient) DeleteOneID(id int) *SearchDocumentDeleteOne {
+	builder := c.Delete().Where(searchdocument.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SearchDocumentDeleteOne{builder}
+}
+
+// Query returns a query builder for SearchDocument.
+func (c *SearchDocumentClient) Query() *SearchDocumentQuery {
+	return &SearchDocumentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSearchDocument},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SearchDocument entity by its id.
+func (c *SearchDocumentClient) Get(ctx context.Context, id int) (*SearchDocument, error) {
+	return c.Query().Where(searchdocument.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SearchDocumentClient) GetX(ctx context.Context, id int) *SearchDocument {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SearchDocumentClient) Hooks() []Hook {
+	return c.hooks.SearchDocument
+}
+
+// Interceptors returns the client interceptors.
+func (c *SearchDocumentClient) Interceptors() []Interceptor {
+	return c.inters.SearchDocument
+}
+
+func (c *SearchDocumentClient) mutate(ctx context.Context, m *SearchDocumentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SearchDocumentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SearchDocumentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SearchDocumentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SearchDocumentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SearchDocument mutation op: %q", m.Op())
+	}
+}
+
+// SessionClient is a client for the Session schema.
+type SessionClient struct {
+	config
+}
+
+// NewSessionClient returns a client for the Session from the given config.
+func NewSessionClient(c config) *SessionClient {
+	return &SessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `session.Hooks(f(g(h())))`.
+func (c *SessionClient) Use(hooks ...Hook) {
+	c.hooks.Session = append(c.hooks.Session, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `session.Intercept(f(g(h())))`.
+func (c *SessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Session = append(c.inters.Session, interceptors...)
+}
+
+// Create returns a builder for creating a Session entity.
+func (c *SessionClient) Create() *SessionCreate {
+	mutation := newSessionMutation(c.config, OpCreate)
+	return &SessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Session entities.
+func (c *SessionClient) CreateBulk(builders ...*SessionCreate) *SessionCreateBulk {
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SessionClient) MapCreateBulk(slice any, setFunc func(*SessionCreate, int)) *SessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SessionCreateBulk{err: fmt.Errorf("calling to SessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Session.
+func (c *SessionClient) Update() *SessionUpdate {
+	mutation := newSessionMutation(c.config, OpUpdate)
+	return &SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SessionClient) UpdateOne(s *Session) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSession(s))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SessionClient) UpdateOneID(id int) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSessionID(id))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Session.
+func (c *SessionClient) Delete() *SessionDelete {
+	mutation := newSessionMutation(c.config, OpDelete)
+	return &SessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SessionClient) DeleteOne(s *Session) *SessionDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SessionClient) DeleteOneID(id int) *SessionDeleteOne {
+	builder := c.Delete().Where(session.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SessionDeleteOne{builder}
+}
+
+// Query returns a query builder for Session.
+func (c *SessionClient) Query() *SessionQuery {
+	return &SessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Session entity by its id.
+func (c *SessionClient) Get(ctx context.Context, id int) (*Session, error) {
+	return c.Query().Where(session.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SessionClient) GetX(ctx context.Context, id int) *Session {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Session.
+func (c *SessionClient) QueryUser(s *Session) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(session.Table, session.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, session.UserTable, session.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SessionClient) Hooks() []Hook {
+	return c.hooks.Session
+}
+
+// Interceptors returns the client interceptors.
+func (c *SessionClient) Interceptors() []Interceptor {
+	return c.inters.Session
+}
+
+func (c *SessionClient) mutate(ctx context.Context, m *SessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Session mutation op: %q", m.Op())
+	}
+}
+
+// SpamCorpusClient is a client for the SpamCorpus schema.
+type SpamCorpusClient struct {
+	config
+}
+
+// NewSpamCorpusClient returns a client for the SpamCorpus from the given config.
+func NewSpamCorpusClient(c config) *SpamCorpusClient {
+	return &SpamCorpusClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `spamcorpus.Hooks(f(g(h())))`.
+func (c *SpamCorpusClient) Use(hooks ...Hook) {
+	c.hooks.SpamCorpus = append(c.hooks.SpamCorpus, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `spamcorpus.Intercept(f(g(h())))`.
+func (c *SpamCorpusClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SpamCorpus = append(c.inters.SpamCorpus, interceptors...)
+}
+
+// Create returns a builder for creating a SpamCorpus entity.
+func (c *SpamCorpusClient) Create() *SpamCorpusCreate {
+	mutation := newSpamCorpusMutation(c.config, OpCreate)
+	return &SpamCorpusCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SpamCorpus entities.
+func (c *SpamCorpusClient) CreateBulk(builders ...*SpamCorpusCreate) *SpamCorpusCreateBulk {
+	return &SpamCorpusCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SpamCorpusClient) MapCreateBulk(slice any, setFunc func(*SpamCorpusCreate, int)) *SpamCorpusCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SpamCorpusCreateBulk{err: fmt.Errorf("calling to SpamCorpusClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ImageCreate, rv.Len())
+	builders := make([]*SpamCorpusCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ImageCreateBulk{config: c.config, builders: builders}
+	return &SpamCorpusCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Image.
-func (c *ImageClient) Update() *ImageUpdate {
-	mutation := newImageMutation(c.config, OpUpdate)
-	return &ImageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SpamCorpus.
+func (c *SpamCorpusClient) Update() *SpamCorpusUpdate {
+	mutation := newSpamCorpusMutation(c.config, OpUpdate)
+	return &SpamCorpusUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ImageClient) UpdateOne(i *Image) *ImageUpdateOne {
-	mutation := newImageMutation(c.config, OpUpdateOne, withImage(i))
-	return &ImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SpamCorpusClient) UpdateOne(sc *SpamCorpus) *SpamCorpusUpdateOne {
+	mutation := newSpamCorpusMutation(c.config, OpUpdateOne, withSpamCorpus(sc))
+	return &SpamCorpusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ImageClient) UpdateOneID(id int) *ImageUpdateOne {
-	mutation := newImageMutation(c.config, OpUpdateOne, withImageID(id))
-	return &ImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SpamCorpusClient) UpdateOneID(id int) *SpamCorpusUpdateOne {
+	mutation := newSpamCorpusMutation(c.config, OpUpdateOne, withSpamCorpusID(id))
+	return &SpamCorpusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Image.
-func (c *ImageClient) Delete() *ImageDelete {
-	mutation := newImageMutation(c.config, OpDelete)
-	return &ImageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SpamCorpus.
+func (c *SpamCorpusClient) Delete() *SpamCorpusDelete {
+	mutation := newSpamCorpusMutation(c.config, OpDelete)
+	return &SpamCorpusDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ImageClient) DeleteOne(i *Image) *ImageDeleteOne {
-	return c.DeleteOneID(i.ID)
+func (c *SpamCorpusClient) DeleteOne(sc *SpamCorpus) *SpamCorpusDeleteOne {
+	return c.DeleteOneID(sc.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ImageClient) DeleteOneID(id int) *ImageDeleteOne {
-	builder := c.Delete().Where(image.ID(id))
+func (c *SpamCorpusClient) DeleteOneID(id int) *SpamCorpusDeleteOne {
+	builder := c.Delete().Where(spamcorpus.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ImageDeleteOne{builder}
+	return &SpamCorpusDeleteOne{builder}
 }
 
-// Query returns a query builder for Image.
-func (c *ImageClient) Query() *ImageQuery {
-	return &ImageQuery{
+// Query returns a query builder for SpamCorpus.
+func (c *SpamCorpusClient) Query() *SpamCorpusQuery {
+	return &SpamCorpusQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeImage},
+		ctx:    &QueryContext{Type: TypeSpamCorpus},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Image entity by its id.
-func (c *ImageClient) Get(ctx context.Context, id int) (*Image, error) {
-	return c.Query().Where(image.ID(id)).Only(ctx)
+// Get returns a SpamCorpus entity by its id.
+func (c *SpamCorpusClient) Get(ctx context.Context, id int) (*SpamCorpus, error) {
+	return c.Query().Where(spamcorpus.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ImageClient) GetX(ctx context.Context, id int) *Image {
+func (c *SpamCorpusClient) GetX(ctx context.Context, id int) *SpamCorpus {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1141,164 +3459,132 @@ func (c *ImageClient) GetX(ctx context.Context, id int) *Image {
 	return obj
 }
 
-// QueryUploadedBy queries the uploaded_by edge of a Image.
-func (c *ImageClient) QueryUploadedBy(i *Image) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := i.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(image.Table, image.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, image.UploadedByTable, image.UploadedByColumn),
-		)
-		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryBooks queries the books edge of a Image.
-func (c *ImageClient) QueryBooks(i *Image) *BookQuery {
-	query := (&BookClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := i.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(image.Table, image.FieldID, id),
-			sqlgraph.To(book.Table, book.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, image.BooksTable, image.BooksColumn),
-		)
-		fromV = sqlgraph.Neighbors(i.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *ImageClient) Hooks() []Hook {
-	return c.hooks.Image
+func (c *SpamCorpusClient) Hooks() []Hook {
+	return c.hooks.SpamCorpus
 }
 
 // Interceptors returns the client interceptors.
-func (c *ImageClient) Interceptors() []Interceptor {
-	return c.inters.Image
+func (c *SpamCorpusClient) Interceptors() []Interceptor {
+	return c.inters.SpamCorpus
 }
 
-func (c *ImageClient) mutate(ctx context.Context, m *ImageMutation) (Value, error) {
+func (c *SpamCorpusClient) mutate(ctx context.Context, m *SpamCorpusMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ImageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SpamCorpusCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ImageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SpamCorpusUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ImageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SpamCorpusUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ImageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SpamCorpusDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Image mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SpamCorpus mutation op: %q", m.Op())
 	}
 }
 
-// PostClient is a client for the Post schema.
-type PostClient struct {
+// SpamTokenClient is a client for the SpamToken schema.
+type SpamTokenClient struct {
 	config
 }
 
-// NewPostClient returns a client for the Post from the given config.
-func NewPostClient(c config) *PostClient {
-	return &PostClient{config: c}
+// NewSpamTokenClient returns a client for the SpamToken from the given config.
+func NewSpamTokenClient(c config) *SpamTokenClient {
+	return &SpamTokenClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `post.Hooks(f(g(h())))`.
-func (c *PostClient) Use(hooks ...Hook) {
-	c.hooks.Post = append(c.hooks.Post, hooks...)
+// A call to `Use(f, g, h)` equals to `spamtoken.Hooks(f(g(h())))`.
+func (c *SpamTokenClient) Use(hooks ...Hook) {
+	c.hooks.SpamToken = append(c.hooks.SpamToken, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `post.Intercept(f(g(h())))`.
-func (c *PostClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Post = append(c.inters.Post, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `spamtoken.Intercept(f(g(h())))`.
+func (c *SpamTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SpamToken = append(c.inters.SpamToken, interceptors...)
 }
 
-// Create returns a builder for creating a Post entity.
-func (c *PostClient) Create() *PostCreate {
-	mutation := newPostMutation(c.config, OpCreate)
-	return &PostCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a SpamToken entity.
+func (c *SpamTokenClient) Create() *SpamTokenCreate {
+	mutation := newSpamTokenMutation(c.config, OpCreate)
+	return &SpamTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Post entities.
-func (c *PostClient) CreateBulk(builders ...*PostCreate) *PostCreateBulk {
-	return &PostCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of SpamToken entities.
+func (c *SpamTokenClient) CreateBulk(builders ...*SpamTokenCreate) *SpamTokenCreateBulk {
+	return &SpamTokenCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PostClient) MapCreateBulk(slice any, setFunc func(*PostCreate, int)) *PostCreateBulk {
+func (c *SpamTokenClient) MapCreateBulk(slice any, setFunc func(*SpamTokenCreate, int)) *SpamTokenCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PostCreateBulk{err: fmt.Errorf("calling to PostClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &SpamTokenCreateBulk{err: fmt.Errorf("calling to SpamTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PostCreate, rv.Len())
+	builders := make([]*SpamTokenCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PostCreateBulk{config: c.config, builders: builders}
+	return &SpamTokenCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Post.
-func (c *PostClient) Update() *PostUpdate {
-	mutation := newPostMutation(c.config, OpUpdate)
-	return &PostUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for SpamToken.
+func (c *SpamTokenClient) Update() *SpamTokenUpdate {
+	mutation := newSpamTokenMutation(c.config, OpUpdate)
+	return &SpamTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PostClient) UpdateOne(po *Post) *PostUpdateOne {
-	mutation := newPostMutation(c.config, OpUpdateOne, withPost(po))
-	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SpamTokenClient) UpdateOne(st *SpamToken) *SpamTokenUpdateOne {
+	mutation := newSpamTokenMutation(c.config, OpUpdateOne, withSpamToken(st))
+	return &SpamTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PostClient) UpdateOneID(id int) *PostUpdateOne {
-	mutation := newPostMutation(c.config, OpUpdateOne, withPostID(id))
-	return &PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *SpamTokenClient) UpdateOneID(id int) *SpamTokenUpdateOne {
+	mutation := newSpamTokenMutation(c.config, OpUpdateOne, withSpamTokenID(id))
+	return &SpamTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Post.
-func (c *PostClient) Delete() *PostDelete {
-	mutation := newPostMutation(c.config, OpDelete)
-	return &PostDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for SpamToken.
+func (c *SpamTokenClient) Delete() *SpamTokenDelete {
+	mutation := newSpamTokenMutation(c.config, OpDelete)
+	return &SpamTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PostClient) DeleteOne(po *Post) *PostDeleteOne {
-	return c.DeleteOneID(po.ID)
+func (c *SpamTokenClient) DeleteOne(st *SpamToken) *SpamTokenDeleteOne {
+	return c.DeleteOneID(st.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PostClient) DeleteOneID(id int) *PostDeleteOne {
-	builder := c.Delete().Where(post.ID(id))
+func (c *SpamTokenClient) DeleteOneID(id int) *SpamTokenDeleteOne {
+	builder := c.Delete().Where(spamtoken.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PostDeleteOne{builder}
+	return &SpamTokenDeleteOne{builder}
 }
 
-// Query returns a query builder for Post.
-func (c *PostClient) Query() *PostQuery {
-	return &PostQuery{
+// Query returns a query builder for SpamToken.
+func (c *SpamTokenClient) Query() *SpamTokenQuery {
+	return &SpamTokenQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePost},
+		ctx:    &QueryContext{Type: TypeSpamToken},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Post entity by its id.
-func (c *PostClient) Get(ctx context.Context, id int) (*Post, error) {
-	return c.Query().Where(post.ID(id)).Only(ctx)
+// Get returns a SpamToken entity by its id.
+func (c *SpamTokenClient) Get(ctx context.Context, id int) (*SpamToken, error) {
+	return c.Query().Where(spamtoken.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PostClient) GetX(ctx context.Context, id int) *Post {
+func (c *SpamTokenClient) GetX(ctx context.Context, id int) *SpamToken {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1306,60 +3592,28 @@ func (c *PostClient) GetX(ctx context.Context, id int) *Post {
 	return obj
 }
 
-// QueryComments queries the comments edge of a Post.
-func (c *PostClient) QueryComments(po *Post) *CommentQuery {
-	query := (&CommentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(comment.Table, comment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, post.CommentsTable, post.CommentsColumn),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTags queries the tags edge of a Post.
-func (c *PostClient) QueryTags(po *Post) *TagQuery {
-	query := (&TagClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := po.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(post.Table, post.FieldID, id),
-			sqlgraph.To(tag.Table, tag.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, post.TagsTable, post.TagsPrimaryKey...),
-		)
-		fromV = sqlgraph.Neighbors(po.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *PostClient) Hooks() []Hook {
-	return c.hooks.Post
+func (c *SpamTokenClient) Hooks() []Hook {
+	return c.hooks.SpamToken
 }
 
 // Interceptors returns the client interceptors.
-func (c *PostClient) Interceptors() []Interceptor {
-	return c.inters.Post
+func (c *SpamTokenClient) Interceptors() []Interceptor {
+	return c.inters.SpamToken
 }
 
-func (c *PostClient) mutate(ctx context.Context, m *PostMutation) (Value, error) {
+func (c *SpamTokenClient) mutate(ctx context.Context, m *SpamTokenMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PostCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SpamTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PostUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SpamTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PostUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SpamTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PostDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SpamTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Post mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SpamToken mutation op: %q", m.Op())
 	}
 }
 
@@ -1700,6 +3954,134 @@ func (c *UserClient) QueryBooks(u *User) *BookQuery {
 	return query
 }
 
+// QueryCommentReactions queries the comment_reactions edge of a User.
+func (c *UserClient) QueryCommentReactions(u *User) *CommentReactionQuery {
+	query := (&CommentReactionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(commentreaction.Table, commentreaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.CommentReactionsTable, user.CommentReactionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIdentities queries the identities edge of a User.
+func (c *UserClient) QueryIdentities(u *User) *IdentityQuery {
+	query := (&IdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(identity.Table, identity.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.IdentitiesTable, user.IdentitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvitations queries the invitations edge of a User.
+func (c *UserClient) QueryInvitations(u *User) *InvitationQuery {
+	query := (&InvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(invitation.Table, invitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.InvitationsTable, user.InvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvitation queries the invitation edge of a User.
+func (c *UserClient) QueryInvitation(u *User) *InvitationQuery {
+	query := (&InvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(invitation.Table, invitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.InvitationTable, user.InvitationColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPasswordResets queries the password_resets edge of a User.
+func (c *UserClient) QueryPasswordResets(u *User) *PasswordResetQuery {
+	query := (&PasswordResetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(passwordreset.Table, passwordreset.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.PasswordResetsTable, user.PasswordResetsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySessions queries the sessions edge of a User.
+func (c *UserClient) QuerySessions(u *User) *SessionQuery {
+	query := (&SessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(session.Table, session.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SessionsTable, user.SessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccessTokens queries the access_tokens edge of a User.
+func (c *UserClient) QueryAccessTokens(u *User) *AccessTokenQuery {
+	query := (&AccessTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(accesstoken.Table, accesstoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AccessTokensTable, user.AccessTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecoveryCodes queries the recovery_codes edge of a User.
+func (c *UserClient) QueryRecoveryCodes(u *User) *RecoveryCodeQuery {
+	query := (&RecoveryCodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(recoverycode.Table, recoverycode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RecoveryCodesTable, user.RecoveryCodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -1728,10 +4110,39 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Book, Collection, Comment, Friend, Hitokoto, Image, Post, Tag, User []ent.Hook
+		AccessToken, Book, Collection, Comment, CommentReaction, CommentRevision,
+		EmailSubscription, Friend, Hitokoto, Identity, Image, Invitation,
+		PasswordReset, Post, PostRevision, PostSlugHistory, RecoveryCode,
+		SearchDocument, Session, SpamCorpus, SpamToken, Tag, User []ent.Hook
 	}
 	inters struct {
-		Book, Collection, Comment, Friend, Hitokoto, Image, Post, Tag,
-		User []ent.Interceptor
+		AccessToken, Book, Collection, Comment, CommentReaction, CommentRevision,
+		EmailSubscription, Friend, Hitokoto, Identity, Image, Invitation,
+		PasswordReset, Post, PostRevision, PostSlugHistory, RecoveryCode,
+		SearchDocument, Session, SpamCorpus, SpamToken, Tag, User []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -27,16 +27,30 @@ type Comment struct {
 	Email string `json:"email,omitempty"`
 	// Website holds the value of the "website" field.
 	Website string `json:"website,omitempty"`
-	// Approved holds the value of the "approved" field.
-	Approved bool `json:"approved,omitempty"`
+	// Status holds the value of the "status" field.
+	Status comment.Status `json:"status,omitempty"`
+	// PreviousStatus holds the value of the "previous_status" field.
+	PreviousStatus *comment.PreviousStatus `json:"previous_status,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// EditedAt holds the value of the "edited_at" field.
+	EditedAt *time.Time `json:"edited_at,omitempty"`
 	// Avatar holds the value of the "avatar" field.
 	Avatar string `json:"avatar,omitempty"`
 	// ParentID holds the value of the "parent_id" field.
 	ParentID *int `json:"parent_id,omitempty"`
+	// ModerationAction holds the value of the "moderation_action" field.
+	ModerationAction comment.ModerationAction `json:"moderation_action,omitempty"`
+	// ModerationReason holds the value of the "moderation_reason" field.
+	ModerationReason string `json:"moderation_reason,omitempty"`
+	// SpamScore holds the value of the "spam_score" field.
+	SpamScore *float64 `json:"spam_score,omitempty"`
+	// SpamLabel holds the value of the "spam_label" field.
+	SpamLabel *comment.SpamLabel `json:"spam_label,omitempty"`
+	// ImportID holds the value of the "import_id" field.
+	ImportID *string `json:"import_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CommentQuery when eager-loading is set.
 	Edges         CommentEdges `json:"edges"`
@@ -55,9 +69,13 @@ type CommentEdges struct {
 	Parent *Comment `json:"parent,omitempty"`
 	// Children holds the value of the children edge.
 	Children []*Comment `json:"children,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*CommentRevision `json:"revisions,omitempty"`
+	// Reactions holds the value of the reactions edge.
+	Reactions []*CommentReaction `json:"reactions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [6]bool
 }
 
 // PostOrErr returns the Post value or an error if the edge
@@ -102,18 +120,36 @@ func (e CommentEdges) ChildrenOrErr() ([]*Comment, error) {
 	return nil, &NotLoadedError{edge: "children"}
 }
 
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e CommentEdges) RevisionsOrErr() ([]*CommentRevision, error) {
+	if e.loadedTypes[4] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// ReactionsOrErr returns the Reactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e CommentEdges) ReactionsOrErr() ([]*CommentReaction, error) {
+	if e.loadedTypes[5] {
+		return e.Reactions, nil
+	}
+	return nil, &NotLoadedError{edge: "reactions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Comment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case comment.FieldApproved:
-			values[i] = new(sql.NullBool)
+		case comment.FieldSpamScore:
+			values[i] = new(sql.NullFloat64)
 		case comment.FieldID, comment.FieldParentID:
 			values[i] = new(sql.NullInt64)
-		case comment.FieldContent, comment.FieldAuthor, comment.FieldEmail, comment.FieldWebsite, comment.FieldAvatar:
+		case comment.FieldContent, comment.FieldAuthor, comment.FieldEmail, comment.FieldWebsite, comment.FieldStatus, comment.FieldPreviousStatus, comment.FieldAvatar, comment.FieldModerationAction, comment.FieldModerationReason, comment.FieldSpamLabel, comment.FieldImportID:
 			values[i] = new(sql.NullString)
-		case comment.FieldCreatedAt, comment.FieldUpdatedAt:
+		case comment.FieldCreatedAt, comment.FieldUpdatedAt, comment.FieldEditedAt:
 			values[i] = new(sql.NullTime)
 		case comment.ForeignKeys[0]: // post_comments
 			values[i] = new(sql.NullInt64)
@@ -164,11 +200,18 @@ func (c *Comment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.Website = value.String
 			}
-		case comment.FieldApproved:
-			if value, ok := values[i].(*sql.NullBool); !ok {
-				return fmt.Errorf("unexpected type %T for field approved", values[i])
+		case comment.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				c.Status = comment.Status(value.String)
+			}
+		case comment.FieldPreviousStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_status", values[i])
 			} else if value.Valid {
-				c.Approved = value.Bool
+				c.PreviousStatus = new(comment.PreviousStatus)
+				*c.PreviousStatus = comment.PreviousStatus(value.String)
 			}
 		case comment.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -182,6 +225,13 @@ func (c *Comment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.UpdatedAt = value.Time
 			}
+		case comment.FieldEditedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
+			} else if value.Valid {
+				c.EditedAt = new(time.Time)
+				*c.EditedAt = value.Time
+			}
 		case comment.FieldAvatar:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field avatar", values[i])
@@ -195,6 +245,39 @@ func (c *Comment) assignValues(columns []string, values []any) error {
 				c.ParentID = new(int)
 				*c.ParentID = int(value.Int64)
 			}
+		case comment.FieldModerationAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field moderation_action", values[i])
+			} else if value.Valid {
+				c.ModerationAction = comment.ModerationAction(value.String)
+			}
+		case comment.FieldModerationReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field moderation_reason", values[i])
+			} else if value.Valid {
+				c.ModerationReason = value.String
+			}
+		case comment.FieldSpamScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field spam_score", values[i])
+			} else if value.Valid {
+				c.SpamScore = new(float64)
+				*c.SpamScore = value.Float64
+			}
+		case comment.FieldSpamLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field spam_label", values[i])
+			} else if value.Valid {
+				c.SpamLabel = new(comment.SpamLabel)
+				*c.SpamLabel = comment.SpamLabel(value.String)
+			}
+		case comment.FieldImportID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field import_id", values[i])
+			} else if value.Valid {
+				c.ImportID = new(string)
+				*c.ImportID = value.String
+			}
 		case comment.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field post_comments", value)
@@ -242,6 +325,16 @@ func (c *Comment) QueryChildren() *CommentQuery {
 	return NewCommentClient(c.config).QueryChildren(c)
 }
 
+// QueryRevisions queries the "revisions" edge of the Comment entity.
+func (c *Comment) QueryRevisions() *CommentRevisionQuery {
+	return NewCommentClient(c.config).QueryRevisions(c)
+}
+
+// QueryReactions queries the "reactions" edge of the Comment entity.
+func (c *Comment) QueryReactions() *CommentReactionQuery {
+	return NewCommentClient(c.config).QueryReactions(c)
+}
+
 // Update returns a builder for updating this Comment.
 // Note that you need to call Comment.Unwrap() before calling this method if this Comment
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -277,8 +370,13 @@ func (c *Comment) String() string {
 	builder.WriteString("website=")
 	builder.WriteString(c.Website)
 	builder.WriteString(", ")
-	builder.WriteString("approved=")
-	builder.WriteString(fmt.Sprintf("%v", c.Approved))
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", c.Status))
+	builder.WriteString(", ")
+	if v := c.PreviousStatus; v != nil {
+		builder.WriteString("previous_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
@@ -286,6 +384,11 @@ func (c *Comment) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := c.EditedAt; v != nil {
+		builder.WriteString("edited_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("avatar=")
 	builder.WriteString(c.Avatar)
 	builder.WriteString(", ")
@@ -293,6 +396,27 @@ func (c *Comment) String() string {
 		builder.WriteString("parent_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("moderation_action=")
+	builder.WriteString(fmt.Sprintf("%v", c.ModerationAction))
+	builder.WriteString(", ")
+	builder.WriteString("moderation_reason=")
+	builder.WriteString(c.ModerationReason)
+	builder.WriteString(", ")
+	if v := c.SpamScore; v != nil {
+		builder.WriteString("spam_score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := c.SpamLabel; v != nil {
+		builder.WriteString("spam_label=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := c.ImportID; v != nil {
+		builder.WriteString("import_id=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package comment
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -20,16 +22,30 @@ const (
 	FieldEmail = "email"
 	// FieldWebsite holds the string denoting the website field in the database.
 	FieldWebsite = "website"
-	// FieldApproved holds the string denoting the approved field in the database.
-	FieldApproved = "approved"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldPreviousStatus holds the string denoting the previous_status field in the database.
+	FieldPreviousStatus = "previous_status"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldEditedAt holds the string denoting the edited_at field in the database.
+	FieldEditedAt = "edited_at"
 	// FieldAvatar holds the string denoting the avatar field in the database.
 	FieldAvatar = "avatar"
 	// FieldParentID holds the string denoting the parent_id field in the database.
 	FieldParentID = "parent_id"
+	// FieldModerationAction holds the string denoting the moderation_action field in the database.
+	FieldModerationAction = "moderation_action"
+	// FieldModerationReason holds the string denoting the moderation_reason field in the database.
+	FieldModerationReason = "moderation_reason"
+	// FieldSpamScore holds the string denoting the spam_score field in the database.
+	FieldSpamScore = "spam_score"
+	// FieldSpamLabel holds the string denoting the spam_label field in the database.
+	FieldSpamLabel = "spam_label"
+	// FieldImportID holds the string denoting the import_id field in the database.
+	FieldImportID = "import_id"
 	// EdgePost holds the string denoting the post edge name in mutations.
 	EdgePost = "post"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -38,6 +54,10 @@ const (
 	EdgeParent = "parent"
 	// EdgeChildren holds the string denoting the children edge name in mutations.
 	EdgeChildren = "children"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
+	// EdgeReactions holds the string denoting the reactions edge name in mutations.
+	EdgeReactions = "reactions"
 	// Table holds the table name of the comment in the database.
 	Table = "comments"
 	// PostTable is the table that holds the post relation/edge.
@@ -62,6 +82,20 @@ const (
 	ChildrenTable = "comments"
 	// ChildrenColumn is the table column denoting the children relation/edge.
 	ChildrenColumn = "parent_id"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "comment_revisions"
+	// RevisionsInverseTable is the table name for the CommentRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "commentrevision" package.
+	RevisionsInverseTable = "comment_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "comment_revisions"
+	// ReactionsTable is the table that holds the reactions relation/edge.
+	ReactionsTable = "comment_reactions"
+	// ReactionsInverseTable is the table name for the CommentReaction entity.
+	// It exists in this package in order to avoid circular dependency with the "commentreaction" package.
+	ReactionsInverseTable = "comment_reactions"
+	// ReactionsColumn is the table column denoting the reactions relation/edge.
+	ReactionsColumn = "comment_id"
 )
 
 // Columns holds all SQL columns for comment fields.
@@ -71,11 +105,18 @@ var Columns = []string{
 	FieldAuthor,
 	FieldEmail,
 	FieldWebsite,
-	FieldApproved,
+	FieldStatus,
+	FieldPreviousStatus,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldEditedAt,
 	FieldAvatar,
 	FieldParentID,
+	FieldModerationAction,
+	FieldModerationReason,
+	FieldSpamScore,
+	FieldSpamLabel,
+	FieldImportID,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "comments"
@@ -107,12 +148,112 @@ var (
 	AuthorValidator func(string) error
 	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	EmailValidator func(string) error
-	// DefaultApproved holds the default value on creation for the "approved" field.
-	DefaultApproved bool
 	// DefaultAvatar holds the default value on creation for the "avatar" field.
 	DefaultAvatar string
 )
 
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending  Status = "pending"
+	StatusApproved Status = "approved"
+	StatusRejected Status = "rejected"
+	StatusSpam     Status = "spam"
+	StatusTrashed  Status = "trashed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusApproved, StatusRejected, StatusSpam, StatusTrashed:
+		return nil
+	default:
+		return fmt.Errorf("comment: invalid enum value for status field: %q", s)
+	}
+}
+
+// PreviousStatus defines the type for the "previous_status" enum field.
+type PreviousStatus string
+
+// PreviousStatus values.
+const (
+	PreviousStatusPending  PreviousStatus = "pending"
+	PreviousStatusApproved PreviousStatus = "approved"
+	PreviousStatusRejected PreviousStatus = "rejected"
+	PreviousStatusSpam     PreviousStatus = "spam"
+	PreviousStatusTrashed  PreviousStatus = "trashed"
+)
+
+func (ps PreviousStatus) String() string {
+	return string(ps)
+}
+
+// PreviousStatusValidator is a validator for the "previous_status" field enum values. It is called by the builders before save.
+func PreviousStatusValidator(ps PreviousStatus) error {
+	switch ps {
+	case PreviousStatusPending, PreviousStatusApproved, PreviousStatusRejected, PreviousStatusSpam, PreviousStatusTrashed:
+		return nil
+	default:
+		return fmt.Errorf("comment: invalid enum value for previous_status field: %q", ps)
+	}
+}
+
+// ModerationAction defines the type for the "moderation_action" enum field.
+type ModerationAction string
+
+// ModerationAction values.
+const (
+	ModerationActionApprove ModerationAction = "approve"
+	ModerationActionHold    ModerationAction = "hold"
+	ModerationActionReject  ModerationAction = "reject"
+)
+
+func (ma ModerationAction) String() string {
+	return string(ma)
+}
+
+// ModerationActionValidator is a validator for the "moderation_action" field enum values. It is called by the builders before save.
+func ModerationActionValidator(ma ModerationAction) error {
+	switch ma {
+	case ModerationActionApprove, ModerationActionHold, ModerationActionReject:
+		return nil
+	default:
+		return fmt.Errorf("comment: invalid enum value for moderation_action field: %q", ma)
+	}
+}
+
+// SpamLabel defines the type for the "spam_label" enum field.
+type SpamLabel string
+
+// SpamLabel values.
+const (
+	SpamLabelSpam SpamLabel = "spam"
+	SpamLabelHam  SpamLabel = "ham"
+)
+
+func (sl SpamLabel) String() string {
+	return string(sl)
+}
+
+// SpamLabelValidator is a validator for the "spam_label" field enum values. It is called by the builders before save.
+func SpamLabelValidator(sl SpamLabel) error {
+	switch sl {
+	case SpamLabelSpam, SpamLabelHam:
+		return nil
+	default:
+		return fmt.Errorf("comment: invalid enum value for spam_label field: %q", sl)
+	}
+}
+
 // OrderOption defines the ordering options for the Comment queries.
 type OrderOption func(*sql.Selector)
 
@@ -141,9 +282,14 @@ func ByWebsite(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWebsite, opts...).ToFunc()
 }
 
-// ByApproved orders the results by the approved field.
-func ByApproved(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldApproved, opts...).ToFunc()
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByPreviousStatus orders the results by the previous_status field.
+func ByPreviousStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousStatus, opts...).ToFunc()
 }
 
 // ByCreatedAt orders the results by the created_at field.
@@ -156,6 +302,11 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByEditedAt orders the results by the edited_at field.
+func ByEditedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEditedAt, opts...).ToFunc()
+}
+
 // ByAvatar orders the results by the avatar field.
 func ByAvatar(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAvatar, opts...).ToFunc()
@@ -166,6 +317,31 @@ func ByParentID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldParentID, opts...).ToFunc()
 }
 
+// ByModerationAction orders the results by the moderation_action field.
+func ByModerationAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModerationAction, opts...).ToFunc()
+}
+
+// ByModerationReason orders the results by the moderation_reason field.
+func ByModerationReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModerationReason, opts...).ToFunc()
+}
+
+// BySpamScore orders the results by the spam_score field.
+func BySpamScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpamScore, opts...).ToFunc()
+}
+
+// BySpamLabel orders the results by the spam_label field.
+func BySpamLabel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpamLabel, opts...).ToFunc()
+}
+
+// ByImportID orders the results by the import_id field.
+func ByImportID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImportID, opts...).ToFunc()
+}
+
 // ByPostField orders the results by post field.
 func ByPostField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -200,6 +376,34 @@ func ByChildren(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newChildrenStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReactionsCount orders the results by reactions count.
+func ByReactionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReactionsStep(), opts...)
+	}
+}
+
+// ByReactions orders the results by reactions terms.
+func ByReactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newPostStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -228,3 +432,17 @@ func newChildrenStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
 	)
 }
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+	)
+}
+func newReactionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReactionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReactionsTable, ReactionsColumn),
+	)
+}
@@ -75,11 +75,6 @@ func Website(v string) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldWebsite, v))
 }
 
-// Approved applies equality check predicate on the "approved" field. It's identical to ApprovedEQ.
-func Approved(v bool) predicate.Comment {
-	return predicate.Comment(sql.FieldEQ(FieldApproved, v))
-}
-
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldCreatedAt, v))
@@ -90,6 +85,11 @@ func UpdatedAt(v time.Time) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// EditedAt applies equality check predicate on the "edited_at" field. It's identical to EditedAtEQ.
+func EditedAt(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldEditedAt, v))
+}
+
 // Avatar applies equality check predicate on the "avatar" field. It's identical to AvatarEQ.
 func Avatar(v string) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldAvatar, v))
@@ -100,6 +100,21 @@ func ParentID(v int) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldParentID, v))
 }
 
+// ModerationReason applies equality check predicate on the "moderation_reason" field. It's identical to ModerationReasonEQ.
+func ModerationReason(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldModerationReason, v))
+}
+
+// SpamScore applies equality check predicate on the "spam_score" field. It's identical to SpamScoreEQ.
+func SpamScore(v float64) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldSpamScore, v))
+}
+
+// ImportID applies equality check predicate on the "import_id" field. It's identical to ImportIDEQ.
+func ImportID(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldImportID, v))
+}
+
 // ContentEQ applies the EQ predicate on the "content" field.
 func ContentEQ(v string) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldContent, v))
@@ -370,14 +385,54 @@ func WebsiteContainsFold(v string) predicate.Comment {
 	return predicate.Comment(sql.FieldContainsFold(FieldWebsite, v))
 }
 
-// ApprovedEQ applies the EQ predicate on the "approved" field.
-func ApprovedEQ(v bool) predicate.Comment {
-	return predicate.Comment(sql.FieldEQ(FieldApproved, v))
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// PreviousStatusEQ applies the EQ predicate on the "previous_status" field.
+func PreviousStatusEQ(v PreviousStatus) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldPreviousStatus, v))
+}
+
+// PreviousStatusNEQ applies the NEQ predicate on the "previous_status" field.
+func PreviousStatusNEQ(v PreviousStatus) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldPreviousStatus, v))
+}
+
+// PreviousStatusIn applies the In predicate on the "previous_status" field.
+func PreviousStatusIn(vs ...PreviousStatus) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldPreviousStatus, vs...))
+}
+
+// PreviousStatusNotIn applies the NotIn predicate on the "previous_status" field.
+func PreviousStatusNotIn(vs ...PreviousStatus) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldPreviousStatus, vs...))
+}
+
+// PreviousStatusIsNil applies the IsNil predicate on the "previous_status" field.
+func PreviousStatusIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldPreviousStatus))
 }
 
-// ApprovedNEQ applies the NEQ predicate on the "approved" field.
-func ApprovedNEQ(v bool) predicate.Comment {
-	return predicate.Comment(sql.FieldNEQ(FieldApproved, v))
+// PreviousStatusNotNil applies the NotNil predicate on the "previous_status" field.
+func PreviousStatusNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldPreviousStatus))
 }
 
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
@@ -460,6 +515,56 @@ func UpdatedAtLTE(v time.Time) predicate.Comment {
 	return predicate.Comment(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// EditedAtEQ applies the EQ predicate on the "edited_at" field.
+func EditedAtEQ(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldEditedAt, v))
+}
+
+// EditedAtNEQ applies the NEQ predicate on the "edited_at" field.
+func EditedAtNEQ(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldEditedAt, v))
+}
+
+// EditedAtIn applies the In predicate on the "edited_at" field.
+func EditedAtIn(vs ...time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldEditedAt, vs...))
+}
+
+// EditedAtNotIn applies the NotIn predicate on the "edited_at" field.
+func EditedAtNotIn(vs ...time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldEditedAt, vs...))
+}
+
+// EditedAtGT applies the GT predicate on the "edited_at" field.
+func EditedAtGT(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldEditedAt, v))
+}
+
+// EditedAtGTE applies the GTE predicate on the "edited_at" field.
+func EditedAtGTE(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldEditedAt, v))
+}
+
+// EditedAtLT applies the LT predicate on the "edited_at" field.
+func EditedAtLT(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldEditedAt, v))
+}
+
+// EditedAtLTE applies the LTE predicate on the "edited_at" field.
+func EditedAtLTE(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldEditedAt, v))
+}
+
+// EditedAtIsNil applies the IsNil predicate on the "edited_at" field.
+func EditedAtIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldEditedAt))
+}
+
+// EditedAtNotNil applies the NotNil predicate on the "edited_at" field.
+func EditedAtNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldEditedAt))
+}
+
 // AvatarEQ applies the EQ predicate on the "avatar" field.
 func AvatarEQ(v string) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldAvatar, v))
@@ -565,6 +670,266 @@ func ParentIDNotNil() predicate.Comment {
 	return predicate.Comment(sql.FieldNotNull(FieldParentID))
 }
 
+// ModerationActionEQ applies the EQ predicate on the "moderation_action" field.
+func ModerationActionEQ(v ModerationAction) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldModerationAction, v))
+}
+
+// ModerationActionNEQ applies the NEQ predicate on the "moderation_action" field.
+func ModerationActionNEQ(v ModerationAction) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldModerationAction, v))
+}
+
+// ModerationActionIn applies the In predicate on the "moderation_action" field.
+func ModerationActionIn(vs ...ModerationAction) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldModerationAction, vs...))
+}
+
+// ModerationActionNotIn applies the NotIn predicate on the "moderation_action" field.
+func ModerationActionNotIn(vs ...ModerationAction) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldModerationAction, vs...))
+}
+
+// ModerationActionIsNil applies the IsNil predicate on the "moderation_action" field.
+func ModerationActionIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldModerationAction))
+}
+
+// ModerationActionNotNil applies the NotNil predicate on the "moderation_action" field.
+func ModerationActionNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldModerationAction))
+}
+
+// ModerationReasonEQ applies the EQ predicate on the "moderation_reason" field.
+func ModerationReasonEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldModerationReason, v))
+}
+
+// ModerationReasonNEQ applies the NEQ predicate on the "moderation_reason" field.
+func ModerationReasonNEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldModerationReason, v))
+}
+
+// ModerationReasonIn applies the In predicate on the "moderation_reason" field.
+func ModerationReasonIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldModerationReason, vs...))
+}
+
+// ModerationReasonNotIn applies the NotIn predicate on the "moderation_reason" field.
+func ModerationReasonNotIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldModerationReason, vs...))
+}
+
+// ModerationReasonGT applies the GT predicate on the "moderation_reason" field.
+func ModerationReasonGT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldModerationReason, v))
+}
+
+// ModerationReasonGTE applies the GTE predicate on the "moderation_reason" field.
+func ModerationReasonGTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldModerationReason, v))
+}
+
+// ModerationReasonLT applies the LT predicate on the "moderation_reason" field.
+func ModerationReasonLT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldModerationReason, v))
+}
+
+// ModerationReasonLTE applies the LTE predicate on the "moderation_reason" field.
+func ModerationReasonLTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldModerationReason, v))
+}
+
+// ModerationReasonContains applies the Contains predicate on the "moderation_reason" field.
+func ModerationReasonContains(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContains(FieldModerationReason, v))
+}
+
+// ModerationReasonHasPrefix applies the HasPrefix predicate on the "moderation_reason" field.
+func ModerationReasonHasPrefix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasPrefix(FieldModerationReason, v))
+}
+
+// ModerationReasonHasSuffix applies the HasSuffix predicate on the "moderation_reason" field.
+func ModerationReasonHasSuffix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasSuffix(FieldModerationReason, v))
+}
+
+// ModerationReasonIsNil applies the IsNil predicate on the "moderation_reason" field.
+func ModerationReasonIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldModerationReason))
+}
+
+// ModerationReasonNotNil applies the NotNil predicate on the "moderation_reason" field.
+func ModerationReasonNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldModerationReason))
+}
+
+// ModerationReasonEqualFold applies the EqualFold predicate on the "moderation_reason" field.
+func ModerationReasonEqualFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEqualFold(FieldModerationReason, v))
+}
+
+// ModerationReasonContainsFold applies the ContainsFold predicate on the "moderation_reason" field.
+func ModerationReasonContainsFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContainsFold(FieldModerationReason, v))
+}
+
+// SpamScoreEQ applies the EQ predicate on the "spam_score" field.
+func SpamScoreEQ(v float64) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldSpamScore, v))
+}
+
+// SpamScoreNEQ applies the NEQ predicate on the "spam_score" field.
+func SpamScoreNEQ(v float64) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldSpamScore, v))
+}
+
+// SpamScoreIn applies the In predicate on the "spam_score" field.
+func SpamScoreIn(vs ...float64) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldSpamScore, vs...))
+}
+
+// SpamScoreNotIn applies the NotIn predicate on the "spam_score" field.
+func SpamScoreNotIn(vs ...float64) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldSpamScore, vs...))
+}
+
+// SpamScoreGT applies the GT predicate on the "spam_score" field.
+func SpamScoreGT(v float64) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldSpamScore, v))
+}
+
+// SpamScoreGTE applies the GTE predicate on the "spam_score" field.
+func SpamScoreGTE(v float64) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldSpamScore, v))
+}
+
+// SpamScoreLT applies the LT predicate on the "spam_score" field.
+func SpamScoreLT(v float64) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldSpamScore, v))
+}
+
+// SpamScoreLTE applies the LTE predicate on the "spam_score" field.
+func SpamScoreLTE(v float64) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldSpamScore, v))
+}
+
+// SpamScoreIsNil applies the IsNil predicate on the "spam_score" field.
+func SpamScoreIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldSpamScore))
+}
+
+// SpamScoreNotNil applies the NotNil predicate on the "spam_score" field.
+func SpamScoreNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldSpamScore))
+}
+
+// SpamLabelEQ applies the EQ predicate on the "spam_label" field.
+func SpamLabelEQ(v SpamLabel) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldSpamLabel, v))
+}
+
+// SpamLabelNEQ applies the NEQ predicate on the "spam_label" field.
+func SpamLabelNEQ(v SpamLabel) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldSpamLabel, v))
+}
+
+// SpamLabelIn applies the In predicate on the "spam_label" field.
+func SpamLabelIn(vs ...SpamLabel) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldSpamLabel, vs...))
+}
+
+// SpamLabelNotIn applies the NotIn predicate on the "spam_label" field.
+func SpamLabelNotIn(vs ...SpamLabel) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldSpamLabel, vs...))
+}
+
+// SpamLabelIsNil applies the IsNil predicate on the "spam_label" field.
+func SpamLabelIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldSpamLabel))
+}
+
+// SpamLabelNotNil applies the NotNil predicate on the "spam_label" field.
+func SpamLabelNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldSpamLabel))
+}
+
+// ImportIDEQ applies the EQ predicate on the "import_id" field.
+func ImportIDEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldImportID, v))
+}
+
+// ImportIDNEQ applies the NEQ predicate on the "import_id" field.
+func ImportIDNEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldImportID, v))
+}
+
+// ImportIDIn applies the In predicate on the "import_id" field.
+func ImportIDIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldImportID, vs...))
+}
+
+// ImportIDNotIn applies the NotIn predicate on the "import_id" field.
+func ImportIDNotIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldImportID, vs...))
+}
+
+// ImportIDGT applies the GT predicate on the "import_id" field.
+func ImportIDGT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldImportID, v))
+}
+
+// ImportIDGTE applies the GTE predicate on the "import_id" field.
+func ImportIDGTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldImportID, v))
+}
+
+// ImportIDLT applies the LT predicate on the "import_id" field.
+func ImportIDLT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldImportID, v))
+}
+
+// ImportIDLTE applies the LTE predicate on the "import_id" field.
+func ImportIDLTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldImportID, v))
+}
+
+// ImportIDContains applies the Contains predicate on the "import_id" field.
+func ImportIDContains(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContains(FieldImportID, v))
+}
+
+// ImportIDHasPrefix applies the HasPrefix predicate on the "import_id" field.
+func ImportIDHasPrefix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasPrefix(FieldImportID, v))
+}
+
+// ImportIDHasSuffix applies the HasSuffix predicate on the "import_id" field.
+func ImportIDHasSuffix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasSuffix(FieldImportID, v))
+}
+
+// ImportIDIsNil applies the IsNil predicate on the "import_id" field.
+func ImportIDIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldImportID))
+}
+
+// ImportIDNotNil applies the NotNil predicate on the "import_id" field.
+func ImportIDNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldImportID))
+}
+
+// ImportIDEqualFold applies the EqualFold predicate on the "import_id" field.
+func ImportIDEqualFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEqualFold(FieldImportID, v))
+}
+
+// ImportIDContainsFold applies the ContainsFold predicate on the "import_id" field.
+func ImportIDContainsFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContainsFold(FieldImportID, v))
+}
+
 // HasPost applies the HasEdge predicate on the "post" edge.
 func HasPost() predicate.Comment {
 	return predicate.Comment(func(s *sql.Selector) {
@@ -657,6 +1022,52 @@ func HasChildrenWith(preds ...predicate.Comment) predicate.Comment {
 	})
 }
 
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.CommentRevision) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReactions applies the HasEdge predicate on the "reactions" edge.
+func HasReactions() predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReactionsTable, ReactionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReactionsWith applies the HasEdge predicate on the "reactions" edge with a given conditions (other predicates).
+func HasReactionsWith(preds ...predicate.CommentReaction) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		step := newReactionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Comment) predicate.Comment {
 	return predicate.Comment(sql.AndPredicates(predicates...))
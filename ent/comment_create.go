@@ -4,6 +4,8 @@ package ent
 
 import (
 	"blog-go/ent/comment"
+	"blog-go/ent/commentreaction"
+	"blog-go/ent/commentrevision"
 	"blog-go/ent/post"
 	"blog-go/ent/user"
 	"context"
@@ -54,16 +56,30 @@ func (cc *CommentCreate) SetNillableWebsite(s *string) *CommentCreate {
 	return cc
 }
 
-// SetApproved sets the "approved" field.
-func (cc *CommentCreate) SetApproved(b bool) *CommentCreate {
-	cc.mutation.SetApproved(b)
+// SetStatus sets the "status" field.
+func (cc *CommentCreate) SetStatus(c comment.Status) *CommentCreate {
+	cc.mutation.SetStatus(c)
 	return cc
 }
 
-// SetNillableApproved sets the "approved" field if the given value is not nil.
-func (cc *CommentCreate) SetNillableApproved(b *bool) *CommentCreate {
-	if b != nil {
-		cc.SetApproved(*b)
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableStatus(c *comment.Status) *CommentCreate {
+	if c != nil {
+		cc.SetStatus(*c)
+	}
+	return cc
+}
+
+// SetPreviousStatus sets the "previous_status" field.
+func (cc *CommentCreate) SetPreviousStatus(cs comment.PreviousStatus) *CommentCreate {
+	cc.mutation.SetPreviousStatus(cs)
+	return cc
+}
+
+// SetNillablePreviousStatus sets the "previous_status" field if the given value is not nil.
+func (cc *CommentCreate) SetNillablePreviousStatus(cs *comment.PreviousStatus) *CommentCreate {
+	if cs != nil {
+		cc.SetPreviousStatus(*cs)
 	}
 	return cc
 }
@@ -80,6 +96,20 @@ func (cc *CommentCreate) SetUpdatedAt(t time.Time) *CommentCreate {
 	return cc
 }
 
+// SetEditedAt sets the "edited_at" field.
+func (cc *CommentCreate) SetEditedAt(t time.Time) *CommentCreate {
+	cc.mutation.SetEditedAt(t)
+	return cc
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableEditedAt(t *time.Time) *CommentCreate {
+	if t != nil {
+		cc.SetEditedAt(*t)
+	}
+	return cc
+}
+
 // SetAvatar sets the "avatar" field.
 func (cc *CommentCreate) SetAvatar(s string) *CommentCreate {
 	cc.mutation.SetAvatar(s)
@@ -108,6 +138,76 @@ func (cc *CommentCreate) SetNillableParentID(i *int) *CommentCreate {
 	return cc
 }
 
+// SetModerationAction sets the "moderation_action" field.
+func (cc *CommentCreate) SetModerationAction(ca comment.ModerationAction) *CommentCreate {
+	cc.mutation.SetModerationAction(ca)
+	return cc
+}
+
+// SetNillableModerationAction sets the "moderation_action" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableModerationAction(ca *comment.ModerationAction) *CommentCreate {
+	if ca != nil {
+		cc.SetModerationAction(*ca)
+	}
+	return cc
+}
+
+// SetModerationReason sets the "moderation_reason" field.
+func (cc *CommentCreate) SetModerationReason(s string) *CommentCreate {
+	cc.mutation.SetModerationReason(s)
+	return cc
+}
+
+// SetNillableModerationReason sets the "moderation_reason" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableModerationReason(s *string) *CommentCreate {
+	if s != nil {
+		cc.SetModerationReason(*s)
+	}
+	return cc
+}
+
+// SetSpamScore sets the "spam_score" field.
+func (cc *CommentCreate) SetSpamScore(f float64) *CommentCreate {
+	cc.mutation.SetSpamScore(f)
+	return cc
+}
+
+// SetNillableSpamScore sets the "spam_score" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableSpamScore(f *float64) *CommentCreate {
+	if f != nil {
+		cc.SetSpamScore(*f)
+	}
+	return cc
+}
+
+// SetSpamLabel sets the "spam_label" field.
+func (cc *CommentCreate) SetSpamLabel(cl comment.SpamLabel) *CommentCreate {
+	cc.mutation.SetSpamLabel(cl)
+	return cc
+}
+
+// SetNillableSpamLabel sets the "spam_label" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableSpamLabel(cl *comment.SpamLabel) *CommentCreate {
+	if cl != nil {
+		cc.SetSpamLabel(*cl)
+	}
+	return cc
+}
+
+// SetImportID sets the "import_id" field.
+func (cc *CommentCreate) SetImportID(s string) *CommentCreate {
+	cc.mutation.SetImportID(s)
+	return cc
+}
+
+// SetNillableImportID sets the "import_id" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableImportID(s *string) *CommentCreate {
+	if s != nil {
+		cc.SetImportID(*s)
+	}
+	return cc
+}
+
 // SetPostID sets the "post" edge to the Post entity by ID.
 func (cc *CommentCreate) SetPostID(id int) *CommentCreate {
 	cc.mutation.SetPostID(id)
@@ -158,6 +258,36 @@ func (cc *CommentCreate) AddChildren(c ...*Comment) *CommentCreate {
 	return cc.AddChildIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the CommentRevision entity by IDs.
+func (cc *CommentCreate) AddRevisionIDs(ids ...int) *CommentCreate {
+	cc.mutation.AddRevisionIDs(ids...)
+	return cc
+}
+
+// AddRevisions adds the "revisions" edges to the CommentRevision entity.
+func (cc *CommentCreate) AddRevisions(c ...*CommentRevision) *CommentCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddRevisionIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the CommentReaction entity by IDs.
+func (cc *CommentCreate) AddReactionIDs(ids ...int) *CommentCreate {
+	cc.mutation.AddReactionIDs(ids...)
+	return cc
+}
+
+// AddReactions adds the "reactions" edges to the CommentReaction entity.
+func (cc *CommentCreate) AddReactions(c ...*CommentReaction) *CommentCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddReactionIDs(ids...)
+}
+
 // Mutation returns the CommentMutation object of the builder.
 func (cc *CommentCreate) Mutation() *CommentMutation {
 	return cc.mutation
@@ -193,9 +323,9 @@ func (cc *CommentCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (cc *CommentCreate) defaults() {
-	if _, ok := cc.mutation.Approved(); !ok {
-		v := comment.DefaultApproved
-		cc.mutation.SetApproved(v)
+	if _, ok := cc.mutation.Status(); !ok {
+		v := comment.DefaultStatus
+		cc.mutation.SetStatus(v)
 	}
 	if _, ok := cc.mutation.Avatar(); !ok {
 		v := comment.DefaultAvatar
@@ -229,8 +359,18 @@ func (cc *CommentCreate) check() error {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Comment.email": %w`, err)}
 		}
 	}
-	if _, ok := cc.mutation.Approved(); !ok {
-		return &ValidationError{Name: "approved", err: errors.New(`ent: missing required field "Comment.approved"`)}
+	if _, ok := cc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Comment.status"`)}
+	}
+	if v, ok := cc.mutation.Status(); ok {
+		if err := comment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Comment.status": %w`, err)}
+		}
+	}
+	if v, ok := cc.mutation.PreviousStatus(); ok {
+		if err := comment.PreviousStatusValidator(v); err != nil {
+			return &ValidationError{Name: "previous_status", err: fmt.Errorf(`ent: validator failed for field "Comment.previous_status": %w`, err)}
+		}
 	}
 	if _, ok := cc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Comment.created_at"`)}
@@ -238,6 +378,16 @@ func (cc *CommentCreate) check() error {
 	if _, ok := cc.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Comment.updated_at"`)}
 	}
+	if v, ok := cc.mutation.ModerationAction(); ok {
+		if err := comment.ModerationActionValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_action", err: fmt.Errorf(`ent: validator failed for field "Comment.moderation_action": %w`, err)}
+		}
+	}
+	if v, ok := cc.mutation.SpamLabel(); ok {
+		if err := comment.SpamLabelValidator(v); err != nil {
+			return &ValidationError{Name: "spam_label", err: fmt.Errorf(`ent: validator failed for field "Comment.spam_label": %w`, err)}
+		}
+	}
 	if _, ok := cc.mutation.PostID(); !ok {
 		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "Comment.post"`)}
 	}
@@ -283,9 +433,13 @@ func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 		_spec.SetField(comment.FieldWebsite, field.TypeString, value)
 		_node.Website = value
 	}
-	if value, ok := cc.mutation.Approved(); ok {
-		_spec.SetField(comment.FieldApproved, field.TypeBool, value)
-		_node.Approved = value
+	if value, ok := cc.mutation.Status(); ok {
+		_spec.SetField(comment.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := cc.mutation.PreviousStatus(); ok {
+		_spec.SetField(comment.FieldPreviousStatus, field.TypeEnum, value)
+		_node.PreviousStatus = &value
 	}
 	if value, ok := cc.mutation.CreatedAt(); ok {
 		_spec.SetField(comment.FieldCreatedAt, field.TypeTime, value)
@@ -295,10 +449,34 @@ func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 		_spec.SetField(comment.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := cc.mutation.EditedAt(); ok {
+		_spec.SetField(comment.FieldEditedAt, field.TypeTime, value)
+		_node.EditedAt = &value
+	}
 	if value, ok := cc.mutation.Avatar(); ok {
 		_spec.SetField(comment.FieldAvatar, field.TypeString, value)
 		_node.Avatar = value
 	}
+	if value, ok := cc.mutation.ModerationAction(); ok {
+		_spec.SetField(comment.FieldModerationAction, field.TypeEnum, value)
+		_node.ModerationAction = value
+	}
+	if value, ok := cc.mutation.ModerationReason(); ok {
+		_spec.SetField(comment.FieldModerationReason, field.TypeString, value)
+		_node.ModerationReason = value
+	}
+	if value, ok := cc.mutation.SpamScore(); ok {
+		_spec.SetField(comment.FieldSpamScore, field.TypeFloat64, value)
+		_node.SpamScore = &value
+	}
+	if value, ok := cc.mutation.SpamLabel(); ok {
+		_spec.SetField(comment.FieldSpamLabel, field.TypeEnum, value)
+		_node.SpamLabel = &value
+	}
+	if value, ok := cc.mutation.ImportID(); ok {
+		_spec.SetField(comment.FieldImportID, field.TypeString, value)
+		_node.ImportID = &value
+	}
 	if nodes := cc.mutation.PostIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -366,6 +544,38 @@ func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := cc.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RevisionsTable,
+			Columns: []string{comment.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.ReactionsTable,
+			Columns: []string{comment.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentreaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
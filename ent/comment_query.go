@@ -4,6 +4,8 @@ package ent
 
 import (
 	"blog-go/ent/comment"
+	"blog-go/ent/commentreaction"
+	"blog-go/ent/commentrevision"
 	"blog-go/ent/post"
 	"blog-go/ent/predicate"
 	"blog-go/ent/user"
@@ -20,15 +22,17 @@ import (
 // CommentQuery is the builder for querying Comment entities.
 type CommentQuery struct {
 	config
-	ctx          *QueryContext
-	order        []comment.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Comment
-	withPost     *PostQuery
-	withUser     *UserQuery
-	withParent   *CommentQuery
-	withChildren *CommentQuery
-	withFKs      bool
+	ctx           *QueryContext
+	order         []comment.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.Comment
+	withPost      *PostQuery
+	withUser      *UserQuery
+	withParent    *CommentQuery
+	withChildren  *CommentQuery
+	withRevisions *CommentRevisionQuery
+	withReactions *CommentReactionQuery
+	withFKs       bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -153,6 +157,50 @@ func (cq *CommentQuery) QueryChildren() *CommentQuery {
 	return query
 }
 
+// QueryRevisions chains the current query on the "revisions" edge.
+func (cq *CommentQuery) QueryRevisions() *CommentRevisionQuery {
+	query := (&CommentRevisionClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, selector),
+			sqlgraph.To(commentrevision.Table, commentrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, comment.RevisionsTable, comment.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReactions chains the current query on the "reactions" edge.
+func (cq *CommentQuery) QueryReactions() *CommentReactionQuery {
+	query := (&CommentReactionClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(comment.Table, comment.FieldID, selector),
+			sqlgraph.To(commentreaction.Table, commentreaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, comment.ReactionsTable, comment.ReactionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Comment entity from the query.
 // Returns a *NotFoundError when no Comment was found.
 func (cq *CommentQuery) First(ctx context.Context) (*Comment, error) {
@@ -340,15 +388,17 @@ func (cq *CommentQuery) Clone() *CommentQuery {
 		return nil
 	}
 	return &CommentQuery{
-		config:       cq.config,
-		ctx:          cq.ctx.Clone(),
-		order:        append([]comment.OrderOption{}, cq.order...),
-		inters:       append([]Interceptor{}, cq.inters...),
-		predicates:   append([]predicate.Comment{}, cq.predicates...),
-		withPost:     cq.withPost.Clone(),
-		withUser:     cq.withUser.Clone(),
-		withParent:   cq.withParent.Clone(),
-		withChildren: cq.withChildren.Clone(),
+		config:        cq.config,
+		ctx:           cq.ctx.Clone(),
+		order:         append([]comment.OrderOption{}, cq.order...),
+		inters:        append([]Interceptor{}, cq.inters...),
+		predicates:    append([]predicate.Comment{}, cq.predicates...),
+		withPost:      cq.withPost.Clone(),
+		withUser:      cq.withUser.Clone(),
+		withParent:    cq.withParent.Clone(),
+		withChildren:  cq.withChildren.Clone(),
+		withRevisions: cq.withRevisions.Clone(),
+		withReactions: cq.withReactions.Clone(),
 		// clone intermediate query.
 		sql:  cq.sql.Clone(),
 		path: cq.path,
@@ -399,6 +449,28 @@ func (cq *CommentQuery) WithChildren(opts ...func(*CommentQuery)) *CommentQuery
 	return cq
 }
 
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CommentQuery) WithRevisions(opts ...func(*CommentRevisionQuery)) *CommentQuery {
+	query := (&CommentRevisionClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withRevisions = query
+	return cq
+}
+
+// WithReactions tells the query-builder to eager-load the nodes that are connected to
+// the "reactions" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CommentQuery) WithReactions(opts ...func(*CommentReactionQuery)) *CommentQuery {
+	query := (&CommentReactionClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withReactions = query
+	return cq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -478,11 +550,13 @@ func (cq *CommentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Comm
 		nodes       = []*Comment{}
 		withFKs     = cq.withFKs
 		_spec       = cq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [6]bool{
 			cq.withPost != nil,
 			cq.withUser != nil,
 			cq.withParent != nil,
 			cq.withChildren != nil,
+			cq.withRevisions != nil,
+			cq.withReactions != nil,
 		}
 	)
 	if cq.withPost != nil || cq.withUser != nil {
@@ -534,6 +608,20 @@ func (cq *CommentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Comm
 			return nil, err
 		}
 	}
+	if query := cq.withRevisions; query != nil {
+		if err := cq.loadRevisions(ctx, query, nodes,
+			func(n *Comment) { n.Edges.Revisions = []*CommentRevision{} },
+			func(n *Comment, e *CommentRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := cq.withReactions; query != nil {
+		if err := cq.loadReactions(ctx, query, nodes,
+			func(n *Comment) { n.Edges.Reactions = []*CommentReaction{} },
+			func(n *Comment, e *CommentReaction) { n.Edges.Reactions = append(n.Edges.Reactions, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -667,6 +755,68 @@ func (cq *CommentQuery) loadChildren(ctx context.Context, query *CommentQuery, n
 	}
 	return nil
 }
+func (cq *CommentQuery) loadRevisions(ctx context.Context, query *CommentRevisionQuery, nodes []*Comment, init func(*Comment), assign func(*Comment, *CommentRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Comment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.CommentRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(comment.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.comment_revisions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "comment_revisions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "comment_revisions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (cq *CommentQuery) loadReactions(ctx context.Context, query *CommentReactionQuery, nodes []*Comment, init func(*Comment), assign func(*Comment, *CommentReaction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Comment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(commentreaction.FieldCommentID)
+	}
+	query.Where(predicate.CommentReaction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(comment.ReactionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CommentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "comment_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (cq *CommentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
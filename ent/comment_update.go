@@ -4,6 +4,8 @@ package ent
 
 import (
 	"blog-go/ent/comment"
+	"blog-go/ent/commentreaction"
+	"blog-go/ent/commentrevision"
 	"blog-go/ent/post"
 	"blog-go/ent/predicate"
 	"blog-go/ent/user"
@@ -92,20 +94,40 @@ func (cu *CommentUpdate) ClearWebsite() *CommentUpdate {
 	return cu
 }
 
-// SetApproved sets the "approved" field.
-func (cu *CommentUpdate) SetApproved(b bool) *CommentUpdate {
-	cu.mutation.SetApproved(b)
+// SetStatus sets the "status" field.
+func (cu *CommentUpdate) SetStatus(c comment.Status) *CommentUpdate {
+	cu.mutation.SetStatus(c)
 	return cu
 }
 
-// SetNillableApproved sets the "approved" field if the given value is not nil.
-func (cu *CommentUpdate) SetNillableApproved(b *bool) *CommentUpdate {
-	if b != nil {
-		cu.SetApproved(*b)
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableStatus(c *comment.Status) *CommentUpdate {
+	if c != nil {
+		cu.SetStatus(*c)
 	}
 	return cu
 }
 
+// SetPreviousStatus sets the "previous_status" field.
+func (cu *CommentUpdate) SetPreviousStatus(cs comment.PreviousStatus) *CommentUpdate {
+	cu.mutation.SetPreviousStatus(cs)
+	return cu
+}
+
+// SetNillablePreviousStatus sets the "previous_status" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillablePreviousStatus(cs *comment.PreviousStatus) *CommentUpdate {
+	if cs != nil {
+		cu.SetPreviousStatus(*cs)
+	}
+	return cu
+}
+
+// ClearPreviousStatus clears the value of the "previous_status" field.
+func (cu *CommentUpdate) ClearPreviousStatus() *CommentUpdate {
+	cu.mutation.ClearPreviousStatus()
+	return cu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (cu *CommentUpdate) SetCreatedAt(t time.Time) *CommentUpdate {
 	cu.mutation.SetCreatedAt(t)
@@ -134,6 +156,26 @@ func (cu *CommentUpdate) SetNillableUpdatedAt(t *time.Time) *CommentUpdate {
 	return cu
 }
 
+// SetEditedAt sets the "edited_at" field.
+func (cu *CommentUpdate) SetEditedAt(t time.Time) *CommentUpdate {
+	cu.mutation.SetEditedAt(t)
+	return cu
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableEditedAt(t *time.Time) *CommentUpdate {
+	if t != nil {
+		cu.SetEditedAt(*t)
+	}
+	return cu
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (cu *CommentUpdate) ClearEditedAt() *CommentUpdate {
+	cu.mutation.ClearEditedAt()
+	return cu
+}
+
 // SetAvatar sets the "avatar" field.
 func (cu *CommentUpdate) SetAvatar(s string) *CommentUpdate {
 	cu.mutation.SetAvatar(s)
@@ -174,6 +216,113 @@ func (cu *CommentUpdate) ClearParentID() *CommentUpdate {
 	return cu
 }
 
+// SetModerationAction sets the "moderation_action" field.
+func (cu *CommentUpdate) SetModerationAction(ca comment.ModerationAction) *CommentUpdate {
+	cu.mutation.SetModerationAction(ca)
+	return cu
+}
+
+// SetNillableModerationAction sets the "moderation_action" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableModerationAction(ca *comment.ModerationAction) *CommentUpdate {
+	if ca != nil {
+		cu.SetModerationAction(*ca)
+	}
+	return cu
+}
+
+// ClearModerationAction clears the value of the "moderation_action" field.
+func (cu *CommentUpdate) ClearModerationAction() *CommentUpdate {
+	cu.mutation.ClearModerationAction()
+	return cu
+}
+
+// SetModerationReason sets the "moderation_reason" field.
+func (cu *CommentUpdate) SetModerationReason(s string) *CommentUpdate {
+	cu.mutation.SetModerationReason(s)
+	return cu
+}
+
+// SetNillableModerationReason sets the "moderation_reason" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableModerationReason(s *string) *CommentUpdate {
+	if s != nil {
+		cu.SetModerationReason(*s)
+	}
+	return cu
+}
+
+// ClearModerationReason clears the value of the "moderation_reason" field.
+func (cu *CommentUpdate) ClearModerationReason() *CommentUpdate {
+	cu.mutation.ClearModerationReason()
+	return cu
+}
+
+// SetSpamScore sets the "spam_score" field.
+func (cu *CommentUpdate) SetSpamScore(f float64) *CommentUpdate {
+	cu.mutation.ResetSpamScore()
+	cu.mutation.SetSpamScore(f)
+	return cu
+}
+
+// SetNillableSpamScore sets the "spam_score" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableSpamScore(f *float64) *CommentUpdate {
+	if f != nil {
+		cu.SetSpamScore(*f)
+	}
+	return cu
+}
+
+// AddSpamScore adds f to the "spam_score" field.
+func (cu *CommentUpdate) AddSpamScore(f float64) *CommentUpdate {
+	cu.mutation.AddSpamScore(f)
+	return cu
+}
+
+// ClearSpamScore clears the value of the "spam_score" field.
+func (cu *CommentUpdate) ClearSpamScore() *CommentUpdate {
+	cu.mutation.ClearSpamScore()
+	return cu
+}
+
+// SetSpamLabel sets the "spam_label" field.
+func (cu *CommentUpdate) SetSpamLabel(cl comment.SpamLabel) *CommentUpdate {
+	cu.mutation.SetSpamLabel(cl)
+	return cu
+}
+
+// SetNillableSpamLabel sets the "spam_label" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableSpamLabel(cl *comment.SpamLabel) *CommentUpdate {
+	if cl != nil {
+		cu.SetSpamLabel(*cl)
+	}
+	return cu
+}
+
+// ClearSpamLabel clears the value of the "spam_label" field.
+func (cu *CommentUpdate) ClearSpamLabel() *CommentUpdate {
+	cu.mutation.ClearSpamLabel()
+	return cu
+}
+
+// SetImportID sets the "import_id" field.
+func (cu *CommentUpdate) SetImportID(s string) *CommentUpdate {
+	cu.mutation.SetImportID(s)
+	return cu
+}
+
+// SetNillableImportID sets the "import_id" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableImportID(s *string) *CommentUpdate {
+	if s != nil {
+		cu.SetImportID(*s)
+	}
+	return cu
+}
+
+// ClearImportID clears the value of the "import_id" field.
+func (cu *CommentUpdate) ClearImportID() *CommentUpdate {
+	cu.mutation.ClearImportID()
+	return cu
+}
+
 // SetPostID sets the "post" edge to the Post entity by ID.
 func (cu *CommentUpdate) SetPostID(id int) *CommentUpdate {
 	cu.mutation.SetPostID(id)
@@ -224,6 +373,36 @@ func (cu *CommentUpdate) AddChildren(c ...*Comment) *CommentUpdate {
 	return cu.AddChildIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the CommentRevision entity by IDs.
+func (cu *CommentUpdate) AddRevisionIDs(ids ...int) *CommentUpdate {
+	cu.mutation.AddRevisionIDs(ids...)
+	return cu
+}
+
+// AddRevisions adds the "revisions" edges to the CommentRevision entity.
+func (cu *CommentUpdate) AddRevisions(c ...*CommentRevision) *CommentUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddRevisionIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the CommentReaction entity by IDs.
+func (cu *CommentUpdate) AddReactionIDs(ids ...int) *CommentUpdate {
+	cu.mutation.AddReactionIDs(ids...)
+	return cu
+}
+
+// AddReactions adds the "reactions" edges to the CommentReaction entity.
+func (cu *CommentUpdate) AddReactions(c ...*CommentReaction) *CommentUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddReactionIDs(ids...)
+}
+
 // Mutation returns the CommentMutation object of the builder.
 func (cu *CommentUpdate) Mutation() *CommentMutation {
 	return cu.mutation
@@ -268,6 +447,48 @@ func (cu *CommentUpdate) RemoveChildren(c ...*Comment) *CommentUpdate {
 	return cu.RemoveChildIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the CommentRevision entity.
+func (cu *CommentUpdate) ClearRevisions() *CommentUpdate {
+	cu.mutation.ClearRevisions()
+	return cu
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to CommentRevision entities by IDs.
+func (cu *CommentUpdate) RemoveRevisionIDs(ids ...int) *CommentUpdate {
+	cu.mutation.RemoveRevisionIDs(ids...)
+	return cu
+}
+
+// RemoveRevisions removes "revisions" edges to CommentRevision entities.
+func (cu *CommentUpdate) RemoveRevisions(c ...*CommentRevision) *CommentUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveRevisionIDs(ids...)
+}
+
+// ClearReactions clears all "reactions" edges to the CommentReaction entity.
+func (cu *CommentUpdate) ClearReactions() *CommentUpdate {
+	cu.mutation.ClearReactions()
+	return cu
+}
+
+// RemoveReactionIDs removes the "reactions" edge to CommentReaction entities by IDs.
+func (cu *CommentUpdate) RemoveReactionIDs(ids ...int) *CommentUpdate {
+	cu.mutation.RemoveReactionIDs(ids...)
+	return cu
+}
+
+// RemoveReactions removes "reactions" edges to CommentReaction entities.
+func (cu *CommentUpdate) RemoveReactions(c ...*CommentReaction) *CommentUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveReactionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cu *CommentUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
@@ -312,6 +533,26 @@ func (cu *CommentUpdate) check() error {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Comment.email": %w`, err)}
 		}
 	}
+	if v, ok := cu.mutation.Status(); ok {
+		if err := comment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Comment.status": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.PreviousStatus(); ok {
+		if err := comment.PreviousStatusValidator(v); err != nil {
+			return &ValidationError{Name: "previous_status", err: fmt.Errorf(`ent: validator failed for field "Comment.previous_status": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.ModerationAction(); ok {
+		if err := comment.ModerationActionValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_action", err: fmt.Errorf(`ent: validator failed for field "Comment.moderation_action": %w`, err)}
+		}
+	}
+	if v, ok := cu.mutation.SpamLabel(); ok {
+		if err := comment.SpamLabelValidator(v); err != nil {
+			return &ValidationError{Name: "spam_label", err: fmt.Errorf(`ent: validator failed for field "Comment.spam_label": %w`, err)}
+		}
+	}
 	if _, ok := cu.mutation.PostID(); cu.mutation.PostCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Comment.post"`)
 	}
@@ -345,8 +586,14 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.WebsiteCleared() {
 		_spec.ClearField(comment.FieldWebsite, field.TypeString)
 	}
-	if value, ok := cu.mutation.Approved(); ok {
-		_spec.SetField(comment.FieldApproved, field.TypeBool, value)
+	if value, ok := cu.mutation.Status(); ok {
+		_spec.SetField(comment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := cu.mutation.PreviousStatus(); ok {
+		_spec.SetField(comment.FieldPreviousStatus, field.TypeEnum, value)
+	}
+	if cu.mutation.PreviousStatusCleared() {
+		_spec.ClearField(comment.FieldPreviousStatus, field.TypeEnum)
 	}
 	if value, ok := cu.mutation.CreatedAt(); ok {
 		_spec.SetField(comment.FieldCreatedAt, field.TypeTime, value)
@@ -354,12 +601,51 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cu.mutation.UpdatedAt(); ok {
 		_spec.SetField(comment.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := cu.mutation.EditedAt(); ok {
+		_spec.SetField(comment.FieldEditedAt, field.TypeTime, value)
+	}
+	if cu.mutation.EditedAtCleared() {
+		_spec.ClearField(comment.FieldEditedAt, field.TypeTime)
+	}
 	if value, ok := cu.mutation.Avatar(); ok {
 		_spec.SetField(comment.FieldAvatar, field.TypeString, value)
 	}
 	if cu.mutation.AvatarCleared() {
 		_spec.ClearField(comment.FieldAvatar, field.TypeString)
 	}
+	if value, ok := cu.mutation.ModerationAction(); ok {
+		_spec.SetField(comment.FieldModerationAction, field.TypeEnum, value)
+	}
+	if cu.mutation.ModerationActionCleared() {
+		_spec.ClearField(comment.FieldModerationAction, field.TypeEnum)
+	}
+	if value, ok := cu.mutation.ModerationReason(); ok {
+		_spec.SetField(comment.FieldModerationReason, field.TypeString, value)
+	}
+	if cu.mutation.ModerationReasonCleared() {
+		_spec.ClearField(comment.FieldModerationReason, field.TypeString)
+	}
+	if value, ok := cu.mutation.SpamScore(); ok {
+		_spec.SetField(comment.FieldSpamScore, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.AddedSpamScore(); ok {
+		_spec.AddField(comment.FieldSpamScore, field.TypeFloat64, value)
+	}
+	if cu.mutation.SpamScoreCleared() {
+		_spec.ClearField(comment.FieldSpamScore, field.TypeFloat64)
+	}
+	if value, ok := cu.mutation.SpamLabel(); ok {
+		_spec.SetField(comment.FieldSpamLabel, field.TypeEnum, value)
+	}
+	if cu.mutation.SpamLabelCleared() {
+		_spec.ClearField(comment.FieldSpamLabel, field.TypeEnum)
+	}
+	if value, ok := cu.mutation.ImportID(); ok {
+		_spec.SetField(comment.FieldImportID, field.TypeString, value)
+	}
+	if cu.mutation.ImportIDCleared() {
+		_spec.ClearField(comment.FieldImportID, field.TypeString)
+	}
 	if cu.mutation.PostCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -492,6 +778,96 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RevisionsTable,
+			Columns: []string{comment.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !cu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RevisionsTable,
+			Columns: []string{comment.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RevisionsTable,
+			Columns: []string{comment.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.ReactionsTable,
+			Columns: []string{comment.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentreaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !cu.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.ReactionsTable,
+			Columns: []string{comment.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentreaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.ReactionsTable,
+			Columns: []string{comment.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentreaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{comment.Label}
@@ -574,20 +950,40 @@ func (cuo *CommentUpdateOne) ClearWebsite() *CommentUpdateOne {
 	return cuo
 }
 
-// SetApproved sets the "approved" field.
-func (cuo *CommentUpdateOne) SetApproved(b bool) *CommentUpdateOne {
-	cuo.mutation.SetApproved(b)
+// SetStatus sets the "status" field.
+func (cuo *CommentUpdateOne) SetStatus(c comment.Status) *CommentUpdateOne {
+	cuo.mutation.SetStatus(c)
 	return cuo
 }
 
-// SetNillableApproved sets the "approved" field if the given value is not nil.
-func (cuo *CommentUpdateOne) SetNillableApproved(b *bool) *CommentUpdateOne {
-	if b != nil {
-		cuo.SetApproved(*b)
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableStatus(c *comment.Status) *CommentUpdateOne {
+	if c != nil {
+		cuo.SetStatus(*c)
 	}
 	return cuo
 }
 
+// SetPreviousStatus sets the "previous_status" field.
+func (cuo *CommentUpdateOne) SetPreviousStatus(cs comment.PreviousStatus) *CommentUpdateOne {
+	cuo.mutation.SetPreviousStatus(cs)
+	return cuo
+}
+
+// SetNillablePreviousStatus sets the "previous_status" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillablePreviousStatus(cs *comment.PreviousStatus) *CommentUpdateOne {
+	if cs != nil {
+		cuo.SetPreviousStatus(*cs)
+	}
+	return cuo
+}
+
+// ClearPreviousStatus clears the value of the "previous_status" field.
+func (cuo *CommentUpdateOne) ClearPreviousStatus() *CommentUpdateOne {
+	cuo.mutation.ClearPreviousStatus()
+	return cuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (cuo *CommentUpdateOne) SetCreatedAt(t time.Time) *CommentUpdateOne {
 	cuo.mutation.SetCreatedAt(t)
@@ -616,6 +1012,26 @@ func (cuo *CommentUpdateOne) SetNillableUpdatedAt(t *time.Time) *CommentUpdateOn
 	return cuo
 }
 
+// SetEditedAt sets the "edited_at" field.
+func (cuo *CommentUpdateOne) SetEditedAt(t time.Time) *CommentUpdateOne {
+	cuo.mutation.SetEditedAt(t)
+	return cuo
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableEditedAt(t *time.Time) *CommentUpdateOne {
+	if t != nil {
+		cuo.SetEditedAt(*t)
+	}
+	return cuo
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (cuo *CommentUpdateOne) ClearEditedAt() *CommentUpdateOne {
+	cuo.mutation.ClearEditedAt()
+	return cuo
+}
+
 // SetAvatar sets the "avatar" field.
 func (cuo *CommentUpdateOne) SetAvatar(s string) *CommentUpdateOne {
 	cuo.mutation.SetAvatar(s)
@@ -656,6 +1072,113 @@ func (cuo *CommentUpdateOne) ClearParentID() *CommentUpdateOne {
 	return cuo
 }
 
+// SetModerationAction sets the "moderation_action" field.
+func (cuo *CommentUpdateOne) SetModerationAction(ca comment.ModerationAction) *CommentUpdateOne {
+	cuo.mutation.SetModerationAction(ca)
+	return cuo
+}
+
+// SetNillableModerationAction sets the "moderation_action" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableModerationAction(ca *comment.ModerationAction) *CommentUpdateOne {
+	if ca != nil {
+		cuo.SetModerationAction(*ca)
+	}
+	return cuo
+}
+
+// ClearModerationAction clears the value of the "moderation_action" field.
+func (cuo *CommentUpdateOne) ClearModerationAction() *CommentUpdateOne {
+	cuo.mutation.ClearModerationAction()
+	return cuo
+}
+
+// SetModerationReason sets the "moderation_reason" field.
+func (cuo *CommentUpdateOne) SetModerationReason(s string) *CommentUpdateOne {
+	cuo.mutation.SetModerationReason(s)
+	return cuo
+}
+
+// SetNillableModerationReason sets the "moderation_reason" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableModerationReason(s *string) *CommentUpdateOne {
+	if s != nil {
+		cuo.SetModerationReason(*s)
+	}
+	return cuo
+}
+
+// ClearModerationReason clears the value of the "moderation_reason" field.
+func (cuo *CommentUpdateOne) ClearModerationReason() *CommentUpdateOne {
+	cuo.mutation.ClearModerationReason()
+	return cuo
+}
+
+// SetSpamScore sets the "spam_score" field.
+func (cuo *CommentUpdateOne) SetSpamScore(f float64) *CommentUpdateOne {
+	cuo.mutation.ResetSpamScore()
+	cuo.mutation.SetSpamScore(f)
+	return cuo
+}
+
+// SetNillableSpamScore sets the "spam_score" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableSpamScore(f *float64) *CommentUpdateOne {
+	if f != nil {
+		cuo.SetSpamScore(*f)
+	}
+	return cuo
+}
+
+// AddSpamScore adds f to the "spam_score" field.
+func (cuo *CommentUpdateOne) AddSpamScore(f float64) *CommentUpdateOne {
+	cuo.mutation.AddSpamScore(f)
+	return cuo
+}
+
+// ClearSpamScore clears the value of the "spam_score" field.
+func (cuo *CommentUpdateOne) ClearSpamScore() *CommentUpdateOne {
+	cuo.mutation.ClearSpamScore()
+	return cuo
+}
+
+// SetSpamLabel sets the "spam_label" field.
+func (cuo *CommentUpdateOne) SetSpamLabel(cl comment.SpamLabel) *CommentUpdateOne {
+	cuo.mutation.SetSpamLabel(cl)
+	return cuo
+}
+
+// SetNillableSpamLabel sets the "spam_label" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableSpamLabel(cl *comment.SpamLabel) *CommentUpdateOne {
+	if cl != nil {
+		cuo.SetSpamLabel(*cl)
+	}
+	return cuo
+}
+
+// ClearSpamLabel clears the value of the "spam_label" field.
+func (cuo *CommentUpdateOne) ClearSpamLabel() *CommentUpdateOne {
+	cuo.mutation.ClearSpamLabel()
+	return cuo
+}
+
+// SetImportID sets the "import_id" field.
+func (cuo *CommentUpdateOne) SetImportID(s string) *CommentUpdateOne {
+	cuo.mutation.SetImportID(s)
+	return cuo
+}
+
+// SetNillableImportID sets the "import_id" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableImportID(s *string) *CommentUpdateOne {
+	if s != nil {
+		cuo.SetImportID(*s)
+	}
+	return cuo
+}
+
+// ClearImportID clears the value of the "import_id" field.
+func (cuo *CommentUpdateOne) ClearImportID() *CommentUpdateOne {
+	cuo.mutation.ClearImportID()
+	return cuo
+}
+
 // SetPostID sets the "post" edge to the Post entity by ID.
 func (cuo *CommentUpdateOne) SetPostID(id int) *CommentUpdateOne {
 	cuo.mutation.SetPostID(id)
@@ -706,6 +1229,36 @@ func (cuo *CommentUpdateOne) AddChildren(c ...*Comment) *CommentUpdateOne {
 	return cuo.AddChildIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the CommentRevision entity by IDs.
+func (cuo *CommentUpdateOne) AddRevisionIDs(ids ...int) *CommentUpdateOne {
+	cuo.mutation.AddRevisionIDs(ids...)
+	return cuo
+}
+
+// AddRevisions adds the "revisions" edges to the CommentRevision entity.
+func (cuo *CommentUpdateOne) AddRevisions(c ...*CommentRevision) *CommentUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddRevisionIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the CommentReaction entity by IDs.
+func (cuo *CommentUpdateOne) AddReactionIDs(ids ...int) *CommentUpdateOne {
+	cuo.mutation.AddReactionIDs(ids...)
+	return cuo
+}
+
+// AddReactions adds the "reactions" edges to the CommentReaction entity.
+func (cuo *CommentUpdateOne) AddReactions(c ...*CommentReaction) *CommentUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddReactionIDs(ids...)
+}
+
 // Mutation returns the CommentMutation object of the builder.
 func (cuo *CommentUpdateOne) Mutation() *CommentMutation {
 	return cuo.mutation
@@ -750,6 +1303,48 @@ func (cuo *CommentUpdateOne) RemoveChildren(c ...*Comment) *CommentUpdateOne {
 	return cuo.RemoveChildIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the CommentRevision entity.
+func (cuo *CommentUpdateOne) ClearRevisions() *CommentUpdateOne {
+	cuo.mutation.ClearRevisions()
+	return cuo
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to CommentRevision entities by IDs.
+func (cuo *CommentUpdateOne) RemoveRevisionIDs(ids ...int) *CommentUpdateOne {
+	cuo.mutation.RemoveRevisionIDs(ids...)
+	return cuo
+}
+
+// RemoveRevisions removes "revisions" edges to CommentRevision entities.
+func (cuo *CommentUpdateOne) RemoveRevisions(c ...*CommentRevision) *CommentUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveRevisionIDs(ids...)
+}
+
+// ClearReactions clears all "reactions" edges to the CommentReaction entity.
+func (cuo *CommentUpdateOne) ClearReactions() *CommentUpdateOne {
+	cuo.mutation.ClearReactions()
+	return cuo
+}
+
+// RemoveReactionIDs removes the "reactions" edge to CommentReaction entities by IDs.
+func (cuo *CommentUpdateOne) RemoveReactionIDs(ids ...int) *CommentUpdateOne {
+	cuo.mutation.RemoveReactionIDs(ids...)
+	return cuo
+}
+
+// RemoveReactions removes "reactions" edges to CommentReaction entities.
+func (cuo *CommentUpdateOne) RemoveReactions(c ...*CommentReaction) *CommentUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveReactionIDs(ids...)
+}
+
 // Where appends a list predicates to the CommentUpdate builder.
 func (cuo *CommentUpdateOne) Where(ps ...predicate.Comment) *CommentUpdateOne {
 	cuo.mutation.Where(ps...)
@@ -807,6 +1402,26 @@ func (cuo *CommentUpdateOne) check() error {
 			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "Comment.email": %w`, err)}
 		}
 	}
+	if v, ok := cuo.mutation.Status(); ok {
+		if err := comment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Comment.status": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.PreviousStatus(); ok {
+		if err := comment.PreviousStatusValidator(v); err != nil {
+			return &ValidationError{Name: "previous_status", err: fmt.Errorf(`ent: validator failed for field "Comment.previous_status": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.ModerationAction(); ok {
+		if err := comment.ModerationActionValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_action", err: fmt.Errorf(`ent: validator failed for field "Comment.moderation_action": %w`, err)}
+		}
+	}
+	if v, ok := cuo.mutation.SpamLabel(); ok {
+		if err := comment.SpamLabelValidator(v); err != nil {
+			return &ValidationError{Name: "spam_label", err: fmt.Errorf(`ent: validator failed for field "Comment.spam_label": %w`, err)}
+		}
+	}
 	if _, ok := cuo.mutation.PostID(); cuo.mutation.PostCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Comment.post"`)
 	}
@@ -857,8 +1472,14 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 	if cuo.mutation.WebsiteCleared() {
 		_spec.ClearField(comment.FieldWebsite, field.TypeString)
 	}
-	if value, ok := cuo.mutation.Approved(); ok {
-		_spec.SetField(comment.FieldApproved, field.TypeBool, value)
+	if value, ok := cuo.mutation.Status(); ok {
+		_spec.SetField(comment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := cuo.mutation.PreviousStatus(); ok {
+		_spec.SetField(comment.FieldPreviousStatus, field.TypeEnum, value)
+	}
+	if cuo.mutation.PreviousStatusCleared() {
+		_spec.ClearField(comment.FieldPreviousStatus, field.TypeEnum)
 	}
 	if value, ok := cuo.mutation.CreatedAt(); ok {
 		_spec.SetField(comment.FieldCreatedAt, field.TypeTime, value)
@@ -866,12 +1487,51 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 	if value, ok := cuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(comment.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := cuo.mutation.EditedAt(); ok {
+		_spec.SetField(comment.FieldEditedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.EditedAtCleared() {
+		_spec.ClearField(comment.FieldEditedAt, field.TypeTime)
+	}
 	if value, ok := cuo.mutation.Avatar(); ok {
 		_spec.SetField(comment.FieldAvatar, field.TypeString, value)
 	}
 	if cuo.mutation.AvatarCleared() {
 		_spec.ClearField(comment.FieldAvatar, field.TypeString)
 	}
+	if value, ok := cuo.mutation.ModerationAction(); ok {
+		_spec.SetField(comment.FieldModerationAction, field.TypeEnum, value)
+	}
+	if cuo.mutation.ModerationActionCleared() {
+		_spec.ClearField(comment.FieldModerationAction, field.TypeEnum)
+	}
+	if value, ok := cuo.mutation.ModerationReason(); ok {
+		_spec.SetField(comment.FieldModerationReason, field.TypeString, value)
+	}
+	if cuo.mutation.ModerationReasonCleared() {
+		_spec.ClearField(comment.FieldModerationReason, field.TypeString)
+	}
+	if value, ok := cuo.mutation.SpamScore(); ok {
+		_spec.SetField(comment.FieldSpamScore, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.AddedSpamScore(); ok {
+		_spec.AddField(comment.FieldSpamScore, field.TypeFloat64, value)
+	}
+	if cuo.mutation.SpamScoreCleared() {
+		_spec.ClearField(comment.FieldSpamScore, field.TypeFloat64)
+	}
+	if value, ok := cuo.mutation.SpamLabel(); ok {
+		_spec.SetField(comment.FieldSpamLabel, field.TypeEnum, value)
+	}
+	if cuo.mutation.SpamLabelCleared() {
+		_spec.ClearField(comment.FieldSpamLabel, field.TypeEnum)
+	}
+	if value, ok := cuo.mutation.ImportID(); ok {
+		_spec.SetField(comment.FieldImportID, field.TypeString, value)
+	}
+	if cuo.mutation.ImportIDCleared() {
+		_spec.ClearField(comment.FieldImportID, field.TypeString)
+	}
 	if cuo.mutation.PostCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1004,6 +1664,96 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cuo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RevisionsTable,
+			Columns: []string{comment.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !cuo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RevisionsTable,
+			Columns: []string{comment.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RevisionsTable,
+			Columns: []string{comment.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.ReactionsTable,
+			Columns: []string{comment.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentreaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !cuo.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.ReactionsTable,
+			Columns: []string{comment.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentreaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.ReactionsTable,
+			Columns: []string{comment.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentreaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Comment{config: cuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
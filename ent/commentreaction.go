@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/comment"
+	"blog-go/ent/commentreaction"
+	"blog-go/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// CommentReaction is the model entity for the CommentReaction schema.
+type CommentReaction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CommentID holds the value of the "comment_id" field.
+	CommentID int `json:"comment_id,omitempty"`
+	// Emoji holds the value of the "emoji" field.
+	Emoji string `json:"emoji,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CommentReactionQuery when eager-loading is set.
+	Edges                  CommentReactionEdges `json:"edges"`
+	user_comment_reactions *int
+	selectValues           sql.SelectValues
+}
+
+// CommentReactionEdges holds the relations/edges for other nodes in the graph.
+type CommentReactionEdges struct {
+	// Comment holds the value of the comment edge.
+	Comment *Comment `json:"comment,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// CommentOrErr returns the Comment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentReactionEdges) CommentOrErr() (*Comment, error) {
+	if e.Comment != nil {
+		return e.Comment, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: comment.Label}
+	}
+	return nil, &NotLoadedError{edge: "comment"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentReactionEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CommentReaction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case commentreaction.FieldID, commentreaction.FieldCommentID:
+			values[i] = new(sql.NullInt64)
+		case commentreaction.FieldEmoji, commentreaction.FieldActor:
+			values[i] = new(sql.NullString)
+		case commentreaction.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case commentreaction.ForeignKeys[0]: // user_comment_reactions
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CommentReaction fields.
+func (cr *CommentReaction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case commentreaction.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cr.ID = int(value.Int64)
+		case commentreaction.FieldCommentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field comment_id", values[i])
+			} else if value.Valid {
+				cr.CommentID = int(value.Int64)
+			}
+		case commentreaction.FieldEmoji:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field emoji", values[i])
+			} else if value.Valid {
+				cr.Emoji = value.String
+			}
+		case commentreaction.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				cr.Actor = value.String
+			}
+		case commentreaction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cr.CreatedAt = value.Time
+			}
+		case commentreaction.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_comment_reactions", value)
+			} else if value.Valid {
+				cr.user_comment_reactions = new(int)
+				*cr.user_comment_reactions = int(value.Int64)
+			}
+		default:
+			cr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CommentReaction.
+// This includes values selected through modifiers, order, etc.
+func (cr *CommentReaction) Value(name string) (ent.Value, error) {
+	return cr.selectValues.Get(name)
+}
+
+// QueryComment queries the "comment" edge of the CommentReaction entity.
+func (cr *CommentReaction) QueryComment() *CommentQuery {
+	return NewCommentReactionClient(cr.config).QueryComment(cr)
+}
+
+// QueryUser queries the "user" edge of the CommentReaction entity.
+func (cr *CommentReaction) QueryUser() *UserQuery {
+	return NewCommentReactionClient(cr.config).QueryUser(cr)
+}
+
+// Update returns a builder for updating this CommentReaction.
+// Note that you need to call CommentReaction.Unwrap() before calling this method if this CommentReaction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cr *CommentReaction) Update() *CommentReactionUpdateOne {
+	return NewCommentReactionClient(cr.config).UpdateOne(cr)
+}
+
+// Unwrap unwraps the CommentReaction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cr *CommentReaction) Unwrap() *CommentReaction {
+	_tx, ok := cr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CommentReaction is not a transactional entity")
+	}
+	cr.config.driver = _tx.drv
+	return cr
+}
+
+// String implements the fmt.Stringer.
+func (cr *CommentReaction) String() string {
+	var builder strings.Builder
+	builder.WriteString("CommentReaction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cr.ID))
+	builder.WriteString("comment_id=")
+	builder.WriteString(fmt.Sprintf("%v", cr.CommentID))
+	builder.WriteString(", ")
+	builder.WriteString("emoji=")
+	builder.WriteString(cr.Emoji)
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(cr.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CommentReactions is a parsable slice of CommentReaction.
+type CommentReactions []*CommentReaction
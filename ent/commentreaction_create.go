@@ -0,0 +1,292 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/comment"
+	"blog-go/ent/commentreaction"
+	"blog-go/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CommentReactionCreate is the builder for creating a CommentReaction entity.
+type CommentReactionCreate struct {
+	config
+	mutation *CommentReactionMutation
+	hooks    []Hook
+}
+
+// SetCommentID sets the "comment_id" field.
+func (crc *CommentReactionCreate) SetCommentID(i int) *CommentReactionCreate {
+	crc.mutation.SetCommentID(i)
+	return crc
+}
+
+// SetEmoji sets the "emoji" field.
+func (crc *CommentReactionCreate) SetEmoji(s string) *CommentReactionCreate {
+	crc.mutation.SetEmoji(s)
+	return crc
+}
+
+// SetActor sets the "actor" field.
+func (crc *CommentReactionCreate) SetActor(s string) *CommentReactionCreate {
+	crc.mutation.SetActor(s)
+	return crc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (crc *CommentReactionCreate) SetCreatedAt(t time.Time) *CommentReactionCreate {
+	crc.mutation.SetCreatedAt(t)
+	return crc
+}
+
+// SetComment sets the "comment" edge to the Comment entity.
+func (crc *CommentReactionCreate) SetComment(c *Comment) *CommentReactionCreate {
+	return crc.SetCommentID(c.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (crc *CommentReactionCreate) SetUserID(id int) *CommentReactionCreate {
+	crc.mutation.SetUserID(id)
+	return crc
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (crc *CommentReactionCreate) SetNillableUserID(id *int) *CommentReactionCreate {
+	if id != nil {
+		crc = crc.SetUserID(*id)
+	}
+	return crc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (crc *CommentReactionCreate) SetUser(u *User) *CommentReactionCreate {
+	return crc.SetUserID(u.ID)
+}
+
+// Mutation returns the CommentReactionMutation object of the builder.
+func (crc *CommentReactionCreate) Mutation() *CommentReactionMutation {
+	return crc.mutation
+}
+
+// Save creates the CommentReaction in the database.
+func (crc *CommentReactionCreate) Save(ctx context.Context) (*CommentReaction, error) {
+	return withHooks(ctx, crc.sqlSave, crc.mutation, crc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (crc *CommentReactionCreate) SaveX(ctx context.Context) *CommentReaction {
+	v, err := crc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crc *CommentReactionCreate) Exec(ctx context.Context) error {
+	_, err := crc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crc *CommentReactionCreate) ExecX(ctx context.Context) {
+	if err := crc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (crc *CommentReactionCreate) check() error {
+	if _, ok := crc.mutation.CommentID(); !ok {
+		return &ValidationError{Name: "comment_id", err: errors.New(`ent: missing required field "CommentReaction.comment_id"`)}
+	}
+	if _, ok := crc.mutation.Emoji(); !ok {
+		return &ValidationError{Name: "emoji", err: errors.New(`ent: missing required field "CommentReaction.emoji"`)}
+	}
+	if v, ok := crc.mutation.Emoji(); ok {
+		if err := commentreaction.EmojiValidator(v); err != nil {
+			return &ValidationError{Name: "emoji", err: fmt.Errorf(`ent: validator failed for field "CommentReaction.emoji": %w`, err)}
+		}
+	}
+	if _, ok := crc.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "CommentReaction.actor"`)}
+	}
+	if v, ok := crc.mutation.Actor(); ok {
+		if err := commentreaction.ActorValidator(v); err != nil {
+			return &ValidationError{Name: "actor", err: fmt.Errorf(`ent: validator failed for field "CommentReaction.actor": %w`, err)}
+		}
+	}
+	if _, ok := crc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CommentReaction.created_at"`)}
+	}
+	if _, ok := crc.mutation.CommentID(); !ok {
+		return &ValidationError{Name: "comment", err: errors.New(`ent: missing required edge "CommentReaction.comment"`)}
+	}
+	return nil
+}
+
+func (crc *CommentReactionCreate) sqlSave(ctx context.Context) (*CommentReaction, error) {
+	if err := crc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := crc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, crc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	crc.mutation.id = &_node.ID
+	crc.mutation.done = true
+	return _node, nil
+}
+
+func (crc *CommentReactionCreate) createSpec() (*CommentReaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CommentReaction{config: crc.config}
+		_spec = sqlgraph.NewCreateSpec(commentreaction.Table, sqlgraph.NewFieldSpec(commentreaction.FieldID, field.TypeInt))
+	)
+	if value, ok := crc.mutation.Emoji(); ok {
+		_spec.SetField(commentreaction.FieldEmoji, field.TypeString, value)
+		_node.Emoji = value
+	}
+	if value, ok := crc.mutation.Actor(); ok {
+		_spec.SetField(commentreaction.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := crc.mutation.CreatedAt(); ok {
+		_spec.SetField(commentreaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := crc.mutation.CommentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   commentreaction.CommentTable,
+			Columns: []string{commentreaction.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CommentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := crc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   commentreaction.UserTable,
+			Columns: []string{commentreaction.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_comment_reactions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CommentReactionCreateBulk is the builder for creating many CommentReaction entities in bulk.
+type CommentReactionCreateBulk struct {
+	config
+	err      error
+	builders []*CommentReactionCreate
+}
+
+// Save creates the CommentReaction entities in the database.
+func (crcb *CommentReactionCreateBulk) Save(ctx context.Context) ([]*CommentReaction, error) {
+	if crcb.err != nil {
+		return nil, crcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(crcb.builders))
+	nodes := make([]*CommentReaction, len(crcb.builders))
+	mutators := make([]Mutator, len(crcb.builders))
+	for i := range crcb.builders {
+		func(i int, root context.Context) {
+			builder := crcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommentReactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, crcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, crcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, crcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (crcb *CommentReactionCreateBulk) SaveX(ctx context.Context) []*CommentReaction {
+	v, err := crcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crcb *CommentReactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := crcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crcb *CommentReactionCreateBulk) ExecX(ctx context.Context) {
+	if err := crcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/commentreaction"
+	"blog-go/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CommentReactionDelete is the builder for deleting a CommentReaction entity.
+type CommentReactionDelete struct {
+	config
+	hooks    []Hook
+	mutation *CommentReactionMutation
+}
+
+// Where appends a list predicates to the CommentReactionDelete builder.
+func (crd *CommentReactionDelete) Where(ps ...predicate.CommentReaction) *CommentReactionDelete {
+	crd.mutation.Where(ps...)
+	return crd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (crd *CommentReactionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, crd.sqlExec, crd.mutation, crd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crd *CommentReactionDelete) ExecX(ctx context.Context) int {
+	n, err := crd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (crd *CommentReactionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(commentreaction.Table, sqlgraph.NewFieldSpec(commentreaction.FieldID, field.TypeInt))
+	if ps := crd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, crd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	crd.mutation.done = true
+	return affected, err
+}
+
+// CommentReactionDeleteOne is the builder for deleting a single CommentReaction entity.
+type CommentReactionDeleteOne struct {
+	crd *CommentReactionDelete
+}
+
+// Where appends a list predicates to the CommentReactionDelete builder.
+func (crdo *CommentReactionDeleteOne) Where(ps ...predicate.CommentReaction) *CommentReactionDeleteOne {
+	crdo.crd.mutation.Where(ps...)
+	return crdo
+}
+
+// Exec executes the deletion query.
+func (crdo *CommentReactionDeleteOne) Exec(ctx context.Context) error {
+	n, err := crdo.crd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{commentreaction.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crdo *CommentReactionDeleteOne) ExecX(ctx context.Context) {
+	if err := crdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,156 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/comment"
+	"blog-go/ent/commentrevision"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// CommentRevision is the model entity for the CommentRevision schema.
+type CommentRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CommentRevisionQuery when eager-loading is set.
+	Edges             CommentRevisionEdges `json:"edges"`
+	comment_revisions *int
+	selectValues      sql.SelectValues
+}
+
+// CommentRevisionEdges holds the relations/edges for other nodes in the graph.
+type CommentRevisionEdges struct {
+	// Comment holds the value of the comment edge.
+	Comment *Comment `json:"comment,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CommentOrErr returns the Comment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentRevisionEdges) CommentOrErr() (*Comment, error) {
+	if e.Comment != nil {
+		return e.Comment, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: comment.Label}
+	}
+	return nil, &NotLoadedError{edge: "comment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CommentRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case commentrevision.FieldID:
+			values[i] = new(sql.NullInt64)
+		case commentrevision.FieldContent:
+			values[i] = new(sql.NullString)
+		case commentrevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case commentrevision.ForeignKeys[0]: // comment_revisions
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CommentRevision fields.
+func (cr *CommentRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case commentrevision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cr.ID = int(value.Int64)
+		case commentrevision.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				cr.Content = value.String
+			}
+		case commentrevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cr.CreatedAt = value.Time
+			}
+		case commentrevision.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field comment_revisions", value)
+			} else if value.Valid {
+				cr.comment_revisions = new(int)
+				*cr.comment_revisions = int(value.Int64)
+			}
+		default:
+			cr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CommentRevision.
+// This includes values selected through modifiers, order, etc.
+func (cr *CommentRevision) Value(name string) (ent.Value, error) {
+	return cr.selectValues.Get(name)
+}
+
+// QueryComment queries the "comment" edge of the CommentRevision entity.
+func (cr *CommentRevision) QueryComment() *CommentQuery {
+	return NewCommentRevisionClient(cr.config).QueryComment(cr)
+}
+
+// Update returns a builder for updating this CommentRevision.
+// Note that you need to call CommentRevision.Unwrap() before calling this method if this CommentRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cr *CommentRevision) Update() *CommentRevisionUpdateOne {
+	return NewCommentRevisionClient(cr.config).UpdateOne(cr)
+}
+
+// Unwrap unwraps the CommentRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cr *CommentRevision) Unwrap() *CommentRevision {
+	_tx, ok := cr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CommentRevision is not a transactional entity")
+	}
+	cr.config.driver = _tx.drv
+	return cr
+}
+
+// String implements the fmt.Stringer.
+func (cr *CommentRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("CommentRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cr.ID))
+	builder.WriteString("content=")
+	builder.WriteString(cr.Content)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CommentRevisions is a parsable slice of CommentRevision.
+type CommentRevisions []*CommentRevision
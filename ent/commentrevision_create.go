@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/comment"
+	"blog-go/ent/commentrevision"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CommentRevisionCreate is the builder for creating a CommentRevision entity.
+type CommentRevisionCreate struct {
+	config
+	mutation *CommentRevisionMutation
+	hooks    []Hook
+}
+
+// SetContent sets the "content" field.
+func (crc *CommentRevisionCreate) SetContent(s string) *CommentRevisionCreate {
+	crc.mutation.SetContent(s)
+	return crc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (crc *CommentRevisionCreate) SetCreatedAt(t time.Time) *CommentRevisionCreate {
+	crc.mutation.SetCreatedAt(t)
+	return crc
+}
+
+// SetCommentID sets the "comment" edge to the Comment entity by ID.
+func (crc *CommentRevisionCreate) SetCommentID(id int) *CommentRevisionCreate {
+	crc.mutation.SetCommentID(id)
+	return crc
+}
+
+// SetComment sets the "comment" edge to the Comment entity.
+func (crc *CommentRevisionCreate) SetComment(c *Comment) *CommentRevisionCreate {
+	return crc.SetCommentID(c.ID)
+}
+
+// Mutation returns the CommentRevisionMutation object of the builder.
+func (crc *CommentRevisionCreate) Mutation() *CommentRevisionMutation {
+	return crc.mutation
+}
+
+// Save creates the CommentRevision in the database.
+func (crc *CommentRevisionCreate) Save(ctx context.Context) (*CommentRevision, error) {
+	return withHooks(ctx, crc.sqlSave, crc.mutation, crc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (crc *CommentRevisionCreate) SaveX(ctx context.Context) *CommentRevision {
+	v, err := crc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crc *CommentRevisionCreate) Exec(ctx context.Context) error {
+	_, err := crc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crc *CommentRevisionCreate) ExecX(ctx context.Context) {
+	if err := crc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (crc *CommentRevisionCreate) check() error {
+	if _, ok := crc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "CommentRevision.content"`)}
+	}
+	if v, ok := crc.mutation.Content(); ok {
+		if err := commentrevision.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "CommentRevision.content": %w`, err)}
+		}
+	}
+	if _, ok := crc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CommentRevision.created_at"`)}
+	}
+	if _, ok := crc.mutation.CommentID(); !ok {
+		return &ValidationError{Name: "comment", err: errors.New(`ent: missing required edge "CommentRevision.comment"`)}
+	}
+	return nil
+}
+
+func (crc *CommentRevisionCreate) sqlSave(ctx context.Context) (*CommentRevision, error) {
+	if err := crc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := crc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, crc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	crc.mutation.id = &_node.ID
+	crc.mutation.done = true
+	return _node, nil
+}
+
+func (crc *CommentRevisionCreate) createSpec() (*CommentRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CommentRevision{config: crc.config}
+		_spec = sqlgraph.NewCreateSpec(commentrevision.Table, sqlgraph.NewFieldSpec(commentrevision.FieldID, field.TypeInt))
+	)
+	if value, ok := crc.mutation.Content(); ok {
+		_spec.SetField(commentrevision.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := crc.mutation.CreatedAt(); ok {
+		_spec.SetField(commentrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := crc.mutation.CommentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   commentrevision.CommentTable,
+			Columns: []string{commentrevision.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.comment_revisions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CommentRevisionCreateBulk is the builder for creating many CommentRevision entities in bulk.
+type CommentRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*CommentRevisionCreate
+}
+
+// Save creates the CommentRevision entities in the database.
+func (crcb *CommentRevisionCreateBulk) Save(ctx context.Context) ([]*CommentRevision, error) {
+	if crcb.err != nil {
+		return nil, crcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(crcb.builders))
+	nodes := make([]*CommentRevision, len(crcb.builders))
+	mutators := make([]Mutator, len(crcb.builders))
+	for i := range crcb.builders {
+		func(i int, root context.Context) {
+			builder := crcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommentRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, crcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, crcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, crcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (crcb *CommentRevisionCreateBulk) SaveX(ctx context.Context) []*CommentRevision {
+	v, err := crcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (crcb *CommentRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := crcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crcb *CommentRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := crcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
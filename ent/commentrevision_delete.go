@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/commentrevision"
+	"blog-go/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CommentRevisionDelete is the builder for deleting a CommentRevision entity.
+type CommentRevisionDelete struct {
+	config
+	hooks    []Hook
+	mutation *CommentRevisionMutation
+}
+
+// Where appends a list predicates to the CommentRevisionDelete builder.
+func (crd *CommentRevisionDelete) Where(ps ...predicate.CommentRevision) *CommentRevisionDelete {
+	crd.mutation.Where(ps...)
+	return crd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (crd *CommentRevisionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, crd.sqlExec, crd.mutation, crd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crd *CommentRevisionDelete) ExecX(ctx context.Context) int {
+	n, err := crd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (crd *CommentRevisionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(commentrevision.Table, sqlgraph.NewFieldSpec(commentrevision.FieldID, field.TypeInt))
+	if ps := crd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, crd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	crd.mutation.done = true
+	return affected, err
+}
+
+// CommentRevisionDeleteOne is the builder for deleting a single CommentRevision entity.
+type CommentRevisionDeleteOne struct {
+	crd *CommentRevisionDelete
+}
+
+// Where appends a list predicates to the CommentRevisionDelete builder.
+func (crdo *CommentRevisionDeleteOne) Where(ps ...predicate.CommentRevision) *CommentRevisionDeleteOne {
+	crdo.crd.mutation.Where(ps...)
+	return crdo
+}
+
+// Exec executes the deletion query.
+func (crdo *CommentRevisionDeleteOne) Exec(ctx context.Context) error {
+	n, err := crdo.crd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{commentrevision.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (crdo *CommentRevisionDeleteOne) ExecX(ctx context.Context) {
+	if err := crdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
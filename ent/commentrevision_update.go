@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/comment"
+	"blog-go/ent/commentrevision"
+	"blog-go/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CommentRevisionUpdate is the builder for updating CommentRevision entities.
+type CommentRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CommentRevisionMutation
+}
+
+// Where appends a list predicates to the CommentRevisionUpdate builder.
+func (cru *CommentRevisionUpdate) Where(ps ...predicate.CommentRevision) *CommentRevisionUpdate {
+	cru.mutation.Where(ps...)
+	return cru
+}
+
+// SetContent sets the "content" field.
+func (cru *CommentRevisionUpdate) SetContent(s string) *CommentRevisionUpdate {
+	cru.mutation.SetContent(s)
+	return cru
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (cru *CommentRevisionUpdate) SetNillableContent(s *string) *CommentRevisionUpdate {
+	if s != nil {
+		cru.SetContent(*s)
+	}
+	return cru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cru *CommentRevisionUpdate) SetCreatedAt(t time.Time) *CommentRevisionUpdate {
+	cru.mutation.SetCreatedAt(t)
+	return cru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cru *CommentRevisionUpdate) SetNillableCreatedAt(t *time.Time) *CommentRevisionUpdate {
+	if t != nil {
+		cru.SetCreatedAt(*t)
+	}
+	return cru
+}
+
+// SetCommentID sets the "comment" edge to the Comment entity by ID.
+func (cru *CommentRevisionUpdate) SetCommentID(id int) *CommentRevisionUpdate {
+	cru.mutation.SetCommentID(id)
+	return cru
+}
+
+// SetComment sets the "comment" edge to the Comment entity.
+func (cru *CommentRevisionUpdate) SetComment(c *Comment) *CommentRevisionUpdate {
+	return cru.SetCommentID(c.ID)
+}
+
+// Mutation returns the CommentRevisionMutation object of the builder.
+func (cru *CommentRevisionUpdate) Mutation() *CommentRevisionMutation {
+	return cru.mutation
+}
+
+// ClearComment clears the "comment" edge to the Comment entity.
+func (cru *CommentRevisionUpdate) ClearComment() *CommentRevisionUpdate {
+	cru.mutation.ClearComment()
+	return cru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cru *CommentRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cru.sqlSave, cru.mutation, cru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cru *CommentRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := cru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cru *CommentRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := cru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cru *CommentRevisionUpdate) ExecX(ctx context.Context) {
+	if err := cru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cru *CommentRevisionUpdate) check() error {
+	if v, ok := cru.mutation.Content(); ok {
+		if err := commentrevision.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "CommentRevision.content": %w`, err)}
+		}
+	}
+	if _, ok := cru.mutation.CommentID(); cru.mutation.CommentCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CommentRevision.comment"`)
+	}
+	return nil
+}
+
+func (cru *CommentRevisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(commentrevision.Table, commentrevision.Columns, sqlgraph.NewFieldSpec(commentrevision.FieldID, field.TypeInt))
+	if ps := cru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cru.mutation.Content(); ok {
+		_spec.SetField(commentrevision.FieldContent, field.TypeString, value)
+	}
+	if value, ok := cru.mutation.CreatedAt(); ok {
+		_spec.SetField(commentrevision.FieldCreatedAt, field.TypeTime, value)
+	}
+	if cru.mutation.CommentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   commentrevision.CommentTable,
+			Columns: []string{commentrevision.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cru.mutation.CommentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   commentrevision.CommentTable,
+			Columns: []string{commentrevision.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commentrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cru.mutation.done = true
+	return n, nil
+}
+
+// CommentRevisionUpdateOne is the builder for updating a single CommentRevision entity.
+type CommentRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CommentRevisionMutation
+}
+
+// SetContent sets the "content" field.
+func (cruo *CommentRevisionUpdateOne) SetContent(s string) *CommentRevisionUpdateOne {
+	cruo.mutation.SetContent(s)
+	return cruo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (cruo *CommentRevisionUpdateOne) SetNillableContent(s *string) *CommentRevisionUpdateOne {
+	if s != nil {
+		cruo.SetContent(*s)
+	}
+	return cruo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cruo *CommentRevisionUpdateOne) SetCreatedAt(t time.Time) *CommentRevisionUpdateOne {
+	cruo.mutation.SetCreatedAt(t)
+	return cruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cruo *CommentRevisionUpdateOne) SetNillableCreatedAt(t *time.Time) *CommentRevisionUpdateOne {
+	if t != nil {
+		cruo.SetCreatedAt(*t)
+	}
+	return cruo
+}
+
+// SetCommentID sets the "comment" edge to the Comment entity by ID.
+func (cruo *CommentRevisionUpdateOne) SetCommentID(id int) *CommentRevisionUpdateOne {
+	cruo.mutation.SetCommentID(id)
+	return cruo
+}
+
+// SetComment sets the "comment" edge to the Comment entity.
+func (cruo *CommentRevisionUpdateOne) SetComment(c *Comment) *CommentRevisionUpdateOne {
+	return cruo.SetCommentID(c.ID)
+}
+
+// Mutation returns the CommentRevisionMutation object of the builder.
+func (cruo *CommentRevisionUpdateOne) Mutation() *CommentRevisionMutation {
+	return cruo.mutation
+}
+
+// ClearComment clears the "comment" edge to the Comment entity.
+func (cruo *CommentRevisionUpdateOne) ClearComment() *CommentRevisionUpdateOne {
+	cruo.mutation.ClearComment()
+	return cruo
+}
+
+// Where appends a list predicates to the CommentRevisionUpdate builder.
+func (cruo *CommentRevisionUpdateOne) Where(ps ...predicate.CommentRevision) *CommentRevisionUpdateOne {
+	cruo.mutation.Where(ps...)
+	return cruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cruo *CommentRevisionUpdateOne) Select(field string, fields ...string) *CommentRevisionUpdateOne {
+	cruo.fields = append([]string{field}, fields...)
+	return cruo
+}
+
+// Save executes the query and returns the updated CommentRevision entity.
+func (cruo *CommentRevisionUpdateOne) Save(ctx context.Context) (*CommentRevision, error) {
+	return withHooks(ctx, cruo.sqlSave, cruo.mutation, cruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cruo *CommentRevisionUpdateOne) SaveX(ctx context.Context) *CommentRevision {
+	node, err := cruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cruo *CommentRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := cruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cruo *CommentRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := cruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cruo *CommentRevisionUpdateOne) check() error {
+	if v, ok := cruo.mutation.Content(); ok {
+		if err := commentrevision.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "CommentRevision.content": %w`, err)}
+		}
+	}
+	if _, ok := cruo.mutation.CommentID(); cruo.mutation.CommentCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "CommentRevision.comment"`)
+	}
+	return nil
+}
+
+func (cruo *CommentRevisionUpdateOne) sqlSave(ctx context.Context) (_node *CommentRevision, err error) {
+	if err := cruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(commentrevision.Table, commentrevision.Columns, sqlgraph.NewFieldSpec(commentrevision.FieldID, field.TypeInt))
+	id, ok := cruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CommentRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, commentrevision.FieldID)
+		for _, f := range fields {
+			if !commentrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != commentrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cruo.mutation.Content(); ok {
+		_spec.SetField(commentrevision.FieldContent, field.TypeString, value)
+	}
+	if value, ok := cruo.mutation.CreatedAt(); ok {
+		_spec.SetField(commentrevision.FieldCreatedAt, field.TypeTime, value)
+	}
+	if cruo.mutation.CommentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   commentrevision.CommentTable,
+			Columns: []string{commentrevision.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cruo.mutation.CommentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   commentrevision.CommentTable,
+			Columns: []string{commentrevision.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CommentRevision{config: cruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commentrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cruo.mutation.done = true
+	return _node, nil
+}
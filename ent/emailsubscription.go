@@ -0,0 +1,155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/emailsubscription"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// EmailSubscription is the model entity for the EmailSubscription schema.
+type EmailSubscription struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// Unsubscribed holds the value of the "unsubscribed" field.
+	Unsubscribed bool `json:"unsubscribed,omitempty"`
+	// UnsubscribedAt holds the value of the "unsubscribed_at" field.
+	UnsubscribedAt *time.Time `json:"unsubscribed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EmailSubscription) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case emailsubscription.FieldUnsubscribed:
+			values[i] = new(sql.NullBool)
+		case emailsubscription.FieldID:
+			values[i] = new(sql.NullInt64)
+		case emailsubscription.FieldEmail:
+			values[i] = new(sql.NullString)
+		case emailsubscription.FieldUnsubscribedAt, emailsubscription.FieldCreatedAt, emailsubscription.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EmailSubscription fields.
+func (es *EmailSubscription) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case emailsubscription.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			es.ID = int(value.Int64)
+		case emailsubscription.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				es.Email = value.String
+			}
+		case emailsubscription.FieldUnsubscribed:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field unsubscribed", values[i])
+			} else if value.Valid {
+				es.Unsubscribed = value.Bool
+			}
+		case emailsubscription.FieldUnsubscribedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field unsubscribed_at", values[i])
+			} else if value.Valid {
+				es.UnsubscribedAt = new(time.Time)
+				*es.UnsubscribedAt = value.Time
+			}
+		case emailsubscription.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				es.CreatedAt = value.Time
+			}
+		case emailsubscription.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				es.UpdatedAt = value.Time
+			}
+		default:
+			es.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EmailSubscription.
+// This includes values selected through modifiers, order, etc.
+func (es *EmailSubscription) Value(name string) (ent.Value, error) {
+	return es.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EmailSubscription.
+// Note that you need to call EmailSubscription.Unwrap() before calling this method if this EmailSubscription
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (es *EmailSubscription) Update() *EmailSubscriptionUpdateOne {
+	return NewEmailSubscriptionClient(es.config).UpdateOne(es)
+}
+
+// Unwrap unwraps the EmailSubscription entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (es *EmailSubscription) Unwrap() *EmailSubscription {
+	_tx, ok := es.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EmailSubscription is not a transactional entity")
+	}
+	es.config.driver = _tx.drv
+	return es
+}
+
+// String implements the fmt.Stringer.
+func (es *EmailSubscription) String() string {
+	var builder strings.Builder
+	builder.WriteString("EmailSubscription(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", es.ID))
+	builder.WriteString("email=")
+	builder.WriteString(es.Email)
+	builder.WriteString(", ")
+	builder.WriteString("unsubscribed=")
+	builder.WriteString(fmt.Sprintf("%v", es.Unsubscribed))
+	builder.WriteString(", ")
+	if v := es.UnsubscribedAt; v != nil {
+		builder.WriteString("unsubscribed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(es.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(es.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EmailSubscriptions is a parsable slice of EmailSubscription.
+type EmailSubscriptions []*EmailSubscription
@@ -0,0 +1,86 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emailsubscription
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the emailsubscription type in the database.
+	Label = "email_subscription"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldUnsubscribed holds the string denoting the unsubscribed field in the database.
+	FieldUnsubscribed = "unsubscribed"
+	// FieldUnsubscribedAt holds the string denoting the unsubscribed_at field in the database.
+	FieldUnsubscribedAt = "unsubscribed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the emailsubscription in the database.
+	Table = "email_subscriptions"
+)
+
+// Columns holds all SQL columns for emailsubscription fields.
+var Columns = []string{
+	FieldID,
+	FieldEmail,
+	FieldUnsubscribed,
+	FieldUnsubscribedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	EmailValidator func(string) error
+	// DefaultUnsubscribed holds the default value on creation for the "unsubscribed" field.
+	DefaultUnsubscribed bool
+)
+
+// OrderOption defines the ordering options for the EmailSubscription queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEmail orders the results by the email field.
+func ByEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmail, opts...).ToFunc()
+}
+
+// ByUnsubscribed orders the results by the unsubscribed field.
+func ByUnsubscribed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnsubscribed, opts...).ToFunc()
+}
+
+// ByUnsubscribedAt orders the results by the unsubscribed_at field.
+func ByUnsubscribedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnsubscribedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package emailsubscription
+
+import (
+	"blog-go/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldLTE(FieldID, id))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldEQ(FieldEmail, v))
+}
+
+// Unsubscribed applies equality check predicate on the "unsubscribed" field. It's identical to UnsubscribedEQ.
+func Unsubscribed(v bool) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldEQ(FieldUnsubscribed, v))
+}
+
+// UnsubscribedAt applies equality check predicate on the "unsubscribed_at" field. It's identical to UnsubscribedAtEQ.
+func UnsubscribedAt(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldEQ(FieldUnsubscribedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// UnsubscribedEQ applies the EQ predicate on the "unsubscribed" field.
+func UnsubscribedEQ(v bool) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldEQ(FieldUnsubscribed, v))
+}
+
+// UnsubscribedNEQ applies the NEQ predicate on the "unsubscribed" field.
+func UnsubscribedNEQ(v bool) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldNEQ(FieldUnsubscribed, v))
+}
+
+// UnsubscribedAtEQ applies the EQ predicate on the "unsubscribed_at" field.
+func UnsubscribedAtEQ(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldEQ(FieldUnsubscribedAt, v))
+}
+
+// UnsubscribedAtNEQ applies the NEQ predicate on the "unsubscribed_at" field.
+func UnsubscribedAtNEQ(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldNEQ(FieldUnsubscribedAt, v))
+}
+
+// UnsubscribedAtIn applies the In predicate on the "unsubscribed_at" field.
+func UnsubscribedAtIn(vs ...time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldIn(FieldUnsubscribedAt, vs...))
+}
+
+// UnsubscribedAtNotIn applies the NotIn predicate on the "unsubscribed_at" field.
+func UnsubscribedAtNotIn(vs ...time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldNotIn(FieldUnsubscribedAt, vs...))
+}
+
+// UnsubscribedAtGT applies the GT predicate on the "unsubscribed_at" field.
+func UnsubscribedAtGT(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldGT(FieldUnsubscribedAt, v))
+}
+
+// UnsubscribedAtGTE applies the GTE predicate on the "unsubscribed_at" field.
+func UnsubscribedAtGTE(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldGTE(FieldUnsubscribedAt, v))
+}
+
+// UnsubscribedAtLT applies the LT predicate on the "unsubscribed_at" field.
+func UnsubscribedAtLT(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldLT(FieldUnsubscribedAt, v))
+}
+
+// UnsubscribedAtLTE applies the LTE predicate on the "unsubscribed_at" field.
+func UnsubscribedAtLTE(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldLTE(FieldUnsubscribedAt, v))
+}
+
+// UnsubscribedAtIsNil applies the IsNil predicate on the "unsubscribed_at" field.
+func UnsubscribedAtIsNil() predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldIsNull(FieldUnsubscribedAt))
+}
+
+// UnsubscribedAtNotNil applies the NotNil predicate on the "unsubscribed_at" field.
+func UnsubscribedAtNotNil() predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldNotNull(FieldUnsubscribedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmailSubscription) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmailSubscription) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmailSubscription) predicate.EmailSubscription {
+	return predicate.EmailSubscription(sql.NotPredicates(p))
+}
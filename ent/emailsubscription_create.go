@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/emailsubscription"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailSubscriptionCreate is the builder for creating a EmailSubscription entity.
+type EmailSubscriptionCreate struct {
+	config
+	mutation *EmailSubscriptionMutation
+	hooks    []Hook
+}
+
+// SetEmail sets the "email" field.
+func (esc *EmailSubscriptionCreate) SetEmail(s string) *EmailSubscriptionCreate {
+	esc.mutation.SetEmail(s)
+	return esc
+}
+
+// SetUnsubscribed sets the "unsubscribed" field.
+func (esc *EmailSubscriptionCreate) SetUnsubscribed(b bool) *EmailSubscriptionCreate {
+	esc.mutation.SetUnsubscribed(b)
+	return esc
+}
+
+// SetNillableUnsubscribed sets the "unsubscribed" field if the given value is not nil.
+func (esc *EmailSubscriptionCreate) SetNillableUnsubscribed(b *bool) *EmailSubscriptionCreate {
+	if b != nil {
+		esc.SetUnsubscribed(*b)
+	}
+	return esc
+}
+
+// SetUnsubscribedAt sets the "unsubscribed_at" field.
+func (esc *EmailSubscriptionCreate) SetUnsubscribedAt(t time.Time) *EmailSubscriptionCreate {
+	esc.mutation.SetUnsubscribedAt(t)
+	return esc
+}
+
+// SetNillableUnsubscribedAt sets the "unsubscribed_at" field if the given value is not nil.
+func (esc *EmailSubscriptionCreate) SetNillableUnsubscribedAt(t *time.Time) *EmailSubscriptionCreate {
+	if t != nil {
+		esc.SetUnsubscribedAt(*t)
+	}
+	return esc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (esc *EmailSubscriptionCreate) SetCreatedAt(t time.Time) *EmailSubscriptionCreate {
+	esc.mutation.SetCreatedAt(t)
+	return esc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (esc *EmailSubscriptionCreate) SetUpdatedAt(t time.Time) *EmailSubscriptionCreate {
+	esc.mutation.SetUpdatedAt(t)
+	return esc
+}
+
+// Mutation returns the EmailSubscriptionMutation object of the builder.
+func (esc *EmailSubscriptionCreate) Mutation() *EmailSubscriptionMutation {
+	return esc.mutation
+}
+
+// Save creates the EmailSubscription in the database.
+func (esc *EmailSubscriptionCreate) Save(ctx context.Context) (*EmailSubscription, error) {
+	esc.defaults()
+	return withHooks(ctx, esc.sqlSave, esc.mutation, esc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (esc *EmailSubscriptionCreate) SaveX(ctx context.Context) *EmailSubscription {
+	v, err := esc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (esc *EmailSubscriptionCreate) Exec(ctx context.Context) error {
+	_, err := esc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esc *EmailSubscriptionCreate) ExecX(ctx context.Context) {
+	if err := esc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (esc *EmailSubscriptionCreate) defaults() {
+	if _, ok := esc.mutation.Unsubscribed(); !ok {
+		v := emailsubscription.DefaultUnsubscribed
+		esc.mutation.SetUnsubscribed(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (esc *EmailSubscriptionCreate) check() error {
+	if _, ok := esc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "EmailSubscription.email"`)}
+	}
+	if v, ok := esc.mutation.Email(); ok {
+		if err := emailsubscription.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "EmailSubscription.email": %w`, err)}
+		}
+	}
+	if _, ok := esc.mutation.Unsubscribed(); !ok {
+		return &ValidationError{Name: "unsubscribed", err: errors.New(`ent: missing required field "EmailSubscription.unsubscribed"`)}
+	}
+	if _, ok := esc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmailSubscription.created_at"`)}
+	}
+	if _, ok := esc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EmailSubscription.updated_at"`)}
+	}
+	return nil
+}
+
+func (esc *EmailSubscriptionCreate) sqlSave(ctx context.Context) (*EmailSubscription, error) {
+	if err := esc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := esc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, esc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	esc.mutation.id = &_node.ID
+	esc.mutation.done = true
+	return _node, nil
+}
+
+func (esc *EmailSubscriptionCreate) createSpec() (*EmailSubscription, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmailSubscription{config: esc.config}
+		_spec = sqlgraph.NewCreateSpec(emailsubscription.Table, sqlgraph.NewFieldSpec(emailsubscription.FieldID, field.TypeInt))
+	)
+	if value, ok := esc.mutation.Email(); ok {
+		_spec.SetField(emailsubscription.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := esc.mutation.Unsubscribed(); ok {
+		_spec.SetField(emailsubscription.FieldUnsubscribed, field.TypeBool, value)
+		_node.Unsubscribed = value
+	}
+	if value, ok := esc.mutation.UnsubscribedAt(); ok {
+		_spec.SetField(emailsubscription.FieldUnsubscribedAt, field.TypeTime, value)
+		_node.UnsubscribedAt = &value
+	}
+	if value, ok := esc.mutation.CreatedAt(); ok {
+		_spec.SetField(emailsubscription.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := esc.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailsubscription.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// EmailSubscriptionCreateBulk is the builder for creating many EmailSubscription entities in bulk.
+type EmailSubscriptionCreateBulk struct {
+	config
+	err      error
+	builders []*EmailSubscriptionCreate
+}
+
+// Save creates the EmailSubscription entities in the database.
+func (escb *EmailSubscriptionCreateBulk) Save(ctx context.Context) ([]*EmailSubscription, error) {
+	if escb.err != nil {
+		return nil, escb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(escb.builders))
+	nodes := make([]*EmailSubscription, len(escb.builders))
+	mutators := make([]Mutator, len(escb.builders))
+	for i := range escb.builders {
+		func(i int, root context.Context) {
+			builder := escb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmailSubscriptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, escb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, escb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, escb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (escb *EmailSubscriptionCreateBulk) SaveX(ctx context.Context) []*EmailSubscription {
+	v, err := escb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (escb *EmailSubscriptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := escb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (escb *EmailSubscriptionCreateBulk) ExecX(ctx context.Context) {
+	if err := escb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
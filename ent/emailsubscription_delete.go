@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/emailsubscription"
+	"blog-go/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailSubscriptionDelete is the builder for deleting a EmailSubscription entity.
+type EmailSubscriptionDelete struct {
+	config
+	hooks    []Hook
+	mutation *EmailSubscriptionMutation
+}
+
+// Where appends a list predicates to the EmailSubscriptionDelete builder.
+func (esd *EmailSubscriptionDelete) Where(ps ...predicate.EmailSubscription) *EmailSubscriptionDelete {
+	esd.mutation.Where(ps...)
+	return esd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (esd *EmailSubscriptionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, esd.sqlExec, esd.mutation, esd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esd *EmailSubscriptionDelete) ExecX(ctx context.Context) int {
+	n, err := esd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (esd *EmailSubscriptionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(emailsubscription.Table, sqlgraph.NewFieldSpec(emailsubscription.FieldID, field.TypeInt))
+	if ps := esd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, esd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	esd.mutation.done = true
+	return affected, err
+}
+
+// EmailSubscriptionDeleteOne is the builder for deleting a single EmailSubscription entity.
+type EmailSubscriptionDeleteOne struct {
+	esd *EmailSubscriptionDelete
+}
+
+// Where appends a list predicates to the EmailSubscriptionDelete builder.
+func (esdo *EmailSubscriptionDeleteOne) Where(ps ...predicate.EmailSubscription) *EmailSubscriptionDeleteOne {
+	esdo.esd.mutation.Where(ps...)
+	return esdo
+}
+
+// Exec executes the deletion query.
+func (esdo *EmailSubscriptionDeleteOne) Exec(ctx context.Context) error {
+	n, err := esdo.esd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{emailsubscription.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esdo *EmailSubscriptionDeleteOne) ExecX(ctx context.Context) {
+	if err := esdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
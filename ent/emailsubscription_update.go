@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/emailsubscription"
+	"blog-go/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailSubscriptionUpdate is the builder for updating EmailSubscription entities.
+type EmailSubscriptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmailSubscriptionMutation
+}
+
+// Where appends a list predicates to the EmailSubscriptionUpdate builder.
+func (esu *EmailSubscriptionUpdate) Where(ps ...predicate.EmailSubscription) *EmailSubscriptionUpdate {
+	esu.mutation.Where(ps...)
+	return esu
+}
+
+// SetEmail sets the "email" field.
+func (esu *EmailSubscriptionUpdate) SetEmail(s string) *EmailSubscriptionUpdate {
+	esu.mutation.SetEmail(s)
+	return esu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (esu *EmailSubscriptionUpdate) SetNillableEmail(s *string) *EmailSubscriptionUpdate {
+	if s != nil {
+		esu.SetEmail(*s)
+	}
+	return esu
+}
+
+// SetUnsubscribed sets the "unsubscribed" field.
+func (esu *EmailSubscriptionUpdate) SetUnsubscribed(b bool) *EmailSubscriptionUpdate {
+	esu.mutation.SetUnsubscribed(b)
+	return esu
+}
+
+// SetNillableUnsubscribed sets the "unsubscribed" field if the given value is not nil.
+func (esu *EmailSubscriptionUpdate) SetNillableUnsubscribed(b *bool) *EmailSubscriptionUpdate {
+	if b != nil {
+		esu.SetUnsubscribed(*b)
+	}
+	return esu
+}
+
+// SetUnsubscribedAt sets the "unsubscribed_at" field.
+func (esu *EmailSubscriptionUpdate) SetUnsubscribedAt(t time.Time) *EmailSubscriptionUpdate {
+	esu.mutation.SetUnsubscribedAt(t)
+	return esu
+}
+
+// SetNillableUnsubscribedAt sets the "unsubscribed_at" field if the given value is not nil.
+func (esu *EmailSubscriptionUpdate) SetNillableUnsubscribedAt(t *time.Time) *EmailSubscriptionUpdate {
+	if t != nil {
+		esu.SetUnsubscribedAt(*t)
+	}
+	return esu
+}
+
+// ClearUnsubscribedAt clears the value of the "unsubscribed_at" field.
+func (esu *EmailSubscriptionUpdate) ClearUnsubscribedAt() *EmailSubscriptionUpdate {
+	esu.mutation.ClearUnsubscribedAt()
+	return esu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (esu *EmailSubscriptionUpdate) SetCreatedAt(t time.Time) *EmailSubscriptionUpdate {
+	esu.mutation.SetCreatedAt(t)
+	return esu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (esu *EmailSubscriptionUpdate) SetNillableCreatedAt(t *time.Time) *EmailSubscriptionUpdate {
+	if t != nil {
+		esu.SetCreatedAt(*t)
+	}
+	return esu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (esu *EmailSubscriptionUpdate) SetUpdatedAt(t time.Time) *EmailSubscriptionUpdate {
+	esu.mutation.SetUpdatedAt(t)
+	return esu
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (esu *EmailSubscriptionUpdate) SetNillableUpdatedAt(t *time.Time) *EmailSubscriptionUpdate {
+	if t != nil {
+		esu.SetUpdatedAt(*t)
+	}
+	return esu
+}
+
+// Mutation returns the EmailSubscriptionMutation object of the builder.
+func (esu *EmailSubscriptionUpdate) Mutation() *EmailSubscriptionMutation {
+	return esu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (esu *EmailSubscriptionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, esu.sqlSave, esu.mutation, esu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (esu *EmailSubscriptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := esu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (esu *EmailSubscriptionUpdate) Exec(ctx context.Context) error {
+	_, err := esu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esu *EmailSubscriptionUpdate) ExecX(ctx context.Context) {
+	if err := esu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (esu *EmailSubscriptionUpdate) check() error {
+	if v, ok := esu.mutation.Email(); ok {
+		if err := emailsubscription.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "EmailSubscription.email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (esu *EmailSubscriptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := esu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailsubscription.Table, emailsubscription.Columns, sqlgraph.NewFieldSpec(emailsubscription.FieldID, field.TypeInt))
+	if ps := esu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := esu.mutation.Email(); ok {
+		_spec.SetField(emailsubscription.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := esu.mutation.Unsubscribed(); ok {
+		_spec.SetField(emailsubscription.FieldUnsubscribed, field.TypeBool, value)
+	}
+	if value, ok := esu.mutation.UnsubscribedAt(); ok {
+		_spec.SetField(emailsubscription.FieldUnsubscribedAt, field.TypeTime, value)
+	}
+	if esu.mutation.UnsubscribedAtCleared() {
+		_spec.ClearField(emailsubscription.FieldUnsubscribedAt, field.TypeTime)
+	}
+	if value, ok := esu.mutation.CreatedAt(); ok {
+		_spec.SetField(emailsubscription.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := esu.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailsubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, esu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	esu.mutation.done = true
+	return n, nil
+}
+
+// EmailSubscriptionUpdateOne is the builder for updating a single EmailSubscription entity.
+type EmailSubscriptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmailSubscriptionMutation
+}
+
+// SetEmail sets the "email" field.
+func (esuo *EmailSubscriptionUpdateOne) SetEmail(s string) *EmailSubscriptionUpdateOne {
+	esuo.mutation.SetEmail(s)
+	return esuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (esuo *EmailSubscriptionUpdateOne) SetNillableEmail(s *string) *EmailSubscriptionUpdateOne {
+	if s != nil {
+		esuo.SetEmail(*s)
+	}
+	return esuo
+}
+
+// SetUnsubscribed sets the "unsubscribed" field.
+func (esuo *EmailSubscriptionUpdateOne) SetUnsubscribed(b bool) *EmailSubscriptionUpdateOne {
+	esuo.mutation.SetUnsubscribed(b)
+	return esuo
+}
+
+// SetNillableUnsubscribed sets the "unsubscribed" field if the given value is not nil.
+func (esuo *EmailSubscriptionUpdateOne) SetNillableUnsubscribed(b *bool) *EmailSubscriptionUpdateOne {
+	if b != nil {
+		esuo.SetUnsubscribed(*b)
+	}
+	return esuo
+}
+
+// SetUnsubscribedAt sets the "unsubscribed_at" field.
+func (esuo *EmailSubscriptionUpdateOne) SetUnsubscribedAt(t time.Time) *EmailSubscriptionUpdateOne {
+	esuo.mutation.SetUnsubscribedAt(t)
+	return esuo
+}
+
+// SetNillableUnsubscribedAt sets the "unsubscribed_at" field if the given value is not nil.
+func (esuo *EmailSubscriptionUpdateOne) SetNillableUnsubscribedAt(t *time.Time) *EmailSubscriptionUpdateOne {
+	if t != nil {
+		esuo.SetUnsubscribedAt(*t)
+	}
+	return esuo
+}
+
+// ClearUnsubscribedAt clears the value of the "unsubscribed_at" field.
+func (esuo *EmailSubscriptionUpdateOne) ClearUnsubscribedAt() *EmailSubscriptionUpdateOne {
+	esuo.mutation.ClearUnsubscribedAt()
+	return esuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (esuo *EmailSubscriptionUpdateOne) SetCreatedAt(t time.Time) *EmailSubscriptionUpdateOne {
+	esuo.mutation.SetCreatedAt(t)
+	return esuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (esuo *EmailSubscriptionUpdateOne) SetNillableCreatedAt(t *time.Time) *EmailSubscriptionUpdateOne {
+	if t != nil {
+		esuo.SetCreatedAt(*t)
+	}
+	return esuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (esuo *EmailSubscriptionUpdateOne) SetUpdatedAt(t time.Time) *EmailSubscriptionUpdateOne {
+	esuo.mutation.SetUpdatedAt(t)
+	return esuo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (esuo *EmailSubscriptionUpdateOne) SetNillableUpdatedAt(t *time.Time) *EmailSubscriptionUpdateOne {
+	if t != nil {
+		esuo.SetUpdatedAt(*t)
+	}
+	return esuo
+}
+
+// Mutation returns the EmailSubscriptionMutation object of the builder.
+func (esuo *EmailSubscriptionUpdateOne) Mutation() *EmailSubscriptionMutation {
+	return esuo.mutation
+}
+
+// Where appends a list predicates to the EmailSubscriptionUpdate builder.
+func (esuo *EmailSubscriptionUpdateOne) Where(ps ...predicate.EmailSubscription) *EmailSubscriptionUpdateOne {
+	esuo.mutation.Where(ps...)
+	return esuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (esuo *EmailSubscriptionUpdateOne) Select(field string, fields ...string) *EmailSubscriptionUpdateOne {
+	esuo.fields = append([]string{field}, fields...)
+	return esuo
+}
+
+// Save executes the query and returns the updated EmailSubscription entity.
+func (esuo *EmailSubscriptionUpdateOne) Save(ctx context.Context) (*EmailSubscription, error) {
+	return withHooks(ctx, esuo.sqlSave, esuo.mutation, esuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (esuo *EmailSubscriptionUpdateOne) SaveX(ctx context.Context) *EmailSubscription {
+	node, err := esuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (esuo *EmailSubscriptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := esuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (esuo *EmailSubscriptionUpdateOne) ExecX(ctx context.Context) {
+	if err := esuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (esuo *EmailSubscriptionUpdateOne) check() error {
+	if v, ok := esuo.mutation.Email(); ok {
+		if err := emailsubscription.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "EmailSubscription.email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (esuo *EmailSubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *EmailSubscription, err error) {
+	if err := esuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(emailsubscription.Table, emailsubscription.Columns, sqlgraph.NewFieldSpec(emailsubscription.FieldID, field.TypeInt))
+	id, ok := esuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmailSubscription.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := esuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, emailsubscription.FieldID)
+		for _, f := range fields {
+			if !emailsubscription.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != emailsubscription.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := esuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := esuo.mutation.Email(); ok {
+		_spec.SetField(emailsubscription.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := esuo.mutation.Unsubscribed(); ok {
+		_spec.SetField(emailsubscription.FieldUnsubscribed, field.TypeBool, value)
+	}
+	if value, ok := esuo.mutation.UnsubscribedAt(); ok {
+		_spec.SetField(emailsubscription.FieldUnsubscribedAt, field.TypeTime, value)
+	}
+	if esuo.mutation.UnsubscribedAtCleared() {
+		_spec.ClearField(emailsubscription.FieldUnsubscribedAt, field.TypeTime)
+	}
+	if value, ok := esuo.mutation.CreatedAt(); ok {
+		_spec.SetField(emailsubscription.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := esuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(emailsubscription.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &EmailSubscription{config: esuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, esuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{emailsubscription.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	esuo.mutation.done = true
+	return _node, nil
+}
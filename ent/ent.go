@@ -3,13 +3,27 @@
 package ent
 
 import (
+	"blog-go/ent/accesstoken"
 	"blog-go/ent/book"
 	"blog-go/ent/collection"
 	"blog-go/ent/comment"
+	"blog-go/ent/commentreaction"
+	"blog-go/ent/commentrevision"
+	"blog-go/ent/emailsubscription"
 	"blog-go/ent/friend"
 	"blog-go/ent/hitokoto"
+	"blog-go/ent/identity"
 	"blog-go/ent/image"
+	"blog-go/ent/invitation"
+	"blog-go/ent/passwordreset"
 	"blog-go/ent/post"
+	"blog-go/ent/postrevision"
+	"blog-go/ent/postslughistory"
+	"blog-go/ent/recoverycode"
+	"blog-go/ent/searchdocument"
+	"blog-go/ent/session"
+	"blog-go/ent/spamcorpus"
+	"blog-go/ent/spamtoken"
 	"blog-go/ent/tag"
 	"blog-go/ent/user"
 	"context"
@@ -81,15 +95,29 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			book.Table:       book.ValidColumn,
-			collection.Table: collection.ValidColumn,
-			comment.Table:    comment.ValidColumn,
-			friend.Table:     friend.ValidColumn,
-			hitokoto.Table:   hitokoto.ValidColumn,
-			image.Table:      image.ValidColumn,
-			post.Table:       post.ValidColumn,
-			tag.Table:        tag.ValidColumn,
-			user.Table:       user.ValidColumn,
+			accesstoken.Table:       accesstoken.ValidColumn,
+			book.Table:              book.ValidColumn,
+			collection.Table:        collection.ValidColumn,
+			comment.Table:           comment.ValidColumn,
+			commentreaction.Table:   commentreaction.ValidColumn,
+			commentrevision.Table:   commentrevision.ValidColumn,
+			emailsubscription.Table: emailsubscription.ValidColumn,
+			friend.Table:            friend.ValidColumn,
+			hitokoto.Table:          hitokoto.ValidColumn,
+			identity.Table:          identity.ValidColumn,
+			image.Table:             image.ValidColumn,
+			invitation.Table:        invitation.ValidColumn,
+			passwordreset.Table:     passwordreset.ValidColumn,
+			post.Table:              post.ValidColumn,
+			postrevision.Table:      postrevision.ValidColumn,
+			postslughistory.Table:   postslughistory.ValidColumn,
+			recoverycode.Table:      recoverycode.ValidColumn,
+			searchdocument.Table:    searchdocument.ValidColumn,
+			session.Table:           session.ValidColumn,
+			spamcorpus.Table:        spamcorpus.ValidColumn,
+			spamtoken.Table:         spamtoken.ValidColumn,
+			tag.Table:               tag.ValidColumn,
+			user.Table:              user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
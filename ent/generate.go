@@ -1,5 +1,5 @@
 //go:build ignore
 
-//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate ./schema
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature sql/execquery ./schema
 
 package main
@@ -0,0 +1,643 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/invitation"
+	"blog-go/ent/predicate"
+	"blog-go/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InvitationUpdate is the builder for updating Invitation entities.
+type InvitationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvitationMutation
+}
+
+// Where appends a list predicates to the InvitationUpdate builder.
+func (iu *InvitationUpdate) Where(ps ...predicate.Invitation) *InvitationUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (iu *InvitationUpdate) SetCodeHash(s string) *InvitationUpdate {
+	iu.mutation.SetCodeHash(s)
+	return iu
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableCodeHash(s *string) *InvitationUpdate {
+	if s != nil {
+		iu.SetCodeHash(*s)
+	}
+	return iu
+}
+
+// SetEmail sets the "email" field.
+func (iu *InvitationUpdate) SetEmail(s string) *InvitationUpdate {
+	iu.mutation.SetEmail(s)
+	return iu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableEmail(s *string) *InvitationUpdate {
+	if s != nil {
+		iu.SetEmail(*s)
+	}
+	return iu
+}
+
+// ClearEmail clears the value of the "email" field.
+func (iu *InvitationUpdate) ClearEmail() *InvitationUpdate {
+	iu.mutation.ClearEmail()
+	return iu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iu *InvitationUpdate) SetExpiresAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetExpiresAt(t)
+	return iu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableExpiresAt(t *time.Time) *InvitationUpdate {
+	if t != nil {
+		iu.SetExpiresAt(*t)
+	}
+	return iu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (iu *InvitationUpdate) ClearExpiresAt() *InvitationUpdate {
+	iu.mutation.ClearExpiresAt()
+	return iu
+}
+
+// SetUsedAt sets the "used_at" field.
+func (iu *InvitationUpdate) SetUsedAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetUsedAt(t)
+	return iu
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableUsedAt(t *time.Time) *InvitationUpdate {
+	if t != nil {
+		iu.SetUsedAt(*t)
+	}
+	return iu
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (iu *InvitationUpdate) ClearUsedAt() *InvitationUpdate {
+	iu.mutation.ClearUsedAt()
+	return iu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iu *InvitationUpdate) SetCreatedAt(t time.Time) *InvitationUpdate {
+	iu.mutation.SetCreatedAt(t)
+	return iu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableCreatedAt(t *time.Time) *InvitationUpdate {
+	if t != nil {
+		iu.SetCreatedAt(*t)
+	}
+	return iu
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by ID.
+func (iu *InvitationUpdate) SetCreatorID(id int) *InvitationUpdate {
+	iu.mutation.SetCreatorID(id)
+	return iu
+}
+
+// SetNillableCreatorID sets the "creator" edge to the User entity by ID if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableCreatorID(id *int) *InvitationUpdate {
+	if id != nil {
+		iu = iu.SetCreatorID(*id)
+	}
+	return iu
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (iu *InvitationUpdate) SetCreator(u *User) *InvitationUpdate {
+	return iu.SetCreatorID(u.ID)
+}
+
+// SetInviteeID sets the "invitee" edge to the User entity by ID.
+func (iu *InvitationUpdate) SetInviteeID(id int) *InvitationUpdate {
+	iu.mutation.SetInviteeID(id)
+	return iu
+}
+
+// SetNillableInviteeID sets the "invitee" edge to the User entity by ID if the given value is not nil.
+func (iu *InvitationUpdate) SetNillableInviteeID(id *int) *InvitationUpdate {
+	if id != nil {
+		iu = iu.SetInviteeID(*id)
+	}
+	return iu
+}
+
+// SetInvitee sets the "invitee" edge to the User entity.
+func (iu *InvitationUpdate) SetInvitee(u *User) *InvitationUpdate {
+	return iu.SetInviteeID(u.ID)
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (iu *InvitationUpdate) Mutation() *InvitationMutation {
+	return iu.mutation
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (iu *InvitationUpdate) ClearCreator() *InvitationUpdate {
+	iu.mutation.ClearCreator()
+	return iu
+}
+
+// ClearInvitee clears the "invitee" edge to the User entity.
+func (iu *InvitationUpdate) ClearInvitee() *InvitationUpdate {
+	iu.mutation.ClearInvitee()
+	return iu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *InvitationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InvitationUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InvitationUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InvitationUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *InvitationUpdate) check() error {
+	if v, ok := iu.mutation.CodeHash(); ok {
+		if err := invitation.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "Invitation.code_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iu *InvitationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invitation.Table, invitation.Columns, sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt))
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.CodeHash(); ok {
+		_spec.SetField(invitation.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.Email(); ok {
+		_spec.SetField(invitation.FieldEmail, field.TypeString, value)
+	}
+	if iu.mutation.EmailCleared() {
+		_spec.ClearField(invitation.FieldEmail, field.TypeString)
+	}
+	if value, ok := iu.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if iu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(invitation.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.UsedAt(); ok {
+		_spec.SetField(invitation.FieldUsedAt, field.TypeTime, value)
+	}
+	if iu.mutation.UsedAtCleared() {
+		_spec.ClearField(invitation.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := iu.mutation.CreatedAt(); ok {
+		_spec.SetField(invitation.FieldCreatedAt, field.TypeTime, value)
+	}
+	if iu.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.CreatorTable,
+			Columns: []string{invitation.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.CreatorTable,
+			Columns: []string{invitation.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.InviteeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   invitation.InviteeTable,
+			Columns: []string{invitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.InviteeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   invitation.InviteeTable,
+			Columns: []string{invitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iu.mutation.done = true
+	return n, nil
+}
+
+// InvitationUpdateOne is the builder for updating a single Invitation entity.
+type InvitationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvitationMutation
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (iuo *InvitationUpdateOne) SetCodeHash(s string) *InvitationUpdateOne {
+	iuo.mutation.SetCodeHash(s)
+	return iuo
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableCodeHash(s *string) *InvitationUpdateOne {
+	if s != nil {
+		iuo.SetCodeHash(*s)
+	}
+	return iuo
+}
+
+// SetEmail sets the "email" field.
+func (iuo *InvitationUpdateOne) SetEmail(s string) *InvitationUpdateOne {
+	iuo.mutation.SetEmail(s)
+	return iuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableEmail(s *string) *InvitationUpdateOne {
+	if s != nil {
+		iuo.SetEmail(*s)
+	}
+	return iuo
+}
+
+// ClearEmail clears the value of the "email" field.
+func (iuo *InvitationUpdateOne) ClearEmail() *InvitationUpdateOne {
+	iuo.mutation.ClearEmail()
+	return iuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (iuo *InvitationUpdateOne) SetExpiresAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetExpiresAt(t)
+	return iuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableExpiresAt(t *time.Time) *InvitationUpdateOne {
+	if t != nil {
+		iuo.SetExpiresAt(*t)
+	}
+	return iuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (iuo *InvitationUpdateOne) ClearExpiresAt() *InvitationUpdateOne {
+	iuo.mutation.ClearExpiresAt()
+	return iuo
+}
+
+// SetUsedAt sets the "used_at" field.
+func (iuo *InvitationUpdateOne) SetUsedAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetUsedAt(t)
+	return iuo
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableUsedAt(t *time.Time) *InvitationUpdateOne {
+	if t != nil {
+		iuo.SetUsedAt(*t)
+	}
+	return iuo
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (iuo *InvitationUpdateOne) ClearUsedAt() *InvitationUpdateOne {
+	iuo.mutation.ClearUsedAt()
+	return iuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (iuo *InvitationUpdateOne) SetCreatedAt(t time.Time) *InvitationUpdateOne {
+	iuo.mutation.SetCreatedAt(t)
+	return iuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableCreatedAt(t *time.Time) *InvitationUpdateOne {
+	if t != nil {
+		iuo.SetCreatedAt(*t)
+	}
+	return iuo
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by ID.
+func (iuo *InvitationUpdateOne) SetCreatorID(id int) *InvitationUpdateOne {
+	iuo.mutation.SetCreatorID(id)
+	return iuo
+}
+
+// SetNillableCreatorID sets the "creator" edge to the User entity by ID if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableCreatorID(id *int) *InvitationUpdateOne {
+	if id != nil {
+		iuo = iuo.SetCreatorID(*id)
+	}
+	return iuo
+}
+
+// SetCreator sets the "creator" edge to the User entity.
+func (iuo *InvitationUpdateOne) SetCreator(u *User) *InvitationUpdateOne {
+	return iuo.SetCreatorID(u.ID)
+}
+
+// SetInviteeID sets the "invitee" edge to the User entity by ID.
+func (iuo *InvitationUpdateOne) SetInviteeID(id int) *InvitationUpdateOne {
+	iuo.mutation.SetInviteeID(id)
+	return iuo
+}
+
+// SetNillableInviteeID sets the "invitee" edge to the User entity by ID if the given value is not nil.
+func (iuo *InvitationUpdateOne) SetNillableInviteeID(id *int) *InvitationUpdateOne {
+	if id != nil {
+		iuo = iuo.SetInviteeID(*id)
+	}
+	return iuo
+}
+
+// SetInvitee sets the "invitee" edge to the User entity.
+func (iuo *InvitationUpdateOne) SetInvitee(u *User) *InvitationUpdateOne {
+	return iuo.SetInviteeID(u.ID)
+}
+
+// Mutation returns the InvitationMutation object of the builder.
+func (iuo *InvitationUpdateOne) Mutation() *InvitationMutation {
+	return iuo.mutation
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (iuo *InvitationUpdateOne) ClearCreator() *InvitationUpdateOne {
+	iuo.mutation.ClearCreator()
+	return iuo
+}
+
+// ClearInvitee clears the "invitee" edge to the User entity.
+func (iuo *InvitationUpdateOne) ClearInvitee() *InvitationUpdateOne {
+	iuo.mutation.ClearInvitee()
+	return iuo
+}
+
+// Where appends a list predicates to the InvitationUpdate builder.
+func (iuo *InvitationUpdateOne) Where(ps ...predicate.Invitation) *InvitationUpdateOne {
+	iuo.mutation.Where(ps...)
+	return iuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *InvitationUpdateOne) Select(field string, fields ...string) *InvitationUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Invitation entity.
+func (iuo *InvitationUpdateOne) Save(ctx context.Context) (*Invitation, error) {
+	return withHooks(ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InvitationUpdateOne) SaveX(ctx context.Context) *Invitation {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *InvitationUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InvitationUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *InvitationUpdateOne) check() error {
+	if v, ok := iuo.mutation.CodeHash(); ok {
+		if err := invitation.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "Invitation.code_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (iuo *InvitationUpdateOne) sqlSave(ctx context.Context) (_node *Invitation, err error) {
+	if err := iuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invitation.Table, invitation.Columns, sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt))
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Invitation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invitation.FieldID)
+		for _, f := range fields {
+			if !invitation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.CodeHash(); ok {
+		_spec.SetField(invitation.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.Email(); ok {
+		_spec.SetField(invitation.FieldEmail, field.TypeString, value)
+	}
+	if iuo.mutation.EmailCleared() {
+		_spec.ClearField(invitation.FieldEmail, field.TypeString)
+	}
+	if value, ok := iuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(invitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if iuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(invitation.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.UsedAt(); ok {
+		_spec.SetField(invitation.FieldUsedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.UsedAtCleared() {
+		_spec.ClearField(invitation.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := iuo.mutation.CreatedAt(); ok {
+		_spec.SetField(invitation.FieldCreatedAt, field.TypeTime, value)
+	}
+	if iuo.mutation.CreatorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.CreatorTable,
+			Columns: []string{invitation.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.CreatorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   invitation.CreatorTable,
+			Columns: []string{invitation.CreatorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.InviteeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   invitation.InviteeTable,
+			Columns: []string{invitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.InviteeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   invitation.InviteeTable,
+			Columns: []string{invitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Invitation{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iuo.mutation.done = true
+	return _node, nil
+}
@@ -3,11 +3,40 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// AccessTokensColumns holds the columns for the "access_tokens" table.
+	AccessTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_ip", Type: field.TypeString, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_access_tokens", Type: field.TypeInt},
+	}
+	// AccessTokensTable holds the schema information for the "access_tokens" table.
+	AccessTokensTable = &schema.Table{
+		Name:       "access_tokens",
+		Columns:    AccessTokensColumns,
+		PrimaryKey: []*schema.Column{AccessTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "access_tokens_users_access_tokens",
+				Columns:    []*schema.Column{AccessTokensColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// BooksColumns holds the columns for the "books" table.
 	BooksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -72,10 +101,17 @@ var (
 		{Name: "author", Type: field.TypeString},
 		{Name: "email", Type: field.TypeString},
 		{Name: "website", Type: field.TypeString, Nullable: true},
-		{Name: "approved", Type: field.TypeBool, Default: false},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "rejected", "spam", "trashed"}, Default: "pending"},
+		{Name: "previous_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"pending", "approved", "rejected", "spam", "trashed"}},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
 		{Name: "avatar", Type: field.TypeString, Nullable: true, Default: "/images/default-avatar.png"},
+		{Name: "moderation_action", Type: field.TypeEnum, Nullable: true, Enums: []string{"approve", "hold", "reject"}},
+		{Name: "moderation_reason", Type: field.TypeString, Nullable: true},
+		{Name: "spam_score", Type: field.TypeFloat64, Nullable: true},
+		{Name: "spam_label", Type: field.TypeEnum, Nullable: true, Enums: []string{"spam", "ham"}},
+		{Name: "import_id", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
 		{Name: "post_comments", Type: field.TypeInt},
 		{Name: "user_comments", Type: field.TypeInt, Nullable: true},
@@ -88,23 +124,102 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "comments_comments_children",
-				Columns:    []*schema.Column{CommentsColumns[9]},
+				Columns:    []*schema.Column{CommentsColumns[16]},
 				RefColumns: []*schema.Column{CommentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "comments_posts_comments",
-				Columns:    []*schema.Column{CommentsColumns[10]},
+				Columns:    []*schema.Column{CommentsColumns[17]},
 				RefColumns: []*schema.Column{PostsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "comments_users_comments",
-				Columns:    []*schema.Column{CommentsColumns[11]},
+				Columns:    []*schema.Column{CommentsColumns[18]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "comment_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CommentsColumns[5], CommentsColumns[7]},
+			},
+		},
+	}
+	// CommentReactionsColumns holds the columns for the "comment_reactions" table.
+	CommentReactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "emoji", Type: field.TypeString},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "comment_id", Type: field.TypeInt},
+		{Name: "user_comment_reactions", Type: field.TypeInt, Nullable: true},
+	}
+	// CommentReactionsTable holds the schema information for the "comment_reactions" table.
+	CommentReactionsTable = &schema.Table{
+		Name:       "comment_reactions",
+		Columns:    CommentReactionsColumns,
+		PrimaryKey: []*schema.Column{CommentReactionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comment_reactions_comments_reactions",
+				Columns:    []*schema.Column{CommentReactionsColumns[4]},
+				RefColumns: []*schema.Column{CommentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "comment_reactions_users_comment_reactions",
+				Columns:    []*schema.Column{CommentReactionsColumns[5]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "commentreaction_comment_id_actor",
+				Unique:  true,
+				Columns: []*schema.Column{CommentReactionsColumns[4], CommentReactionsColumns[2]},
+			},
+		},
+	}
+	// CommentRevisionsColumns holds the columns for the "comment_revisions" table.
+	CommentRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "comment_revisions", Type: field.TypeInt},
+	}
+	// CommentRevisionsTable holds the schema information for the "comment_revisions" table.
+	CommentRevisionsTable = &schema.Table{
+		Name:       "comment_revisions",
+		Columns:    CommentRevisionsColumns,
+		PrimaryKey: []*schema.Column{CommentRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comment_revisions_comments_revisions",
+				Columns:    []*schema.Column{CommentRevisionsColumns[3]},
+				RefColumns: []*schema.Column{CommentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// EmailSubscriptionsColumns holds the columns for the "email_subscriptions" table.
+	EmailSubscriptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "unsubscribed", Type: field.TypeBool, Default: false},
+		{Name: "unsubscribed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// EmailSubscriptionsTable holds the schema information for the "email_subscriptions" table.
+	EmailSubscriptionsTable = &schema.Table{
+		Name:       "email_subscriptions",
+		Columns:    EmailSubscriptionsColumns,
+		PrimaryKey: []*schema.Column{EmailSubscriptionsColumns[0]},
 	}
 	// FriendsColumns holds the columns for the "friends" table.
 	FriendsColumns = []*schema.Column{
@@ -136,6 +251,39 @@ var (
 		Columns:    HitokotosColumns,
 		PrimaryKey: []*schema.Column{HitokotosColumns[0]},
 	}
+	// IdentitiesColumns holds the columns for the "identities" table.
+	IdentitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "provider_user_id", Type: field.TypeString},
+		{Name: "login", Type: field.TypeString, Nullable: true},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "avatar", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_identities", Type: field.TypeInt},
+	}
+	// IdentitiesTable holds the schema information for the "identities" table.
+	IdentitiesTable = &schema.Table{
+		Name:       "identities",
+		Columns:    IdentitiesColumns,
+		PrimaryKey: []*schema.Column{IdentitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "identities_users_identities",
+				Columns:    []*schema.Column{IdentitiesColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "identity_provider_provider_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{IdentitiesColumns[1], IdentitiesColumns[2]},
+			},
+		},
+	}
 	// ImagesColumns holds the columns for the "images" table.
 	ImagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -162,14 +310,73 @@ var (
 			},
 		},
 	}
+	// InvitationsColumns holds the columns for the "invitations" table.
+	InvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code_hash", Type: field.TypeString, Unique: true},
+		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_invitations", Type: field.TypeInt, Nullable: true},
+		{Name: "user_invitation", Type: field.TypeInt, Unique: true, Nullable: true},
+	}
+	// InvitationsTable holds the schema information for the "invitations" table.
+	InvitationsTable = &schema.Table{
+		Name:       "invitations",
+		Columns:    InvitationsColumns,
+		PrimaryKey: []*schema.Column{InvitationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "invitations_users_invitations",
+				Columns:    []*schema.Column{InvitationsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "invitations_users_invitation",
+				Columns:    []*schema.Column{InvitationsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// PasswordResetsColumns holds the columns for the "password_resets" table.
+	PasswordResetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_password_resets", Type: field.TypeInt},
+	}
+	// PasswordResetsTable holds the schema information for the "password_resets" table.
+	PasswordResetsTable = &schema.Table{
+		Name:       "password_resets",
+		Columns:    PasswordResetsColumns,
+		PrimaryKey: []*schema.Column{PasswordResetsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "password_resets_users_password_resets",
+				Columns:    []*schema.Column{PasswordResetsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// PostsColumns holds the columns for the "posts" table.
 	PostsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "title", Type: field.TypeString},
+		{Name: "slug", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "content_html", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "toc", Type: field.TypeJSON, Nullable: true},
 		{Name: "excerpt", Type: field.TypeString},
 		{Name: "cover_image", Type: field.TypeString, Default: "/images/post-cover.jpg"},
 		{Name: "published", Type: field.TypeBool, Default: false},
+		{Name: "scheduled", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "published_at", Type: field.TypeTime, Nullable: true},
@@ -186,11 +393,193 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "posts_users_posts",
-				Columns:    []*schema.Column{PostsColumns[12]},
+				Columns:    []*schema.Column{PostsColumns[16]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "post_scheduled_published_at",
+				Unique:  false,
+				Columns: []*schema.Column{PostsColumns[9], PostsColumns[12]},
+			},
+		},
+	}
+	// PostRevisionsColumns holds the columns for the "post_revisions" table.
+	PostRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "title", Type: field.TypeString},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "excerpt", Type: field.TypeString},
+		{Name: "editor", Type: field.TypeString, Nullable: true},
+		{Name: "restored_from", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "post_revisions", Type: field.TypeInt},
+	}
+	// PostRevisionsTable holds the schema information for the "post_revisions" table.
+	PostRevisionsTable = &schema.Table{
+		Name:       "post_revisions",
+		Columns:    PostRevisionsColumns,
+		PrimaryKey: []*schema.Column{PostRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_revisions_posts_revisions",
+				Columns:    []*schema.Column{PostRevisionsColumns[8]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "postrevision_version_post_revisions",
+				Unique:  true,
+				Columns: []*schema.Column{PostRevisionsColumns[1], PostRevisionsColumns[8]},
+			},
+		},
+	}
+	// PostSlugHistoriesColumns holds the columns for the "post_slug_histories" table.
+	PostSlugHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "post_slug_history", Type: field.TypeInt},
+	}
+	// PostSlugHistoriesTable holds the schema information for the "post_slug_histories" table.
+	PostSlugHistoriesTable = &schema.Table{
+		Name:       "post_slug_histories",
+		Columns:    PostSlugHistoriesColumns,
+		PrimaryKey: []*schema.Column{PostSlugHistoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "post_slug_histories_posts_slug_history",
+				Columns:    []*schema.Column{PostSlugHistoriesColumns[3]},
+				RefColumns: []*schema.Column{PostsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// RecoveryCodesColumns holds the columns for the "recovery_codes" table.
+	RecoveryCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code_hash", Type: field.TypeString},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_recovery_codes", Type: field.TypeInt},
+	}
+	// RecoveryCodesTable holds the schema information for the "recovery_codes" table.
+	RecoveryCodesTable = &schema.Table{
+		Name:       "recovery_codes",
+		Columns:    RecoveryCodesColumns,
+		PrimaryKey: []*schema.Column{RecoveryCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recovery_codes_users_recovery_codes",
+				Columns:    []*schema.Column{RecoveryCodesColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// SearchDocumentsColumns holds the columns for the "search_documents" table.
+	SearchDocumentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "doc_type", Type: field.TypeEnum, Enums: []string{"post", "book", "collection"}},
+		{Name: "doc_id", Type: field.TypeInt},
+		{Name: "title", Type: field.TypeString},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "vector", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "tsvector"}},
+		{Name: "visible", Type: field.TypeBool, Default: true},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SearchDocumentsTable holds the schema information for the "search_documents" table.
+	SearchDocumentsTable = &schema.Table{
+		Name:       "search_documents",
+		Columns:    SearchDocumentsColumns,
+		PrimaryKey: []*schema.Column{SearchDocumentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "searchdocument_doc_type_doc_id",
+				Unique:  true,
+				Columns: []*schema.Column{SearchDocumentsColumns[1], SearchDocumentsColumns[2]},
+			},
+			{
+				Name:    "searchdocument_vector",
+				Unique:  false,
+				Columns: []*schema.Column{SearchDocumentsColumns[5]},
+				Annotation: &entsql.IndexAnnotation{
+					Type: "GIN",
+				},
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "refresh_token_hash", Type: field.TypeString, Unique: true},
+		{Name: "previous_token_hash", Type: field.TypeString, Nullable: true},
+		{Name: "device", Type: field.TypeString, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_sessions", Type: field.TypeInt},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_users_sessions",
+				Columns:    []*schema.Column{SessionsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "session_previous_token_hash",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[2]},
+			},
+		},
+	}
+	// SpamCorpusColumns holds the columns for the "spam_corpus" table.
+	SpamCorpusColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "class", Type: field.TypeEnum, Enums: []string{"spam", "ham"}},
+		{Name: "documents", Type: field.TypeInt, Default: 0},
+	}
+	// SpamCorpusTable holds the schema information for the "spam_corpus" table.
+	SpamCorpusTable = &schema.Table{
+		Name:       "spam_corpus",
+		Columns:    SpamCorpusColumns,
+		PrimaryKey: []*schema.Column{SpamCorpusColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "spamcorpus_class",
+				Unique:  true,
+				Columns: []*schema.Column{SpamCorpusColumns[1]},
+			},
+		},
+	}
+	// SpamTokensColumns holds the columns for the "spam_tokens" table.
+	SpamTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token", Type: field.TypeString, Unique: true},
+		{Name: "spam", Type: field.TypeInt, Default: 0},
+		{Name: "ham", Type: field.TypeInt, Default: 0},
+	}
+	// SpamTokensTable holds the schema information for the "spam_tokens" table.
+	SpamTokensTable = &schema.Table{
+		Name:       "spam_tokens",
+		Columns:    SpamTokensColumns,
+		PrimaryKey: []*schema.Column{SpamTokensColumns[0]},
 	}
 	// TagsColumns holds the columns for the "tags" table.
 	TagsColumns = []*schema.Column{
@@ -221,8 +610,16 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "username", Type: field.TypeString, Unique: true},
 		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "email_verified_at", Type: field.TypeTime, Nullable: true},
 		{Name: "password", Type: field.TypeString},
-		{Name: "role", Type: field.TypeString, Default: "user"},
+		{Name: "password_changed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true},
+		{Name: "totp_enabled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_last_step", Type: field.TypeInt64, Default: 0},
+		{Name: "failed_login_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_failed_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "role", Type: field.TypeString, Default: "commenter"},
 		{Name: "avatar", Type: field.TypeString, Nullable: true},
 		{Name: "nickname", Type: field.TypeString, Nullable: true},
 		{Name: "bio", Type: field.TypeString, Nullable: true},
@@ -262,13 +659,27 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccessTokensTable,
 		BooksTable,
 		CollectionsTable,
 		CommentsTable,
+		CommentReactionsTable,
+		CommentRevisionsTable,
+		EmailSubscriptionsTable,
 		FriendsTable,
 		HitokotosTable,
+		IdentitiesTable,
 		ImagesTable,
+		InvitationsTable,
+		PasswordResetsTable,
 		PostsTable,
+		PostRevisionsTable,
+		PostSlugHistoriesTable,
+		RecoveryCodesTable,
+		SearchDocumentsTable,
+		SessionsTable,
+		SpamCorpusTable,
+		SpamTokensTable,
 		TagsTable,
 		UsersTable,
 		PostTagsTable,
@@ -276,13 +687,25 @@ var (
 )
 
 func init() {
+	AccessTokensTable.ForeignKeys[0].RefTable = UsersTable
 	BooksTable.ForeignKeys[0].RefTable = ImagesTable
 	BooksTable.ForeignKeys[1].RefTable = UsersTable
 	CommentsTable.ForeignKeys[0].RefTable = CommentsTable
 	CommentsTable.ForeignKeys[1].RefTable = PostsTable
 	CommentsTable.ForeignKeys[2].RefTable = UsersTable
+	CommentReactionsTable.ForeignKeys[0].RefTable = CommentsTable
+	CommentReactionsTable.ForeignKeys[1].RefTable = UsersTable
+	CommentRevisionsTable.ForeignKeys[0].RefTable = CommentsTable
+	IdentitiesTable.ForeignKeys[0].RefTable = UsersTable
 	ImagesTable.ForeignKeys[0].RefTable = UsersTable
+	InvitationsTable.ForeignKeys[0].RefTable = UsersTable
+	InvitationsTable.ForeignKeys[1].RefTable = UsersTable
+	PasswordResetsTable.ForeignKeys[0].RefTable = UsersTable
 	PostsTable.ForeignKeys[0].RefTable = UsersTable
+	PostRevisionsTable.ForeignKeys[0].RefTable = PostsTable
+	PostSlugHistoriesTable.ForeignKeys[0].RefTable = PostsTable
+	RecoveryCodesTable.ForeignKeys[0].RefTable = UsersTable
+	SessionsTable.ForeignKeys[0].RefTable = UsersTable
 	TagsTable.ForeignKeys[0].RefTable = UsersTable
 	PostTagsTable.ForeignKeys[0].RefTable = PostsTable
 	PostTagsTable.ForeignKeys[1].RefTable = TagsTable
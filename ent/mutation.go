@@ -3,16 +3,31 @@
 package ent
 
 import (
+	"blog-go/ent/accesstoken"
 	"blog-go/ent/book"
 	"blog-go/ent/collection"
 	"blog-go/ent/comment"
+	"blog-go/ent/commentreaction"
+	"blog-go/ent/commentrevision"
+	"blog-go/ent/emailsubscription"
 	"blog-go/ent/friend"
 	"blog-go/ent/hitokoto"
+	"blog-go/ent/identity"
 	"blog-go/ent/image"
+	"blog-go/ent/invitation"
+	"blog-go/ent/passwordreset"
 	"blog-go/ent/post"
+	"blog-go/ent/postrevision"
+	"blog-go/ent/postslughistory"
 	"blog-go/ent/predicate"
+	"blog-go/ent/recoverycode"
+	"blog-go/ent/searchdocument"
+	"blog-go/ent/session"
+	"blog-go/ent/spamcorpus"
+	"blog-go/ent/spamtoken"
 	"blog-go/ent/tag"
 	"blog-go/ent/user"
+	"blog-go/models"
 	"context"
 	"errors"
 	"fmt"
@@ -32,59 +47,66 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeBook       = "Book"
-	TypeCollection = "Collection"
-	TypeComment    = "Comment"
-	TypeFriend     = "Friend"
-	TypeHitokoto   = "Hitokoto"
-	TypeImage      = "Image"
-	TypePost       = "Post"
-	TypeTag        = "Tag"
-	TypeUser       = "User"
+	TypeAccessToken       = "AccessToken"
+	TypeBook              = "Book"
+	TypeCollection        = "Collection"
+	TypeComment           = "Comment"
+	TypeCommentReaction   = "CommentReaction"
+	TypeCommentRevision   = "CommentRevision"
+	TypeEmailSubscription = "EmailSubscription"
+	TypeFriend            = "Friend"
+	TypeHitokoto          = "Hitokoto"
+	TypeIdentity          = "Identity"
+	TypeImage             = "Image"
+	TypeInvitation        = "Invitation"
+	TypePasswordReset     = "PasswordReset"
+	TypePost              = "Post"
+	TypePostRevision      = "PostRevision"
+	TypePostSlugHistory   = "PostSlugHistory"
+	TypeRecoveryCode      = "RecoveryCode"
+	TypeSearchDocument    = "SearchDocument"
+	TypeSession           = "Session"
+	TypeSpamCorpus        = "SpamCorpus"
+	TypeSpamToken         = "SpamToken"
+	TypeTag               = "Tag"
+	TypeUser              = "User"
 )
 
-// BookMutation represents an operation that mutates the Book nodes in the graph.
-type BookMutation struct {
+// AccessTokenMutation represents an operation that mutates the AccessToken nodes in the graph.
+type AccessTokenMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	title              *string
-	author             *string
-	desc               *string
-	cover              *string
-	publisher          *string
-	publish_date       *string
-	isbn               *string
-	pages              *int
-	addpages           *int
-	rating             *float64
-	addrating          *float64
-	status             *book.Status
-	review             *string
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	cover_image        *int
-	clearedcover_image bool
-	owner              *int
-	clearedowner       bool
-	done               bool
-	oldValue           func(context.Context) (*Book, error)
-	predicates         []predicate.Book
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	token_hash    *string
+	prefix        *string
+	scopes        *[]string
+	appendscopes  []string
+	expires_at    *time.Time
+	last_used_at  *time.Time
+	last_used_ip  *string
+	revoked_at    *time.Time
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*AccessToken, error)
+	predicates    []predicate.AccessToken
 }
 
-var _ ent.Mutation = (*BookMutation)(nil)
+var _ ent.Mutation = (*AccessTokenMutation)(nil)
 
-// bookOption allows management of the mutation configuration using functional options.
-type bookOption func(*BookMutation)
+// accesstokenOption allows management of the mutation configuration using functional options.
+type accesstokenOption func(*AccessTokenMutation)
 
-// newBookMutation creates new mutation for the Book entity.
-func newBookMutation(c config, op Op, opts ...bookOption) *BookMutation {
-	m := &BookMutation{
+// newAccessTokenMutation creates new mutation for the AccessToken entity.
+func newAccessTokenMutation(c config, op Op, opts ...accesstokenOption) *AccessTokenMutation {
+	m := &AccessTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeBook,
+		typ:           TypeAccessToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -93,20 +115,20 @@ func newBookMutation(c config, op Op, opts ...bookOption) *BookMutation {
 	return m
 }
 
-// withBookID sets the ID field of the mutation.
-func withBookID(id int) bookOption {
-	return func(m *BookMutation) {
+// withAccessTokenID sets the ID field of the mutation.
+func withAccessTokenID(id int) accesstokenOption {
+	return func(m *AccessTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Book
+			value *AccessToken
 		)
-		m.oldValue = func(ctx context.Context) (*Book, error) {
+		m.oldValue = func(ctx context.Context) (*AccessToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Book.Get(ctx, id)
+					value, err = m.Client().AccessToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -115,10 +137,10 @@ func withBookID(id int) bookOption {
 	}
 }
 
-// withBook sets the old Book of the mutation.
-func withBook(node *Book) bookOption {
-	return func(m *BookMutation) {
-		m.oldValue = func(context.Context) (*Book, error) {
+// withAccessToken sets the old AccessToken of the mutation.
+func withAccessToken(node *AccessToken) accesstokenOption {
+	return func(m *AccessTokenMutation) {
+		m.oldValue = func(context.Context) (*AccessToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -127,7 +149,7 @@ func withBook(node *Book) bookOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BookMutation) Client() *Client {
+func (m AccessTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -135,7 +157,7 @@ func (m BookMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m BookMutation) Tx() (*Tx, error) {
+func (m AccessTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -146,7 +168,7 @@ func (m BookMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *BookMutation) ID() (id int, exists bool) {
+func (m *AccessTokenMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -157,7 +179,7 @@ func (m *BookMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *BookMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AccessTokenMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -166,1100 +188,751 @@ func (m *BookMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Book.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AccessToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *BookMutation) SetTitle(s string) {
-	m.title = &s
+// SetName sets the "name" field.
+func (m *AccessTokenMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *BookMutation) Title() (r string, exists bool) {
-	v := m.title
+// Name returns the value of the "name" field in the mutation.
+func (m *AccessTokenMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Book entity.
-// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *AccessTokenMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Name, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *BookMutation) ResetTitle() {
-	m.title = nil
+// ResetName resets all changes to the "name" field.
+func (m *AccessTokenMutation) ResetName() {
+	m.name = nil
 }
 
-// SetAuthor sets the "author" field.
-func (m *BookMutation) SetAuthor(s string) {
-	m.author = &s
+// SetTokenHash sets the "token_hash" field.
+func (m *AccessTokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
 }
 
-// Author returns the value of the "author" field in the mutation.
-func (m *BookMutation) Author() (r string, exists bool) {
-	v := m.author
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *AccessTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthor returns the old "author" field's value of the Book entity.
-// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenHash returns the old "token_hash" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldAuthor(ctx context.Context) (v string, err error) {
+func (m *AccessTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthor requires an ID field in the mutation")
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
 	}
-	return oldValue.Author, nil
+	return oldValue.TokenHash, nil
 }
 
-// ResetAuthor resets all changes to the "author" field.
-func (m *BookMutation) ResetAuthor() {
-	m.author = nil
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *AccessTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
 }
 
-// SetDesc sets the "desc" field.
-func (m *BookMutation) SetDesc(s string) {
-	m.desc = &s
+// SetPrefix sets the "prefix" field.
+func (m *AccessTokenMutation) SetPrefix(s string) {
+	m.prefix = &s
 }
 
-// Desc returns the value of the "desc" field in the mutation.
-func (m *BookMutation) Desc() (r string, exists bool) {
-	v := m.desc
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *AccessTokenMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDesc returns the old "desc" field's value of the Book entity.
-// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// OldPrefix returns the old "prefix" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldDesc(ctx context.Context) (v string, err error) {
+func (m *AccessTokenMutation) OldPrefix(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDesc is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDesc requires an ID field in the mutation")
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDesc: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
 	}
-	return oldValue.Desc, nil
+	return oldValue.Prefix, nil
 }
 
-// ClearDesc clears the value of the "desc" field.
-func (m *BookMutation) ClearDesc() {
-	m.desc = nil
-	m.clearedFields[book.FieldDesc] = struct{}{}
-}
-
-// DescCleared returns if the "desc" field was cleared in this mutation.
-func (m *BookMutation) DescCleared() bool {
-	_, ok := m.clearedFields[book.FieldDesc]
-	return ok
-}
-
-// ResetDesc resets all changes to the "desc" field.
-func (m *BookMutation) ResetDesc() {
-	m.desc = nil
-	delete(m.clearedFields, book.FieldDesc)
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *AccessTokenMutation) ResetPrefix() {
+	m.prefix = nil
 }
 
-// SetCover sets the "cover" field.
-func (m *BookMutation) SetCover(s string) {
-	m.cover = &s
+// SetScopes sets the "scopes" field.
+func (m *AccessTokenMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
 }
 
-// Cover returns the value of the "cover" field in the mutation.
-func (m *BookMutation) Cover() (r string, exists bool) {
-	v := m.cover
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *AccessTokenMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCover returns the old "cover" field's value of the Book entity.
-// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// OldScopes returns the old "scopes" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldCover(ctx context.Context) (v string, err error) {
+func (m *AccessTokenMutation) OldScopes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCover is only allowed on UpdateOne operations")
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCover requires an ID field in the mutation")
+		return v, errors.New("OldScopes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCover: %w", err)
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
 	}
-	return oldValue.Cover, nil
+	return oldValue.Scopes, nil
 }
 
-// ResetCover resets all changes to the "cover" field.
-func (m *BookMutation) ResetCover() {
-	m.cover = nil
+// AppendScopes adds s to the "scopes" field.
+func (m *AccessTokenMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
 }
 
-// SetPublisher sets the "publisher" field.
-func (m *BookMutation) SetPublisher(s string) {
-	m.publisher = &s
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *AccessTokenMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
 }
 
-// Publisher returns the value of the "publisher" field in the mutation.
-func (m *BookMutation) Publisher() (r string, exists bool) {
-	v := m.publisher
+// ResetScopes resets all changes to the "scopes" field.
+func (m *AccessTokenMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *AccessTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *AccessTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPublisher returns the old "publisher" field's value of the Book entity.
-// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldPublisher(ctx context.Context) (v string, err error) {
+func (m *AccessTokenMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublisher is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublisher requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublisher: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Publisher, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ClearPublisher clears the value of the "publisher" field.
-func (m *BookMutation) ClearPublisher() {
-	m.publisher = nil
-	m.clearedFields[book.FieldPublisher] = struct{}{}
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *AccessTokenMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[accesstoken.FieldExpiresAt] = struct{}{}
 }
 
-// PublisherCleared returns if the "publisher" field was cleared in this mutation.
-func (m *BookMutation) PublisherCleared() bool {
-	_, ok := m.clearedFields[book.FieldPublisher]
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *AccessTokenMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[accesstoken.FieldExpiresAt]
 	return ok
 }
 
-// ResetPublisher resets all changes to the "publisher" field.
-func (m *BookMutation) ResetPublisher() {
-	m.publisher = nil
-	delete(m.clearedFields, book.FieldPublisher)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *AccessTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, accesstoken.FieldExpiresAt)
 }
 
-// SetPublishDate sets the "publish_date" field.
-func (m *BookMutation) SetPublishDate(s string) {
-	m.publish_date = &s
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *AccessTokenMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// PublishDate returns the value of the "publish_date" field in the mutation.
-func (m *BookMutation) PublishDate() (r string, exists bool) {
-	v := m.publish_date
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *AccessTokenMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPublishDate returns the old "publish_date" field's value of the Book entity.
-// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldPublishDate(ctx context.Context) (v string, err error) {
+func (m *AccessTokenMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublishDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublishDate requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublishDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.PublishDate, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// ClearPublishDate clears the value of the "publish_date" field.
-func (m *BookMutation) ClearPublishDate() {
-	m.publish_date = nil
-	m.clearedFields[book.FieldPublishDate] = struct{}{}
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *AccessTokenMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[accesstoken.FieldLastUsedAt] = struct{}{}
 }
 
-// PublishDateCleared returns if the "publish_date" field was cleared in this mutation.
-func (m *BookMutation) PublishDateCleared() bool {
-	_, ok := m.clearedFields[book.FieldPublishDate]
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *AccessTokenMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[accesstoken.FieldLastUsedAt]
 	return ok
 }
 
-// ResetPublishDate resets all changes to the "publish_date" field.
-func (m *BookMutation) ResetPublishDate() {
-	m.publish_date = nil
-	delete(m.clearedFields, book.FieldPublishDate)
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *AccessTokenMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, accesstoken.FieldLastUsedAt)
 }
 
-// SetIsbn sets the "isbn" field.
-func (m *BookMutation) SetIsbn(s string) {
-	m.isbn = &s
+// SetLastUsedIP sets the "last_used_ip" field.
+func (m *AccessTokenMutation) SetLastUsedIP(s string) {
+	m.last_used_ip = &s
 }
 
-// Isbn returns the value of the "isbn" field in the mutation.
-func (m *BookMutation) Isbn() (r string, exists bool) {
-	v := m.isbn
+// LastUsedIP returns the value of the "last_used_ip" field in the mutation.
+func (m *AccessTokenMutation) LastUsedIP() (r string, exists bool) {
+	v := m.last_used_ip
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsbn returns the old "isbn" field's value of the Book entity.
-// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedIP returns the old "last_used_ip" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldIsbn(ctx context.Context) (v string, err error) {
+func (m *AccessTokenMutation) OldLastUsedIP(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsbn is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedIP is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsbn requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedIP requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsbn: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedIP: %w", err)
 	}
-	return oldValue.Isbn, nil
+	return oldValue.LastUsedIP, nil
 }
 
-// ClearIsbn clears the value of the "isbn" field.
-func (m *BookMutation) ClearIsbn() {
-	m.isbn = nil
-	m.clearedFields[book.FieldIsbn] = struct{}{}
+// ClearLastUsedIP clears the value of the "last_used_ip" field.
+func (m *AccessTokenMutation) ClearLastUsedIP() {
+	m.last_used_ip = nil
+	m.clearedFields[accesstoken.FieldLastUsedIP] = struct{}{}
 }
 
-// IsbnCleared returns if the "isbn" field was cleared in this mutation.
-func (m *BookMutation) IsbnCleared() bool {
-	_, ok := m.clearedFields[book.FieldIsbn]
+// LastUsedIPCleared returns if the "last_used_ip" field was cleared in this mutation.
+func (m *AccessTokenMutation) LastUsedIPCleared() bool {
+	_, ok := m.clearedFields[accesstoken.FieldLastUsedIP]
 	return ok
 }
 
-// ResetIsbn resets all changes to the "isbn" field.
-func (m *BookMutation) ResetIsbn() {
-	m.isbn = nil
-	delete(m.clearedFields, book.FieldIsbn)
+// ResetLastUsedIP resets all changes to the "last_used_ip" field.
+func (m *AccessTokenMutation) ResetLastUsedIP() {
+	m.last_used_ip = nil
+	delete(m.clearedFields, accesstoken.FieldLastUsedIP)
 }
 
-// SetPages sets the "pages" field.
-func (m *BookMutation) SetPages(i int) {
-	m.pages = &i
-	m.addpages = nil
+// SetRevokedAt sets the "revoked_at" field.
+func (m *AccessTokenMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
 }
 
-// Pages returns the value of the "pages" field in the mutation.
-func (m *BookMutation) Pages() (r int, exists bool) {
-	v := m.pages
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *AccessTokenMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPages returns the old "pages" field's value of the Book entity.
-// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedAt returns the old "revoked_at" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldPages(ctx context.Context) (v int, err error) {
+func (m *AccessTokenMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPages is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPages requires an ID field in the mutation")
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPages: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
 	}
-	return oldValue.Pages, nil
+	return oldValue.RevokedAt, nil
 }
 
-// AddPages adds i to the "pages" field.
-func (m *BookMutation) AddPages(i int) {
-	if m.addpages != nil {
-		*m.addpages += i
-	} else {
-		m.addpages = &i
-	}
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *AccessTokenMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[accesstoken.FieldRevokedAt] = struct{}{}
 }
 
-// AddedPages returns the value that was added to the "pages" field in this mutation.
-func (m *BookMutation) AddedPages() (r int, exists bool) {
-	v := m.addpages
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *AccessTokenMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[accesstoken.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *AccessTokenMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, accesstoken.FieldRevokedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AccessTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AccessTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearPages clears the value of the "pages" field.
-func (m *BookMutation) ClearPages() {
-	m.pages = nil
-	m.addpages = nil
-	m.clearedFields[book.FieldPages] = struct{}{}
-}
-
-// PagesCleared returns if the "pages" field was cleared in this mutation.
-func (m *BookMutation) PagesCleared() bool {
-	_, ok := m.clearedFields[book.FieldPages]
-	return ok
-}
-
-// ResetPages resets all changes to the "pages" field.
-func (m *BookMutation) ResetPages() {
-	m.pages = nil
-	m.addpages = nil
-	delete(m.clearedFields, book.FieldPages)
-}
-
-// SetRating sets the "rating" field.
-func (m *BookMutation) SetRating(f float64) {
-	m.rating = &f
-	m.addrating = nil
-}
-
-// Rating returns the value of the "rating" field in the mutation.
-func (m *BookMutation) Rating() (r float64, exists bool) {
-	v := m.rating
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldRating returns the old "rating" field's value of the Book entity.
-// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AccessToken entity.
+// If the AccessToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldRating(ctx context.Context) (v float64, err error) {
+func (m *AccessTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRating is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRating requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRating: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Rating, nil
+	return oldValue.CreatedAt, nil
 }
 
-// AddRating adds f to the "rating" field.
-func (m *BookMutation) AddRating(f float64) {
-	if m.addrating != nil {
-		*m.addrating += f
-	} else {
-		m.addrating = &f
-	}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AccessTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AddedRating returns the value that was added to the "rating" field in this mutation.
-func (m *BookMutation) AddedRating() (r float64, exists bool) {
-	v := m.addrating
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *AccessTokenMutation) SetUserID(id int) {
+	m.user = &id
 }
 
-// ResetRating resets all changes to the "rating" field.
-func (m *BookMutation) ResetRating() {
-	m.rating = nil
-	m.addrating = nil
+// ClearUser clears the "user" edge to the User entity.
+func (m *AccessTokenMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// SetStatus sets the "status" field.
-func (m *BookMutation) SetStatus(b book.Status) {
-	m.status = &b
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *AccessTokenMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *BookMutation) Status() (r book.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
+// UserID returns the "user" edge ID in the mutation.
+func (m *AccessTokenMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
-	return *v, true
+	return
 }
 
-// OldStatus returns the old "status" field's value of the Book entity.
-// If the Book object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldStatus(ctx context.Context) (v book.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *AccessTokenMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *BookMutation) ResetStatus() {
-	m.status = nil
+	return
 }
 
-// SetReview sets the "review" field.
-func (m *BookMutation) SetReview(s string) {
-	m.review = &s
+// ResetUser resets all changes to the "user" edge.
+func (m *AccessTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Review returns the value of the "review" field in the mutation.
-func (m *BookMutation) Review() (r string, exists bool) {
-	v := m.review
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the AccessTokenMutation builder.
+func (m *AccessTokenMutation) Where(ps ...predicate.AccessToken) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldReview returns the old "review" field's value of the Book entity.
-// If the Book object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldReview(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReview is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReview requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReview: %w", err)
+// WhereP appends storage-level predicates to the AccessTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AccessTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AccessToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.Review, nil
-}
-
-// ClearReview clears the value of the "review" field.
-func (m *BookMutation) ClearReview() {
-	m.review = nil
-	m.clearedFields[book.FieldReview] = struct{}{}
+	m.Where(p...)
 }
 
-// ReviewCleared returns if the "review" field was cleared in this mutation.
-func (m *BookMutation) ReviewCleared() bool {
-	_, ok := m.clearedFields[book.FieldReview]
-	return ok
+// Op returns the operation name.
+func (m *AccessTokenMutation) Op() Op {
+	return m.op
 }
 
-// ResetReview resets all changes to the "review" field.
-func (m *BookMutation) ResetReview() {
-	m.review = nil
-	delete(m.clearedFields, book.FieldReview)
+// SetOp allows setting the mutation operation.
+func (m *AccessTokenMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *BookMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// Type returns the node type of this mutation (AccessToken).
+func (m *AccessTokenMutation) Type() string {
+	return m.typ
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *BookMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AccessTokenMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.name != nil {
+		fields = append(fields, accesstoken.FieldName)
 	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the Book entity.
-// If the Book object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	if m.token_hash != nil {
+		fields = append(fields, accesstoken.FieldTokenHash)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	if m.prefix != nil {
+		fields = append(fields, accesstoken.FieldPrefix)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	if m.scopes != nil {
+		fields = append(fields, accesstoken.FieldScopes)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *BookMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *BookMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *BookMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+	if m.expires_at != nil {
+		fields = append(fields, accesstoken.FieldExpiresAt)
 	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the Book entity.
-// If the Book object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	if m.last_used_at != nil {
+		fields = append(fields, accesstoken.FieldLastUsedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	if m.last_used_ip != nil {
+		fields = append(fields, accesstoken.FieldLastUsedIP)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	if m.revoked_at != nil {
+		fields = append(fields, accesstoken.FieldRevokedAt)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *BookMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// SetCoverImageID sets the "cover_image" edge to the Image entity by id.
-func (m *BookMutation) SetCoverImageID(id int) {
-	m.cover_image = &id
+	if m.created_at != nil {
+		fields = append(fields, accesstoken.FieldCreatedAt)
+	}
+	return fields
 }
 
-// ClearCoverImage clears the "cover_image" edge to the Image entity.
-func (m *BookMutation) ClearCoverImage() {
-	m.clearedcover_image = true
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AccessTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case accesstoken.FieldName:
+		return m.Name()
+	case accesstoken.FieldTokenHash:
+		return m.TokenHash()
+	case accesstoken.FieldPrefix:
+		return m.Prefix()
+	case accesstoken.FieldScopes:
+		return m.Scopes()
+	case accesstoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case accesstoken.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case accesstoken.FieldLastUsedIP:
+		return m.LastUsedIP()
+	case accesstoken.FieldRevokedAt:
+		return m.RevokedAt()
+	case accesstoken.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
 }
 
-// CoverImageCleared reports if the "cover_image" edge to the Image entity was cleared.
-func (m *BookMutation) CoverImageCleared() bool {
-	return m.clearedcover_image
-}
-
-// CoverImageID returns the "cover_image" edge ID in the mutation.
-func (m *BookMutation) CoverImageID() (id int, exists bool) {
-	if m.cover_image != nil {
-		return *m.cover_image, true
-	}
-	return
-}
-
-// CoverImageIDs returns the "cover_image" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CoverImageID instead. It exists only for internal usage by the builders.
-func (m *BookMutation) CoverImageIDs() (ids []int) {
-	if id := m.cover_image; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetCoverImage resets all changes to the "cover_image" edge.
-func (m *BookMutation) ResetCoverImage() {
-	m.cover_image = nil
-	m.clearedcover_image = false
-}
-
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *BookMutation) SetOwnerID(id int) {
-	m.owner = &id
-}
-
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *BookMutation) ClearOwner() {
-	m.clearedowner = true
-}
-
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *BookMutation) OwnerCleared() bool {
-	return m.clearedowner
-}
-
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *BookMutation) OwnerID() (id int, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
-	}
-	return
-}
-
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *BookMutation) OwnerIDs() (ids []int) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetOwner resets all changes to the "owner" edge.
-func (m *BookMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
-}
-
-// Where appends a list predicates to the BookMutation builder.
-func (m *BookMutation) Where(ps ...predicate.Book) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the BookMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BookMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Book, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *BookMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *BookMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (Book).
-func (m *BookMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *BookMutation) Fields() []string {
-	fields := make([]string, 0, 13)
-	if m.title != nil {
-		fields = append(fields, book.FieldTitle)
-	}
-	if m.author != nil {
-		fields = append(fields, book.FieldAuthor)
-	}
-	if m.desc != nil {
-		fields = append(fields, book.FieldDesc)
-	}
-	if m.cover != nil {
-		fields = append(fields, book.FieldCover)
-	}
-	if m.publisher != nil {
-		fields = append(fields, book.FieldPublisher)
-	}
-	if m.publish_date != nil {
-		fields = append(fields, book.FieldPublishDate)
-	}
-	if m.isbn != nil {
-		fields = append(fields, book.FieldIsbn)
-	}
-	if m.pages != nil {
-		fields = append(fields, book.FieldPages)
-	}
-	if m.rating != nil {
-		fields = append(fields, book.FieldRating)
-	}
-	if m.status != nil {
-		fields = append(fields, book.FieldStatus)
-	}
-	if m.review != nil {
-		fields = append(fields, book.FieldReview)
-	}
-	if m.created_at != nil {
-		fields = append(fields, book.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, book.FieldUpdatedAt)
-	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *BookMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case book.FieldTitle:
-		return m.Title()
-	case book.FieldAuthor:
-		return m.Author()
-	case book.FieldDesc:
-		return m.Desc()
-	case book.FieldCover:
-		return m.Cover()
-	case book.FieldPublisher:
-		return m.Publisher()
-	case book.FieldPublishDate:
-		return m.PublishDate()
-	case book.FieldIsbn:
-		return m.Isbn()
-	case book.FieldPages:
-		return m.Pages()
-	case book.FieldRating:
-		return m.Rating()
-	case book.FieldStatus:
-		return m.Status()
-	case book.FieldReview:
-		return m.Review()
-	case book.FieldCreatedAt:
-		return m.CreatedAt()
-	case book.FieldUpdatedAt:
-		return m.UpdatedAt()
-	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *BookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case book.FieldTitle:
-		return m.OldTitle(ctx)
-	case book.FieldAuthor:
-		return m.OldAuthor(ctx)
-	case book.FieldDesc:
-		return m.OldDesc(ctx)
-	case book.FieldCover:
-		return m.OldCover(ctx)
-	case book.FieldPublisher:
-		return m.OldPublisher(ctx)
-	case book.FieldPublishDate:
-		return m.OldPublishDate(ctx)
-	case book.FieldIsbn:
-		return m.OldIsbn(ctx)
-	case book.FieldPages:
-		return m.OldPages(ctx)
-	case book.FieldRating:
-		return m.OldRating(ctx)
-	case book.FieldStatus:
-		return m.OldStatus(ctx)
-	case book.FieldReview:
-		return m.OldReview(ctx)
-	case book.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case book.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Book field %s", name)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AccessTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case accesstoken.FieldName:
+		return m.OldName(ctx)
+	case accesstoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case accesstoken.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case accesstoken.FieldScopes:
+		return m.OldScopes(ctx)
+	case accesstoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case accesstoken.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case accesstoken.FieldLastUsedIP:
+		return m.OldLastUsedIP(ctx)
+	case accesstoken.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case accesstoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AccessToken field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BookMutation) SetField(name string, value ent.Value) error {
+func (m *AccessTokenMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case book.FieldTitle:
+	case accesstoken.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetName(v)
 		return nil
-	case book.FieldAuthor:
+	case accesstoken.FieldTokenHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAuthor(v)
+		m.SetTokenHash(v)
 		return nil
-	case book.FieldDesc:
+	case accesstoken.FieldPrefix:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDesc(v)
+		m.SetPrefix(v)
 		return nil
-	case book.FieldCover:
-		v, ok := value.(string)
+	case accesstoken.FieldScopes:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCover(v)
+		m.SetScopes(v)
 		return nil
-	case book.FieldPublisher:
-		v, ok := value.(string)
+	case accesstoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPublisher(v)
+		m.SetExpiresAt(v)
 		return nil
-	case book.FieldPublishDate:
-		v, ok := value.(string)
+	case accesstoken.FieldLastUsedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPublishDate(v)
+		m.SetLastUsedAt(v)
 		return nil
-	case book.FieldIsbn:
+	case accesstoken.FieldLastUsedIP:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsbn(v)
-		return nil
-	case book.FieldPages:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPages(v)
-		return nil
-	case book.FieldRating:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRating(v)
-		return nil
-	case book.FieldStatus:
-		v, ok := value.(book.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
+		m.SetLastUsedIP(v)
 		return nil
-	case book.FieldReview:
-		v, ok := value.(string)
+	case accesstoken.FieldRevokedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReview(v)
+		m.SetRevokedAt(v)
 		return nil
-	case book.FieldCreatedAt:
+	case accesstoken.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case book.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Book field %s", name)
+	return fmt.Errorf("unknown AccessToken field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *BookMutation) AddedFields() []string {
-	var fields []string
-	if m.addpages != nil {
-		fields = append(fields, book.FieldPages)
-	}
-	if m.addrating != nil {
-		fields = append(fields, book.FieldRating)
-	}
-	return fields
+func (m *AccessTokenMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *BookMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case book.FieldPages:
-		return m.AddedPages()
-	case book.FieldRating:
-		return m.AddedRating()
-	}
+func (m *AccessTokenMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BookMutation) AddField(name string, value ent.Value) error {
+func (m *AccessTokenMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case book.FieldPages:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPages(v)
-		return nil
-	case book.FieldRating:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRating(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Book numeric field %s", name)
+	return fmt.Errorf("unknown AccessToken numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *BookMutation) ClearedFields() []string {
+func (m *AccessTokenMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(book.FieldDesc) {
-		fields = append(fields, book.FieldDesc)
-	}
-	if m.FieldCleared(book.FieldPublisher) {
-		fields = append(fields, book.FieldPublisher)
-	}
-	if m.FieldCleared(book.FieldPublishDate) {
-		fields = append(fields, book.FieldPublishDate)
+	if m.FieldCleared(accesstoken.FieldExpiresAt) {
+		fields = append(fields, accesstoken.FieldExpiresAt)
 	}
-	if m.FieldCleared(book.FieldIsbn) {
-		fields = append(fields, book.FieldIsbn)
+	if m.FieldCleared(accesstoken.FieldLastUsedAt) {
+		fields = append(fields, accesstoken.FieldLastUsedAt)
 	}
-	if m.FieldCleared(book.FieldPages) {
-		fields = append(fields, book.FieldPages)
+	if m.FieldCleared(accesstoken.FieldLastUsedIP) {
+		fields = append(fields, accesstoken.FieldLastUsedIP)
 	}
-	if m.FieldCleared(book.FieldReview) {
-		fields = append(fields, book.FieldReview)
+	if m.FieldCleared(accesstoken.FieldRevokedAt) {
+		fields = append(fields, accesstoken.FieldRevokedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *BookMutation) FieldCleared(name string) bool {
+func (m *AccessTokenMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *BookMutation) ClearField(name string) error {
+func (m *AccessTokenMutation) ClearField(name string) error {
 	switch name {
-	case book.FieldDesc:
-		m.ClearDesc()
-		return nil
-	case book.FieldPublisher:
-		m.ClearPublisher()
-		return nil
-	case book.FieldPublishDate:
-		m.ClearPublishDate()
+	case accesstoken.FieldExpiresAt:
+		m.ClearExpiresAt()
 		return nil
-	case book.FieldIsbn:
-		m.ClearIsbn()
+	case accesstoken.FieldLastUsedAt:
+		m.ClearLastUsedAt()
 		return nil
-	case book.FieldPages:
-		m.ClearPages()
+	case accesstoken.FieldLastUsedIP:
+		m.ClearLastUsedIP()
 		return nil
-	case book.FieldReview:
-		m.ClearReview()
+	case accesstoken.FieldRevokedAt:
+		m.ClearRevokedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Book nullable field %s", name)
+	return fmt.Errorf("unknown AccessToken nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *BookMutation) ResetField(name string) error {
+func (m *AccessTokenMutation) ResetField(name string) error {
 	switch name {
-	case book.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case book.FieldAuthor:
-		m.ResetAuthor()
-		return nil
-	case book.FieldDesc:
-		m.ResetDesc()
-		return nil
-	case book.FieldCover:
-		m.ResetCover()
+	case accesstoken.FieldName:
+		m.ResetName()
 		return nil
-	case book.FieldPublisher:
-		m.ResetPublisher()
+	case accesstoken.FieldTokenHash:
+		m.ResetTokenHash()
 		return nil
-	case book.FieldPublishDate:
-		m.ResetPublishDate()
+	case accesstoken.FieldPrefix:
+		m.ResetPrefix()
 		return nil
-	case book.FieldIsbn:
-		m.ResetIsbn()
+	case accesstoken.FieldScopes:
+		m.ResetScopes()
 		return nil
-	case book.FieldPages:
-		m.ResetPages()
+	case accesstoken.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case book.FieldRating:
-		m.ResetRating()
+	case accesstoken.FieldLastUsedAt:
+		m.ResetLastUsedAt()
 		return nil
-	case book.FieldStatus:
-		m.ResetStatus()
+	case accesstoken.FieldLastUsedIP:
+		m.ResetLastUsedIP()
 		return nil
-	case book.FieldReview:
-		m.ResetReview()
+	case accesstoken.FieldRevokedAt:
+		m.ResetRevokedAt()
 		return nil
-	case book.FieldCreatedAt:
+	case accesstoken.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case book.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Book field %s", name)
+	return fmt.Errorf("unknown AccessToken field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BookMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cover_image != nil {
-		edges = append(edges, book.EdgeCoverImage)
-	}
-	if m.owner != nil {
-		edges = append(edges, book.EdgeOwner)
+func (m *AccessTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, accesstoken.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *BookMutation) AddedIDs(name string) []ent.Value {
+func (m *AccessTokenMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case book.EdgeCoverImage:
-		if id := m.cover_image; id != nil {
-			return []ent.Value{*id}
-		}
-	case book.EdgeOwner:
-		if id := m.owner; id != nil {
+	case accesstoken.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -1267,100 +940,100 @@ func (m *BookMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BookMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *AccessTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *BookMutation) RemovedIDs(name string) []ent.Value {
+func (m *AccessTokenMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BookMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedcover_image {
-		edges = append(edges, book.EdgeCoverImage)
-	}
-	if m.clearedowner {
-		edges = append(edges, book.EdgeOwner)
+func (m *AccessTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, accesstoken.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *BookMutation) EdgeCleared(name string) bool {
+func (m *AccessTokenMutation) EdgeCleared(name string) bool {
 	switch name {
-	case book.EdgeCoverImage:
-		return m.clearedcover_image
-	case book.EdgeOwner:
-		return m.clearedowner
+	case accesstoken.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *BookMutation) ClearEdge(name string) error {
+func (m *AccessTokenMutation) ClearEdge(name string) error {
 	switch name {
-	case book.EdgeCoverImage:
-		m.ClearCoverImage()
-		return nil
-	case book.EdgeOwner:
-		m.ClearOwner()
+	case accesstoken.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Book unique edge %s", name)
+	return fmt.Errorf("unknown AccessToken unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *BookMutation) ResetEdge(name string) error {
+func (m *AccessTokenMutation) ResetEdge(name string) error {
 	switch name {
-	case book.EdgeCoverImage:
-		m.ResetCoverImage()
-		return nil
-	case book.EdgeOwner:
-		m.ResetOwner()
+	case accesstoken.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Book edge %s", name)
+	return fmt.Errorf("unknown AccessToken edge %s", name)
 }
 
-// CollectionMutation represents an operation that mutates the Collection nodes in the graph.
-type CollectionMutation struct {
+// BookMutation represents an operation that mutates the Book nodes in the graph.
+type BookMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	_type         *string
-	title         *string
-	author        *string
-	cover         *string
-	date          *string
-	link          *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Collection, error)
-	predicates    []predicate.Collection
+	op                 Op
+	typ                string
+	id                 *int
+	title              *string
+	author             *string
+	desc               *string
+	cover              *string
+	publisher          *string
+	publish_date       *string
+	isbn               *string
+	pages              *int
+	addpages           *int
+	rating             *float64
+	addrating          *float64
+	status             *book.Status
+	review             *string
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	cover_image        *int
+	clearedcover_image bool
+	owner              *int
+	clearedowner       bool
+	done               bool
+	oldValue           func(context.Context) (*Book, error)
+	predicates         []predicate.Book
 }
 
-var _ ent.Mutation = (*CollectionMutation)(nil)
+var _ ent.Mutation = (*BookMutation)(nil)
 
-// collectionOption allows management of the mutation configuration using functional options.
-type collectionOption func(*CollectionMutation)
+// bookOption allows management of the mutation configuration using functional options.
+type bookOption func(*BookMutation)
 
-// newCollectionMutation creates new mutation for the Collection entity.
-func newCollectionMutation(c config, op Op, opts ...collectionOption) *CollectionMutation {
-	m := &CollectionMutation{
+// newBookMutation creates new mutation for the Book entity.
+func newBookMutation(c config, op Op, opts ...bookOption) *BookMutation {
+	m := &BookMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCollection,
+		typ:           TypeBook,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1369,20 +1042,20 @@ func newCollectionMutation(c config, op Op, opts ...collectionOption) *Collectio
 	return m
 }
 
-// withCollectionID sets the ID field of the mutation.
-func withCollectionID(id int) collectionOption {
-	return func(m *CollectionMutation) {
+// withBookID sets the ID field of the mutation.
+func withBookID(id int) bookOption {
+	return func(m *BookMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Collection
+			value *Book
 		)
-		m.oldValue = func(ctx context.Context) (*Collection, error) {
+		m.oldValue = func(ctx context.Context) (*Book, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Collection.Get(ctx, id)
+					value, err = m.Client().Book.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1391,10 +1064,10 @@ func withCollectionID(id int) collectionOption {
 	}
 }
 
-// withCollection sets the old Collection of the mutation.
-func withCollection(node *Collection) collectionOption {
-	return func(m *CollectionMutation) {
-		m.oldValue = func(context.Context) (*Collection, error) {
+// withBook sets the old Book of the mutation.
+func withBook(node *Book) bookOption {
+	return func(m *BookMutation) {
+		m.oldValue = func(context.Context) (*Book, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1403,7 +1076,7 @@ func withCollection(node *Collection) collectionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CollectionMutation) Client() *Client {
+func (m BookMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1411,7 +1084,7 @@ func (m CollectionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CollectionMutation) Tx() (*Tx, error) {
+func (m BookMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1422,7 +1095,7 @@ func (m CollectionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CollectionMutation) ID() (id int, exists bool) {
+func (m *BookMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1433,7 +1106,7 @@ func (m *CollectionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CollectionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BookMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1442,140 +1115,140 @@ func (m *CollectionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Collection.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Book.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetType sets the "type" field.
-func (m *CollectionMutation) SetType(s string) {
-	m._type = &s
+// SetTitle sets the "title" field.
+func (m *BookMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *CollectionMutation) GetType() (r string, exists bool) {
-	v := m._type
-	if v == nil {
+// Title returns the value of the "title" field in the mutation.
+func (m *BookMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Collection entity.
-// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CollectionMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *BookMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Title, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *CollectionMutation) ResetType() {
-	m._type = nil
+// ResetTitle resets all changes to the "title" field.
+func (m *BookMutation) ResetTitle() {
+	m.title = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *CollectionMutation) SetTitle(s string) {
-	m.title = &s
+// SetAuthor sets the "author" field.
+func (m *BookMutation) SetAuthor(s string) {
+	m.author = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *CollectionMutation) Title() (r string, exists bool) {
-	v := m.title
+// Author returns the value of the "author" field in the mutation.
+func (m *BookMutation) Author() (r string, exists bool) {
+	v := m.author
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Collection entity.
-// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// OldAuthor returns the old "author" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CollectionMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *BookMutation) OldAuthor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Author, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *CollectionMutation) ResetTitle() {
-	m.title = nil
+// ResetAuthor resets all changes to the "author" field.
+func (m *BookMutation) ResetAuthor() {
+	m.author = nil
 }
 
-// SetAuthor sets the "author" field.
-func (m *CollectionMutation) SetAuthor(s string) {
-	m.author = &s
+// SetDesc sets the "desc" field.
+func (m *BookMutation) SetDesc(s string) {
+	m.desc = &s
 }
 
-// Author returns the value of the "author" field in the mutation.
-func (m *CollectionMutation) Author() (r string, exists bool) {
-	v := m.author
+// Desc returns the value of the "desc" field in the mutation.
+func (m *BookMutation) Desc() (r string, exists bool) {
+	v := m.desc
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthor returns the old "author" field's value of the Collection entity.
-// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// OldDesc returns the old "desc" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CollectionMutation) OldAuthor(ctx context.Context) (v string, err error) {
+func (m *BookMutation) OldDesc(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+		return v, errors.New("OldDesc is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthor requires an ID field in the mutation")
+		return v, errors.New("OldDesc requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+		return v, fmt.Errorf("querying old value for OldDesc: %w", err)
 	}
-	return oldValue.Author, nil
+	return oldValue.Desc, nil
 }
 
-// ClearAuthor clears the value of the "author" field.
-func (m *CollectionMutation) ClearAuthor() {
-	m.author = nil
-	m.clearedFields[collection.FieldAuthor] = struct{}{}
+// ClearDesc clears the value of the "desc" field.
+func (m *BookMutation) ClearDesc() {
+	m.desc = nil
+	m.clearedFields[book.FieldDesc] = struct{}{}
 }
 
-// AuthorCleared returns if the "author" field was cleared in this mutation.
-func (m *CollectionMutation) AuthorCleared() bool {
-	_, ok := m.clearedFields[collection.FieldAuthor]
+// DescCleared returns if the "desc" field was cleared in this mutation.
+func (m *BookMutation) DescCleared() bool {
+	_, ok := m.clearedFields[book.FieldDesc]
 	return ok
 }
 
-// ResetAuthor resets all changes to the "author" field.
-func (m *CollectionMutation) ResetAuthor() {
-	m.author = nil
-	delete(m.clearedFields, collection.FieldAuthor)
+// ResetDesc resets all changes to the "desc" field.
+func (m *BookMutation) ResetDesc() {
+	m.desc = nil
+	delete(m.clearedFields, book.FieldDesc)
 }
 
 // SetCover sets the "cover" field.
-func (m *CollectionMutation) SetCover(s string) {
+func (m *BookMutation) SetCover(s string) {
 	m.cover = &s
 }
 
 // Cover returns the value of the "cover" field in the mutation.
-func (m *CollectionMutation) Cover() (r string, exists bool) {
+func (m *BookMutation) Cover() (r string, exists bool) {
 	v := m.cover
 	if v == nil {
 		return
@@ -1583,10 +1256,10 @@ func (m *CollectionMutation) Cover() (r string, exists bool) {
 	return *v, true
 }
 
-// OldCover returns the old "cover" field's value of the Collection entity.
-// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// OldCover returns the old "cover" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CollectionMutation) OldCover(ctx context.Context) (v string, err error) {
+func (m *BookMutation) OldCover(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCover is only allowed on UpdateOne operations")
 	}
@@ -1600,559 +1273,11035 @@ func (m *CollectionMutation) OldCover(ctx context.Context) (v string, err error)
 	return oldValue.Cover, nil
 }
 
-// ClearCover clears the value of the "cover" field.
-func (m *CollectionMutation) ClearCover() {
-	m.cover = nil
-	m.clearedFields[collection.FieldCover] = struct{}{}
-}
-
-// CoverCleared returns if the "cover" field was cleared in this mutation.
-func (m *CollectionMutation) CoverCleared() bool {
-	_, ok := m.clearedFields[collection.FieldCover]
-	return ok
-}
-
 // ResetCover resets all changes to the "cover" field.
-func (m *CollectionMutation) ResetCover() {
+func (m *BookMutation) ResetCover() {
 	m.cover = nil
-	delete(m.clearedFields, collection.FieldCover)
 }
 
-// SetDate sets the "date" field.
-func (m *CollectionMutation) SetDate(s string) {
-	m.date = &s
+// SetPublisher sets the "publisher" field.
+func (m *BookMutation) SetPublisher(s string) {
+	m.publisher = &s
 }
 
-// Date returns the value of the "date" field in the mutation.
-func (m *CollectionMutation) Date() (r string, exists bool) {
-	v := m.date
+// Publisher returns the value of the "publisher" field in the mutation.
+func (m *BookMutation) Publisher() (r string, exists bool) {
+	v := m.publisher
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDate returns the old "date" field's value of the Collection entity.
-// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// OldPublisher returns the old "publisher" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CollectionMutation) OldDate(ctx context.Context) (v string, err error) {
+func (m *BookMutation) OldPublisher(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldPublisher is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDate requires an ID field in the mutation")
+		return v, errors.New("OldPublisher requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldPublisher: %w", err)
 	}
-	return oldValue.Date, nil
+	return oldValue.Publisher, nil
 }
 
-// ClearDate clears the value of the "date" field.
-func (m *CollectionMutation) ClearDate() {
-	m.date = nil
-	m.clearedFields[collection.FieldDate] = struct{}{}
+// ClearPublisher clears the value of the "publisher" field.
+func (m *BookMutation) ClearPublisher() {
+	m.publisher = nil
+	m.clearedFields[book.FieldPublisher] = struct{}{}
 }
 
-// DateCleared returns if the "date" field was cleared in this mutation.
-func (m *CollectionMutation) DateCleared() bool {
-	_, ok := m.clearedFields[collection.FieldDate]
+// PublisherCleared returns if the "publisher" field was cleared in this mutation.
+func (m *BookMutation) PublisherCleared() bool {
+	_, ok := m.clearedFields[book.FieldPublisher]
 	return ok
 }
 
-// ResetDate resets all changes to the "date" field.
-func (m *CollectionMutation) ResetDate() {
-	m.date = nil
-	delete(m.clearedFields, collection.FieldDate)
+// ResetPublisher resets all changes to the "publisher" field.
+func (m *BookMutation) ResetPublisher() {
+	m.publisher = nil
+	delete(m.clearedFields, book.FieldPublisher)
 }
 
-// SetLink sets the "link" field.
-func (m *CollectionMutation) SetLink(s string) {
-	m.link = &s
+// SetPublishDate sets the "publish_date" field.
+func (m *BookMutation) SetPublishDate(s string) {
+	m.publish_date = &s
 }
 
-// Link returns the value of the "link" field in the mutation.
-func (m *CollectionMutation) Link() (r string, exists bool) {
-	v := m.link
+// PublishDate returns the value of the "publish_date" field in the mutation.
+func (m *BookMutation) PublishDate() (r string, exists bool) {
+	v := m.publish_date
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLink returns the old "link" field's value of the Collection entity.
-// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// OldPublishDate returns the old "publish_date" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CollectionMutation) OldLink(ctx context.Context) (v string, err error) {
+func (m *BookMutation) OldPublishDate(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLink is only allowed on UpdateOne operations")
+		return v, errors.New("OldPublishDate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLink requires an ID field in the mutation")
+		return v, errors.New("OldPublishDate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLink: %w", err)
+		return v, fmt.Errorf("querying old value for OldPublishDate: %w", err)
 	}
-	return oldValue.Link, nil
+	return oldValue.PublishDate, nil
 }
 
-// ClearLink clears the value of the "link" field.
-func (m *CollectionMutation) ClearLink() {
-	m.link = nil
-	m.clearedFields[collection.FieldLink] = struct{}{}
+// ClearPublishDate clears the value of the "publish_date" field.
+func (m *BookMutation) ClearPublishDate() {
+	m.publish_date = nil
+	m.clearedFields[book.FieldPublishDate] = struct{}{}
 }
 
-// LinkCleared returns if the "link" field was cleared in this mutation.
-func (m *CollectionMutation) LinkCleared() bool {
-	_, ok := m.clearedFields[collection.FieldLink]
+// PublishDateCleared returns if the "publish_date" field was cleared in this mutation.
+func (m *BookMutation) PublishDateCleared() bool {
+	_, ok := m.clearedFields[book.FieldPublishDate]
 	return ok
 }
 
-// ResetLink resets all changes to the "link" field.
-func (m *CollectionMutation) ResetLink() {
-	m.link = nil
-	delete(m.clearedFields, collection.FieldLink)
+// ResetPublishDate resets all changes to the "publish_date" field.
+func (m *BookMutation) ResetPublishDate() {
+	m.publish_date = nil
+	delete(m.clearedFields, book.FieldPublishDate)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *CollectionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetIsbn sets the "isbn" field.
+func (m *BookMutation) SetIsbn(s string) {
+	m.isbn = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CollectionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Isbn returns the value of the "isbn" field in the mutation.
+func (m *BookMutation) Isbn() (r string, exists bool) {
+	v := m.isbn
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Collection entity.
-// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// OldIsbn returns the old "isbn" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CollectionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BookMutation) OldIsbn(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsbn is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldIsbn requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsbn: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CollectionMutation) ResetCreatedAt() {
-	m.created_at = nil
+	return oldValue.Isbn, nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *CollectionMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ClearIsbn clears the value of the "isbn" field.
+func (m *BookMutation) ClearIsbn() {
+	m.isbn = nil
+	m.clearedFields[book.FieldIsbn] = struct{}{}
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CollectionMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// IsbnCleared returns if the "isbn" field was cleared in this mutation.
+func (m *BookMutation) IsbnCleared() bool {
+	_, ok := m.clearedFields[book.FieldIsbn]
+	return ok
+}
+
+// ResetIsbn resets all changes to the "isbn" field.
+func (m *BookMutation) ResetIsbn() {
+	m.isbn = nil
+	delete(m.clearedFields, book.FieldIsbn)
+}
+
+// SetPages sets the "pages" field.
+func (m *BookMutation) SetPages(i int) {
+	m.pages = &i
+	m.addpages = nil
+}
+
+// Pages returns the value of the "pages" field in the mutation.
+func (m *BookMutation) Pages() (r int, exists bool) {
+	v := m.pages
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Collection entity.
-// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// OldPages returns the old "pages" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CollectionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BookMutation) OldPages(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPages is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldPages requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPages: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Pages, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CollectionMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AddPages adds i to the "pages" field.
+func (m *BookMutation) AddPages(i int) {
+	if m.addpages != nil {
+		*m.addpages += i
+	} else {
+		m.addpages = &i
+	}
 }
 
-// Where appends a list predicates to the CollectionMutation builder.
-func (m *CollectionMutation) Where(ps ...predicate.Collection) {
-	m.predicates = append(m.predicates, ps...)
+// AddedPages returns the value that was added to the "pages" field in this mutation.
+func (m *BookMutation) AddedPages() (r int, exists bool) {
+	v := m.addpages
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the CollectionMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CollectionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Collection, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ClearPages clears the value of the "pages" field.
+func (m *BookMutation) ClearPages() {
+	m.pages = nil
+	m.addpages = nil
+	m.clearedFields[book.FieldPages] = struct{}{}
 }
 
-// Op returns the operation name.
-func (m *CollectionMutation) Op() Op {
-	return m.op
+// PagesCleared returns if the "pages" field was cleared in this mutation.
+func (m *BookMutation) PagesCleared() bool {
+	_, ok := m.clearedFields[book.FieldPages]
+	return ok
 }
 
-// SetOp allows setting the mutation operation.
-func (m *CollectionMutation) SetOp(op Op) {
-	m.op = op
+// ResetPages resets all changes to the "pages" field.
+func (m *BookMutation) ResetPages() {
+	m.pages = nil
+	m.addpages = nil
+	delete(m.clearedFields, book.FieldPages)
 }
 
-// Type returns the node type of this mutation (Collection).
-func (m *CollectionMutation) Type() string {
-	return m.typ
+// SetRating sets the "rating" field.
+func (m *BookMutation) SetRating(f float64) {
+	m.rating = &f
+	m.addrating = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *CollectionMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m._type != nil {
-		fields = append(fields, collection.FieldType)
-	}
-	if m.title != nil {
-		fields = append(fields, collection.FieldTitle)
+// Rating returns the value of the "rating" field in the mutation.
+func (m *BookMutation) Rating() (r float64, exists bool) {
+	v := m.rating
+	if v == nil {
+		return
 	}
-	if m.author != nil {
-		fields = append(fields, collection.FieldAuthor)
+	return *v, true
+}
+
+// OldRating returns the old "rating" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldRating(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRating is only allowed on UpdateOne operations")
 	}
-	if m.cover != nil {
-		fields = append(fields, collection.FieldCover)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRating requires an ID field in the mutation")
 	}
-	if m.date != nil {
-		fields = append(fields, collection.FieldDate)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRating: %w", err)
 	}
-	if m.link != nil {
-		fields = append(fields, collection.FieldLink)
+	return oldValue.Rating, nil
+}
+
+// AddRating adds f to the "rating" field.
+func (m *BookMutation) AddRating(f float64) {
+	if m.addrating != nil {
+		*m.addrating += f
+	} else {
+		m.addrating = &f
 	}
-	if m.created_at != nil {
-		fields = append(fields, collection.FieldCreatedAt)
+}
+
+// AddedRating returns the value that was added to the "rating" field in this mutation.
+func (m *BookMutation) AddedRating() (r float64, exists bool) {
+	v := m.addrating
+	if v == nil {
+		return
 	}
-	if m.updated_at != nil {
-		fields = append(fields, collection.FieldUpdatedAt)
+	return *v, true
+}
+
+// ResetRating resets all changes to the "rating" field.
+func (m *BookMutation) ResetRating() {
+	m.rating = nil
+	m.addrating = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *BookMutation) SetStatus(b book.Status) {
+	m.status = &b
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *BookMutation) Status() (r book.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *CollectionMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case collection.FieldType:
-		return m.GetType()
-	case collection.FieldTitle:
-		return m.Title()
-	case collection.FieldAuthor:
-		return m.Author()
-	case collection.FieldCover:
-		return m.Cover()
-	case collection.FieldDate:
-		return m.Date()
-	case collection.FieldLink:
-		return m.Link()
-	case collection.FieldCreatedAt:
-		return m.CreatedAt()
-	case collection.FieldUpdatedAt:
-		return m.UpdatedAt()
+// OldStatus returns the old "status" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldStatus(ctx context.Context) (v book.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *CollectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case collection.FieldType:
-		return m.OldType(ctx)
-	case collection.FieldTitle:
-		return m.OldTitle(ctx)
-	case collection.FieldAuthor:
-		return m.OldAuthor(ctx)
-	case collection.FieldCover:
-		return m.OldCover(ctx)
-	case collection.FieldDate:
-		return m.OldDate(ctx)
-	case collection.FieldLink:
-		return m.OldLink(ctx)
-	case collection.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case collection.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// ResetStatus resets all changes to the "status" field.
+func (m *BookMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetReview sets the "review" field.
+func (m *BookMutation) SetReview(s string) {
+	m.review = &s
+}
+
+// Review returns the value of the "review" field in the mutation.
+func (m *BookMutation) Review() (r string, exists bool) {
+	v := m.review
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Collection field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CollectionMutation) SetField(name string, value ent.Value) error {
+// OldReview returns the old "review" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldReview(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReview is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReview requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReview: %w", err)
+	}
+	return oldValue.Review, nil
+}
+
+// ClearReview clears the value of the "review" field.
+func (m *BookMutation) ClearReview() {
+	m.review = nil
+	m.clearedFields[book.FieldReview] = struct{}{}
+}
+
+// ReviewCleared returns if the "review" field was cleared in this mutation.
+func (m *BookMutation) ReviewCleared() bool {
+	_, ok := m.clearedFields[book.FieldReview]
+	return ok
+}
+
+// ResetReview resets all changes to the "review" field.
+func (m *BookMutation) ResetReview() {
+	m.review = nil
+	delete(m.clearedFields, book.FieldReview)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BookMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BookMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BookMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BookMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BookMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BookMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCoverImageID sets the "cover_image" edge to the Image entity by id.
+func (m *BookMutation) SetCoverImageID(id int) {
+	m.cover_image = &id
+}
+
+// ClearCoverImage clears the "cover_image" edge to the Image entity.
+func (m *BookMutation) ClearCoverImage() {
+	m.clearedcover_image = true
+}
+
+// CoverImageCleared reports if the "cover_image" edge to the Image entity was cleared.
+func (m *BookMutation) CoverImageCleared() bool {
+	return m.clearedcover_image
+}
+
+// CoverImageID returns the "cover_image" edge ID in the mutation.
+func (m *BookMutation) CoverImageID() (id int, exists bool) {
+	if m.cover_image != nil {
+		return *m.cover_image, true
+	}
+	return
+}
+
+// CoverImageIDs returns the "cover_image" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CoverImageID instead. It exists only for internal usage by the builders.
+func (m *BookMutation) CoverImageIDs() (ids []int) {
+	if id := m.cover_image; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCoverImage resets all changes to the "cover_image" edge.
+func (m *BookMutation) ResetCoverImage() {
+	m.cover_image = nil
+	m.clearedcover_image = false
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *BookMutation) SetOwnerID(id int) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *BookMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *BookMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *BookMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *BookMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *BookMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Where appends a list predicates to the BookMutation builder.
+func (m *BookMutation) Where(ps ...predicate.Book) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BookMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Book, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BookMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BookMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Book).
+func (m *BookMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BookMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.title != nil {
+		fields = append(fields, book.FieldTitle)
+	}
+	if m.author != nil {
+		fields = append(fields, book.FieldAuthor)
+	}
+	if m.desc != nil {
+		fields = append(fields, book.FieldDesc)
+	}
+	if m.cover != nil {
+		fields = append(fields, book.FieldCover)
+	}
+	if m.publisher != nil {
+		fields = append(fields, book.FieldPublisher)
+	}
+	if m.publish_date != nil {
+		fields = append(fields, book.FieldPublishDate)
+	}
+	if m.isbn != nil {
+		fields = append(fields, book.FieldIsbn)
+	}
+	if m.pages != nil {
+		fields = append(fields, book.FieldPages)
+	}
+	if m.rating != nil {
+		fields = append(fields, book.FieldRating)
+	}
+	if m.status != nil {
+		fields = append(fields, book.FieldStatus)
+	}
+	if m.review != nil {
+		fields = append(fields, book.FieldReview)
+	}
+	if m.created_at != nil {
+		fields = append(fields, book.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, book.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BookMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case book.FieldTitle:
+		return m.Title()
+	case book.FieldAuthor:
+		return m.Author()
+	case book.FieldDesc:
+		return m.Desc()
+	case book.FieldCover:
+		return m.Cover()
+	case book.FieldPublisher:
+		return m.Publisher()
+	case book.FieldPublishDate:
+		return m.PublishDate()
+	case book.FieldIsbn:
+		return m.Isbn()
+	case book.FieldPages:
+		return m.Pages()
+	case book.FieldRating:
+		return m.Rating()
+	case book.FieldStatus:
+		return m.Status()
+	case book.FieldReview:
+		return m.Review()
+	case book.FieldCreatedAt:
+		return m.CreatedAt()
+	case book.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case book.FieldTitle:
+		return m.OldTitle(ctx)
+	case book.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case book.FieldDesc:
+		return m.OldDesc(ctx)
+	case book.FieldCover:
+		return m.OldCover(ctx)
+	case book.FieldPublisher:
+		return m.OldPublisher(ctx)
+	case book.FieldPublishDate:
+		return m.OldPublishDate(ctx)
+	case book.FieldIsbn:
+		return m.OldIsbn(ctx)
+	case book.FieldPages:
+		return m.OldPages(ctx)
+	case book.FieldRating:
+		return m.OldRating(ctx)
+	case book.FieldStatus:
+		return m.OldStatus(ctx)
+	case book.FieldReview:
+		return m.OldReview(ctx)
+	case book.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case book.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Book field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BookMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case book.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case book.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case book.FieldDesc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDesc(v)
+		return nil
+	case book.FieldCover:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCover(v)
+		return nil
+	case book.FieldPublisher:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublisher(v)
+		return nil
+	case book.FieldPublishDate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishDate(v)
+		return nil
+	case book.FieldIsbn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsbn(v)
+		return nil
+	case book.FieldPages:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPages(v)
+		return nil
+	case book.FieldRating:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRating(v)
+		return nil
+	case book.FieldStatus:
+		v, ok := value.(book.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case book.FieldReview:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReview(v)
+		return nil
+	case book.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case book.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Book field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BookMutation) AddedFields() []string {
+	var fields []string
+	if m.addpages != nil {
+		fields = append(fields, book.FieldPages)
+	}
+	if m.addrating != nil {
+		fields = append(fields, book.FieldRating)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BookMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case book.FieldPages:
+		return m.AddedPages()
+	case book.FieldRating:
+		return m.AddedRating()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BookMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case book.FieldPages:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPages(v)
+		return nil
+	case book.FieldRating:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRating(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Book numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BookMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(book.FieldDesc) {
+		fields = append(fields, book.FieldDesc)
+	}
+	if m.FieldCleared(book.FieldPublisher) {
+		fields = append(fields, book.FieldPublisher)
+	}
+	if m.FieldCleared(book.FieldPublishDate) {
+		fields = append(fields, book.FieldPublishDate)
+	}
+	if m.FieldCleared(book.FieldIsbn) {
+		fields = append(fields, book.FieldIsbn)
+	}
+	if m.FieldCleared(book.FieldPages) {
+		fields = append(fields, book.FieldPages)
+	}
+	if m.FieldCleared(book.FieldReview) {
+		fields = append(fields, book.FieldReview)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BookMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BookMutation) ClearField(name string) error {
+	switch name {
+	case book.FieldDesc:
+		m.ClearDesc()
+		return nil
+	case book.FieldPublisher:
+		m.ClearPublisher()
+		return nil
+	case book.FieldPublishDate:
+		m.ClearPublishDate()
+		return nil
+	case book.FieldIsbn:
+		m.ClearIsbn()
+		return nil
+	case book.FieldPages:
+		m.ClearPages()
+		return nil
+	case book.FieldReview:
+		m.ClearReview()
+		return nil
+	}
+	return fmt.Errorf("unknown Book nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BookMutation) ResetField(name string) error {
+	switch name {
+	case book.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case book.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case book.FieldDesc:
+		m.ResetDesc()
+		return nil
+	case book.FieldCover:
+		m.ResetCover()
+		return nil
+	case book.FieldPublisher:
+		m.ResetPublisher()
+		return nil
+	case book.FieldPublishDate:
+		m.ResetPublishDate()
+		return nil
+	case book.FieldIsbn:
+		m.ResetIsbn()
+		return nil
+	case book.FieldPages:
+		m.ResetPages()
+		return nil
+	case book.FieldRating:
+		m.ResetRating()
+		return nil
+	case book.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case book.FieldReview:
+		m.ResetReview()
+		return nil
+	case book.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case book.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Book field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cover_image != nil {
+		edges = append(edges, book.EdgeCoverImage)
+	}
+	if m.owner != nil {
+		edges = append(edges, book.EdgeOwner)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BookMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case book.EdgeCoverImage:
+		if id := m.cover_image; id != nil {
+			return []ent.Value{*id}
+		}
+	case book.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BookMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcover_image {
+		edges = append(edges, book.EdgeCoverImage)
+	}
+	if m.clearedowner {
+		edges = append(edges, book.EdgeOwner)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BookMutation) EdgeCleared(name string) bool {
+	switch name {
+	case book.EdgeCoverImage:
+		return m.clearedcover_image
+	case book.EdgeOwner:
+		return m.clearedowner
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BookMutation) ClearEdge(name string) error {
+	switch name {
+	case book.EdgeCoverImage:
+		m.ClearCoverImage()
+		return nil
+	case book.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Book unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BookMutation) ResetEdge(name string) error {
+	switch name {
+	case book.EdgeCoverImage:
+		m.ResetCoverImage()
+		return nil
+	case book.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Book edge %s", name)
+}
+
+// CollectionMutation represents an operation that mutates the Collection nodes in the graph.
+type CollectionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	_type         *string
+	title         *string
+	author        *string
+	cover         *string
+	date          *string
+	link          *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Collection, error)
+	predicates    []predicate.Collection
+}
+
+var _ ent.Mutation = (*CollectionMutation)(nil)
+
+// collectionOption allows management of the mutation configuration using functional options.
+type collectionOption func(*CollectionMutation)
+
+// newCollectionMutation creates new mutation for the Collection entity.
+func newCollectionMutation(c config, op Op, opts ...collectionOption) *CollectionMutation {
+	m := &CollectionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCollection,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCollectionID sets the ID field of the mutation.
+func withCollectionID(id int) collectionOption {
+	return func(m *CollectionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Collection
+		)
+		m.oldValue = func(ctx context.Context) (*Collection, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Collection.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCollection sets the old Collection of the mutation.
+func withCollection(node *Collection) collectionOption {
+	return func(m *CollectionMutation) {
+		m.oldValue = func(context.Context) (*Collection, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CollectionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CollectionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CollectionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CollectionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Collection.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *CollectionMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *CollectionMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *CollectionMutation) ResetType() {
+	m._type = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *CollectionMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *CollectionMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *CollectionMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetAuthor sets the "author" field.
+func (m *CollectionMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *CollectionMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (m *CollectionMutation) ClearAuthor() {
+	m.author = nil
+	m.clearedFields[collection.FieldAuthor] = struct{}{}
+}
+
+// AuthorCleared returns if the "author" field was cleared in this mutation.
+func (m *CollectionMutation) AuthorCleared() bool {
+	_, ok := m.clearedFields[collection.FieldAuthor]
+	return ok
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *CollectionMutation) ResetAuthor() {
+	m.author = nil
+	delete(m.clearedFields, collection.FieldAuthor)
+}
+
+// SetCover sets the "cover" field.
+func (m *CollectionMutation) SetCover(s string) {
+	m.cover = &s
+}
+
+// Cover returns the value of the "cover" field in the mutation.
+func (m *CollectionMutation) Cover() (r string, exists bool) {
+	v := m.cover
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCover returns the old "cover" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldCover(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCover is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCover requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCover: %w", err)
+	}
+	return oldValue.Cover, nil
+}
+
+// ClearCover clears the value of the "cover" field.
+func (m *CollectionMutation) ClearCover() {
+	m.cover = nil
+	m.clearedFields[collection.FieldCover] = struct{}{}
+}
+
+// CoverCleared returns if the "cover" field was cleared in this mutation.
+func (m *CollectionMutation) CoverCleared() bool {
+	_, ok := m.clearedFields[collection.FieldCover]
+	return ok
+}
+
+// ResetCover resets all changes to the "cover" field.
+func (m *CollectionMutation) ResetCover() {
+	m.cover = nil
+	delete(m.clearedFields, collection.FieldCover)
+}
+
+// SetDate sets the "date" field.
+func (m *CollectionMutation) SetDate(s string) {
+	m.date = &s
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *CollectionMutation) Date() (r string, exists bool) {
+	v := m.date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDate returns the old "date" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldDate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+	}
+	return oldValue.Date, nil
+}
+
+// ClearDate clears the value of the "date" field.
+func (m *CollectionMutation) ClearDate() {
+	m.date = nil
+	m.clearedFields[collection.FieldDate] = struct{}{}
+}
+
+// DateCleared returns if the "date" field was cleared in this mutation.
+func (m *CollectionMutation) DateCleared() bool {
+	_, ok := m.clearedFields[collection.FieldDate]
+	return ok
+}
+
+// ResetDate resets all changes to the "date" field.
+func (m *CollectionMutation) ResetDate() {
+	m.date = nil
+	delete(m.clearedFields, collection.FieldDate)
+}
+
+// SetLink sets the "link" field.
+func (m *CollectionMutation) SetLink(s string) {
+	m.link = &s
+}
+
+// Link returns the value of the "link" field in the mutation.
+func (m *CollectionMutation) Link() (r string, exists bool) {
+	v := m.link
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLink returns the old "link" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldLink(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLink is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLink requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLink: %w", err)
+	}
+	return oldValue.Link, nil
+}
+
+// ClearLink clears the value of the "link" field.
+func (m *CollectionMutation) ClearLink() {
+	m.link = nil
+	m.clearedFields[collection.FieldLink] = struct{}{}
+}
+
+// LinkCleared returns if the "link" field was cleared in this mutation.
+func (m *CollectionMutation) LinkCleared() bool {
+	_, ok := m.clearedFields[collection.FieldLink]
+	return ok
+}
+
+// ResetLink resets all changes to the "link" field.
+func (m *CollectionMutation) ResetLink() {
+	m.link = nil
+	delete(m.clearedFields, collection.FieldLink)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CollectionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CollectionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CollectionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CollectionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CollectionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CollectionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the CollectionMutation builder.
+func (m *CollectionMutation) Where(ps ...predicate.Collection) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CollectionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CollectionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Collection, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CollectionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CollectionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Collection).
+func (m *CollectionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CollectionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m._type != nil {
+		fields = append(fields, collection.FieldType)
+	}
+	if m.title != nil {
+		fields = append(fields, collection.FieldTitle)
+	}
+	if m.author != nil {
+		fields = append(fields, collection.FieldAuthor)
+	}
+	if m.cover != nil {
+		fields = append(fields, collection.FieldCover)
+	}
+	if m.date != nil {
+		fields = append(fields, collection.FieldDate)
+	}
+	if m.link != nil {
+		fields = append(fields, collection.FieldLink)
+	}
+	if m.created_at != nil {
+		fields = append(fields, collection.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, collection.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CollectionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case collection.FieldType:
+		return m.GetType()
+	case collection.FieldTitle:
+		return m.Title()
+	case collection.FieldAuthor:
+		return m.Author()
+	case collection.FieldCover:
+		return m.Cover()
+	case collection.FieldDate:
+		return m.Date()
+	case collection.FieldLink:
+		return m.Link()
+	case collection.FieldCreatedAt:
+		return m.CreatedAt()
+	case collection.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CollectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case collection.FieldType:
+		return m.OldType(ctx)
+	case collection.FieldTitle:
+		return m.OldTitle(ctx)
+	case collection.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case collection.FieldCover:
+		return m.OldCover(ctx)
+	case collection.FieldDate:
+		return m.OldDate(ctx)
+	case collection.FieldLink:
+		return m.OldLink(ctx)
+	case collection.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case collection.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Collection field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CollectionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case collection.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case collection.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case collection.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case collection.FieldCover:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCover(v)
+		return nil
+	case collection.FieldDate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDate(v)
+		return nil
+	case collection.FieldLink:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLink(v)
+		return nil
+	case collection.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case collection.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Collection field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CollectionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CollectionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CollectionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Collection numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CollectionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(collection.FieldAuthor) {
+		fields = append(fields, collection.FieldAuthor)
+	}
+	if m.FieldCleared(collection.FieldCover) {
+		fields = append(fields, collection.FieldCover)
+	}
+	if m.FieldCleared(collection.FieldDate) {
+		fields = append(fields, collection.FieldDate)
+	}
+	if m.FieldCleared(collection.FieldLink) {
+		fields = append(fields, collection.FieldLink)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CollectionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CollectionMutation) ClearField(name string) error {
+	switch name {
+	case collection.FieldAuthor:
+		m.ClearAuthor()
+		return nil
+	case collection.FieldCover:
+		m.ClearCover()
+		return nil
+	case collection.FieldDate:
+		m.ClearDate()
+		return nil
+	case collection.FieldLink:
+		m.ClearLink()
+		return nil
+	}
+	return fmt.Errorf("unknown Collection nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CollectionMutation) ResetField(name string) error {
+	switch name {
+	case collection.FieldType:
+		m.ResetType()
+		return nil
+	case collection.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case collection.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case collection.FieldCover:
+		m.ResetCover()
+		return nil
+	case collection.FieldDate:
+		m.ResetDate()
+		return nil
+	case collection.FieldLink:
+		m.ResetLink()
+		return nil
+	case collection.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case collection.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Collection field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CollectionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CollectionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CollectionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CollectionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CollectionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CollectionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CollectionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Collection unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CollectionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Collection edge %s", name)
+}
+
+// CommentMutation represents an operation that mutates the Comment nodes in the graph.
+type CommentMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	content           *string
+	author            *string
+	email             *string
+	website           *string
+	status            *comment.Status
+	previous_status   *comment.PreviousStatus
+	created_at        *time.Time
+	updated_at        *time.Time
+	edited_at         *time.Time
+	avatar            *string
+	moderation_action *comment.ModerationAction
+	moderation_reason *string
+	spam_score        *float64
+	addspam_score     *float64
+	spam_label        *comment.SpamLabel
+	import_id         *string
+	clearedFields     map[string]struct{}
+	post              *int
+	clearedpost       bool
+	user              *int
+	cleareduser       bool
+	parent            *int
+	clearedparent     bool
+	children          map[int]struct{}
+	removedchildren   map[int]struct{}
+	clearedchildren   bool
+	revisions         map[int]struct{}
+	removedrevisions  map[int]struct{}
+	clearedrevisions  bool
+	reactions         map[int]struct{}
+	removedreactions  map[int]struct{}
+	clearedreactions  bool
+	done              bool
+	oldValue          func(context.Context) (*Comment, error)
+	predicates        []predicate.Comment
+}
+
+var _ ent.Mutation = (*CommentMutation)(nil)
+
+// commentOption allows management of the mutation configuration using functional options.
+type commentOption func(*CommentMutation)
+
+// newCommentMutation creates new mutation for the Comment entity.
+func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation {
+	m := &CommentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeComment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCommentID sets the ID field of the mutation.
+func withCommentID(id int) commentOption {
+	return func(m *CommentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Comment
+		)
+		m.oldValue = func(ctx context.Context) (*Comment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Comment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withComment sets the old Comment of the mutation.
+func withComment(node *Comment) commentOption {
+	return func(m *CommentMutation) {
+		m.oldValue = func(context.Context) (*Comment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CommentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CommentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CommentMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CommentMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Comment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetContent sets the "content" field.
+func (m *CommentMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *CommentMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *CommentMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetAuthor sets the "author" field.
+func (m *CommentMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *CommentMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *CommentMutation) ResetAuthor() {
+	m.author = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *CommentMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *CommentMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *CommentMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetWebsite sets the "website" field.
+func (m *CommentMutation) SetWebsite(s string) {
+	m.website = &s
+}
+
+// Website returns the value of the "website" field in the mutation.
+func (m *CommentMutation) Website() (r string, exists bool) {
+	v := m.website
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWebsite returns the old "website" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldWebsite(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebsite is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebsite requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebsite: %w", err)
+	}
+	return oldValue.Website, nil
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (m *CommentMutation) ClearWebsite() {
+	m.website = nil
+	m.clearedFields[comment.FieldWebsite] = struct{}{}
+}
+
+// WebsiteCleared returns if the "website" field was cleared in this mutation.
+func (m *CommentMutation) WebsiteCleared() bool {
+	_, ok := m.clearedFields[comment.FieldWebsite]
+	return ok
+}
+
+// ResetWebsite resets all changes to the "website" field.
+func (m *CommentMutation) ResetWebsite() {
+	m.website = nil
+	delete(m.clearedFields, comment.FieldWebsite)
+}
+
+// SetStatus sets the "status" field.
+func (m *CommentMutation) SetStatus(c comment.Status) {
+	m.status = &c
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *CommentMutation) Status() (r comment.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldStatus(ctx context.Context) (v comment.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *CommentMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetPreviousStatus sets the "previous_status" field.
+func (m *CommentMutation) SetPreviousStatus(cs comment.PreviousStatus) {
+	m.previous_status = &cs
+}
+
+// PreviousStatus returns the value of the "previous_status" field in the mutation.
+func (m *CommentMutation) PreviousStatus() (r comment.PreviousStatus, exists bool) {
+	v := m.previous_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousStatus returns the old "previous_status" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldPreviousStatus(ctx context.Context) (v *comment.PreviousStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousStatus: %w", err)
+	}
+	return oldValue.PreviousStatus, nil
+}
+
+// ClearPreviousStatus clears the value of the "previous_status" field.
+func (m *CommentMutation) ClearPreviousStatus() {
+	m.previous_status = nil
+	m.clearedFields[comment.FieldPreviousStatus] = struct{}{}
+}
+
+// PreviousStatusCleared returns if the "previous_status" field was cleared in this mutation.
+func (m *CommentMutation) PreviousStatusCleared() bool {
+	_, ok := m.clearedFields[comment.FieldPreviousStatus]
+	return ok
+}
+
+// ResetPreviousStatus resets all changes to the "previous_status" field.
+func (m *CommentMutation) ResetPreviousStatus() {
+	m.previous_status = nil
+	delete(m.clearedFields, comment.FieldPreviousStatus)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CommentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CommentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CommentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CommentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CommentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CommentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (m *CommentMutation) SetEditedAt(t time.Time) {
+	m.edited_at = &t
+}
+
+// EditedAt returns the value of the "edited_at" field in the mutation.
+func (m *CommentMutation) EditedAt() (r time.Time, exists bool) {
+	v := m.edited_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditedAt returns the old "edited_at" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldEditedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditedAt: %w", err)
+	}
+	return oldValue.EditedAt, nil
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (m *CommentMutation) ClearEditedAt() {
+	m.edited_at = nil
+	m.clearedFields[comment.FieldEditedAt] = struct{}{}
+}
+
+// EditedAtCleared returns if the "edited_at" field was cleared in this mutation.
+func (m *CommentMutation) EditedAtCleared() bool {
+	_, ok := m.clearedFields[comment.FieldEditedAt]
+	return ok
+}
+
+// ResetEditedAt resets all changes to the "edited_at" field.
+func (m *CommentMutation) ResetEditedAt() {
+	m.edited_at = nil
+	delete(m.clearedFields, comment.FieldEditedAt)
+}
+
+// SetAvatar sets the "avatar" field.
+func (m *CommentMutation) SetAvatar(s string) {
+	m.avatar = &s
+}
+
+// Avatar returns the value of the "avatar" field in the mutation.
+func (m *CommentMutation) Avatar() (r string, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatar returns the old "avatar" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldAvatar(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatar requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+	}
+	return oldValue.Avatar, nil
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (m *CommentMutation) ClearAvatar() {
+	m.avatar = nil
+	m.clearedFields[comment.FieldAvatar] = struct{}{}
+}
+
+// AvatarCleared returns if the "avatar" field was cleared in this mutation.
+func (m *CommentMutation) AvatarCleared() bool {
+	_, ok := m.clearedFields[comment.FieldAvatar]
+	return ok
+}
+
+// ResetAvatar resets all changes to the "avatar" field.
+func (m *CommentMutation) ResetAvatar() {
+	m.avatar = nil
+	delete(m.clearedFields, comment.FieldAvatar)
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *CommentMutation) SetParentID(i int) {
+	m.parent = &i
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *CommentMutation) ParentID() (r int, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldParentID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *CommentMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[comment.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *CommentMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[comment.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *CommentMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, comment.FieldParentID)
+}
+
+// SetModerationAction sets the "moderation_action" field.
+func (m *CommentMutation) SetModerationAction(ca comment.ModerationAction) {
+	m.moderation_action = &ca
+}
+
+// ModerationAction returns the value of the "moderation_action" field in the mutation.
+func (m *CommentMutation) ModerationAction() (r comment.ModerationAction, exists bool) {
+	v := m.moderation_action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModerationAction returns the old "moderation_action" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldModerationAction(ctx context.Context) (v comment.ModerationAction, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModerationAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModerationAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModerationAction: %w", err)
+	}
+	return oldValue.ModerationAction, nil
+}
+
+// ClearModerationAction clears the value of the "moderation_action" field.
+func (m *CommentMutation) ClearModerationAction() {
+	m.moderation_action = nil
+	m.clearedFields[comment.FieldModerationAction] = struct{}{}
+}
+
+// ModerationActionCleared returns if the "moderation_action" field was cleared in this mutation.
+func (m *CommentMutation) ModerationActionCleared() bool {
+	_, ok := m.clearedFields[comment.FieldModerationAction]
+	return ok
+}
+
+// ResetModerationAction resets all changes to the "moderation_action" field.
+func (m *CommentMutation) ResetModerationAction() {
+	m.moderation_action = nil
+	delete(m.clearedFields, comment.FieldModerationAction)
+}
+
+// SetModerationReason sets the "moderation_reason" field.
+func (m *CommentMutation) SetModerationReason(s string) {
+	m.moderation_reason = &s
+}
+
+// ModerationReason returns the value of the "moderation_reason" field in the mutation.
+func (m *CommentMutation) ModerationReason() (r string, exists bool) {
+	v := m.moderation_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModerationReason returns the old "moderation_reason" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldModerationReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModerationReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModerationReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModerationReason: %w", err)
+	}
+	return oldValue.ModerationReason, nil
+}
+
+// ClearModerationReason clears the value of the "moderation_reason" field.
+func (m *CommentMutation) ClearModerationReason() {
+	m.moderation_reason = nil
+	m.clearedFields[comment.FieldModerationReason] = struct{}{}
+}
+
+// ModerationReasonCleared returns if the "moderation_reason" field was cleared in this mutation.
+func (m *CommentMutation) ModerationReasonCleared() bool {
+	_, ok := m.clearedFields[comment.FieldModerationReason]
+	return ok
+}
+
+// ResetModerationReason resets all changes to the "moderation_reason" field.
+func (m *CommentMutation) ResetModerationReason() {
+	m.moderation_reason = nil
+	delete(m.clearedFields, comment.FieldModerationReason)
+}
+
+// SetSpamScore sets the "spam_score" field.
+func (m *CommentMutation) SetSpamScore(f float64) {
+	m.spam_score = &f
+	m.addspam_score = nil
+}
+
+// SpamScore returns the value of the "spam_score" field in the mutation.
+func (m *CommentMutation) SpamScore() (r float64, exists bool) {
+	v := m.spam_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpamScore returns the old "spam_score" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldSpamScore(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpamScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpamScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpamScore: %w", err)
+	}
+	return oldValue.SpamScore, nil
+}
+
+// AddSpamScore adds f to the "spam_score" field.
+func (m *CommentMutation) AddSpamScore(f float64) {
+	if m.addspam_score != nil {
+		*m.addspam_score += f
+	} else {
+		m.addspam_score = &f
+	}
+}
+
+// AddedSpamScore returns the value that was added to the "spam_score" field in this mutation.
+func (m *CommentMutation) AddedSpamScore() (r float64, exists bool) {
+	v := m.addspam_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSpamScore clears the value of the "spam_score" field.
+func (m *CommentMutation) ClearSpamScore() {
+	m.spam_score = nil
+	m.addspam_score = nil
+	m.clearedFields[comment.FieldSpamScore] = struct{}{}
+}
+
+// SpamScoreCleared returns if the "spam_score" field was cleared in this mutation.
+func (m *CommentMutation) SpamScoreCleared() bool {
+	_, ok := m.clearedFields[comment.FieldSpamScore]
+	return ok
+}
+
+// ResetSpamScore resets all changes to the "spam_score" field.
+func (m *CommentMutation) ResetSpamScore() {
+	m.spam_score = nil
+	m.addspam_score = nil
+	delete(m.clearedFields, comment.FieldSpamScore)
+}
+
+// SetSpamLabel sets the "spam_label" field.
+func (m *CommentMutation) SetSpamLabel(cl comment.SpamLabel) {
+	m.spam_label = &cl
+}
+
+// SpamLabel returns the value of the "spam_label" field in the mutation.
+func (m *CommentMutation) SpamLabel() (r comment.SpamLabel, exists bool) {
+	v := m.spam_label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpamLabel returns the old "spam_label" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldSpamLabel(ctx context.Context) (v *comment.SpamLabel, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpamLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpamLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpamLabel: %w", err)
+	}
+	return oldValue.SpamLabel, nil
+}
+
+// ClearSpamLabel clears the value of the "spam_label" field.
+func (m *CommentMutation) ClearSpamLabel() {
+	m.spam_label = nil
+	m.clearedFields[comment.FieldSpamLabel] = struct{}{}
+}
+
+// SpamLabelCleared returns if the "spam_label" field was cleared in this mutation.
+func (m *CommentMutation) SpamLabelCleared() bool {
+	_, ok := m.clearedFields[comment.FieldSpamLabel]
+	return ok
+}
+
+// ResetSpamLabel resets all changes to the "spam_label" field.
+func (m *CommentMutation) ResetSpamLabel() {
+	m.spam_label = nil
+	delete(m.clearedFields, comment.FieldSpamLabel)
+}
+
+// SetImportID sets the "import_id" field.
+func (m *CommentMutation) SetImportID(s string) {
+	m.import_id = &s
+}
+
+// ImportID returns the value of the "import_id" field in the mutation.
+func (m *CommentMutation) ImportID() (r string, exists bool) {
+	v := m.import_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImportID returns the old "import_id" field's value of the Comment entity.
+// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentMutation) OldImportID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImportID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImportID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImportID: %w", err)
+	}
+	return oldValue.ImportID, nil
+}
+
+// ClearImportID clears the value of the "import_id" field.
+func (m *CommentMutation) ClearImportID() {
+	m.import_id = nil
+	m.clearedFields[comment.FieldImportID] = struct{}{}
+}
+
+// ImportIDCleared returns if the "import_id" field was cleared in this mutation.
+func (m *CommentMutation) ImportIDCleared() bool {
+	_, ok := m.clearedFields[comment.FieldImportID]
+	return ok
+}
+
+// ResetImportID resets all changes to the "import_id" field.
+func (m *CommentMutation) ResetImportID() {
+	m.import_id = nil
+	delete(m.clearedFields, comment.FieldImportID)
+}
+
+// SetPostID sets the "post" edge to the Post entity by id.
+func (m *CommentMutation) SetPostID(id int) {
+	m.post = &id
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (m *CommentMutation) ClearPost() {
+	m.clearedpost = true
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *CommentMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostID returns the "post" edge ID in the mutation.
+func (m *CommentMutation) PostID() (id int, exists bool) {
+	if m.post != nil {
+		return *m.post, true
+	}
+	return
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) PostIDs() (ids []int) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPost resets all changes to the "post" edge.
+func (m *CommentMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *CommentMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *CommentMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *CommentMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *CommentMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *CommentMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearParent clears the "parent" edge to the Comment entity.
+func (m *CommentMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[comment.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Comment entity was cleared.
+func (m *CommentMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *CommentMutation) ParentIDs() (ids []int) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *CommentMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Comment entity by ids.
+func (m *CommentMutation) AddChildIDs(ids ...int) {
+	if m.children == nil {
+		m.children = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Comment entity.
+func (m *CommentMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Comment entity was cleared.
+func (m *CommentMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Comment entity by IDs.
+func (m *CommentMutation) RemoveChildIDs(ids ...int) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Comment entity.
+func (m *CommentMutation) RemovedChildrenIDs() (ids []int) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *CommentMutation) ChildrenIDs() (ids []int) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *CommentMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddRevisionIDs adds the "revisions" edge to the CommentRevision entity by ids.
+func (m *CommentMutation) AddRevisionIDs(ids ...int) {
+	if m.revisions == nil {
+		m.revisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRevisions clears the "revisions" edge to the CommentRevision entity.
+func (m *CommentMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the CommentRevision entity was cleared.
+func (m *CommentMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the CommentRevision entity by IDs.
+func (m *CommentMutation) RemoveRevisionIDs(ids ...int) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the CommentRevision entity.
+func (m *CommentMutation) RemovedRevisionsIDs() (ids []int) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *CommentMutation) RevisionsIDs() (ids []int) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *CommentMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
+}
+
+// AddReactionIDs adds the "reactions" edge to the CommentReaction entity by ids.
+func (m *CommentMutation) AddReactionIDs(ids ...int) {
+	if m.reactions == nil {
+		m.reactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.reactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReactions clears the "reactions" edge to the CommentReaction entity.
+func (m *CommentMutation) ClearReactions() {
+	m.clearedreactions = true
+}
+
+// ReactionsCleared reports if the "reactions" edge to the CommentReaction entity was cleared.
+func (m *CommentMutation) ReactionsCleared() bool {
+	return m.clearedreactions
+}
+
+// RemoveReactionIDs removes the "reactions" edge to the CommentReaction entity by IDs.
+func (m *CommentMutation) RemoveReactionIDs(ids ...int) {
+	if m.removedreactions == nil {
+		m.removedreactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.reactions, ids[i])
+		m.removedreactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReactions returns the removed IDs of the "reactions" edge to the CommentReaction entity.
+func (m *CommentMutation) RemovedReactionsIDs() (ids []int) {
+	for id := range m.removedreactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReactionsIDs returns the "reactions" edge IDs in the mutation.
+func (m *CommentMutation) ReactionsIDs() (ids []int) {
+	for id := range m.reactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReactions resets all changes to the "reactions" edge.
+func (m *CommentMutation) ResetReactions() {
+	m.reactions = nil
+	m.clearedreactions = false
+	m.removedreactions = nil
+}
+
+// Where appends a list predicates to the CommentMutation builder.
+func (m *CommentMutation) Where(ps ...predicate.Comment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Comment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CommentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CommentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Comment).
+func (m *CommentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CommentMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.content != nil {
+		fields = append(fields, comment.FieldContent)
+	}
+	if m.author != nil {
+		fields = append(fields, comment.FieldAuthor)
+	}
+	if m.email != nil {
+		fields = append(fields, comment.FieldEmail)
+	}
+	if m.website != nil {
+		fields = append(fields, comment.FieldWebsite)
+	}
+	if m.status != nil {
+		fields = append(fields, comment.FieldStatus)
+	}
+	if m.previous_status != nil {
+		fields = append(fields, comment.FieldPreviousStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, comment.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, comment.FieldUpdatedAt)
+	}
+	if m.edited_at != nil {
+		fields = append(fields, comment.FieldEditedAt)
+	}
+	if m.avatar != nil {
+		fields = append(fields, comment.FieldAvatar)
+	}
+	if m.parent != nil {
+		fields = append(fields, comment.FieldParentID)
+	}
+	if m.moderation_action != nil {
+		fields = append(fields, comment.FieldModerationAction)
+	}
+	if m.moderation_reason != nil {
+		fields = append(fields, comment.FieldModerationReason)
+	}
+	if m.spam_score != nil {
+		fields = append(fields, comment.FieldSpamScore)
+	}
+	if m.spam_label != nil {
+		fields = append(fields, comment.FieldSpamLabel)
+	}
+	if m.import_id != nil {
+		fields = append(fields, comment.FieldImportID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CommentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case comment.FieldContent:
+		return m.Content()
+	case comment.FieldAuthor:
+		return m.Author()
+	case comment.FieldEmail:
+		return m.Email()
+	case comment.FieldWebsite:
+		return m.Website()
+	case comment.FieldStatus:
+		return m.Status()
+	case comment.FieldPreviousStatus:
+		return m.PreviousStatus()
+	case comment.FieldCreatedAt:
+		return m.CreatedAt()
+	case comment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case comment.FieldEditedAt:
+		return m.EditedAt()
+	case comment.FieldAvatar:
+		return m.Avatar()
+	case comment.FieldParentID:
+		return m.ParentID()
+	case comment.FieldModerationAction:
+		return m.ModerationAction()
+	case comment.FieldModerationReason:
+		return m.ModerationReason()
+	case comment.FieldSpamScore:
+		return m.SpamScore()
+	case comment.FieldSpamLabel:
+		return m.SpamLabel()
+	case comment.FieldImportID:
+		return m.ImportID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case comment.FieldContent:
+		return m.OldContent(ctx)
+	case comment.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case comment.FieldEmail:
+		return m.OldEmail(ctx)
+	case comment.FieldWebsite:
+		return m.OldWebsite(ctx)
+	case comment.FieldStatus:
+		return m.OldStatus(ctx)
+	case comment.FieldPreviousStatus:
+		return m.OldPreviousStatus(ctx)
+	case comment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case comment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case comment.FieldEditedAt:
+		return m.OldEditedAt(ctx)
+	case comment.FieldAvatar:
+		return m.OldAvatar(ctx)
+	case comment.FieldParentID:
+		return m.OldParentID(ctx)
+	case comment.FieldModerationAction:
+		return m.OldModerationAction(ctx)
+	case comment.FieldModerationReason:
+		return m.OldModerationReason(ctx)
+	case comment.FieldSpamScore:
+		return m.OldSpamScore(ctx)
+	case comment.FieldSpamLabel:
+		return m.OldSpamLabel(ctx)
+	case comment.FieldImportID:
+		return m.OldImportID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Comment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case comment.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case comment.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case comment.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case comment.FieldWebsite:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebsite(v)
+		return nil
+	case comment.FieldStatus:
+		v, ok := value.(comment.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case comment.FieldPreviousStatus:
+		v, ok := value.(comment.PreviousStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousStatus(v)
+		return nil
+	case comment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case comment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case comment.FieldEditedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditedAt(v)
+		return nil
+	case comment.FieldAvatar:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatar(v)
+		return nil
+	case comment.FieldParentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case comment.FieldModerationAction:
+		v, ok := value.(comment.ModerationAction)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModerationAction(v)
+		return nil
+	case comment.FieldModerationReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModerationReason(v)
+		return nil
+	case comment.FieldSpamScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpamScore(v)
+		return nil
+	case comment.FieldSpamLabel:
+		v, ok := value.(comment.SpamLabel)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpamLabel(v)
+		return nil
+	case comment.FieldImportID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImportID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Comment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CommentMutation) AddedFields() []string {
+	var fields []string
+	if m.addspam_score != nil {
+		fields = append(fields, comment.FieldSpamScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case comment.FieldSpamScore:
+		return m.AddedSpamScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case comment.FieldSpamScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpamScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Comment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CommentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(comment.FieldWebsite) {
+		fields = append(fields, comment.FieldWebsite)
+	}
+	if m.FieldCleared(comment.FieldPreviousStatus) {
+		fields = append(fields, comment.FieldPreviousStatus)
+	}
+	if m.FieldCleared(comment.FieldEditedAt) {
+		fields = append(fields, comment.FieldEditedAt)
+	}
+	if m.FieldCleared(comment.FieldAvatar) {
+		fields = append(fields, comment.FieldAvatar)
+	}
+	if m.FieldCleared(comment.FieldParentID) {
+		fields = append(fields, comment.FieldParentID)
+	}
+	if m.FieldCleared(comment.FieldModerationAction) {
+		fields = append(fields, comment.FieldModerationAction)
+	}
+	if m.FieldCleared(comment.FieldModerationReason) {
+		fields = append(fields, comment.FieldModerationReason)
+	}
+	if m.FieldCleared(comment.FieldSpamScore) {
+		fields = append(fields, comment.FieldSpamScore)
+	}
+	if m.FieldCleared(comment.FieldSpamLabel) {
+		fields = append(fields, comment.FieldSpamLabel)
+	}
+	if m.FieldCleared(comment.FieldImportID) {
+		fields = append(fields, comment.FieldImportID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CommentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CommentMutation) ClearField(name string) error {
+	switch name {
+	case comment.FieldWebsite:
+		m.ClearWebsite()
+		return nil
+	case comment.FieldPreviousStatus:
+		m.ClearPreviousStatus()
+		return nil
+	case comment.FieldEditedAt:
+		m.ClearEditedAt()
+		return nil
+	case comment.FieldAvatar:
+		m.ClearAvatar()
+		return nil
+	case comment.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case comment.FieldModerationAction:
+		m.ClearModerationAction()
+		return nil
+	case comment.FieldModerationReason:
+		m.ClearModerationReason()
+		return nil
+	case comment.FieldSpamScore:
+		m.ClearSpamScore()
+		return nil
+	case comment.FieldSpamLabel:
+		m.ClearSpamLabel()
+		return nil
+	case comment.FieldImportID:
+		m.ClearImportID()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CommentMutation) ResetField(name string) error {
+	switch name {
+	case comment.FieldContent:
+		m.ResetContent()
+		return nil
+	case comment.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case comment.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case comment.FieldWebsite:
+		m.ResetWebsite()
+		return nil
+	case comment.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case comment.FieldPreviousStatus:
+		m.ResetPreviousStatus()
+		return nil
+	case comment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case comment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case comment.FieldEditedAt:
+		m.ResetEditedAt()
+		return nil
+	case comment.FieldAvatar:
+		m.ResetAvatar()
+		return nil
+	case comment.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case comment.FieldModerationAction:
+		m.ResetModerationAction()
+		return nil
+	case comment.FieldModerationReason:
+		m.ResetModerationReason()
+		return nil
+	case comment.FieldSpamScore:
+		m.ResetSpamScore()
+		return nil
+	case comment.FieldSpamLabel:
+		m.ResetSpamLabel()
+		return nil
+	case comment.FieldImportID:
+		m.ResetImportID()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CommentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.post != nil {
+		edges = append(edges, comment.EdgePost)
+	}
+	if m.user != nil {
+		edges = append(edges, comment.EdgeUser)
+	}
+	if m.parent != nil {
+		edges = append(edges, comment.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, comment.EdgeChildren)
+	}
+	if m.revisions != nil {
+		edges = append(edges, comment.EdgeRevisions)
+	}
+	if m.reactions != nil {
+		edges = append(edges, comment.EdgeReactions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CommentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case comment.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	case comment.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case comment.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case comment.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case comment.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case comment.EdgeReactions:
+		ids := make([]ent.Value, 0, len(m.reactions))
+		for id := range m.reactions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CommentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedchildren != nil {
+		edges = append(edges, comment.EdgeChildren)
+	}
+	if m.removedrevisions != nil {
+		edges = append(edges, comment.EdgeRevisions)
+	}
+	if m.removedreactions != nil {
+		edges = append(edges, comment.EdgeReactions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case comment.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case comment.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case comment.EdgeReactions:
+		ids := make([]ent.Value, 0, len(m.removedreactions))
+		for id := range m.removedreactions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CommentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedpost {
+		edges = append(edges, comment.EdgePost)
+	}
+	if m.cleareduser {
+		edges = append(edges, comment.EdgeUser)
+	}
+	if m.clearedparent {
+		edges = append(edges, comment.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, comment.EdgeChildren)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, comment.EdgeRevisions)
+	}
+	if m.clearedreactions {
+		edges = append(edges, comment.EdgeReactions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CommentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case comment.EdgePost:
+		return m.clearedpost
+	case comment.EdgeUser:
+		return m.cleareduser
+	case comment.EdgeParent:
+		return m.clearedparent
+	case comment.EdgeChildren:
+		return m.clearedchildren
+	case comment.EdgeRevisions:
+		return m.clearedrevisions
+	case comment.EdgeReactions:
+		return m.clearedreactions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CommentMutation) ClearEdge(name string) error {
+	switch name {
+	case comment.EdgePost:
+		m.ClearPost()
+		return nil
+	case comment.EdgeUser:
+		m.ClearUser()
+		return nil
+	case comment.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CommentMutation) ResetEdge(name string) error {
+	switch name {
+	case comment.EdgePost:
+		m.ResetPost()
+		return nil
+	case comment.EdgeUser:
+		m.ResetUser()
+		return nil
+	case comment.EdgeParent:
+		m.ResetParent()
+		return nil
+	case comment.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case comment.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	case comment.EdgeReactions:
+		m.ResetReactions()
+		return nil
+	}
+	return fmt.Errorf("unknown Comment edge %s", name)
+}
+
+// CommentReactionMutation represents an operation that mutates the CommentReaction nodes in the graph.
+type CommentReactionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	emoji          *string
+	actor          *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	comment        *int
+	clearedcomment bool
+	user           *int
+	cleareduser    bool
+	done           bool
+	oldValue       func(context.Context) (*CommentReaction, error)
+	predicates     []predicate.CommentReaction
+}
+
+var _ ent.Mutation = (*CommentReactionMutation)(nil)
+
+// commentreactionOption allows management of the mutation configuration using functional options.
+type commentreactionOption func(*CommentReactionMutation)
+
+// newCommentReactionMutation creates new mutation for the CommentReaction entity.
+func newCommentReactionMutation(c config, op Op, opts ...commentreactionOption) *CommentReactionMutation {
+	m := &CommentReactionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCommentReaction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCommentReactionID sets the ID field of the mutation.
+func withCommentReactionID(id int) commentreactionOption {
+	return func(m *CommentReactionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CommentReaction
+		)
+		m.oldValue = func(ctx context.Context) (*CommentReaction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CommentReaction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCommentReaction sets the old CommentReaction of the mutation.
+func withCommentReaction(node *CommentReaction) commentreactionOption {
+	return func(m *CommentReactionMutation) {
+		m.oldValue = func(context.Context) (*CommentReaction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CommentReactionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CommentReactionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CommentReactionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CommentReactionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CommentReaction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCommentID sets the "comment_id" field.
+func (m *CommentReactionMutation) SetCommentID(i int) {
+	m.comment = &i
+}
+
+// CommentID returns the value of the "comment_id" field in the mutation.
+func (m *CommentReactionMutation) CommentID() (r int, exists bool) {
+	v := m.comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommentID returns the old "comment_id" field's value of the CommentReaction entity.
+// If the CommentReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentReactionMutation) OldCommentID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommentID: %w", err)
+	}
+	return oldValue.CommentID, nil
+}
+
+// ResetCommentID resets all changes to the "comment_id" field.
+func (m *CommentReactionMutation) ResetCommentID() {
+	m.comment = nil
+}
+
+// SetEmoji sets the "emoji" field.
+func (m *CommentReactionMutation) SetEmoji(s string) {
+	m.emoji = &s
+}
+
+// Emoji returns the value of the "emoji" field in the mutation.
+func (m *CommentReactionMutation) Emoji() (r string, exists bool) {
+	v := m.emoji
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmoji returns the old "emoji" field's value of the CommentReaction entity.
+// If the CommentReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentReactionMutation) OldEmoji(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmoji is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmoji requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmoji: %w", err)
+	}
+	return oldValue.Emoji, nil
+}
+
+// ResetEmoji resets all changes to the "emoji" field.
+func (m *CommentReactionMutation) ResetEmoji() {
+	m.emoji = nil
+}
+
+// SetActor sets the "actor" field.
+func (m *CommentReactionMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *CommentReactionMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the CommentReaction entity.
+// If the CommentReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentReactionMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *CommentReactionMutation) ResetActor() {
+	m.actor = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CommentReactionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CommentReactionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CommentReaction entity.
+// If the CommentReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentReactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CommentReactionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearComment clears the "comment" edge to the Comment entity.
+func (m *CommentReactionMutation) ClearComment() {
+	m.clearedcomment = true
+	m.clearedFields[commentreaction.FieldCommentID] = struct{}{}
+}
+
+// CommentCleared reports if the "comment" edge to the Comment entity was cleared.
+func (m *CommentReactionMutation) CommentCleared() bool {
+	return m.clearedcomment
+}
+
+// CommentIDs returns the "comment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CommentID instead. It exists only for internal usage by the builders.
+func (m *CommentReactionMutation) CommentIDs() (ids []int) {
+	if id := m.comment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetComment resets all changes to the "comment" edge.
+func (m *CommentReactionMutation) ResetComment() {
+	m.comment = nil
+	m.clearedcomment = false
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *CommentReactionMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *CommentReactionMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *CommentReactionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *CommentReactionMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *CommentReactionMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *CommentReactionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the CommentReactionMutation builder.
+func (m *CommentReactionMutation) Where(ps ...predicate.CommentReaction) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CommentReactionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CommentReactionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CommentReaction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CommentReactionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CommentReactionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CommentReaction).
+func (m *CommentReactionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CommentReactionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.comment != nil {
+		fields = append(fields, commentreaction.FieldCommentID)
+	}
+	if m.emoji != nil {
+		fields = append(fields, commentreaction.FieldEmoji)
+	}
+	if m.actor != nil {
+		fields = append(fields, commentreaction.FieldActor)
+	}
+	if m.created_at != nil {
+		fields = append(fields, commentreaction.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CommentReactionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case commentreaction.FieldCommentID:
+		return m.CommentID()
+	case commentreaction.FieldEmoji:
+		return m.Emoji()
+	case commentreaction.FieldActor:
+		return m.Actor()
+	case commentreaction.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CommentReactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case commentreaction.FieldCommentID:
+		return m.OldCommentID(ctx)
+	case commentreaction.FieldEmoji:
+		return m.OldEmoji(ctx)
+	case commentreaction.FieldActor:
+		return m.OldActor(ctx)
+	case commentreaction.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CommentReaction field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentReactionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case commentreaction.FieldCommentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommentID(v)
+		return nil
+	case commentreaction.FieldEmoji:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmoji(v)
+		return nil
+	case commentreaction.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case commentreaction.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CommentReaction field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CommentReactionMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CommentReactionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentReactionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CommentReaction numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CommentReactionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CommentReactionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CommentReactionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CommentReaction nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CommentReactionMutation) ResetField(name string) error {
+	switch name {
+	case commentreaction.FieldCommentID:
+		m.ResetCommentID()
+		return nil
+	case commentreaction.FieldEmoji:
+		m.ResetEmoji()
+		return nil
+	case commentreaction.FieldActor:
+		m.ResetActor()
+		return nil
+	case commentreaction.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CommentReaction field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CommentReactionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.comment != nil {
+		edges = append(edges, commentreaction.EdgeComment)
+	}
+	if m.user != nil {
+		edges = append(edges, commentreaction.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CommentReactionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case commentreaction.EdgeComment:
+		if id := m.comment; id != nil {
+			return []ent.Value{*id}
+		}
+	case commentreaction.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CommentReactionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CommentReactionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CommentReactionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcomment {
+		edges = append(edges, commentreaction.EdgeComment)
+	}
+	if m.cleareduser {
+		edges = append(edges, commentreaction.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CommentReactionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case commentreaction.EdgeComment:
+		return m.clearedcomment
+	case commentreaction.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CommentReactionMutation) ClearEdge(name string) error {
+	switch name {
+	case commentreaction.EdgeComment:
+		m.ClearComment()
+		return nil
+	case commentreaction.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown CommentReaction unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CommentReactionMutation) ResetEdge(name string) error {
+	switch name {
+	case commentreaction.EdgeComment:
+		m.ResetComment()
+		return nil
+	case commentreaction.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown CommentReaction edge %s", name)
+}
+
+// CommentRevisionMutation represents an operation that mutates the CommentRevision nodes in the graph.
+type CommentRevisionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	content        *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	comment        *int
+	clearedcomment bool
+	done           bool
+	oldValue       func(context.Context) (*CommentRevision, error)
+	predicates     []predicate.CommentRevision
+}
+
+var _ ent.Mutation = (*CommentRevisionMutation)(nil)
+
+// commentrevisionOption allows management of the mutation configuration using functional options.
+type commentrevisionOption func(*CommentRevisionMutation)
+
+// newCommentRevisionMutation creates new mutation for the CommentRevision entity.
+func newCommentRevisionMutation(c config, op Op, opts ...commentrevisionOption) *CommentRevisionMutation {
+	m := &CommentRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCommentRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCommentRevisionID sets the ID field of the mutation.
+func withCommentRevisionID(id int) commentrevisionOption {
+	return func(m *CommentRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CommentRevision
+		)
+		m.oldValue = func(ctx context.Context) (*CommentRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CommentRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCommentRevision sets the old CommentRevision of the mutation.
+func withCommentRevision(node *CommentRevision) commentrevisionOption {
+	return func(m *CommentRevisionMutation) {
+		m.oldValue = func(context.Context) (*CommentRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CommentRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CommentRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CommentRevisionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CommentRevisionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CommentRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetContent sets the "content" field.
+func (m *CommentRevisionMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *CommentRevisionMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the CommentRevision entity.
+// If the CommentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentRevisionMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *CommentRevisionMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CommentRevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CommentRevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CommentRevision entity.
+// If the CommentRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommentRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CommentRevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetCommentID sets the "comment" edge to the Comment entity by id.
+func (m *CommentRevisionMutation) SetCommentID(id int) {
+	m.comment = &id
+}
+
+// ClearComment clears the "comment" edge to the Comment entity.
+func (m *CommentRevisionMutation) ClearComment() {
+	m.clearedcomment = true
+}
+
+// CommentCleared reports if the "comment" edge to the Comment entity was cleared.
+func (m *CommentRevisionMutation) CommentCleared() bool {
+	return m.clearedcomment
+}
+
+// CommentID returns the "comment" edge ID in the mutation.
+func (m *CommentRevisionMutation) CommentID() (id int, exists bool) {
+	if m.comment != nil {
+		return *m.comment, true
+	}
+	return
+}
+
+// CommentIDs returns the "comment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CommentID instead. It exists only for internal usage by the builders.
+func (m *CommentRevisionMutation) CommentIDs() (ids []int) {
+	if id := m.comment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetComment resets all changes to the "comment" edge.
+func (m *CommentRevisionMutation) ResetComment() {
+	m.comment = nil
+	m.clearedcomment = false
+}
+
+// Where appends a list predicates to the CommentRevisionMutation builder.
+func (m *CommentRevisionMutation) Where(ps ...predicate.CommentRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CommentRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CommentRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CommentRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CommentRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CommentRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CommentRevision).
+func (m *CommentRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CommentRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.content != nil {
+		fields = append(fields, commentrevision.FieldContent)
+	}
+	if m.created_at != nil {
+		fields = append(fields, commentrevision.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CommentRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case commentrevision.FieldContent:
+		return m.Content()
+	case commentrevision.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CommentRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case commentrevision.FieldContent:
+		return m.OldContent(ctx)
+	case commentrevision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CommentRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case commentrevision.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case commentrevision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CommentRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CommentRevisionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CommentRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CommentRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CommentRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CommentRevisionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CommentRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CommentRevisionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CommentRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CommentRevisionMutation) ResetField(name string) error {
+	switch name {
+	case commentrevision.FieldContent:
+		m.ResetContent()
+		return nil
+	case commentrevision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CommentRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CommentRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.comment != nil {
+		edges = append(edges, commentrevision.EdgeComment)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CommentRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case commentrevision.EdgeComment:
+		if id := m.comment; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CommentRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CommentRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CommentRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcomment {
+		edges = append(edges, commentrevision.EdgeComment)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CommentRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case commentrevision.EdgeComment:
+		return m.clearedcomment
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CommentRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case commentrevision.EdgeComment:
+		m.ClearComment()
+		return nil
+	}
+	return fmt.Errorf("unknown CommentRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CommentRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case commentrevision.EdgeComment:
+		m.ResetComment()
+		return nil
+	}
+	return fmt.Errorf("unknown CommentRevision edge %s", name)
+}
+
+// EmailSubscriptionMutation represents an operation that mutates the EmailSubscription nodes in the graph.
+type EmailSubscriptionMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	email           *string
+	unsubscribed    *bool
+	unsubscribed_at *time.Time
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*EmailSubscription, error)
+	predicates      []predicate.EmailSubscription
+}
+
+var _ ent.Mutation = (*EmailSubscriptionMutation)(nil)
+
+// emailsubscriptionOption allows management of the mutation configuration using functional options.
+type emailsubscriptionOption func(*EmailSubscriptionMutation)
+
+// newEmailSubscriptionMutation creates new mutation for the EmailSubscription entity.
+func newEmailSubscriptionMutation(c config, op Op, opts ...emailsubscriptionOption) *EmailSubscriptionMutation {
+	m := &EmailSubscriptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEmailSubscription,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEmailSubscriptionID sets the ID field of the mutation.
+func withEmailSubscriptionID(id int) emailsubscriptionOption {
+	return func(m *EmailSubscriptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EmailSubscription
+		)
+		m.oldValue = func(ctx context.Context) (*EmailSubscription, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EmailSubscription.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEmailSubscription sets the old EmailSubscription of the mutation.
+func withEmailSubscription(node *EmailSubscription) emailsubscriptionOption {
+	return func(m *EmailSubscriptionMutation) {
+		m.oldValue = func(context.Context) (*EmailSubscription, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EmailSubscriptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EmailSubscriptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EmailSubscriptionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EmailSubscriptionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EmailSubscription.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEmail sets the "email" field.
+func (m *EmailSubscriptionMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *EmailSubscriptionMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the EmailSubscription entity.
+// If the EmailSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailSubscriptionMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *EmailSubscriptionMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetUnsubscribed sets the "unsubscribed" field.
+func (m *EmailSubscriptionMutation) SetUnsubscribed(b bool) {
+	m.unsubscribed = &b
+}
+
+// Unsubscribed returns the value of the "unsubscribed" field in the mutation.
+func (m *EmailSubscriptionMutation) Unsubscribed() (r bool, exists bool) {
+	v := m.unsubscribed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnsubscribed returns the old "unsubscribed" field's value of the EmailSubscription entity.
+// If the EmailSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailSubscriptionMutation) OldUnsubscribed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnsubscribed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnsubscribed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnsubscribed: %w", err)
+	}
+	return oldValue.Unsubscribed, nil
+}
+
+// ResetUnsubscribed resets all changes to the "unsubscribed" field.
+func (m *EmailSubscriptionMutation) ResetUnsubscribed() {
+	m.unsubscribed = nil
+}
+
+// SetUnsubscribedAt sets the "unsubscribed_at" field.
+func (m *EmailSubscriptionMutation) SetUnsubscribedAt(t time.Time) {
+	m.unsubscribed_at = &t
+}
+
+// UnsubscribedAt returns the value of the "unsubscribed_at" field in the mutation.
+func (m *EmailSubscriptionMutation) UnsubscribedAt() (r time.Time, exists bool) {
+	v := m.unsubscribed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnsubscribedAt returns the old "unsubscribed_at" field's value of the EmailSubscription entity.
+// If the EmailSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailSubscriptionMutation) OldUnsubscribedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnsubscribedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnsubscribedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnsubscribedAt: %w", err)
+	}
+	return oldValue.UnsubscribedAt, nil
+}
+
+// ClearUnsubscribedAt clears the value of the "unsubscribed_at" field.
+func (m *EmailSubscriptionMutation) ClearUnsubscribedAt() {
+	m.unsubscribed_at = nil
+	m.clearedFields[emailsubscription.FieldUnsubscribedAt] = struct{}{}
+}
+
+// UnsubscribedAtCleared returns if the "unsubscribed_at" field was cleared in this mutation.
+func (m *EmailSubscriptionMutation) UnsubscribedAtCleared() bool {
+	_, ok := m.clearedFields[emailsubscription.FieldUnsubscribedAt]
+	return ok
+}
+
+// ResetUnsubscribedAt resets all changes to the "unsubscribed_at" field.
+func (m *EmailSubscriptionMutation) ResetUnsubscribedAt() {
+	m.unsubscribed_at = nil
+	delete(m.clearedFields, emailsubscription.FieldUnsubscribedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EmailSubscriptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EmailSubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EmailSubscription entity.
+// If the EmailSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailSubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EmailSubscriptionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EmailSubscriptionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EmailSubscriptionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the EmailSubscription entity.
+// If the EmailSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailSubscriptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EmailSubscriptionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the EmailSubscriptionMutation builder.
+func (m *EmailSubscriptionMutation) Where(ps ...predicate.EmailSubscription) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EmailSubscriptionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EmailSubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EmailSubscription, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EmailSubscriptionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EmailSubscriptionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EmailSubscription).
+func (m *EmailSubscriptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EmailSubscriptionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.email != nil {
+		fields = append(fields, emailsubscription.FieldEmail)
+	}
+	if m.unsubscribed != nil {
+		fields = append(fields, emailsubscription.FieldUnsubscribed)
+	}
+	if m.unsubscribed_at != nil {
+		fields = append(fields, emailsubscription.FieldUnsubscribedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, emailsubscription.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, emailsubscription.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EmailSubscriptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case emailsubscription.FieldEmail:
+		return m.Email()
+	case emailsubscription.FieldUnsubscribed:
+		return m.Unsubscribed()
+	case emailsubscription.FieldUnsubscribedAt:
+		return m.UnsubscribedAt()
+	case emailsubscription.FieldCreatedAt:
+		return m.CreatedAt()
+	case emailsubscription.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EmailSubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case emailsubscription.FieldEmail:
+		return m.OldEmail(ctx)
+	case emailsubscription.FieldUnsubscribed:
+		return m.OldUnsubscribed(ctx)
+	case emailsubscription.FieldUnsubscribedAt:
+		return m.OldUnsubscribedAt(ctx)
+	case emailsubscription.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case emailsubscription.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EmailSubscription field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailSubscriptionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case emailsubscription.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case emailsubscription.FieldUnsubscribed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnsubscribed(v)
+		return nil
+	case emailsubscription.FieldUnsubscribedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnsubscribedAt(v)
+		return nil
+	case emailsubscription.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case emailsubscription.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmailSubscription field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EmailSubscriptionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EmailSubscriptionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailSubscriptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EmailSubscription numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EmailSubscriptionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(emailsubscription.FieldUnsubscribedAt) {
+		fields = append(fields, emailsubscription.FieldUnsubscribedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EmailSubscriptionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EmailSubscriptionMutation) ClearField(name string) error {
+	switch name {
+	case emailsubscription.FieldUnsubscribedAt:
+		m.ClearUnsubscribedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailSubscription nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EmailSubscriptionMutation) ResetField(name string) error {
+	switch name {
+	case emailsubscription.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case emailsubscription.FieldUnsubscribed:
+		m.ResetUnsubscribed()
+		return nil
+	case emailsubscription.FieldUnsubscribedAt:
+		m.ResetUnsubscribedAt()
+		return nil
+	case emailsubscription.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case emailsubscription.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailSubscription field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EmailSubscriptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EmailSubscriptionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EmailSubscriptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EmailSubscriptionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EmailSubscriptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EmailSubscriptionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EmailSubscriptionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EmailSubscription unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EmailSubscriptionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EmailSubscription edge %s", name)
+}
+
+// FriendMutation represents an operation that mutates the Friend nodes in the graph.
+type FriendMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	url           *string
+	avatar        *string
+	desc          *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Friend, error)
+	predicates    []predicate.Friend
+}
+
+var _ ent.Mutation = (*FriendMutation)(nil)
+
+// friendOption allows management of the mutation configuration using functional options.
+type friendOption func(*FriendMutation)
+
+// newFriendMutation creates new mutation for the Friend entity.
+func newFriendMutation(c config, op Op, opts ...friendOption) *FriendMutation {
+	m := &FriendMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFriend,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFriendID sets the ID field of the mutation.
+func withFriendID(id int) friendOption {
+	return func(m *FriendMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Friend
+		)
+		m.oldValue = func(ctx context.Context) (*Friend, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Friend.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFriend sets the old Friend of the mutation.
+func withFriend(node *Friend) friendOption {
+	return func(m *FriendMutation) {
+		m.oldValue = func(context.Context) (*Friend, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FriendMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FriendMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FriendMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FriendMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Friend.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *FriendMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *FriendMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Friend entity.
+// If the Friend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FriendMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *FriendMutation) ResetName() {
+	m.name = nil
+}
+
+// SetURL sets the "url" field.
+func (m *FriendMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *FriendMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Friend entity.
+// If the Friend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FriendMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *FriendMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetAvatar sets the "avatar" field.
+func (m *FriendMutation) SetAvatar(s string) {
+	m.avatar = &s
+}
+
+// Avatar returns the value of the "avatar" field in the mutation.
+func (m *FriendMutation) Avatar() (r string, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatar returns the old "avatar" field's value of the Friend entity.
+// If the Friend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FriendMutation) OldAvatar(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatar requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+	}
+	return oldValue.Avatar, nil
+}
+
+// ResetAvatar resets all changes to the "avatar" field.
+func (m *FriendMutation) ResetAvatar() {
+	m.avatar = nil
+}
+
+// SetDesc sets the "desc" field.
+func (m *FriendMutation) SetDesc(s string) {
+	m.desc = &s
+}
+
+// Desc returns the value of the "desc" field in the mutation.
+func (m *FriendMutation) Desc() (r string, exists bool) {
+	v := m.desc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDesc returns the old "desc" field's value of the Friend entity.
+// If the Friend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FriendMutation) OldDesc(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDesc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDesc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDesc: %w", err)
+	}
+	return oldValue.Desc, nil
+}
+
+// ClearDesc clears the value of the "desc" field.
+func (m *FriendMutation) ClearDesc() {
+	m.desc = nil
+	m.clearedFields[friend.FieldDesc] = struct{}{}
+}
+
+// DescCleared returns if the "desc" field was cleared in this mutation.
+func (m *FriendMutation) DescCleared() bool {
+	_, ok := m.clearedFields[friend.FieldDesc]
+	return ok
+}
+
+// ResetDesc resets all changes to the "desc" field.
+func (m *FriendMutation) ResetDesc() {
+	m.desc = nil
+	delete(m.clearedFields, friend.FieldDesc)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FriendMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FriendMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Friend entity.
+// If the Friend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FriendMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FriendMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FriendMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FriendMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Friend entity.
+// If the Friend object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FriendMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FriendMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the FriendMutation builder.
+func (m *FriendMutation) Where(ps ...predicate.Friend) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FriendMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FriendMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Friend, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FriendMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FriendMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Friend).
+func (m *FriendMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FriendMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, friend.FieldName)
+	}
+	if m.url != nil {
+		fields = append(fields, friend.FieldURL)
+	}
+	if m.avatar != nil {
+		fields = append(fields, friend.FieldAvatar)
+	}
+	if m.desc != nil {
+		fields = append(fields, friend.FieldDesc)
+	}
+	if m.created_at != nil {
+		fields = append(fields, friend.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, friend.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FriendMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case friend.FieldName:
+		return m.Name()
+	case friend.FieldURL:
+		return m.URL()
+	case friend.FieldAvatar:
+		return m.Avatar()
+	case friend.FieldDesc:
+		return m.Desc()
+	case friend.FieldCreatedAt:
+		return m.CreatedAt()
+	case friend.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FriendMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case friend.FieldName:
+		return m.OldName(ctx)
+	case friend.FieldURL:
+		return m.OldURL(ctx)
+	case friend.FieldAvatar:
+		return m.OldAvatar(ctx)
+	case friend.FieldDesc:
+		return m.OldDesc(ctx)
+	case friend.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case friend.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Friend field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FriendMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case friend.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case friend.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case friend.FieldAvatar:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatar(v)
+		return nil
+	case friend.FieldDesc:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDesc(v)
+		return nil
+	case friend.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case friend.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Friend field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FriendMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FriendMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FriendMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Friend numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FriendMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(friend.FieldDesc) {
+		fields = append(fields, friend.FieldDesc)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FriendMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FriendMutation) ClearField(name string) error {
+	switch name {
+	case friend.FieldDesc:
+		m.ClearDesc()
+		return nil
+	}
+	return fmt.Errorf("unknown Friend nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FriendMutation) ResetField(name string) error {
+	switch name {
+	case friend.FieldName:
+		m.ResetName()
+		return nil
+	case friend.FieldURL:
+		m.ResetURL()
+		return nil
+	case friend.FieldAvatar:
+		m.ResetAvatar()
+		return nil
+	case friend.FieldDesc:
+		m.ResetDesc()
+		return nil
+	case friend.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case friend.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Friend field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FriendMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FriendMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FriendMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FriendMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FriendMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FriendMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FriendMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Friend unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FriendMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Friend edge %s", name)
+}
+
+// HitokotoMutation represents an operation that mutates the Hitokoto nodes in the graph.
+type HitokotoMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	content       *string
+	source        *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Hitokoto, error)
+	predicates    []predicate.Hitokoto
+}
+
+var _ ent.Mutation = (*HitokotoMutation)(nil)
+
+// hitokotoOption allows management of the mutation configuration using functional options.
+type hitokotoOption func(*HitokotoMutation)
+
+// newHitokotoMutation creates new mutation for the Hitokoto entity.
+func newHitokotoMutation(c config, op Op, opts ...hitokotoOption) *HitokotoMutation {
+	m := &HitokotoMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHitokoto,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHitokotoID sets the ID field of the mutation.
+func withHitokotoID(id int) hitokotoOption {
+	return func(m *HitokotoMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Hitokoto
+		)
+		m.oldValue = func(ctx context.Context) (*Hitokoto, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Hitokoto.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHitokoto sets the old Hitokoto of the mutation.
+func withHitokoto(node *Hitokoto) hitokotoOption {
+	return func(m *HitokotoMutation) {
+		m.oldValue = func(context.Context) (*Hitokoto, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HitokotoMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HitokotoMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HitokotoMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HitokotoMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Hitokoto.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetContent sets the "content" field.
+func (m *HitokotoMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *HitokotoMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Hitokoto entity.
+// If the Hitokoto object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HitokotoMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *HitokotoMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetSource sets the "source" field.
+func (m *HitokotoMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *HitokotoMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Hitokoto entity.
+// If the Hitokoto object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HitokotoMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ClearSource clears the value of the "source" field.
+func (m *HitokotoMutation) ClearSource() {
+	m.source = nil
+	m.clearedFields[hitokoto.FieldSource] = struct{}{}
+}
+
+// SourceCleared returns if the "source" field was cleared in this mutation.
+func (m *HitokotoMutation) SourceCleared() bool {
+	_, ok := m.clearedFields[hitokoto.FieldSource]
+	return ok
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *HitokotoMutation) ResetSource() {
+	m.source = nil
+	delete(m.clearedFields, hitokoto.FieldSource)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *HitokotoMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *HitokotoMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Hitokoto entity.
+// If the Hitokoto object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HitokotoMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *HitokotoMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *HitokotoMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *HitokotoMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Hitokoto entity.
+// If the Hitokoto object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HitokotoMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *HitokotoMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the HitokotoMutation builder.
+func (m *HitokotoMutation) Where(ps ...predicate.Hitokoto) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HitokotoMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HitokotoMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Hitokoto, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HitokotoMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HitokotoMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Hitokoto).
+func (m *HitokotoMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HitokotoMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.content != nil {
+		fields = append(fields, hitokoto.FieldContent)
+	}
+	if m.source != nil {
+		fields = append(fields, hitokoto.FieldSource)
+	}
+	if m.created_at != nil {
+		fields = append(fields, hitokoto.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, hitokoto.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HitokotoMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case hitokoto.FieldContent:
+		return m.Content()
+	case hitokoto.FieldSource:
+		return m.Source()
+	case hitokoto.FieldCreatedAt:
+		return m.CreatedAt()
+	case hitokoto.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HitokotoMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case hitokoto.FieldContent:
+		return m.OldContent(ctx)
+	case hitokoto.FieldSource:
+		return m.OldSource(ctx)
+	case hitokoto.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case hitokoto.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Hitokoto field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HitokotoMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case hitokoto.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case hitokoto.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case hitokoto.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case hitokoto.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Hitokoto field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HitokotoMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HitokotoMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HitokotoMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Hitokoto numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HitokotoMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(hitokoto.FieldSource) {
+		fields = append(fields, hitokoto.FieldSource)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HitokotoMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HitokotoMutation) ClearField(name string) error {
+	switch name {
+	case hitokoto.FieldSource:
+		m.ClearSource()
+		return nil
+	}
+	return fmt.Errorf("unknown Hitokoto nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HitokotoMutation) ResetField(name string) error {
+	switch name {
+	case hitokoto.FieldContent:
+		m.ResetContent()
+		return nil
+	case hitokoto.FieldSource:
+		m.ResetSource()
+		return nil
+	case hitokoto.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case hitokoto.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Hitokoto field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HitokotoMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HitokotoMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HitokotoMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HitokotoMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HitokotoMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HitokotoMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HitokotoMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Hitokoto unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HitokotoMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Hitokoto edge %s", name)
+}
+
+// IdentityMutation represents an operation that mutates the Identity nodes in the graph.
+type IdentityMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	provider         *string
+	provider_user_id *string
+	login            *string
+	email            *string
+	avatar           *string
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	user             *int
+	cleareduser      bool
+	done             bool
+	oldValue         func(context.Context) (*Identity, error)
+	predicates       []predicate.Identity
+}
+
+var _ ent.Mutation = (*IdentityMutation)(nil)
+
+// identityOption allows management of the mutation configuration using functional options.
+type identityOption func(*IdentityMutation)
+
+// newIdentityMutation creates new mutation for the Identity entity.
+func newIdentityMutation(c config, op Op, opts ...identityOption) *IdentityMutation {
+	m := &IdentityMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeIdentity,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withIdentityID sets the ID field of the mutation.
+func withIdentityID(id int) identityOption {
+	return func(m *IdentityMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Identity
+		)
+		m.oldValue = func(ctx context.Context) (*Identity, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Identity.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withIdentity sets the old Identity of the mutation.
+func withIdentity(node *Identity) identityOption {
+	return func(m *IdentityMutation) {
+		m.oldValue = func(context.Context) (*Identity, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m IdentityMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m IdentityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *IdentityMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *IdentityMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Identity.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProvider sets the "provider" field.
+func (m *IdentityMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *IdentityMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *IdentityMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetProviderUserID sets the "provider_user_id" field.
+func (m *IdentityMutation) SetProviderUserID(s string) {
+	m.provider_user_id = &s
+}
+
+// ProviderUserID returns the value of the "provider_user_id" field in the mutation.
+func (m *IdentityMutation) ProviderUserID() (r string, exists bool) {
+	v := m.provider_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProviderUserID returns the old "provider_user_id" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldProviderUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProviderUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProviderUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProviderUserID: %w", err)
+	}
+	return oldValue.ProviderUserID, nil
+}
+
+// ResetProviderUserID resets all changes to the "provider_user_id" field.
+func (m *IdentityMutation) ResetProviderUserID() {
+	m.provider_user_id = nil
+}
+
+// SetLogin sets the "login" field.
+func (m *IdentityMutation) SetLogin(s string) {
+	m.login = &s
+}
+
+// Login returns the value of the "login" field in the mutation.
+func (m *IdentityMutation) Login() (r string, exists bool) {
+	v := m.login
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogin returns the old "login" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldLogin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogin: %w", err)
+	}
+	return oldValue.Login, nil
+}
+
+// ClearLogin clears the value of the "login" field.
+func (m *IdentityMutation) ClearLogin() {
+	m.login = nil
+	m.clearedFields[identity.FieldLogin] = struct{}{}
+}
+
+// LoginCleared returns if the "login" field was cleared in this mutation.
+func (m *IdentityMutation) LoginCleared() bool {
+	_, ok := m.clearedFields[identity.FieldLogin]
+	return ok
+}
+
+// ResetLogin resets all changes to the "login" field.
+func (m *IdentityMutation) ResetLogin() {
+	m.login = nil
+	delete(m.clearedFields, identity.FieldLogin)
+}
+
+// SetEmail sets the "email" field.
+func (m *IdentityMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *IdentityMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of the "email" field.
+func (m *IdentityMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[identity.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *IdentityMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[identity.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *IdentityMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, identity.FieldEmail)
+}
+
+// SetAvatar sets the "avatar" field.
+func (m *IdentityMutation) SetAvatar(s string) {
+	m.avatar = &s
+}
+
+// Avatar returns the value of the "avatar" field in the mutation.
+func (m *IdentityMutation) Avatar() (r string, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatar returns the old "avatar" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldAvatar(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatar requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+	}
+	return oldValue.Avatar, nil
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (m *IdentityMutation) ClearAvatar() {
+	m.avatar = nil
+	m.clearedFields[identity.FieldAvatar] = struct{}{}
+}
+
+// AvatarCleared returns if the "avatar" field was cleared in this mutation.
+func (m *IdentityMutation) AvatarCleared() bool {
+	_, ok := m.clearedFields[identity.FieldAvatar]
+	return ok
+}
+
+// ResetAvatar resets all changes to the "avatar" field.
+func (m *IdentityMutation) ResetAvatar() {
+	m.avatar = nil
+	delete(m.clearedFields, identity.FieldAvatar)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *IdentityMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *IdentityMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *IdentityMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *IdentityMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *IdentityMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Identity entity.
+// If the Identity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *IdentityMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *IdentityMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *IdentityMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *IdentityMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *IdentityMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *IdentityMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *IdentityMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *IdentityMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the IdentityMutation builder.
+func (m *IdentityMutation) Where(ps ...predicate.Identity) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the IdentityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *IdentityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Identity, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *IdentityMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *IdentityMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Identity).
+func (m *IdentityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *IdentityMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.provider != nil {
+		fields = append(fields, identity.FieldProvider)
+	}
+	if m.provider_user_id != nil {
+		fields = append(fields, identity.FieldProviderUserID)
+	}
+	if m.login != nil {
+		fields = append(fields, identity.FieldLogin)
+	}
+	if m.email != nil {
+		fields = append(fields, identity.FieldEmail)
+	}
+	if m.avatar != nil {
+		fields = append(fields, identity.FieldAvatar)
+	}
+	if m.created_at != nil {
+		fields = append(fields, identity.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, identity.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *IdentityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case identity.FieldProvider:
+		return m.Provider()
+	case identity.FieldProviderUserID:
+		return m.ProviderUserID()
+	case identity.FieldLogin:
+		return m.Login()
+	case identity.FieldEmail:
+		return m.Email()
+	case identity.FieldAvatar:
+		return m.Avatar()
+	case identity.FieldCreatedAt:
+		return m.CreatedAt()
+	case identity.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *IdentityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case identity.FieldProvider:
+		return m.OldProvider(ctx)
+	case identity.FieldProviderUserID:
+		return m.OldProviderUserID(ctx)
+	case identity.FieldLogin:
+		return m.OldLogin(ctx)
+	case identity.FieldEmail:
+		return m.OldEmail(ctx)
+	case identity.FieldAvatar:
+		return m.OldAvatar(ctx)
+	case identity.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case identity.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Identity field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdentityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case identity.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case identity.FieldProviderUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProviderUserID(v)
+		return nil
+	case identity.FieldLogin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogin(v)
+		return nil
+	case identity.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case identity.FieldAvatar:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatar(v)
+		return nil
+	case identity.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case identity.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Identity field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *IdentityMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *IdentityMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *IdentityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Identity numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *IdentityMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(identity.FieldLogin) {
+		fields = append(fields, identity.FieldLogin)
+	}
+	if m.FieldCleared(identity.FieldEmail) {
+		fields = append(fields, identity.FieldEmail)
+	}
+	if m.FieldCleared(identity.FieldAvatar) {
+		fields = append(fields, identity.FieldAvatar)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *IdentityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *IdentityMutation) ClearField(name string) error {
+	switch name {
+	case identity.FieldLogin:
+		m.ClearLogin()
+		return nil
+	case identity.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case identity.FieldAvatar:
+		m.ClearAvatar()
+		return nil
+	}
+	return fmt.Errorf("unknown Identity nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *IdentityMutation) ResetField(name string) error {
+	switch name {
+	case identity.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case identity.FieldProviderUserID:
+		m.ResetProviderUserID()
+		return nil
+	case identity.FieldLogin:
+		m.ResetLogin()
+		return nil
+	case identity.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case identity.FieldAvatar:
+		m.ResetAvatar()
+		return nil
+	case identity.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case identity.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Identity field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *IdentityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, identity.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *IdentityMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case identity.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *IdentityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *IdentityMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *IdentityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, identity.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *IdentityMutation) EdgeCleared(name string) bool {
+	switch name {
+	case identity.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *IdentityMutation) ClearEdge(name string) error {
+	switch name {
+	case identity.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Identity unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *IdentityMutation) ResetEdge(name string) error {
+	switch name {
+	case identity.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Identity edge %s", name)
+}
+
+// ImageMutation represents an operation that mutates the Image nodes in the graph.
+type ImageMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	filename           *string
+	url                *string
+	size               *int64
+	addsize            *int64
+	_type              *string
+	created_at         *time.Time
+	width              *int
+	addwidth           *int
+	height             *int
+	addheight          *int
+	clearedFields      map[string]struct{}
+	uploaded_by        *int
+	cleareduploaded_by bool
+	books              map[int]struct{}
+	removedbooks       map[int]struct{}
+	clearedbooks       bool
+	done               bool
+	oldValue           func(context.Context) (*Image, error)
+	predicates         []predicate.Image
+}
+
+var _ ent.Mutation = (*ImageMutation)(nil)
+
+// imageOption allows management of the mutation configuration using functional options.
+type imageOption func(*ImageMutation)
+
+// newImageMutation creates new mutation for the Image entity.
+func newImageMutation(c config, op Op, opts ...imageOption) *ImageMutation {
+	m := &ImageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeImage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withImageID sets the ID field of the mutation.
+func withImageID(id int) imageOption {
+	return func(m *ImageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Image
+		)
+		m.oldValue = func(ctx context.Context) (*Image, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Image.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withImage sets the old Image of the mutation.
+func withImage(node *Image) imageOption {
+	return func(m *ImageMutation) {
+		m.oldValue = func(context.Context) (*Image, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ImageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ImageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ImageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ImageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Image.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFilename sets the "filename" field.
+func (m *ImageMutation) SetFilename(s string) {
+	m.filename = &s
+}
+
+// Filename returns the value of the "filename" field in the mutation.
+func (m *ImageMutation) Filename() (r string, exists bool) {
+	v := m.filename
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilename returns the old "filename" field's value of the Image entity.
+// If the Image object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImageMutation) OldFilename(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilename requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+	}
+	return oldValue.Filename, nil
+}
+
+// ResetFilename resets all changes to the "filename" field.
+func (m *ImageMutation) ResetFilename() {
+	m.filename = nil
+}
+
+// SetURL sets the "url" field.
+func (m *ImageMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *ImageMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Image entity.
+// If the Image object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImageMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *ImageMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetSize sets the "size" field.
+func (m *ImageMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *ImageMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the Image entity.
+// If the Image object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImageMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *ImageMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *ImageMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *ImageMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetType sets the "type" field.
+func (m *ImageMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ImageMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Image entity.
+// If the Image object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImageMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ImageMutation) ResetType() {
+	m._type = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ImageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ImageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Image entity.
+// If the Image object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ImageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetWidth sets the "width" field.
+func (m *ImageMutation) SetWidth(i int) {
+	m.width = &i
+	m.addwidth = nil
+}
+
+// Width returns the value of the "width" field in the mutation.
+func (m *ImageMutation) Width() (r int, exists bool) {
+	v := m.width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWidth returns the old "width" field's value of the Image entity.
+// If the Image object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImageMutation) OldWidth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
+}
+
+// AddWidth adds i to the "width" field.
+func (m *ImageMutation) AddWidth(i int) {
+	if m.addwidth != nil {
+		*m.addwidth += i
+	} else {
+		m.addwidth = &i
+	}
+}
+
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *ImageMutation) AddedWidth() (r int, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWidth resets all changes to the "width" field.
+func (m *ImageMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+}
+
+// SetHeight sets the "height" field.
+func (m *ImageMutation) SetHeight(i int) {
+	m.height = &i
+	m.addheight = nil
+}
+
+// Height returns the value of the "height" field in the mutation.
+func (m *ImageMutation) Height() (r int, exists bool) {
+	v := m.height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeight returns the old "height" field's value of the Image entity.
+// If the Image object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ImageMutation) OldHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	}
+	return oldValue.Height, nil
+}
+
+// AddHeight adds i to the "height" field.
+func (m *ImageMutation) AddHeight(i int) {
+	if m.addheight != nil {
+		*m.addheight += i
+	} else {
+		m.addheight = &i
+	}
+}
+
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *ImageMutation) AddedHeight() (r int, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHeight resets all changes to the "height" field.
+func (m *ImageMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+}
+
+// SetUploadedByID sets the "uploaded_by" edge to the User entity by id.
+func (m *ImageMutation) SetUploadedByID(id int) {
+	m.uploaded_by = &id
+}
+
+// ClearUploadedBy clears the "uploaded_by" edge to the User entity.
+func (m *ImageMutation) ClearUploadedBy() {
+	m.cleareduploaded_by = true
+}
+
+// UploadedByCleared reports if the "uploaded_by" edge to the User entity was cleared.
+func (m *ImageMutation) UploadedByCleared() bool {
+	return m.cleareduploaded_by
+}
+
+// UploadedByID returns the "uploaded_by" edge ID in the mutation.
+func (m *ImageMutation) UploadedByID() (id int, exists bool) {
+	if m.uploaded_by != nil {
+		return *m.uploaded_by, true
+	}
+	return
+}
+
+// UploadedByIDs returns the "uploaded_by" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UploadedByID instead. It exists only for internal usage by the builders.
+func (m *ImageMutation) UploadedByIDs() (ids []int) {
+	if id := m.uploaded_by; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUploadedBy resets all changes to the "uploaded_by" edge.
+func (m *ImageMutation) ResetUploadedBy() {
+	m.uploaded_by = nil
+	m.cleareduploaded_by = false
+}
+
+// AddBookIDs adds the "books" edge to the Book entity by ids.
+func (m *ImageMutation) AddBookIDs(ids ...int) {
+	if m.books == nil {
+		m.books = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.books[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBooks clears the "books" edge to the Book entity.
+func (m *ImageMutation) ClearBooks() {
+	m.clearedbooks = true
+}
+
+// BooksCleared reports if the "books" edge to the Book entity was cleared.
+func (m *ImageMutation) BooksCleared() bool {
+	return m.clearedbooks
+}
+
+// RemoveBookIDs removes the "books" edge to the Book entity by IDs.
+func (m *ImageMutation) RemoveBookIDs(ids ...int) {
+	if m.removedbooks == nil {
+		m.removedbooks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.books, ids[i])
+		m.removedbooks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBooks returns the removed IDs of the "books" edge to the Book entity.
+func (m *ImageMutation) RemovedBooksIDs() (ids []int) {
+	for id := range m.removedbooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BooksIDs returns the "books" edge IDs in the mutation.
+func (m *ImageMutation) BooksIDs() (ids []int) {
+	for id := range m.books {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBooks resets all changes to the "books" edge.
+func (m *ImageMutation) ResetBooks() {
+	m.books = nil
+	m.clearedbooks = false
+	m.removedbooks = nil
+}
+
+// Where appends a list predicates to the ImageMutation builder.
+func (m *ImageMutation) Where(ps ...predicate.Image) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ImageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ImageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Image, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ImageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ImageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Image).
+func (m *ImageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ImageMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.filename != nil {
+		fields = append(fields, image.FieldFilename)
+	}
+	if m.url != nil {
+		fields = append(fields, image.FieldURL)
+	}
+	if m.size != nil {
+		fields = append(fields, image.FieldSize)
+	}
+	if m._type != nil {
+		fields = append(fields, image.FieldType)
+	}
+	if m.created_at != nil {
+		fields = append(fields, image.FieldCreatedAt)
+	}
+	if m.width != nil {
+		fields = append(fields, image.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, image.FieldHeight)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ImageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case image.FieldFilename:
+		return m.Filename()
+	case image.FieldURL:
+		return m.URL()
+	case image.FieldSize:
+		return m.Size()
+	case image.FieldType:
+		return m.GetType()
+	case image.FieldCreatedAt:
+		return m.CreatedAt()
+	case image.FieldWidth:
+		return m.Width()
+	case image.FieldHeight:
+		return m.Height()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ImageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case image.FieldFilename:
+		return m.OldFilename(ctx)
+	case image.FieldURL:
+		return m.OldURL(ctx)
+	case image.FieldSize:
+		return m.OldSize(ctx)
+	case image.FieldType:
+		return m.OldType(ctx)
+	case image.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case image.FieldWidth:
+		return m.OldWidth(ctx)
+	case image.FieldHeight:
+		return m.OldHeight(ctx)
+	}
+	return nil, fmt.Errorf("unknown Image field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case image.FieldFilename:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilename(v)
+		return nil
+	case image.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case image.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case image.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case image.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case image.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case image.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Image field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ImageMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, image.FieldSize)
+	}
+	if m.addwidth != nil {
+		fields = append(fields, image.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, image.FieldHeight)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ImageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case image.FieldSize:
+		return m.AddedSize()
+	case image.FieldWidth:
+		return m.AddedWidth()
+	case image.FieldHeight:
+		return m.AddedHeight()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ImageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case image.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case image.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case image.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Image numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ImageMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ImageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ImageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Image nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ImageMutation) ResetField(name string) error {
+	switch name {
+	case image.FieldFilename:
+		m.ResetFilename()
+		return nil
+	case image.FieldURL:
+		m.ResetURL()
+		return nil
+	case image.FieldSize:
+		m.ResetSize()
+		return nil
+	case image.FieldType:
+		m.ResetType()
+		return nil
+	case image.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case image.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case image.FieldHeight:
+		m.ResetHeight()
+		return nil
+	}
+	return fmt.Errorf("unknown Image field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ImageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.uploaded_by != nil {
+		edges = append(edges, image.EdgeUploadedBy)
+	}
+	if m.books != nil {
+		edges = append(edges, image.EdgeBooks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ImageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case image.EdgeUploadedBy:
+		if id := m.uploaded_by; id != nil {
+			return []ent.Value{*id}
+		}
+	case image.EdgeBooks:
+		ids := make([]ent.Value, 0, len(m.books))
+		for id := range m.books {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ImageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedbooks != nil {
+		edges = append(edges, image.EdgeBooks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ImageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case image.EdgeBooks:
+		ids := make([]ent.Value, 0, len(m.removedbooks))
+		for id := range m.removedbooks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ImageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduploaded_by {
+		edges = append(edges, image.EdgeUploadedBy)
+	}
+	if m.clearedbooks {
+		edges = append(edges, image.EdgeBooks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ImageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case image.EdgeUploadedBy:
+		return m.cleareduploaded_by
+	case image.EdgeBooks:
+		return m.clearedbooks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ImageMutation) ClearEdge(name string) error {
+	switch name {
+	case image.EdgeUploadedBy:
+		m.ClearUploadedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Image unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ImageMutation) ResetEdge(name string) error {
+	switch name {
+	case image.EdgeUploadedBy:
+		m.ResetUploadedBy()
+		return nil
+	case image.EdgeBooks:
+		m.ResetBooks()
+		return nil
+	}
+	return fmt.Errorf("unknown Image edge %s", name)
+}
+
+// InvitationMutation represents an operation that mutates the Invitation nodes in the graph.
+type InvitationMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	code_hash      *string
+	email          *string
+	expires_at     *time.Time
+	used_at        *time.Time
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	creator        *int
+	clearedcreator bool
+	invitee        *int
+	clearedinvitee bool
+	done           bool
+	oldValue       func(context.Context) (*Invitation, error)
+	predicates     []predicate.Invitation
+}
+
+var _ ent.Mutation = (*InvitationMutation)(nil)
+
+// invitationOption allows management of the mutation configuration using functional options.
+type invitationOption func(*InvitationMutation)
+
+// newInvitationMutation creates new mutation for the Invitation entity.
+func newInvitationMutation(c config, op Op, opts ...invitationOption) *InvitationMutation {
+	m := &InvitationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInvitation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withInvitationID sets the ID field of the mutation.
+func withInvitationID(id int) invitationOption {
+	return func(m *InvitationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Invitation
+		)
+		m.oldValue = func(ctx context.Context) (*Invitation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Invitation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withInvitation sets the old Invitation of the mutation.
+func withInvitation(node *Invitation) invitationOption {
+	return func(m *InvitationMutation) {
+		m.oldValue = func(context.Context) (*Invitation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InvitationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InvitationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *InvitationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *InvitationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Invitation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (m *InvitationMutation) SetCodeHash(s string) {
+	m.code_hash = &s
+}
+
+// CodeHash returns the value of the "code_hash" field in the mutation.
+func (m *InvitationMutation) CodeHash() (r string, exists bool) {
+	v := m.code_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCodeHash returns the old "code_hash" field's value of the Invitation entity.
+// If the Invitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationMutation) OldCodeHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCodeHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCodeHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCodeHash: %w", err)
+	}
+	return oldValue.CodeHash, nil
+}
+
+// ResetCodeHash resets all changes to the "code_hash" field.
+func (m *InvitationMutation) ResetCodeHash() {
+	m.code_hash = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *InvitationMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *InvitationMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Invitation entity.
+// If the Invitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of the "email" field.
+func (m *InvitationMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[invitation.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *InvitationMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[invitation.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *InvitationMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, invitation.FieldEmail)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *InvitationMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *InvitationMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Invitation entity.
+// If the Invitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *InvitationMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[invitation.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *InvitationMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[invitation.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *InvitationMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, invitation.FieldExpiresAt)
+}
+
+// SetUsedAt sets the "used_at" field.
+func (m *InvitationMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
+}
+
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *InvitationMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsedAt returns the old "used_at" field's value of the Invitation entity.
+// If the Invitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+	}
+	return oldValue.UsedAt, nil
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *InvitationMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[invitation.FieldUsedAt] = struct{}{}
+}
+
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *InvitationMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[invitation.FieldUsedAt]
+	return ok
+}
+
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *InvitationMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, invitation.FieldUsedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *InvitationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *InvitationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Invitation entity.
+// If the Invitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InvitationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *InvitationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by id.
+func (m *InvitationMutation) SetCreatorID(id int) {
+	m.creator = &id
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (m *InvitationMutation) ClearCreator() {
+	m.clearedcreator = true
+}
+
+// CreatorCleared reports if the "creator" edge to the User entity was cleared.
+func (m *InvitationMutation) CreatorCleared() bool {
+	return m.clearedcreator
+}
+
+// CreatorID returns the "creator" edge ID in the mutation.
+func (m *InvitationMutation) CreatorID() (id int, exists bool) {
+	if m.creator != nil {
+		return *m.creator, true
+	}
+	return
+}
+
+// CreatorIDs returns the "creator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatorID instead. It exists only for internal usage by the builders.
+func (m *InvitationMutation) CreatorIDs() (ids []int) {
+	if id := m.creator; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCreator resets all changes to the "creator" edge.
+func (m *InvitationMutation) ResetCreator() {
+	m.creator = nil
+	m.clearedcreator = false
+}
+
+// SetInviteeID sets the "invitee" edge to the User entity by id.
+func (m *InvitationMutation) SetInviteeID(id int) {
+	m.invitee = &id
+}
+
+// ClearInvitee clears the "invitee" edge to the User entity.
+func (m *InvitationMutation) ClearInvitee() {
+	m.clearedinvitee = true
+}
+
+// InviteeCleared reports if the "invitee" edge to the User entity was cleared.
+func (m *InvitationMutation) InviteeCleared() bool {
+	return m.clearedinvitee
+}
+
+// InviteeID returns the "invitee" edge ID in the mutation.
+func (m *InvitationMutation) InviteeID() (id int, exists bool) {
+	if m.invitee != nil {
+		return *m.invitee, true
+	}
+	return
+}
+
+// InviteeIDs returns the "invitee" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// InviteeID instead. It exists only for internal usage by the builders.
+func (m *InvitationMutation) InviteeIDs() (ids []int) {
+	if id := m.invitee; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetInvitee resets all changes to the "invitee" edge.
+func (m *InvitationMutation) ResetInvitee() {
+	m.invitee = nil
+	m.clearedinvitee = false
+}
+
+// Where appends a list predicates to the InvitationMutation builder.
+func (m *InvitationMutation) Where(ps ...predicate.Invitation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the InvitationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InvitationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Invitation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *InvitationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *InvitationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Invitation).
+func (m *InvitationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InvitationMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.code_hash != nil {
+		fields = append(fields, invitation.FieldCodeHash)
+	}
+	if m.email != nil {
+		fields = append(fields, invitation.FieldEmail)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, invitation.FieldExpiresAt)
+	}
+	if m.used_at != nil {
+		fields = append(fields, invitation.FieldUsedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, invitation.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *InvitationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case invitation.FieldCodeHash:
+		return m.CodeHash()
+	case invitation.FieldEmail:
+		return m.Email()
+	case invitation.FieldExpiresAt:
+		return m.ExpiresAt()
+	case invitation.FieldUsedAt:
+		return m.UsedAt()
+	case invitation.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *InvitationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case invitation.FieldCodeHash:
+		return m.OldCodeHash(ctx)
+	case invitation.FieldEmail:
+		return m.OldEmail(ctx)
+	case invitation.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case invitation.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case invitation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Invitation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InvitationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case invitation.FieldCodeHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCodeHash(v)
+		return nil
+	case invitation.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case invitation.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case invitation.FieldUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedAt(v)
+		return nil
+	case invitation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Invitation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *InvitationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *InvitationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *InvitationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Invitation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *InvitationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(invitation.FieldEmail) {
+		fields = append(fields, invitation.FieldEmail)
+	}
+	if m.FieldCleared(invitation.FieldExpiresAt) {
+		fields = append(fields, invitation.FieldExpiresAt)
+	}
+	if m.FieldCleared(invitation.FieldUsedAt) {
+		fields = append(fields, invitation.FieldUsedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *InvitationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InvitationMutation) ClearField(name string) error {
+	switch name {
+	case invitation.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case invitation.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case invitation.FieldUsedAt:
+		m.ClearUsedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Invitation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *InvitationMutation) ResetField(name string) error {
+	switch name {
+	case invitation.FieldCodeHash:
+		m.ResetCodeHash()
+		return nil
+	case invitation.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case invitation.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case invitation.FieldUsedAt:
+		m.ResetUsedAt()
+		return nil
+	case invitation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Invitation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *InvitationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.creator != nil {
+		edges = append(edges, invitation.EdgeCreator)
+	}
+	if m.invitee != nil {
+		edges = append(edges, invitation.EdgeInvitee)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *InvitationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case invitation.EdgeCreator:
+		if id := m.creator; id != nil {
+			return []ent.Value{*id}
+		}
+	case invitation.EdgeInvitee:
+		if id := m.invitee; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *InvitationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *InvitationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *InvitationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcreator {
+		edges = append(edges, invitation.EdgeCreator)
+	}
+	if m.clearedinvitee {
+		edges = append(edges, invitation.EdgeInvitee)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *InvitationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case invitation.EdgeCreator:
+		return m.clearedcreator
+	case invitation.EdgeInvitee:
+		return m.clearedinvitee
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *InvitationMutation) ClearEdge(name string) error {
+	switch name {
+	case invitation.EdgeCreator:
+		m.ClearCreator()
+		return nil
+	case invitation.EdgeInvitee:
+		m.ClearInvitee()
+		return nil
+	}
+	return fmt.Errorf("unknown Invitation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *InvitationMutation) ResetEdge(name string) error {
+	switch name {
+	case invitation.EdgeCreator:
+		m.ResetCreator()
+		return nil
+	case invitation.EdgeInvitee:
+		m.ResetInvitee()
+		return nil
+	}
+	return fmt.Errorf("unknown Invitation edge %s", name)
+}
+
+// PasswordResetMutation represents an operation that mutates the PasswordReset nodes in the graph.
+type PasswordResetMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	token_hash    *string
+	expires_at    *time.Time
+	used_at       *time.Time
+	ip            *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*PasswordReset, error)
+	predicates    []predicate.PasswordReset
+}
+
+var _ ent.Mutation = (*PasswordResetMutation)(nil)
+
+// passwordresetOption allows management of the mutation configuration using functional options.
+type passwordresetOption func(*PasswordResetMutation)
+
+// newPasswordResetMutation creates new mutation for the PasswordReset entity.
+func newPasswordResetMutation(c config, op Op, opts ...passwordresetOption) *PasswordResetMutation {
+	m := &PasswordResetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePasswordReset,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPasswordResetID sets the ID field of the mutation.
+func withPasswordResetID(id int) passwordresetOption {
+	return func(m *PasswordResetMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PasswordReset
+		)
+		m.oldValue = func(ctx context.Context) (*PasswordReset, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PasswordReset.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPasswordReset sets the old PasswordReset of the mutation.
+func withPasswordReset(node *PasswordReset) passwordresetOption {
+	return func(m *PasswordResetMutation) {
+		m.oldValue = func(context.Context) (*PasswordReset, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PasswordResetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PasswordResetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PasswordResetMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PasswordResetMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PasswordReset.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *PasswordResetMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *PasswordResetMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the PasswordReset entity.
+// If the PasswordReset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *PasswordResetMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *PasswordResetMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *PasswordResetMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the PasswordReset entity.
+// If the PasswordReset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *PasswordResetMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetUsedAt sets the "used_at" field.
+func (m *PasswordResetMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
+}
+
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *PasswordResetMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsedAt returns the old "used_at" field's value of the PasswordReset entity.
+// If the PasswordReset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+	}
+	return oldValue.UsedAt, nil
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *PasswordResetMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[passwordreset.FieldUsedAt] = struct{}{}
+}
+
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *PasswordResetMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[passwordreset.FieldUsedAt]
+	return ok
+}
+
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *PasswordResetMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, passwordreset.FieldUsedAt)
+}
+
+// SetIP sets the "ip" field.
+func (m *PasswordResetMutation) SetIP(s string) {
+	m.ip = &s
+}
+
+// IP returns the value of the "ip" field in the mutation.
+func (m *PasswordResetMutation) IP() (r string, exists bool) {
+	v := m.ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIP returns the old "ip" field's value of the PasswordReset entity.
+// If the PasswordReset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetMutation) OldIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+	}
+	return oldValue.IP, nil
+}
+
+// ClearIP clears the value of the "ip" field.
+func (m *PasswordResetMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[passwordreset.FieldIP] = struct{}{}
+}
+
+// IPCleared returns if the "ip" field was cleared in this mutation.
+func (m *PasswordResetMutation) IPCleared() bool {
+	_, ok := m.clearedFields[passwordreset.FieldIP]
+	return ok
+}
+
+// ResetIP resets all changes to the "ip" field.
+func (m *PasswordResetMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, passwordreset.FieldIP)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PasswordResetMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PasswordResetMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PasswordReset entity.
+// If the PasswordReset object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordResetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PasswordResetMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *PasswordResetMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PasswordResetMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PasswordResetMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *PasswordResetMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PasswordResetMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PasswordResetMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the PasswordResetMutation builder.
+func (m *PasswordResetMutation) Where(ps ...predicate.PasswordReset) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PasswordResetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PasswordResetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PasswordReset, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PasswordResetMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PasswordResetMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PasswordReset).
+func (m *PasswordResetMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PasswordResetMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.token_hash != nil {
+		fields = append(fields, passwordreset.FieldTokenHash)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, passwordreset.FieldExpiresAt)
+	}
+	if m.used_at != nil {
+		fields = append(fields, passwordreset.FieldUsedAt)
+	}
+	if m.ip != nil {
+		fields = append(fields, passwordreset.FieldIP)
+	}
+	if m.created_at != nil {
+		fields = append(fields, passwordreset.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PasswordResetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case passwordreset.FieldTokenHash:
+		return m.TokenHash()
+	case passwordreset.FieldExpiresAt:
+		return m.ExpiresAt()
+	case passwordreset.FieldUsedAt:
+		return m.UsedAt()
+	case passwordreset.FieldIP:
+		return m.IP()
+	case passwordreset.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PasswordResetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case passwordreset.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case passwordreset.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case passwordreset.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case passwordreset.FieldIP:
+		return m.OldIP(ctx)
+	case passwordreset.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PasswordReset field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordResetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case passwordreset.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case passwordreset.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case passwordreset.FieldUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedAt(v)
+		return nil
+	case passwordreset.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case passwordreset.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordReset field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PasswordResetMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PasswordResetMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordResetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PasswordReset numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PasswordResetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(passwordreset.FieldUsedAt) {
+		fields = append(fields, passwordreset.FieldUsedAt)
+	}
+	if m.FieldCleared(passwordreset.FieldIP) {
+		fields = append(fields, passwordreset.FieldIP)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PasswordResetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PasswordResetMutation) ClearField(name string) error {
+	switch name {
+	case passwordreset.FieldUsedAt:
+		m.ClearUsedAt()
+		return nil
+	case passwordreset.FieldIP:
+		m.ClearIP()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordReset nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PasswordResetMutation) ResetField(name string) error {
+	switch name {
+	case passwordreset.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case passwordreset.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case passwordreset.FieldUsedAt:
+		m.ResetUsedAt()
+		return nil
+	case passwordreset.FieldIP:
+		m.ResetIP()
+		return nil
+	case passwordreset.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordReset field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PasswordResetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, passwordreset.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PasswordResetMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case passwordreset.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PasswordResetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PasswordResetMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PasswordResetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, passwordreset.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PasswordResetMutation) EdgeCleared(name string) bool {
+	switch name {
+	case passwordreset.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PasswordResetMutation) ClearEdge(name string) error {
+	switch name {
+	case passwordreset.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordReset unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PasswordResetMutation) ResetEdge(name string) error {
+	switch name {
+	case passwordreset.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordReset edge %s", name)
+}
+
+// PostMutation represents an operation that mutates the Post nodes in the graph.
+type PostMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	title               *string
+	slug                *string
+	content             *string
+	content_html        *string
+	toc                 *[]models.TocItem
+	appendtoc           []models.TocItem
+	excerpt             *string
+	cover_image         *string
+	published           *bool
+	scheduled           *bool
+	created_at          *time.Time
+	updated_at          *time.Time
+	published_at        *time.Time
+	views               *int
+	addviews            *int
+	author_type         *post.AuthorType
+	author              *string
+	clearedFields       map[string]struct{}
+	owner               *int
+	clearedowner        bool
+	comments            map[int]struct{}
+	removedcomments     map[int]struct{}
+	clearedcomments     bool
+	tags                map[int]struct{}
+	removedtags         map[int]struct{}
+	clearedtags         bool
+	revisions           map[int]struct{}
+	removedrevisions    map[int]struct{}
+	clearedrevisions    bool
+	slug_history        map[int]struct{}
+	removedslug_history map[int]struct{}
+	clearedslug_history bool
+	done                bool
+	oldValue            func(context.Context) (*Post, error)
+	predicates          []predicate.Post
+}
+
+var _ ent.Mutation = (*PostMutation)(nil)
+
+// postOption allows management of the mutation configuration using functional options.
+type postOption func(*PostMutation)
+
+// newPostMutation creates new mutation for the Post entity.
+func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
+	m := &PostMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePost,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPostID sets the ID field of the mutation.
+func withPostID(id int) postOption {
+	return func(m *PostMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Post
+		)
+		m.oldValue = func(ctx context.Context) (*Post, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Post.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPost sets the old Post of the mutation.
+func withPost(node *Post) postOption {
+	return func(m *PostMutation) {
+		m.oldValue = func(context.Context) (*Post, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PostMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PostMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Post.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTitle sets the "title" field.
+func (m *PostMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *PostMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *PostMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetSlug sets the "slug" field.
+func (m *PostMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *PostMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (m *PostMutation) ClearSlug() {
+	m.slug = nil
+	m.clearedFields[post.FieldSlug] = struct{}{}
+}
+
+// SlugCleared returns if the "slug" field was cleared in this mutation.
+func (m *PostMutation) SlugCleared() bool {
+	_, ok := m.clearedFields[post.FieldSlug]
+	return ok
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *PostMutation) ResetSlug() {
+	m.slug = nil
+	delete(m.clearedFields, post.FieldSlug)
+}
+
+// SetContent sets the "content" field.
+func (m *PostMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *PostMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *PostMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetContentHTML sets the "content_html" field.
+func (m *PostMutation) SetContentHTML(s string) {
+	m.content_html = &s
+}
+
+// ContentHTML returns the value of the "content_html" field in the mutation.
+func (m *PostMutation) ContentHTML() (r string, exists bool) {
+	v := m.content_html
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentHTML returns the old "content_html" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldContentHTML(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentHTML is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentHTML requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentHTML: %w", err)
+	}
+	return oldValue.ContentHTML, nil
+}
+
+// ClearContentHTML clears the value of the "content_html" field.
+func (m *PostMutation) ClearContentHTML() {
+	m.content_html = nil
+	m.clearedFields[post.FieldContentHTML] = struct{}{}
+}
+
+// ContentHTMLCleared returns if the "content_html" field was cleared in this mutation.
+func (m *PostMutation) ContentHTMLCleared() bool {
+	_, ok := m.clearedFields[post.FieldContentHTML]
+	return ok
+}
+
+// ResetContentHTML resets all changes to the "content_html" field.
+func (m *PostMutation) ResetContentHTML() {
+	m.content_html = nil
+	delete(m.clearedFields, post.FieldContentHTML)
+}
+
+// SetToc sets the "toc" field.
+func (m *PostMutation) SetToc(mi []models.TocItem) {
+	m.toc = &mi
+	m.appendtoc = nil
+}
+
+// Toc returns the value of the "toc" field in the mutation.
+func (m *PostMutation) Toc() (r []models.TocItem, exists bool) {
+	v := m.toc
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToc returns the old "toc" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldToc(ctx context.Context) (v []models.TocItem, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToc is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToc requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToc: %w", err)
+	}
+	return oldValue.Toc, nil
+}
+
+// AppendToc adds mi to the "toc" field.
+func (m *PostMutation) AppendToc(mi []models.TocItem) {
+	m.appendtoc = append(m.appendtoc, mi...)
+}
+
+// AppendedToc returns the list of values that were appended to the "toc" field in this mutation.
+func (m *PostMutation) AppendedToc() ([]models.TocItem, bool) {
+	if len(m.appendtoc) == 0 {
+		return nil, false
+	}
+	return m.appendtoc, true
+}
+
+// ClearToc clears the value of the "toc" field.
+func (m *PostMutation) ClearToc() {
+	m.toc = nil
+	m.appendtoc = nil
+	m.clearedFields[post.FieldToc] = struct{}{}
+}
+
+// TocCleared returns if the "toc" field was cleared in this mutation.
+func (m *PostMutation) TocCleared() bool {
+	_, ok := m.clearedFields[post.FieldToc]
+	return ok
+}
+
+// ResetToc resets all changes to the "toc" field.
+func (m *PostMutation) ResetToc() {
+	m.toc = nil
+	m.appendtoc = nil
+	delete(m.clearedFields, post.FieldToc)
+}
+
+// SetExcerpt sets the "excerpt" field.
+func (m *PostMutation) SetExcerpt(s string) {
+	m.excerpt = &s
+}
+
+// Excerpt returns the value of the "excerpt" field in the mutation.
+func (m *PostMutation) Excerpt() (r string, exists bool) {
+	v := m.excerpt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExcerpt returns the old "excerpt" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldExcerpt(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExcerpt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExcerpt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExcerpt: %w", err)
+	}
+	return oldValue.Excerpt, nil
+}
+
+// ResetExcerpt resets all changes to the "excerpt" field.
+func (m *PostMutation) ResetExcerpt() {
+	m.excerpt = nil
+}
+
+// SetCoverImage sets the "cover_image" field.
+func (m *PostMutation) SetCoverImage(s string) {
+	m.cover_image = &s
+}
+
+// CoverImage returns the value of the "cover_image" field in the mutation.
+func (m *PostMutation) CoverImage() (r string, exists bool) {
+	v := m.cover_image
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCoverImage returns the old "cover_image" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldCoverImage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCoverImage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCoverImage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCoverImage: %w", err)
+	}
+	return oldValue.CoverImage, nil
+}
+
+// ResetCoverImage resets all changes to the "cover_image" field.
+func (m *PostMutation) ResetCoverImage() {
+	m.cover_image = nil
+}
+
+// SetPublished sets the "published" field.
+func (m *PostMutation) SetPublished(b bool) {
+	m.published = &b
+}
+
+// Published returns the value of the "published" field in the mutation.
+func (m *PostMutation) Published() (r bool, exists bool) {
+	v := m.published
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublished returns the old "published" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldPublished(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublished is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublished requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublished: %w", err)
+	}
+	return oldValue.Published, nil
+}
+
+// ResetPublished resets all changes to the "published" field.
+func (m *PostMutation) ResetPublished() {
+	m.published = nil
+}
+
+// SetScheduled sets the "scheduled" field.
+func (m *PostMutation) SetScheduled(b bool) {
+	m.scheduled = &b
+}
+
+// Scheduled returns the value of the "scheduled" field in the mutation.
+func (m *PostMutation) Scheduled() (r bool, exists bool) {
+	v := m.scheduled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScheduled returns the old "scheduled" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldScheduled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduled: %w", err)
+	}
+	return oldValue.Scheduled, nil
+}
+
+// ResetScheduled resets all changes to the "scheduled" field.
+func (m *PostMutation) ResetScheduled() {
+	m.scheduled = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PostMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PostMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PostMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PostMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PostMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PostMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (m *PostMutation) SetPublishedAt(t time.Time) {
+	m.published_at = &t
+}
+
+// PublishedAt returns the value of the "published_at" field in the mutation.
+func (m *PostMutation) PublishedAt() (r time.Time, exists bool) {
+	v := m.published_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishedAt returns the old "published_at" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldPublishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedAt: %w", err)
+	}
+	return oldValue.PublishedAt, nil
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (m *PostMutation) ClearPublishedAt() {
+	m.published_at = nil
+	m.clearedFields[post.FieldPublishedAt] = struct{}{}
+}
+
+// PublishedAtCleared returns if the "published_at" field was cleared in this mutation.
+func (m *PostMutation) PublishedAtCleared() bool {
+	_, ok := m.clearedFields[post.FieldPublishedAt]
+	return ok
+}
+
+// ResetPublishedAt resets all changes to the "published_at" field.
+func (m *PostMutation) ResetPublishedAt() {
+	m.published_at = nil
+	delete(m.clearedFields, post.FieldPublishedAt)
+}
+
+// SetViews sets the "views" field.
+func (m *PostMutation) SetViews(i int) {
+	m.views = &i
+	m.addviews = nil
+}
+
+// Views returns the value of the "views" field in the mutation.
+func (m *PostMutation) Views() (r int, exists bool) {
+	v := m.views
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldViews returns the old "views" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldViews(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldViews is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldViews requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldViews: %w", err)
+	}
+	return oldValue.Views, nil
+}
+
+// AddViews adds i to the "views" field.
+func (m *PostMutation) AddViews(i int) {
+	if m.addviews != nil {
+		*m.addviews += i
+	} else {
+		m.addviews = &i
+	}
+}
+
+// AddedViews returns the value that was added to the "views" field in this mutation.
+func (m *PostMutation) AddedViews() (r int, exists bool) {
+	v := m.addviews
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetViews resets all changes to the "views" field.
+func (m *PostMutation) ResetViews() {
+	m.views = nil
+	m.addviews = nil
+}
+
+// SetAuthorType sets the "author_type" field.
+func (m *PostMutation) SetAuthorType(pt post.AuthorType) {
+	m.author_type = &pt
+}
+
+// AuthorType returns the value of the "author_type" field in the mutation.
+func (m *PostMutation) AuthorType() (r post.AuthorType, exists bool) {
+	v := m.author_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorType returns the old "author_type" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldAuthorType(ctx context.Context) (v post.AuthorType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorType: %w", err)
+	}
+	return oldValue.AuthorType, nil
+}
+
+// ResetAuthorType resets all changes to the "author_type" field.
+func (m *PostMutation) ResetAuthorType() {
+	m.author_type = nil
+}
+
+// SetAuthor sets the "author" field.
+func (m *PostMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *PostMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the Post entity.
+// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostMutation) OldAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *PostMutation) ResetAuthor() {
+	m.author = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *PostMutation) SetOwnerID(id int) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *PostMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *PostMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *PostMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *PostMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *PostMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *PostMutation) AddCommentIDs(ids ...int) {
+	if m.comments == nil {
+		m.comments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *PostMutation) ClearComments() {
+	m.clearedcomments = true
+}
+
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *PostMutation) CommentsCleared() bool {
+	return m.clearedcomments
+}
+
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *PostMutation) RemoveCommentIDs(ids ...int) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *PostMutation) RemovedCommentsIDs() (ids []int) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *PostMutation) CommentsIDs() (ids []int) {
+	for id := range m.comments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetComments resets all changes to the "comments" edge.
+func (m *PostMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *PostMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *PostMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *PostMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *PostMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *PostMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *PostMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *PostMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by ids.
+func (m *PostMutation) AddRevisionIDs(ids ...int) {
+	if m.revisions == nil {
+		m.revisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRevisions clears the "revisions" edge to the PostRevision entity.
+func (m *PostMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the PostRevision entity was cleared.
+func (m *PostMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the PostRevision entity by IDs.
+func (m *PostMutation) RemoveRevisionIDs(ids ...int) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the PostRevision entity.
+func (m *PostMutation) RemovedRevisionsIDs() (ids []int) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *PostMutation) RevisionsIDs() (ids []int) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *PostMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
+}
+
+// AddSlugHistoryIDs adds the "slug_history" edge to the PostSlugHistory entity by ids.
+func (m *PostMutation) AddSlugHistoryIDs(ids ...int) {
+	if m.slug_history == nil {
+		m.slug_history = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.slug_history[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSlugHistory clears the "slug_history" edge to the PostSlugHistory entity.
+func (m *PostMutation) ClearSlugHistory() {
+	m.clearedslug_history = true
+}
+
+// SlugHistoryCleared reports if the "slug_history" edge to the PostSlugHistory entity was cleared.
+func (m *PostMutation) SlugHistoryCleared() bool {
+	return m.clearedslug_history
+}
+
+// RemoveSlugHistoryIDs removes the "slug_history" edge to the PostSlugHistory entity by IDs.
+func (m *PostMutation) RemoveSlugHistoryIDs(ids ...int) {
+	if m.removedslug_history == nil {
+		m.removedslug_history = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.slug_history, ids[i])
+		m.removedslug_history[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSlugHistory returns the removed IDs of the "slug_history" edge to the PostSlugHistory entity.
+func (m *PostMutation) RemovedSlugHistoryIDs() (ids []int) {
+	for id := range m.removedslug_history {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SlugHistoryIDs returns the "slug_history" edge IDs in the mutation.
+func (m *PostMutation) SlugHistoryIDs() (ids []int) {
+	for id := range m.slug_history {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSlugHistory resets all changes to the "slug_history" edge.
+func (m *PostMutation) ResetSlugHistory() {
+	m.slug_history = nil
+	m.clearedslug_history = false
+	m.removedslug_history = nil
+}
+
+// Where appends a list predicates to the PostMutation builder.
+func (m *PostMutation) Where(ps ...predicate.Post) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PostMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Post, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PostMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PostMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Post).
+func (m *PostMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PostMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.title != nil {
+		fields = append(fields, post.FieldTitle)
+	}
+	if m.slug != nil {
+		fields = append(fields, post.FieldSlug)
+	}
+	if m.content != nil {
+		fields = append(fields, post.FieldContent)
+	}
+	if m.content_html != nil {
+		fields = append(fields, post.FieldContentHTML)
+	}
+	if m.toc != nil {
+		fields = append(fields, post.FieldToc)
+	}
+	if m.excerpt != nil {
+		fields = append(fields, post.FieldExcerpt)
+	}
+	if m.cover_image != nil {
+		fields = append(fields, post.FieldCoverImage)
+	}
+	if m.published != nil {
+		fields = append(fields, post.FieldPublished)
+	}
+	if m.scheduled != nil {
+		fields = append(fields, post.FieldScheduled)
+	}
+	if m.created_at != nil {
+		fields = append(fields, post.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, post.FieldUpdatedAt)
+	}
+	if m.published_at != nil {
+		fields = append(fields, post.FieldPublishedAt)
+	}
+	if m.views != nil {
+		fields = append(fields, post.FieldViews)
+	}
+	if m.author_type != nil {
+		fields = append(fields, post.FieldAuthorType)
+	}
+	if m.author != nil {
+		fields = append(fields, post.FieldAuthor)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PostMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case collection.FieldType:
+	case post.FieldTitle:
+		return m.Title()
+	case post.FieldSlug:
+		return m.Slug()
+	case post.FieldContent:
+		return m.Content()
+	case post.FieldContentHTML:
+		return m.ContentHTML()
+	case post.FieldToc:
+		return m.Toc()
+	case post.FieldExcerpt:
+		return m.Excerpt()
+	case post.FieldCoverImage:
+		return m.CoverImage()
+	case post.FieldPublished:
+		return m.Published()
+	case post.FieldScheduled:
+		return m.Scheduled()
+	case post.FieldCreatedAt:
+		return m.CreatedAt()
+	case post.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case post.FieldPublishedAt:
+		return m.PublishedAt()
+	case post.FieldViews:
+		return m.Views()
+	case post.FieldAuthorType:
+		return m.AuthorType()
+	case post.FieldAuthor:
+		return m.Author()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case post.FieldTitle:
+		return m.OldTitle(ctx)
+	case post.FieldSlug:
+		return m.OldSlug(ctx)
+	case post.FieldContent:
+		return m.OldContent(ctx)
+	case post.FieldContentHTML:
+		return m.OldContentHTML(ctx)
+	case post.FieldToc:
+		return m.OldToc(ctx)
+	case post.FieldExcerpt:
+		return m.OldExcerpt(ctx)
+	case post.FieldCoverImage:
+		return m.OldCoverImage(ctx)
+	case post.FieldPublished:
+		return m.OldPublished(ctx)
+	case post.FieldScheduled:
+		return m.OldScheduled(ctx)
+	case post.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case post.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case post.FieldPublishedAt:
+		return m.OldPublishedAt(ctx)
+	case post.FieldViews:
+		return m.OldViews(ctx)
+	case post.FieldAuthorType:
+		return m.OldAuthorType(ctx)
+	case post.FieldAuthor:
+		return m.OldAuthor(ctx)
+	}
+	return nil, fmt.Errorf("unknown Post field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case post.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetTitle(v)
 		return nil
-	case collection.FieldTitle:
+	case post.FieldSlug:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetSlug(v)
 		return nil
-	case collection.FieldAuthor:
+	case post.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAuthor(v)
+		m.SetContent(v)
 		return nil
-	case collection.FieldCover:
+	case post.FieldContentHTML:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCover(v)
+		m.SetContentHTML(v)
 		return nil
-	case collection.FieldDate:
+	case post.FieldToc:
+		v, ok := value.([]models.TocItem)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToc(v)
+		return nil
+	case post.FieldExcerpt:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDate(v)
+		m.SetExcerpt(v)
 		return nil
-	case collection.FieldLink:
+	case post.FieldCoverImage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLink(v)
+		m.SetCoverImage(v)
 		return nil
-	case collection.FieldCreatedAt:
+	case post.FieldPublished:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublished(v)
+		return nil
+	case post.FieldScheduled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduled(v)
+		return nil
+	case post.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case collection.FieldUpdatedAt:
+	case post.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
+	case post.FieldPublishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedAt(v)
+		return nil
+	case post.FieldViews:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetViews(v)
+		return nil
+	case post.FieldAuthorType:
+		v, ok := value.(post.AuthorType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorType(v)
+		return nil
+	case post.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Collection field %s", name)
+	return fmt.Errorf("unknown Post field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CollectionMutation) AddedFields() []string {
-	return nil
+func (m *PostMutation) AddedFields() []string {
+	var fields []string
+	if m.addviews != nil {
+		fields = append(fields, post.FieldViews)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CollectionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case post.FieldViews:
+		return m.AddedViews()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CollectionMutation) AddField(name string, value ent.Value) error {
+func (m *PostMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case post.FieldViews:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddViews(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Collection numeric field %s", name)
+	return fmt.Errorf("unknown Post numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CollectionMutation) ClearedFields() []string {
+func (m *PostMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(collection.FieldAuthor) {
-		fields = append(fields, collection.FieldAuthor)
+	if m.FieldCleared(post.FieldSlug) {
+		fields = append(fields, post.FieldSlug)
 	}
-	if m.FieldCleared(collection.FieldCover) {
-		fields = append(fields, collection.FieldCover)
+	if m.FieldCleared(post.FieldContentHTML) {
+		fields = append(fields, post.FieldContentHTML)
 	}
-	if m.FieldCleared(collection.FieldDate) {
-		fields = append(fields, collection.FieldDate)
+	if m.FieldCleared(post.FieldToc) {
+		fields = append(fields, post.FieldToc)
 	}
-	if m.FieldCleared(collection.FieldLink) {
-		fields = append(fields, collection.FieldLink)
+	if m.FieldCleared(post.FieldPublishedAt) {
+		fields = append(fields, post.FieldPublishedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CollectionMutation) FieldCleared(name string) bool {
+func (m *PostMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CollectionMutation) ClearField(name string) error {
+func (m *PostMutation) ClearField(name string) error {
 	switch name {
-	case collection.FieldAuthor:
-		m.ClearAuthor()
+	case post.FieldSlug:
+		m.ClearSlug()
 		return nil
-	case collection.FieldCover:
-		m.ClearCover()
+	case post.FieldContentHTML:
+		m.ClearContentHTML()
 		return nil
-	case collection.FieldDate:
-		m.ClearDate()
+	case post.FieldToc:
+		m.ClearToc()
 		return nil
-	case collection.FieldLink:
-		m.ClearLink()
+	case post.FieldPublishedAt:
+		m.ClearPublishedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Collection nullable field %s", name)
+	return fmt.Errorf("unknown Post nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CollectionMutation) ResetField(name string) error {
+func (m *PostMutation) ResetField(name string) error {
 	switch name {
-	case collection.FieldType:
-		m.ResetType()
-		return nil
-	case collection.FieldTitle:
+	case post.FieldTitle:
 		m.ResetTitle()
 		return nil
-	case collection.FieldAuthor:
-		m.ResetAuthor()
+	case post.FieldSlug:
+		m.ResetSlug()
 		return nil
-	case collection.FieldCover:
-		m.ResetCover()
+	case post.FieldContent:
+		m.ResetContent()
+		return nil
+	case post.FieldContentHTML:
+		m.ResetContentHTML()
+		return nil
+	case post.FieldToc:
+		m.ResetToc()
+		return nil
+	case post.FieldExcerpt:
+		m.ResetExcerpt()
+		return nil
+	case post.FieldCoverImage:
+		m.ResetCoverImage()
 		return nil
-	case collection.FieldDate:
-		m.ResetDate()
+	case post.FieldPublished:
+		m.ResetPublished()
 		return nil
-	case collection.FieldLink:
-		m.ResetLink()
+	case post.FieldScheduled:
+		m.ResetScheduled()
 		return nil
-	case collection.FieldCreatedAt:
+	case post.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case collection.FieldUpdatedAt:
+	case post.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
+	case post.FieldPublishedAt:
+		m.ResetPublishedAt()
+		return nil
+	case post.FieldViews:
+		m.ResetViews()
+		return nil
+	case post.FieldAuthorType:
+		m.ResetAuthorType()
+		return nil
+	case post.FieldAuthor:
+		m.ResetAuthor()
+		return nil
 	}
-	return fmt.Errorf("unknown Collection field %s", name)
+	return fmt.Errorf("unknown Post field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CollectionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *PostMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.owner != nil {
+		edges = append(edges, post.EdgeOwner)
+	}
+	if m.comments != nil {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.tags != nil {
+		edges = append(edges, post.EdgeTags)
+	}
+	if m.revisions != nil {
+		edges = append(edges, post.EdgeRevisions)
+	}
+	if m.slug_history != nil {
+		edges = append(edges, post.EdgeSlugHistory)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CollectionMutation) AddedIDs(name string) []ent.Value {
+func (m *PostMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case post.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case post.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.comments))
+		for id := range m.comments {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeSlugHistory:
+		ids := make([]ent.Value, 0, len(m.slug_history))
+		for id := range m.slug_history {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CollectionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *PostMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedcomments != nil {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, post.EdgeTags)
+	}
+	if m.removedrevisions != nil {
+		edges = append(edges, post.EdgeRevisions)
+	}
+	if m.removedslug_history != nil {
+		edges = append(edges, post.EdgeSlugHistory)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CollectionMutation) RemovedIDs(name string) []ent.Value {
+func (m *PostMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case post.EdgeComments:
+		ids := make([]ent.Value, 0, len(m.removedcomments))
+		for id := range m.removedcomments {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case post.EdgeSlugHistory:
+		ids := make([]ent.Value, 0, len(m.removedslug_history))
+		for id := range m.removedslug_history {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CollectionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *PostMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedowner {
+		edges = append(edges, post.EdgeOwner)
+	}
+	if m.clearedcomments {
+		edges = append(edges, post.EdgeComments)
+	}
+	if m.clearedtags {
+		edges = append(edges, post.EdgeTags)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, post.EdgeRevisions)
+	}
+	if m.clearedslug_history {
+		edges = append(edges, post.EdgeSlugHistory)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CollectionMutation) EdgeCleared(name string) bool {
+func (m *PostMutation) EdgeCleared(name string) bool {
+	switch name {
+	case post.EdgeOwner:
+		return m.clearedowner
+	case post.EdgeComments:
+		return m.clearedcomments
+	case post.EdgeTags:
+		return m.clearedtags
+	case post.EdgeRevisions:
+		return m.clearedrevisions
+	case post.EdgeSlugHistory:
+		return m.clearedslug_history
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CollectionMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Collection unique edge %s", name)
+func (m *PostMutation) ClearEdge(name string) error {
+	switch name {
+	case post.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Post unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CollectionMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Collection edge %s", name)
+func (m *PostMutation) ResetEdge(name string) error {
+	switch name {
+	case post.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case post.EdgeComments:
+		m.ResetComments()
+		return nil
+	case post.EdgeTags:
+		m.ResetTags()
+		return nil
+	case post.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	case post.EdgeSlugHistory:
+		m.ResetSlugHistory()
+		return nil
+	}
+	return fmt.Errorf("unknown Post edge %s", name)
 }
 
-// CommentMutation represents an operation that mutates the Comment nodes in the graph.
-type CommentMutation struct {
+// PostRevisionMutation represents an operation that mutates the PostRevision nodes in the graph.
+type PostRevisionMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	content         *string
-	author          *string
-	email           *string
-	website         *string
-	approved        *bool
-	created_at      *time.Time
-	updated_at      *time.Time
-	avatar          *string
-	clearedFields   map[string]struct{}
-	post            *int
-	clearedpost     bool
-	user            *int
-	cleareduser     bool
-	parent          *int
-	clearedparent   bool
-	children        map[int]struct{}
-	removedchildren map[int]struct{}
-	clearedchildren bool
-	done            bool
-	oldValue        func(context.Context) (*Comment, error)
-	predicates      []predicate.Comment
-}
-
-var _ ent.Mutation = (*CommentMutation)(nil)
-
-// commentOption allows management of the mutation configuration using functional options.
-type commentOption func(*CommentMutation)
-
-// newCommentMutation creates new mutation for the Comment entity.
-func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation {
-	m := &CommentMutation{
+	op               Op
+	typ              string
+	id               *int
+	version          *int
+	addversion       *int
+	title            *string
+	content          *string
+	excerpt          *string
+	editor           *string
+	restored_from    *int
+	addrestored_from *int
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	post             *int
+	clearedpost      bool
+	done             bool
+	oldValue         func(context.Context) (*PostRevision, error)
+	predicates       []predicate.PostRevision
+}
+
+var _ ent.Mutation = (*PostRevisionMutation)(nil)
+
+// postrevisionOption allows management of the mutation configuration using functional options.
+type postrevisionOption func(*PostRevisionMutation)
+
+// newPostRevisionMutation creates new mutation for the PostRevision entity.
+func newPostRevisionMutation(c config, op Op, opts ...postrevisionOption) *PostRevisionMutation {
+	m := &PostRevisionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeComment,
+		typ:           TypePostRevision,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2161,20 +12310,20 @@ func newCommentMutation(c config, op Op, opts ...commentOption) *CommentMutation
 	return m
 }
 
-// withCommentID sets the ID field of the mutation.
-func withCommentID(id int) commentOption {
-	return func(m *CommentMutation) {
+// withPostRevisionID sets the ID field of the mutation.
+func withPostRevisionID(id int) postrevisionOption {
+	return func(m *PostRevisionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Comment
+			value *PostRevision
 		)
-		m.oldValue = func(ctx context.Context) (*Comment, error) {
+		m.oldValue = func(ctx context.Context) (*PostRevision, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Comment.Get(ctx, id)
+					value, err = m.Client().PostRevision.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2183,10 +12332,10 @@ func withCommentID(id int) commentOption {
 	}
 }
 
-// withComment sets the old Comment of the mutation.
-func withComment(node *Comment) commentOption {
-	return func(m *CommentMutation) {
-		m.oldValue = func(context.Context) (*Comment, error) {
+// withPostRevision sets the old PostRevision of the mutation.
+func withPostRevision(node *PostRevision) postrevisionOption {
+	return func(m *PostRevisionMutation) {
+		m.oldValue = func(context.Context) (*PostRevision, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2195,7 +12344,7 @@ func withComment(node *Comment) commentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CommentMutation) Client() *Client {
+func (m PostRevisionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2203,7 +12352,7 @@ func (m CommentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CommentMutation) Tx() (*Tx, error) {
+func (m PostRevisionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2214,7 +12363,7 @@ func (m CommentMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CommentMutation) ID() (id int, exists bool) {
+func (m *PostRevisionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2225,7 +12374,7 @@ func (m *CommentMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CommentMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *PostRevisionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2234,212 +12383,302 @@ func (m *CommentMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Comment.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().PostRevision.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetContent sets the "content" field.
-func (m *CommentMutation) SetContent(s string) {
-	m.content = &s
+// SetVersion sets the "version" field.
+func (m *PostRevisionMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *CommentMutation) Content() (r string, exists bool) {
-	v := m.content
+// Version returns the value of the "version" field in the mutation.
+func (m *PostRevisionMutation) Version() (r int, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *PostRevisionMutation) OldVersion(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.Version, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *CommentMutation) ResetContent() {
-	m.content = nil
+// AddVersion adds i to the "version" field.
+func (m *PostRevisionMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
 }
 
-// SetAuthor sets the "author" field.
-func (m *CommentMutation) SetAuthor(s string) {
-	m.author = &s
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *PostRevisionMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Author returns the value of the "author" field in the mutation.
-func (m *CommentMutation) Author() (r string, exists bool) {
-	v := m.author
+// ResetVersion resets all changes to the "version" field.
+func (m *PostRevisionMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *PostRevisionMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *PostRevisionMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthor returns the old "author" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldAuthor(ctx context.Context) (v string, err error) {
+func (m *PostRevisionMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthor requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *PostRevisionMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetContent sets the "content" field.
+func (m *PostRevisionMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *PostRevisionMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostRevisionMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
 	}
-	return oldValue.Author, nil
+	return oldValue.Content, nil
 }
 
-// ResetAuthor resets all changes to the "author" field.
-func (m *CommentMutation) ResetAuthor() {
-	m.author = nil
+// ResetContent resets all changes to the "content" field.
+func (m *PostRevisionMutation) ResetContent() {
+	m.content = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *CommentMutation) SetEmail(s string) {
-	m.email = &s
+// SetExcerpt sets the "excerpt" field.
+func (m *PostRevisionMutation) SetExcerpt(s string) {
+	m.excerpt = &s
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *CommentMutation) Email() (r string, exists bool) {
-	v := m.email
+// Excerpt returns the value of the "excerpt" field in the mutation.
+func (m *PostRevisionMutation) Excerpt() (r string, exists bool) {
+	v := m.excerpt
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldExcerpt returns the old "excerpt" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *PostRevisionMutation) OldExcerpt(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldExcerpt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldExcerpt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldExcerpt: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.Excerpt, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *CommentMutation) ResetEmail() {
-	m.email = nil
+// ResetExcerpt resets all changes to the "excerpt" field.
+func (m *PostRevisionMutation) ResetExcerpt() {
+	m.excerpt = nil
 }
 
-// SetWebsite sets the "website" field.
-func (m *CommentMutation) SetWebsite(s string) {
-	m.website = &s
+// SetEditor sets the "editor" field.
+func (m *PostRevisionMutation) SetEditor(s string) {
+	m.editor = &s
 }
 
-// Website returns the value of the "website" field in the mutation.
-func (m *CommentMutation) Website() (r string, exists bool) {
-	v := m.website
+// Editor returns the value of the "editor" field in the mutation.
+func (m *PostRevisionMutation) Editor() (r string, exists bool) {
+	v := m.editor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWebsite returns the old "website" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldEditor returns the old "editor" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldWebsite(ctx context.Context) (v string, err error) {
+func (m *PostRevisionMutation) OldEditor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWebsite is only allowed on UpdateOne operations")
+		return v, errors.New("OldEditor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWebsite requires an ID field in the mutation")
+		return v, errors.New("OldEditor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWebsite: %w", err)
+		return v, fmt.Errorf("querying old value for OldEditor: %w", err)
 	}
-	return oldValue.Website, nil
+	return oldValue.Editor, nil
 }
 
-// ClearWebsite clears the value of the "website" field.
-func (m *CommentMutation) ClearWebsite() {
-	m.website = nil
-	m.clearedFields[comment.FieldWebsite] = struct{}{}
+// ClearEditor clears the value of the "editor" field.
+func (m *PostRevisionMutation) ClearEditor() {
+	m.editor = nil
+	m.clearedFields[postrevision.FieldEditor] = struct{}{}
 }
 
-// WebsiteCleared returns if the "website" field was cleared in this mutation.
-func (m *CommentMutation) WebsiteCleared() bool {
-	_, ok := m.clearedFields[comment.FieldWebsite]
+// EditorCleared returns if the "editor" field was cleared in this mutation.
+func (m *PostRevisionMutation) EditorCleared() bool {
+	_, ok := m.clearedFields[postrevision.FieldEditor]
 	return ok
 }
 
-// ResetWebsite resets all changes to the "website" field.
-func (m *CommentMutation) ResetWebsite() {
-	m.website = nil
-	delete(m.clearedFields, comment.FieldWebsite)
+// ResetEditor resets all changes to the "editor" field.
+func (m *PostRevisionMutation) ResetEditor() {
+	m.editor = nil
+	delete(m.clearedFields, postrevision.FieldEditor)
 }
 
-// SetApproved sets the "approved" field.
-func (m *CommentMutation) SetApproved(b bool) {
-	m.approved = &b
+// SetRestoredFrom sets the "restored_from" field.
+func (m *PostRevisionMutation) SetRestoredFrom(i int) {
+	m.restored_from = &i
+	m.addrestored_from = nil
 }
 
-// Approved returns the value of the "approved" field in the mutation.
-func (m *CommentMutation) Approved() (r bool, exists bool) {
-	v := m.approved
+// RestoredFrom returns the value of the "restored_from" field in the mutation.
+func (m *PostRevisionMutation) RestoredFrom() (r int, exists bool) {
+	v := m.restored_from
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldApproved returns the old "approved" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldRestoredFrom returns the old "restored_from" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldApproved(ctx context.Context) (v bool, err error) {
+func (m *PostRevisionMutation) OldRestoredFrom(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldApproved is only allowed on UpdateOne operations")
+		return v, errors.New("OldRestoredFrom is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldApproved requires an ID field in the mutation")
+		return v, errors.New("OldRestoredFrom requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldApproved: %w", err)
+		return v, fmt.Errorf("querying old value for OldRestoredFrom: %w", err)
+	}
+	return oldValue.RestoredFrom, nil
+}
+
+// AddRestoredFrom adds i to the "restored_from" field.
+func (m *PostRevisionMutation) AddRestoredFrom(i int) {
+	if m.addrestored_from != nil {
+		*m.addrestored_from += i
+	} else {
+		m.addrestored_from = &i
+	}
+}
+
+// AddedRestoredFrom returns the value that was added to the "restored_from" field in this mutation.
+func (m *PostRevisionMutation) AddedRestoredFrom() (r int, exists bool) {
+	v := m.addrestored_from
+	if v == nil {
+		return
 	}
-	return oldValue.Approved, nil
+	return *v, true
+}
+
+// ClearRestoredFrom clears the value of the "restored_from" field.
+func (m *PostRevisionMutation) ClearRestoredFrom() {
+	m.restored_from = nil
+	m.addrestored_from = nil
+	m.clearedFields[postrevision.FieldRestoredFrom] = struct{}{}
+}
+
+// RestoredFromCleared returns if the "restored_from" field was cleared in this mutation.
+func (m *PostRevisionMutation) RestoredFromCleared() bool {
+	_, ok := m.clearedFields[postrevision.FieldRestoredFrom]
+	return ok
 }
 
-// ResetApproved resets all changes to the "approved" field.
-func (m *CommentMutation) ResetApproved() {
-	m.approved = nil
+// ResetRestoredFrom resets all changes to the "restored_from" field.
+func (m *PostRevisionMutation) ResetRestoredFrom() {
+	m.restored_from = nil
+	m.addrestored_from = nil
+	delete(m.clearedFields, postrevision.FieldRestoredFrom)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *CommentMutation) SetCreatedAt(t time.Time) {
+func (m *PostRevisionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CommentMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *PostRevisionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2447,10 +12686,10 @@ func (m *CommentMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the PostRevision entity.
+// If the PostRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PostRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2465,312 +12704,632 @@ func (m *CommentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CommentMutation) ResetCreatedAt() {
+func (m *PostRevisionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *CommentMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetPostID sets the "post" edge to the Post entity by id.
+func (m *PostRevisionMutation) SetPostID(id int) {
+	m.post = &id
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CommentMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// ClearPost clears the "post" edge to the Post entity.
+func (m *PostRevisionMutation) ClearPost() {
+	m.clearedpost = true
+}
+
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *PostRevisionMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostID returns the "post" edge ID in the mutation.
+func (m *PostRevisionMutation) PostID() (id int, exists bool) {
+	if m.post != nil {
+		return *m.post, true
+	}
+	return
+}
+
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *PostRevisionMutation) PostIDs() (ids []int) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPost resets all changes to the "post" edge.
+func (m *PostRevisionMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
+}
+
+// Where appends a list predicates to the PostRevisionMutation builder.
+func (m *PostRevisionMutation) Where(ps ...predicate.PostRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PostRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PostRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PostRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PostRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PostRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PostRevision).
+func (m *PostRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PostRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.version != nil {
+		fields = append(fields, postrevision.FieldVersion)
+	}
+	if m.title != nil {
+		fields = append(fields, postrevision.FieldTitle)
+	}
+	if m.content != nil {
+		fields = append(fields, postrevision.FieldContent)
+	}
+	if m.excerpt != nil {
+		fields = append(fields, postrevision.FieldExcerpt)
+	}
+	if m.editor != nil {
+		fields = append(fields, postrevision.FieldEditor)
+	}
+	if m.restored_from != nil {
+		fields = append(fields, postrevision.FieldRestoredFrom)
+	}
+	if m.created_at != nil {
+		fields = append(fields, postrevision.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PostRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case postrevision.FieldVersion:
+		return m.Version()
+	case postrevision.FieldTitle:
+		return m.Title()
+	case postrevision.FieldContent:
+		return m.Content()
+	case postrevision.FieldExcerpt:
+		return m.Excerpt()
+	case postrevision.FieldEditor:
+		return m.Editor()
+	case postrevision.FieldRestoredFrom:
+		return m.RestoredFrom()
+	case postrevision.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PostRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case postrevision.FieldVersion:
+		return m.OldVersion(ctx)
+	case postrevision.FieldTitle:
+		return m.OldTitle(ctx)
+	case postrevision.FieldContent:
+		return m.OldContent(ctx)
+	case postrevision.FieldExcerpt:
+		return m.OldExcerpt(ctx)
+	case postrevision.FieldEditor:
+		return m.OldEditor(ctx)
+	case postrevision.FieldRestoredFrom:
+		return m.OldRestoredFrom(ctx)
+	case postrevision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PostRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case postrevision.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case postrevision.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case postrevision.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case postrevision.FieldExcerpt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExcerpt(v)
+		return nil
+	case postrevision.FieldEditor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditor(v)
+		return nil
+	case postrevision.FieldRestoredFrom:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRestoredFrom(v)
+		return nil
+	case postrevision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PostRevisionMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, postrevision.FieldVersion)
+	}
+	if m.addrestored_from != nil {
+		fields = append(fields, postrevision.FieldRestoredFrom)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PostRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case postrevision.FieldVersion:
+		return m.AddedVersion()
+	case postrevision.FieldRestoredFrom:
+		return m.AddedRestoredFrom()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case postrevision.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	case postrevision.FieldRestoredFrom:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRestoredFrom(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown PostRevision numeric field %s", name)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PostRevisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(postrevision.FieldEditor) {
+		fields = append(fields, postrevision.FieldEditor)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	if m.FieldCleared(postrevision.FieldRestoredFrom) {
+		fields = append(fields, postrevision.FieldRestoredFrom)
 	}
-	return oldValue.UpdatedAt, nil
+	return fields
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CommentMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PostRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetAvatar sets the "avatar" field.
-func (m *CommentMutation) SetAvatar(s string) {
-	m.avatar = &s
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PostRevisionMutation) ClearField(name string) error {
+	switch name {
+	case postrevision.FieldEditor:
+		m.ClearEditor()
+		return nil
+	case postrevision.FieldRestoredFrom:
+		m.ClearRestoredFrom()
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision nullable field %s", name)
 }
 
-// Avatar returns the value of the "avatar" field in the mutation.
-func (m *CommentMutation) Avatar() (r string, exists bool) {
-	v := m.avatar
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PostRevisionMutation) ResetField(name string) error {
+	switch name {
+	case postrevision.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case postrevision.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case postrevision.FieldContent:
+		m.ResetContent()
+		return nil
+	case postrevision.FieldExcerpt:
+		m.ResetExcerpt()
+		return nil
+	case postrevision.FieldEditor:
+		m.ResetEditor()
+		return nil
+	case postrevision.FieldRestoredFrom:
+		m.ResetRestoredFrom()
+		return nil
+	case postrevision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown PostRevision field %s", name)
 }
 
-// OldAvatar returns the old "avatar" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldAvatar(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatar requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PostRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.post != nil {
+		edges = append(edges, postrevision.EdgePost)
 	}
-	return oldValue.Avatar, nil
+	return edges
 }
 
-// ClearAvatar clears the value of the "avatar" field.
-func (m *CommentMutation) ClearAvatar() {
-	m.avatar = nil
-	m.clearedFields[comment.FieldAvatar] = struct{}{}
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PostRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case postrevision.EdgePost:
+		if id := m.post; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// AvatarCleared returns if the "avatar" field was cleared in this mutation.
-func (m *CommentMutation) AvatarCleared() bool {
-	_, ok := m.clearedFields[comment.FieldAvatar]
-	return ok
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PostRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// ResetAvatar resets all changes to the "avatar" field.
-func (m *CommentMutation) ResetAvatar() {
-	m.avatar = nil
-	delete(m.clearedFields, comment.FieldAvatar)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PostRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetParentID sets the "parent_id" field.
-func (m *CommentMutation) SetParentID(i int) {
-	m.parent = &i
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PostRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedpost {
+		edges = append(edges, postrevision.EdgePost)
+	}
+	return edges
 }
 
-// ParentID returns the value of the "parent_id" field in the mutation.
-func (m *CommentMutation) ParentID() (r int, exists bool) {
-	v := m.parent
-	if v == nil {
-		return
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PostRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case postrevision.EdgePost:
+		return m.clearedpost
 	}
-	return *v, true
+	return false
 }
 
-// OldParentID returns the old "parent_id" field's value of the Comment entity.
-// If the Comment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CommentMutation) OldParentID(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PostRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case postrevision.EdgePost:
+		m.ClearPost()
+		return nil
 	}
-	return oldValue.ParentID, nil
+	return fmt.Errorf("unknown PostRevision unique edge %s", name)
 }
 
-// ClearParentID clears the value of the "parent_id" field.
-func (m *CommentMutation) ClearParentID() {
-	m.parent = nil
-	m.clearedFields[comment.FieldParentID] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PostRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case postrevision.EdgePost:
+		m.ResetPost()
+		return nil
+	}
+	return fmt.Errorf("unknown PostRevision edge %s", name)
 }
 
-// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
-func (m *CommentMutation) ParentIDCleared() bool {
-	_, ok := m.clearedFields[comment.FieldParentID]
-	return ok
+// PostSlugHistoryMutation represents an operation that mutates the PostSlugHistory nodes in the graph.
+type PostSlugHistoryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	slug          *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	post          *int
+	clearedpost   bool
+	done          bool
+	oldValue      func(context.Context) (*PostSlugHistory, error)
+	predicates    []predicate.PostSlugHistory
 }
 
-// ResetParentID resets all changes to the "parent_id" field.
-func (m *CommentMutation) ResetParentID() {
-	m.parent = nil
-	delete(m.clearedFields, comment.FieldParentID)
-}
+var _ ent.Mutation = (*PostSlugHistoryMutation)(nil)
 
-// SetPostID sets the "post" edge to the Post entity by id.
-func (m *CommentMutation) SetPostID(id int) {
-	m.post = &id
-}
+// postslughistoryOption allows management of the mutation configuration using functional options.
+type postslughistoryOption func(*PostSlugHistoryMutation)
 
-// ClearPost clears the "post" edge to the Post entity.
-func (m *CommentMutation) ClearPost() {
-	m.clearedpost = true
+// newPostSlugHistoryMutation creates new mutation for the PostSlugHistory entity.
+func newPostSlugHistoryMutation(c config, op Op, opts ...postslughistoryOption) *PostSlugHistoryMutation {
+	m := &PostSlugHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePostSlugHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// PostCleared reports if the "post" edge to the Post entity was cleared.
-func (m *CommentMutation) PostCleared() bool {
-	return m.clearedpost
+// withPostSlugHistoryID sets the ID field of the mutation.
+func withPostSlugHistoryID(id int) postslughistoryOption {
+	return func(m *PostSlugHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PostSlugHistory
+		)
+		m.oldValue = func(ctx context.Context) (*PostSlugHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PostSlugHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// PostID returns the "post" edge ID in the mutation.
-func (m *CommentMutation) PostID() (id int, exists bool) {
-	if m.post != nil {
-		return *m.post, true
+// withPostSlugHistory sets the old PostSlugHistory of the mutation.
+func withPostSlugHistory(node *PostSlugHistory) postslughistoryOption {
+	return func(m *PostSlugHistoryMutation) {
+		m.oldValue = func(context.Context) (*PostSlugHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// PostIDs returns the "post" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PostID instead. It exists only for internal usage by the builders.
-func (m *CommentMutation) PostIDs() (ids []int) {
-	if id := m.post; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PostSlugHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ResetPost resets all changes to the "post" edge.
-func (m *CommentMutation) ResetPost() {
-	m.post = nil
-	m.clearedpost = false
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PostSlugHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *CommentMutation) SetUserID(id int) {
-	m.user = &id
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PostSlugHistoryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *CommentMutation) ClearUser() {
-	m.cleareduser = true
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PostSlugHistoryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PostSlugHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *CommentMutation) UserCleared() bool {
-	return m.cleareduser
+// SetSlug sets the "slug" field.
+func (m *PostSlugHistoryMutation) SetSlug(s string) {
+	m.slug = &s
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *CommentMutation) UserID() (id int, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// Slug returns the value of the "slug" field in the mutation.
+func (m *PostSlugHistoryMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *CommentMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldSlug returns the old "slug" field's value of the PostSlugHistory entity.
+// If the PostSlugHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostSlugHistoryMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *CommentMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
 }
 
-// ClearParent clears the "parent" edge to the Comment entity.
-func (m *CommentMutation) ClearParent() {
-	m.clearedparent = true
-	m.clearedFields[comment.FieldParentID] = struct{}{}
+// ResetSlug resets all changes to the "slug" field.
+func (m *PostSlugHistoryMutation) ResetSlug() {
+	m.slug = nil
 }
 
-// ParentCleared reports if the "parent" edge to the Comment entity was cleared.
-func (m *CommentMutation) ParentCleared() bool {
-	return m.ParentIDCleared() || m.clearedparent
+// SetCreatedAt sets the "created_at" field.
+func (m *PostSlugHistoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *CommentMutation) ParentIDs() (ids []int) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PostSlugHistoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetParent resets all changes to the "parent" edge.
-func (m *CommentMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+	return *v, true
 }
 
-// AddChildIDs adds the "children" edge to the Comment entity by ids.
-func (m *CommentMutation) AddChildIDs(ids ...int) {
-	if m.children == nil {
-		m.children = make(map[int]struct{})
+// OldCreatedAt returns the old "created_at" field's value of the PostSlugHistory entity.
+// If the PostSlugHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PostSlugHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.children[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearChildren clears the "children" edge to the Comment entity.
-func (m *CommentMutation) ClearChildren() {
-	m.clearedchildren = true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PostSlugHistoryMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ChildrenCleared reports if the "children" edge to the Comment entity was cleared.
-func (m *CommentMutation) ChildrenCleared() bool {
-	return m.clearedchildren
+// SetPostID sets the "post" edge to the Post entity by id.
+func (m *PostSlugHistoryMutation) SetPostID(id int) {
+	m.post = &id
 }
 
-// RemoveChildIDs removes the "children" edge to the Comment entity by IDs.
-func (m *CommentMutation) RemoveChildIDs(ids ...int) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
-	}
+// ClearPost clears the "post" edge to the Post entity.
+func (m *PostSlugHistoryMutation) ClearPost() {
+	m.clearedpost = true
 }
 
-// RemovedChildren returns the removed IDs of the "children" edge to the Comment entity.
-func (m *CommentMutation) RemovedChildrenIDs() (ids []int) {
-	for id := range m.removedchildren {
-		ids = append(ids, id)
+// PostCleared reports if the "post" edge to the Post entity was cleared.
+func (m *PostSlugHistoryMutation) PostCleared() bool {
+	return m.clearedpost
+}
+
+// PostID returns the "post" edge ID in the mutation.
+func (m *PostSlugHistoryMutation) PostID() (id int, exists bool) {
+	if m.post != nil {
+		return *m.post, true
 	}
 	return
 }
 
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *CommentMutation) ChildrenIDs() (ids []int) {
-	for id := range m.children {
-		ids = append(ids, id)
+// PostIDs returns the "post" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PostID instead. It exists only for internal usage by the builders.
+func (m *PostSlugHistoryMutation) PostIDs() (ids []int) {
+	if id := m.post; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetChildren resets all changes to the "children" edge.
-func (m *CommentMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
+// ResetPost resets all changes to the "post" edge.
+func (m *PostSlugHistoryMutation) ResetPost() {
+	m.post = nil
+	m.clearedpost = false
 }
 
-// Where appends a list predicates to the CommentMutation builder.
-func (m *CommentMutation) Where(ps ...predicate.Comment) {
+// Where appends a list predicates to the PostSlugHistoryMutation builder.
+func (m *PostSlugHistoryMutation) Where(ps ...predicate.PostSlugHistory) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CommentMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PostSlugHistoryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Comment, len(ps))
+func (m *PostSlugHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PostSlugHistory, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2778,51 +13337,30 @@ func (m *CommentMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CommentMutation) Op() Op {
+func (m *PostSlugHistoryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CommentMutation) SetOp(op Op) {
+func (m *PostSlugHistoryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Comment).
-func (m *CommentMutation) Type() string {
+// Type returns the node type of this mutation (PostSlugHistory).
+func (m *PostSlugHistoryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CommentMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.content != nil {
-		fields = append(fields, comment.FieldContent)
-	}
-	if m.author != nil {
-		fields = append(fields, comment.FieldAuthor)
-	}
-	if m.email != nil {
-		fields = append(fields, comment.FieldEmail)
-	}
-	if m.website != nil {
-		fields = append(fields, comment.FieldWebsite)
-	}
-	if m.approved != nil {
-		fields = append(fields, comment.FieldApproved)
+func (m *PostSlugHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.slug != nil {
+		fields = append(fields, postslughistory.FieldSlug)
 	}
 	if m.created_at != nil {
-		fields = append(fields, comment.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, comment.FieldUpdatedAt)
-	}
-	if m.avatar != nil {
-		fields = append(fields, comment.FieldAvatar)
-	}
-	if m.parent != nil {
-		fields = append(fields, comment.FieldParentID)
+		fields = append(fields, postslughistory.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2830,26 +13368,12 @@ func (m *CommentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CommentMutation) Field(name string) (ent.Value, bool) {
+func (m *PostSlugHistoryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case comment.FieldContent:
-		return m.Content()
-	case comment.FieldAuthor:
-		return m.Author()
-	case comment.FieldEmail:
-		return m.Email()
-	case comment.FieldWebsite:
-		return m.Website()
-	case comment.FieldApproved:
-		return m.Approved()
-	case comment.FieldCreatedAt:
+	case postslughistory.FieldSlug:
+		return m.Slug()
+	case postslughistory.FieldCreatedAt:
 		return m.CreatedAt()
-	case comment.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case comment.FieldAvatar:
-		return m.Avatar()
-	case comment.FieldParentID:
-		return m.ParentID()
 	}
 	return nil, false
 }
@@ -2857,369 +13381,196 @@ func (m *CommentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CommentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PostSlugHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case comment.FieldContent:
-		return m.OldContent(ctx)
-	case comment.FieldAuthor:
-		return m.OldAuthor(ctx)
-	case comment.FieldEmail:
-		return m.OldEmail(ctx)
-	case comment.FieldWebsite:
-		return m.OldWebsite(ctx)
-	case comment.FieldApproved:
-		return m.OldApproved(ctx)
-	case comment.FieldCreatedAt:
+	case postslughistory.FieldSlug:
+		return m.OldSlug(ctx)
+	case postslughistory.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case comment.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case comment.FieldAvatar:
-		return m.OldAvatar(ctx)
-	case comment.FieldParentID:
-		return m.OldParentID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Comment field %s", name)
+	return nil, fmt.Errorf("unknown PostSlugHistory field %s", name)
 }
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CommentMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case comment.FieldContent:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContent(v)
-		return nil
-	case comment.FieldAuthor:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAuthor(v)
-		return nil
-	case comment.FieldEmail:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmail(v)
-		return nil
-	case comment.FieldWebsite:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWebsite(v)
-		return nil
-	case comment.FieldApproved:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetApproved(v)
-		return nil
-	case comment.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case comment.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case comment.FieldAvatar:
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PostSlugHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case postslughistory.FieldSlug:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAvatar(v)
+		m.SetSlug(v)
 		return nil
-	case comment.FieldParentID:
-		v, ok := value.(int)
+	case postslughistory.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetParentID(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Comment field %s", name)
+	return fmt.Errorf("unknown PostSlugHistory field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CommentMutation) AddedFields() []string {
-	var fields []string
-	return fields
+func (m *PostSlugHistoryMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CommentMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	}
+func (m *PostSlugHistoryMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CommentMutation) AddField(name string, value ent.Value) error {
+func (m *PostSlugHistoryMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Comment numeric field %s", name)
+	return fmt.Errorf("unknown PostSlugHistory numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CommentMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(comment.FieldWebsite) {
-		fields = append(fields, comment.FieldWebsite)
-	}
-	if m.FieldCleared(comment.FieldAvatar) {
-		fields = append(fields, comment.FieldAvatar)
-	}
-	if m.FieldCleared(comment.FieldParentID) {
-		fields = append(fields, comment.FieldParentID)
-	}
-	return fields
+func (m *PostSlugHistoryMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CommentMutation) FieldCleared(name string) bool {
+func (m *PostSlugHistoryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CommentMutation) ClearField(name string) error {
-	switch name {
-	case comment.FieldWebsite:
-		m.ClearWebsite()
-		return nil
-	case comment.FieldAvatar:
-		m.ClearAvatar()
-		return nil
-	case comment.FieldParentID:
-		m.ClearParentID()
-		return nil
-	}
-	return fmt.Errorf("unknown Comment nullable field %s", name)
+func (m *PostSlugHistoryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PostSlugHistory nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CommentMutation) ResetField(name string) error {
+func (m *PostSlugHistoryMutation) ResetField(name string) error {
 	switch name {
-	case comment.FieldContent:
-		m.ResetContent()
-		return nil
-	case comment.FieldAuthor:
-		m.ResetAuthor()
-		return nil
-	case comment.FieldEmail:
-		m.ResetEmail()
-		return nil
-	case comment.FieldWebsite:
-		m.ResetWebsite()
-		return nil
-	case comment.FieldApproved:
-		m.ResetApproved()
+	case postslughistory.FieldSlug:
+		m.ResetSlug()
 		return nil
-	case comment.FieldCreatedAt:
+	case postslughistory.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case comment.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case comment.FieldAvatar:
-		m.ResetAvatar()
-		return nil
-	case comment.FieldParentID:
-		m.ResetParentID()
-		return nil
 	}
-	return fmt.Errorf("unknown Comment field %s", name)
+	return fmt.Errorf("unknown PostSlugHistory field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CommentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
+func (m *PostSlugHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.post != nil {
-		edges = append(edges, comment.EdgePost)
-	}
-	if m.user != nil {
-		edges = append(edges, comment.EdgeUser)
-	}
-	if m.parent != nil {
-		edges = append(edges, comment.EdgeParent)
-	}
-	if m.children != nil {
-		edges = append(edges, comment.EdgeChildren)
+		edges = append(edges, postslughistory.EdgePost)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CommentMutation) AddedIDs(name string) []ent.Value {
+func (m *PostSlugHistoryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case comment.EdgePost:
+	case postslughistory.EdgePost:
 		if id := m.post; id != nil {
 			return []ent.Value{*id}
 		}
-	case comment.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case comment.EdgeParent:
-		if id := m.parent; id != nil {
-			return []ent.Value{*id}
-		}
-	case comment.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CommentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedchildren != nil {
-		edges = append(edges, comment.EdgeChildren)
-	}
+func (m *PostSlugHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CommentMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case comment.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *PostSlugHistoryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CommentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
+func (m *PostSlugHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.clearedpost {
-		edges = append(edges, comment.EdgePost)
-	}
-	if m.cleareduser {
-		edges = append(edges, comment.EdgeUser)
-	}
-	if m.clearedparent {
-		edges = append(edges, comment.EdgeParent)
-	}
-	if m.clearedchildren {
-		edges = append(edges, comment.EdgeChildren)
+		edges = append(edges, postslughistory.EdgePost)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CommentMutation) EdgeCleared(name string) bool {
+func (m *PostSlugHistoryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case comment.EdgePost:
+	case postslughistory.EdgePost:
 		return m.clearedpost
-	case comment.EdgeUser:
-		return m.cleareduser
-	case comment.EdgeParent:
-		return m.clearedparent
-	case comment.EdgeChildren:
-		return m.clearedchildren
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CommentMutation) ClearEdge(name string) error {
+func (m *PostSlugHistoryMutation) ClearEdge(name string) error {
 	switch name {
-	case comment.EdgePost:
+	case postslughistory.EdgePost:
 		m.ClearPost()
 		return nil
-	case comment.EdgeUser:
-		m.ClearUser()
-		return nil
-	case comment.EdgeParent:
-		m.ClearParent()
-		return nil
 	}
-	return fmt.Errorf("unknown Comment unique edge %s", name)
+	return fmt.Errorf("unknown PostSlugHistory unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CommentMutation) ResetEdge(name string) error {
+func (m *PostSlugHistoryMutation) ResetEdge(name string) error {
 	switch name {
-	case comment.EdgePost:
+	case postslughistory.EdgePost:
 		m.ResetPost()
 		return nil
-	case comment.EdgeUser:
-		m.ResetUser()
-		return nil
-	case comment.EdgeParent:
-		m.ResetParent()
-		return nil
-	case comment.EdgeChildren:
-		m.ResetChildren()
-		return nil
 	}
-	return fmt.Errorf("unknown Comment edge %s", name)
+	return fmt.Errorf("unknown PostSlugHistory edge %s", name)
 }
 
-// FriendMutation represents an operation that mutates the Friend nodes in the graph.
-type FriendMutation struct {
+// RecoveryCodeMutation represents an operation that mutates the RecoveryCode nodes in the graph.
+type RecoveryCodeMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	name          *string
-	url           *string
-	avatar        *string
-	desc          *string
+	code_hash     *string
+	used_at       *time.Time
 	created_at    *time.Time
-	updated_at    *time.Time
 	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*Friend, error)
-	predicates    []predicate.Friend
+	oldValue      func(context.Context) (*RecoveryCode, error)
+	predicates    []predicate.RecoveryCode
 }
 
-var _ ent.Mutation = (*FriendMutation)(nil)
+var _ ent.Mutation = (*RecoveryCodeMutation)(nil)
 
-// friendOption allows management of the mutation configuration using functional options.
-type friendOption func(*FriendMutation)
+// recoverycodeOption allows management of the mutation configuration using functional options.
+type recoverycodeOption func(*RecoveryCodeMutation)
 
-// newFriendMutation creates new mutation for the Friend entity.
-func newFriendMutation(c config, op Op, opts ...friendOption) *FriendMutation {
-	m := &FriendMutation{
+// newRecoveryCodeMutation creates new mutation for the RecoveryCode entity.
+func newRecoveryCodeMutation(c config, op Op, opts ...recoverycodeOption) *RecoveryCodeMutation {
+	m := &RecoveryCodeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFriend,
+		typ:           TypeRecoveryCode,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3228,20 +13579,20 @@ func newFriendMutation(c config, op Op, opts ...friendOption) *FriendMutation {
 	return m
 }
 
-// withFriendID sets the ID field of the mutation.
-func withFriendID(id int) friendOption {
-	return func(m *FriendMutation) {
+// withRecoveryCodeID sets the ID field of the mutation.
+func withRecoveryCodeID(id int) recoverycodeOption {
+	return func(m *RecoveryCodeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Friend
+			value *RecoveryCode
 		)
-		m.oldValue = func(ctx context.Context) (*Friend, error) {
+		m.oldValue = func(ctx context.Context) (*RecoveryCode, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Friend.Get(ctx, id)
+					value, err = m.Client().RecoveryCode.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3250,10 +13601,10 @@ func withFriendID(id int) friendOption {
 	}
 }
 
-// withFriend sets the old Friend of the mutation.
-func withFriend(node *Friend) friendOption {
-	return func(m *FriendMutation) {
-		m.oldValue = func(context.Context) (*Friend, error) {
+// withRecoveryCode sets the old RecoveryCode of the mutation.
+func withRecoveryCode(node *RecoveryCode) recoverycodeOption {
+	return func(m *RecoveryCodeMutation) {
+		m.oldValue = func(context.Context) (*RecoveryCode, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3262,7 +13613,7 @@ func withFriend(node *Friend) friendOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FriendMutation) Client() *Client {
+func (m RecoveryCodeMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3270,207 +13621,135 @@ func (m FriendMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FriendMutation) Tx() (*Tx, error) {
+func (m RecoveryCodeMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
 	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *FriendMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *FriendMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Friend.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetName sets the "name" field.
-func (m *FriendMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *FriendMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the Friend entity.
-// If the Friend object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FriendMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *FriendMutation) ResetName() {
-	m.name = nil
-}
-
-// SetURL sets the "url" field.
-func (m *FriendMutation) SetURL(s string) {
-	m.url = &s
+	tx.init()
+	return tx, nil
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *FriendMutation) URL() (r string, exists bool) {
-	v := m.url
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RecoveryCodeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldURL returns the old "url" field's value of the Friend entity.
-// If the Friend object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FriendMutation) OldURL(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RecoveryCodeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RecoveryCode.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.URL, nil
-}
-
-// ResetURL resets all changes to the "url" field.
-func (m *FriendMutation) ResetURL() {
-	m.url = nil
 }
 
-// SetAvatar sets the "avatar" field.
-func (m *FriendMutation) SetAvatar(s string) {
-	m.avatar = &s
+// SetCodeHash sets the "code_hash" field.
+func (m *RecoveryCodeMutation) SetCodeHash(s string) {
+	m.code_hash = &s
 }
 
-// Avatar returns the value of the "avatar" field in the mutation.
-func (m *FriendMutation) Avatar() (r string, exists bool) {
-	v := m.avatar
+// CodeHash returns the value of the "code_hash" field in the mutation.
+func (m *RecoveryCodeMutation) CodeHash() (r string, exists bool) {
+	v := m.code_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAvatar returns the old "avatar" field's value of the Friend entity.
-// If the Friend object wasn't provided to the builder, the object is fetched from the database.
+// OldCodeHash returns the old "code_hash" field's value of the RecoveryCode entity.
+// If the RecoveryCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FriendMutation) OldAvatar(ctx context.Context) (v string, err error) {
+func (m *RecoveryCodeMutation) OldCodeHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+		return v, errors.New("OldCodeHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatar requires an ID field in the mutation")
+		return v, errors.New("OldCodeHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+		return v, fmt.Errorf("querying old value for OldCodeHash: %w", err)
 	}
-	return oldValue.Avatar, nil
+	return oldValue.CodeHash, nil
 }
 
-// ResetAvatar resets all changes to the "avatar" field.
-func (m *FriendMutation) ResetAvatar() {
-	m.avatar = nil
+// ResetCodeHash resets all changes to the "code_hash" field.
+func (m *RecoveryCodeMutation) ResetCodeHash() {
+	m.code_hash = nil
 }
 
-// SetDesc sets the "desc" field.
-func (m *FriendMutation) SetDesc(s string) {
-	m.desc = &s
+// SetUsedAt sets the "used_at" field.
+func (m *RecoveryCodeMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
 }
 
-// Desc returns the value of the "desc" field in the mutation.
-func (m *FriendMutation) Desc() (r string, exists bool) {
-	v := m.desc
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *RecoveryCodeMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDesc returns the old "desc" field's value of the Friend entity.
-// If the Friend object wasn't provided to the builder, the object is fetched from the database.
+// OldUsedAt returns the old "used_at" field's value of the RecoveryCode entity.
+// If the RecoveryCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FriendMutation) OldDesc(ctx context.Context) (v string, err error) {
+func (m *RecoveryCodeMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDesc is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDesc requires an ID field in the mutation")
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDesc: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
 	}
-	return oldValue.Desc, nil
+	return oldValue.UsedAt, nil
 }
 
-// ClearDesc clears the value of the "desc" field.
-func (m *FriendMutation) ClearDesc() {
-	m.desc = nil
-	m.clearedFields[friend.FieldDesc] = struct{}{}
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *RecoveryCodeMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[recoverycode.FieldUsedAt] = struct{}{}
 }
 
-// DescCleared returns if the "desc" field was cleared in this mutation.
-func (m *FriendMutation) DescCleared() bool {
-	_, ok := m.clearedFields[friend.FieldDesc]
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *RecoveryCodeMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[recoverycode.FieldUsedAt]
 	return ok
 }
 
-// ResetDesc resets all changes to the "desc" field.
-func (m *FriendMutation) ResetDesc() {
-	m.desc = nil
-	delete(m.clearedFields, friend.FieldDesc)
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *RecoveryCodeMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, recoverycode.FieldUsedAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *FriendMutation) SetCreatedAt(t time.Time) {
+func (m *RecoveryCodeMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FriendMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *RecoveryCodeMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3478,10 +13757,10 @@ func (m *FriendMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Friend entity.
-// If the Friend object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the RecoveryCode entity.
+// If the RecoveryCode object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FriendMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *RecoveryCodeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3496,55 +13775,58 @@ func (m *FriendMutation) OldCreatedAt(ctx context.Context) (v time.Time, err err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FriendMutation) ResetCreatedAt() {
+func (m *RecoveryCodeMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *FriendMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *RecoveryCodeMutation) SetUserID(id int) {
+	m.user = &id
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *FriendMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearUser clears the "user" edge to the User entity.
+func (m *RecoveryCodeMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Friend entity.
-// If the Friend object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FriendMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RecoveryCodeMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *RecoveryCodeMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RecoveryCodeMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *FriendMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *RecoveryCodeMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the FriendMutation builder.
-func (m *FriendMutation) Where(ps ...predicate.Friend) {
+// Where appends a list predicates to the RecoveryCodeMutation builder.
+func (m *RecoveryCodeMutation) Where(ps ...predicate.RecoveryCode) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FriendMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RecoveryCodeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FriendMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Friend, len(ps))
+func (m *RecoveryCodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RecoveryCode, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3552,42 +13834,33 @@ func (m *FriendMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FriendMutation) Op() Op {
+func (m *RecoveryCodeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FriendMutation) SetOp(op Op) {
+func (m *RecoveryCodeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Friend).
-func (m *FriendMutation) Type() string {
+// Type returns the node type of this mutation (RecoveryCode).
+func (m *RecoveryCodeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FriendMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.name != nil {
-		fields = append(fields, friend.FieldName)
+func (m *RecoveryCodeMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.code_hash != nil {
+		fields = append(fields, recoverycode.FieldCodeHash)
 	}
-	if m.url != nil {
-		fields = append(fields, friend.FieldURL)
-	}
-	if m.avatar != nil {
-		fields = append(fields, friend.FieldAvatar)
-	}
-	if m.desc != nil {
-		fields = append(fields, friend.FieldDesc)
+	if m.used_at != nil {
+		fields = append(fields, recoverycode.FieldUsedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, friend.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, friend.FieldUpdatedAt)
+		fields = append(fields, recoverycode.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3595,20 +13868,14 @@ func (m *FriendMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FriendMutation) Field(name string) (ent.Value, bool) {
+func (m *RecoveryCodeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case friend.FieldName:
-		return m.Name()
-	case friend.FieldURL:
-		return m.URL()
-	case friend.FieldAvatar:
-		return m.Avatar()
-	case friend.FieldDesc:
-		return m.Desc()
-	case friend.FieldCreatedAt:
+	case recoverycode.FieldCodeHash:
+		return m.CodeHash()
+	case recoverycode.FieldUsedAt:
+		return m.UsedAt()
+	case recoverycode.FieldCreatedAt:
 		return m.CreatedAt()
-	case friend.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -3616,226 +13883,220 @@ func (m *FriendMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FriendMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RecoveryCodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case friend.FieldName:
-		return m.OldName(ctx)
-	case friend.FieldURL:
-		return m.OldURL(ctx)
-	case friend.FieldAvatar:
-		return m.OldAvatar(ctx)
-	case friend.FieldDesc:
-		return m.OldDesc(ctx)
-	case friend.FieldCreatedAt:
+	case recoverycode.FieldCodeHash:
+		return m.OldCodeHash(ctx)
+	case recoverycode.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case recoverycode.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case friend.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Friend field %s", name)
+	return nil, fmt.Errorf("unknown RecoveryCode field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FriendMutation) SetField(name string, value ent.Value) error {
+func (m *RecoveryCodeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case friend.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case friend.FieldURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetURL(v)
-		return nil
-	case friend.FieldAvatar:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAvatar(v)
-		return nil
-	case friend.FieldDesc:
+	case recoverycode.FieldCodeHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDesc(v)
+		m.SetCodeHash(v)
 		return nil
-	case friend.FieldCreatedAt:
+	case recoverycode.FieldUsedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetUsedAt(v)
 		return nil
-	case friend.FieldUpdatedAt:
+	case recoverycode.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Friend field %s", name)
+	return fmt.Errorf("unknown RecoveryCode field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FriendMutation) AddedFields() []string {
+func (m *RecoveryCodeMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FriendMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RecoveryCodeMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FriendMutation) AddField(name string, value ent.Value) error {
+func (m *RecoveryCodeMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Friend numeric field %s", name)
+	return fmt.Errorf("unknown RecoveryCode numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FriendMutation) ClearedFields() []string {
+func (m *RecoveryCodeMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(friend.FieldDesc) {
-		fields = append(fields, friend.FieldDesc)
+	if m.FieldCleared(recoverycode.FieldUsedAt) {
+		fields = append(fields, recoverycode.FieldUsedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FriendMutation) FieldCleared(name string) bool {
+func (m *RecoveryCodeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FriendMutation) ClearField(name string) error {
+func (m *RecoveryCodeMutation) ClearField(name string) error {
 	switch name {
-	case friend.FieldDesc:
-		m.ClearDesc()
+	case recoverycode.FieldUsedAt:
+		m.ClearUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Friend nullable field %s", name)
+	return fmt.Errorf("unknown RecoveryCode nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FriendMutation) ResetField(name string) error {
+func (m *RecoveryCodeMutation) ResetField(name string) error {
 	switch name {
-	case friend.FieldName:
-		m.ResetName()
-		return nil
-	case friend.FieldURL:
-		m.ResetURL()
-		return nil
-	case friend.FieldAvatar:
-		m.ResetAvatar()
+	case recoverycode.FieldCodeHash:
+		m.ResetCodeHash()
 		return nil
-	case friend.FieldDesc:
-		m.ResetDesc()
+	case recoverycode.FieldUsedAt:
+		m.ResetUsedAt()
 		return nil
-	case friend.FieldCreatedAt:
+	case recoverycode.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case friend.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Friend field %s", name)
+	return fmt.Errorf("unknown RecoveryCode field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FriendMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *RecoveryCodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, recoverycode.EdgeUser)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FriendMutation) AddedIDs(name string) []ent.Value {
+func (m *RecoveryCodeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case recoverycode.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FriendMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *RecoveryCodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FriendMutation) RemovedIDs(name string) []ent.Value {
+func (m *RecoveryCodeMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FriendMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *RecoveryCodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, recoverycode.EdgeUser)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FriendMutation) EdgeCleared(name string) bool {
+func (m *RecoveryCodeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case recoverycode.EdgeUser:
+		return m.cleareduser
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FriendMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Friend unique edge %s", name)
+func (m *RecoveryCodeMutation) ClearEdge(name string) error {
+	switch name {
+	case recoverycode.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RecoveryCode unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FriendMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Friend edge %s", name)
+func (m *RecoveryCodeMutation) ResetEdge(name string) error {
+	switch name {
+	case recoverycode.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RecoveryCode edge %s", name)
 }
 
-// HitokotoMutation represents an operation that mutates the Hitokoto nodes in the graph.
-type HitokotoMutation struct {
+// SearchDocumentMutation represents an operation that mutates the SearchDocument nodes in the graph.
+type SearchDocumentMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
+	doc_type      *searchdocument.DocType
+	doc_id        *int
+	adddoc_id     *int
+	title         *string
 	content       *string
-	source        *string
-	created_at    *time.Time
+	vector        *string
+	visible       *bool
 	updated_at    *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*Hitokoto, error)
-	predicates    []predicate.Hitokoto
+	oldValue      func(context.Context) (*SearchDocument, error)
+	predicates    []predicate.SearchDocument
 }
 
-var _ ent.Mutation = (*HitokotoMutation)(nil)
+var _ ent.Mutation = (*SearchDocumentMutation)(nil)
 
-// hitokotoOption allows management of the mutation configuration using functional options.
-type hitokotoOption func(*HitokotoMutation)
+// searchdocumentOption allows management of the mutation configuration using functional options.
+type searchdocumentOption func(*SearchDocumentMutation)
 
-// newHitokotoMutation creates new mutation for the Hitokoto entity.
-func newHitokotoMutation(c config, op Op, opts ...hitokotoOption) *HitokotoMutation {
-	m := &HitokotoMutation{
+// newSearchDocumentMutation creates new mutation for the SearchDocument entity.
+func newSearchDocumentMutation(c config, op Op, opts ...searchdocumentOption) *SearchDocumentMutation {
+	m := &SearchDocumentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeHitokoto,
+		typ:           TypeSearchDocument,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3844,20 +14105,20 @@ func newHitokotoMutation(c config, op Op, opts ...hitokotoOption) *HitokotoMutat
 	return m
 }
 
-// withHitokotoID sets the ID field of the mutation.
-func withHitokotoID(id int) hitokotoOption {
-	return func(m *HitokotoMutation) {
+// withSearchDocumentID sets the ID field of the mutation.
+func withSearchDocumentID(id int) searchdocumentOption {
+	return func(m *SearchDocumentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Hitokoto
+			value *SearchDocument
 		)
-		m.oldValue = func(ctx context.Context) (*Hitokoto, error) {
+		m.oldValue = func(ctx context.Context) (*SearchDocument, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Hitokoto.Get(ctx, id)
+					value, err = m.Client().SearchDocument.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3866,10 +14127,10 @@ func withHitokotoID(id int) hitokotoOption {
 	}
 }
 
-// withHitokoto sets the old Hitokoto of the mutation.
-func withHitokoto(node *Hitokoto) hitokotoOption {
-	return func(m *HitokotoMutation) {
-		m.oldValue = func(context.Context) (*Hitokoto, error) {
+// withSearchDocument sets the old SearchDocument of the mutation.
+func withSearchDocument(node *SearchDocument) searchdocumentOption {
+	return func(m *SearchDocumentMutation) {
+		m.oldValue = func(context.Context) (*SearchDocument, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3878,7 +14139,7 @@ func withHitokoto(node *Hitokoto) hitokotoOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m HitokotoMutation) Client() *Client {
+func (m SearchDocumentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3886,7 +14147,7 @@ func (m HitokotoMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m HitokotoMutation) Tx() (*Tx, error) {
+func (m SearchDocumentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3897,7 +14158,7 @@ func (m HitokotoMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *HitokotoMutation) ID() (id int, exists bool) {
+func (m *SearchDocumentMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3908,7 +14169,7 @@ func (m *HitokotoMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *HitokotoMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SearchDocumentMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3917,19 +14178,147 @@ func (m *HitokotoMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Hitokoto.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SearchDocument.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetDocType sets the "doc_type" field.
+func (m *SearchDocumentMutation) SetDocType(st searchdocument.DocType) {
+	m.doc_type = &st
+}
+
+// DocType returns the value of the "doc_type" field in the mutation.
+func (m *SearchDocumentMutation) DocType() (r searchdocument.DocType, exists bool) {
+	v := m.doc_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocType returns the old "doc_type" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchDocumentMutation) OldDocType(ctx context.Context) (v searchdocument.DocType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocType: %w", err)
+	}
+	return oldValue.DocType, nil
+}
+
+// ResetDocType resets all changes to the "doc_type" field.
+func (m *SearchDocumentMutation) ResetDocType() {
+	m.doc_type = nil
+}
+
+// SetDocID sets the "doc_id" field.
+func (m *SearchDocumentMutation) SetDocID(i int) {
+	m.doc_id = &i
+	m.adddoc_id = nil
+}
+
+// DocID returns the value of the "doc_id" field in the mutation.
+func (m *SearchDocumentMutation) DocID() (r int, exists bool) {
+	v := m.doc_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocID returns the old "doc_id" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchDocumentMutation) OldDocID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocID: %w", err)
+	}
+	return oldValue.DocID, nil
+}
+
+// AddDocID adds i to the "doc_id" field.
+func (m *SearchDocumentMutation) AddDocID(i int) {
+	if m.adddoc_id != nil {
+		*m.adddoc_id += i
+	} else {
+		m.adddoc_id = &i
+	}
+}
+
+// AddedDocID returns the value that was added to the "doc_id" field in this mutation.
+func (m *SearchDocumentMutation) AddedDocID() (r int, exists bool) {
+	v := m.adddoc_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDocID resets all changes to the "doc_id" field.
+func (m *SearchDocumentMutation) ResetDocID() {
+	m.doc_id = nil
+	m.adddoc_id = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *SearchDocumentMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *SearchDocumentMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchDocumentMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *SearchDocumentMutation) ResetTitle() {
+	m.title = nil
+}
+
 // SetContent sets the "content" field.
-func (m *HitokotoMutation) SetContent(s string) {
+func (m *SearchDocumentMutation) SetContent(s string) {
 	m.content = &s
 }
 
 // Content returns the value of the "content" field in the mutation.
-func (m *HitokotoMutation) Content() (r string, exists bool) {
+func (m *SearchDocumentMutation) Content() (r string, exists bool) {
 	v := m.content
 	if v == nil {
 		return
@@ -3937,10 +14326,10 @@ func (m *HitokotoMutation) Content() (r string, exists bool) {
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Hitokoto entity.
-// If the Hitokoto object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HitokotoMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *SearchDocumentMutation) OldContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
@@ -3954,103 +14343,116 @@ func (m *HitokotoMutation) OldContent(ctx context.Context) (v string, err error)
 	return oldValue.Content, nil
 }
 
+// ClearContent clears the value of the "content" field.
+func (m *SearchDocumentMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[searchdocument.FieldContent] = struct{}{}
+}
+
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *SearchDocumentMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[searchdocument.FieldContent]
+	return ok
+}
+
 // ResetContent resets all changes to the "content" field.
-func (m *HitokotoMutation) ResetContent() {
+func (m *SearchDocumentMutation) ResetContent() {
 	m.content = nil
+	delete(m.clearedFields, searchdocument.FieldContent)
 }
 
-// SetSource sets the "source" field.
-func (m *HitokotoMutation) SetSource(s string) {
-	m.source = &s
+// SetVector sets the "vector" field.
+func (m *SearchDocumentMutation) SetVector(s string) {
+	m.vector = &s
 }
 
-// Source returns the value of the "source" field in the mutation.
-func (m *HitokotoMutation) Source() (r string, exists bool) {
-	v := m.source
+// Vector returns the value of the "vector" field in the mutation.
+func (m *SearchDocumentMutation) Vector() (r string, exists bool) {
+	v := m.vector
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSource returns the old "source" field's value of the Hitokoto entity.
-// If the Hitokoto object wasn't provided to the builder, the object is fetched from the database.
+// OldVector returns the old "vector" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HitokotoMutation) OldSource(ctx context.Context) (v string, err error) {
+func (m *SearchDocumentMutation) OldVector(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+		return v, errors.New("OldVector is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSource requires an ID field in the mutation")
+		return v, errors.New("OldVector requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+		return v, fmt.Errorf("querying old value for OldVector: %w", err)
 	}
-	return oldValue.Source, nil
+	return oldValue.Vector, nil
 }
 
-// ClearSource clears the value of the "source" field.
-func (m *HitokotoMutation) ClearSource() {
-	m.source = nil
-	m.clearedFields[hitokoto.FieldSource] = struct{}{}
+// ClearVector clears the value of the "vector" field.
+func (m *SearchDocumentMutation) ClearVector() {
+	m.vector = nil
+	m.clearedFields[searchdocument.FieldVector] = struct{}{}
 }
 
-// SourceCleared returns if the "source" field was cleared in this mutation.
-func (m *HitokotoMutation) SourceCleared() bool {
-	_, ok := m.clearedFields[hitokoto.FieldSource]
+// VectorCleared returns if the "vector" field was cleared in this mutation.
+func (m *SearchDocumentMutation) VectorCleared() bool {
+	_, ok := m.clearedFields[searchdocument.FieldVector]
 	return ok
 }
 
-// ResetSource resets all changes to the "source" field.
-func (m *HitokotoMutation) ResetSource() {
-	m.source = nil
-	delete(m.clearedFields, hitokoto.FieldSource)
+// ResetVector resets all changes to the "vector" field.
+func (m *SearchDocumentMutation) ResetVector() {
+	m.vector = nil
+	delete(m.clearedFields, searchdocument.FieldVector)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *HitokotoMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetVisible sets the "visible" field.
+func (m *SearchDocumentMutation) SetVisible(b bool) {
+	m.visible = &b
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *HitokotoMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Visible returns the value of the "visible" field in the mutation.
+func (m *SearchDocumentMutation) Visible() (r bool, exists bool) {
+	v := m.visible
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Hitokoto entity.
-// If the Hitokoto object wasn't provided to the builder, the object is fetched from the database.
+// OldVisible returns the old "visible" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HitokotoMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SearchDocumentMutation) OldVisible(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldVisible is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldVisible requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldVisible: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Visible, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *HitokotoMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetVisible resets all changes to the "visible" field.
+func (m *SearchDocumentMutation) ResetVisible() {
+	m.visible = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *HitokotoMutation) SetUpdatedAt(t time.Time) {
+func (m *SearchDocumentMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *HitokotoMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *SearchDocumentMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -4058,10 +14460,10 @@ func (m *HitokotoMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Hitokoto entity.
-// If the Hitokoto object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the SearchDocument entity.
+// If the SearchDocument object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HitokotoMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SearchDocumentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -4076,19 +14478,19 @@ func (m *HitokotoMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *HitokotoMutation) ResetUpdatedAt() {
+func (m *SearchDocumentMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// Where appends a list predicates to the HitokotoMutation builder.
-func (m *HitokotoMutation) Where(ps ...predicate.Hitokoto) {
+// Where appends a list predicates to the SearchDocumentMutation builder.
+func (m *SearchDocumentMutation) Where(ps ...predicate.SearchDocument) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the HitokotoMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SearchDocumentMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *HitokotoMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Hitokoto, len(ps))
+func (m *SearchDocumentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SearchDocument, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4096,36 +14498,45 @@ func (m *HitokotoMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *HitokotoMutation) Op() Op {
+func (m *SearchDocumentMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *HitokotoMutation) SetOp(op Op) {
+func (m *SearchDocumentMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Hitokoto).
-func (m *HitokotoMutation) Type() string {
+// Type returns the node type of this mutation (SearchDocument).
+func (m *SearchDocumentMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *HitokotoMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+func (m *SearchDocumentMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.doc_type != nil {
+		fields = append(fields, searchdocument.FieldDocType)
+	}
+	if m.doc_id != nil {
+		fields = append(fields, searchdocument.FieldDocID)
+	}
+	if m.title != nil {
+		fields = append(fields, searchdocument.FieldTitle)
+	}
 	if m.content != nil {
-		fields = append(fields, hitokoto.FieldContent)
+		fields = append(fields, searchdocument.FieldContent)
 	}
-	if m.source != nil {
-		fields = append(fields, hitokoto.FieldSource)
+	if m.vector != nil {
+		fields = append(fields, searchdocument.FieldVector)
 	}
-	if m.created_at != nil {
-		fields = append(fields, hitokoto.FieldCreatedAt)
+	if m.visible != nil {
+		fields = append(fields, searchdocument.FieldVisible)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, hitokoto.FieldUpdatedAt)
+		fields = append(fields, searchdocument.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -4133,15 +14544,21 @@ func (m *HitokotoMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *HitokotoMutation) Field(name string) (ent.Value, bool) {
+func (m *SearchDocumentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case hitokoto.FieldContent:
+	case searchdocument.FieldDocType:
+		return m.DocType()
+	case searchdocument.FieldDocID:
+		return m.DocID()
+	case searchdocument.FieldTitle:
+		return m.Title()
+	case searchdocument.FieldContent:
 		return m.Content()
-	case hitokoto.FieldSource:
-		return m.Source()
-	case hitokoto.FieldCreatedAt:
-		return m.CreatedAt()
-	case hitokoto.FieldUpdatedAt:
+	case searchdocument.FieldVector:
+		return m.Vector()
+	case searchdocument.FieldVisible:
+		return m.Visible()
+	case searchdocument.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -4150,47 +14567,74 @@ func (m *HitokotoMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *HitokotoMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SearchDocumentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case hitokoto.FieldContent:
+	case searchdocument.FieldDocType:
+		return m.OldDocType(ctx)
+	case searchdocument.FieldDocID:
+		return m.OldDocID(ctx)
+	case searchdocument.FieldTitle:
+		return m.OldTitle(ctx)
+	case searchdocument.FieldContent:
 		return m.OldContent(ctx)
-	case hitokoto.FieldSource:
-		return m.OldSource(ctx)
-	case hitokoto.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case hitokoto.FieldUpdatedAt:
+	case searchdocument.FieldVector:
+		return m.OldVector(ctx)
+	case searchdocument.FieldVisible:
+		return m.OldVisible(ctx)
+	case searchdocument.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Hitokoto field %s", name)
+	return nil, fmt.Errorf("unknown SearchDocument field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HitokotoMutation) SetField(name string, value ent.Value) error {
+func (m *SearchDocumentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case hitokoto.FieldContent:
+	case searchdocument.FieldDocType:
+		v, ok := value.(searchdocument.DocType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocType(v)
+		return nil
+	case searchdocument.FieldDocID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocID(v)
+		return nil
+	case searchdocument.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case searchdocument.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetContent(v)
 		return nil
-	case hitokoto.FieldSource:
+	case searchdocument.FieldVector:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSource(v)
+		m.SetVector(v)
 		return nil
-	case hitokoto.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case searchdocument.FieldVisible:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetVisible(v)
 		return nil
-	case hitokoto.FieldUpdatedAt:
+	case searchdocument.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -4198,165 +14642,191 @@ func (m *HitokotoMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Hitokoto field %s", name)
+	return fmt.Errorf("unknown SearchDocument field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *HitokotoMutation) AddedFields() []string {
-	return nil
+func (m *SearchDocumentMutation) AddedFields() []string {
+	var fields []string
+	if m.adddoc_id != nil {
+		fields = append(fields, searchdocument.FieldDocID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *HitokotoMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SearchDocumentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case searchdocument.FieldDocID:
+		return m.AddedDocID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HitokotoMutation) AddField(name string, value ent.Value) error {
+func (m *SearchDocumentMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case searchdocument.FieldDocID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDocID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Hitokoto numeric field %s", name)
+	return fmt.Errorf("unknown SearchDocument numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *HitokotoMutation) ClearedFields() []string {
+func (m *SearchDocumentMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(hitokoto.FieldSource) {
-		fields = append(fields, hitokoto.FieldSource)
+	if m.FieldCleared(searchdocument.FieldContent) {
+		fields = append(fields, searchdocument.FieldContent)
+	}
+	if m.FieldCleared(searchdocument.FieldVector) {
+		fields = append(fields, searchdocument.FieldVector)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *HitokotoMutation) FieldCleared(name string) bool {
+func (m *SearchDocumentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *HitokotoMutation) ClearField(name string) error {
+func (m *SearchDocumentMutation) ClearField(name string) error {
 	switch name {
-	case hitokoto.FieldSource:
-		m.ClearSource()
+	case searchdocument.FieldContent:
+		m.ClearContent()
+		return nil
+	case searchdocument.FieldVector:
+		m.ClearVector()
 		return nil
 	}
-	return fmt.Errorf("unknown Hitokoto nullable field %s", name)
+	return fmt.Errorf("unknown SearchDocument nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *HitokotoMutation) ResetField(name string) error {
+func (m *SearchDocumentMutation) ResetField(name string) error {
 	switch name {
-	case hitokoto.FieldContent:
+	case searchdocument.FieldDocType:
+		m.ResetDocType()
+		return nil
+	case searchdocument.FieldDocID:
+		m.ResetDocID()
+		return nil
+	case searchdocument.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case searchdocument.FieldContent:
 		m.ResetContent()
 		return nil
-	case hitokoto.FieldSource:
-		m.ResetSource()
+	case searchdocument.FieldVector:
+		m.ResetVector()
 		return nil
-	case hitokoto.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case searchdocument.FieldVisible:
+		m.ResetVisible()
 		return nil
-	case hitokoto.FieldUpdatedAt:
+	case searchdocument.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Hitokoto field %s", name)
+	return fmt.Errorf("unknown SearchDocument field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *HitokotoMutation) AddedEdges() []string {
+func (m *SearchDocumentMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *HitokotoMutation) AddedIDs(name string) []ent.Value {
+func (m *SearchDocumentMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *HitokotoMutation) RemovedEdges() []string {
+func (m *SearchDocumentMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *HitokotoMutation) RemovedIDs(name string) []ent.Value {
+func (m *SearchDocumentMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *HitokotoMutation) ClearedEdges() []string {
+func (m *SearchDocumentMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *HitokotoMutation) EdgeCleared(name string) bool {
+func (m *SearchDocumentMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *HitokotoMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Hitokoto unique edge %s", name)
+func (m *SearchDocumentMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SearchDocument unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *HitokotoMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Hitokoto edge %s", name)
+func (m *SearchDocumentMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SearchDocument edge %s", name)
 }
 
-// ImageMutation represents an operation that mutates the Image nodes in the graph.
-type ImageMutation struct {
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	filename           *string
-	url                *string
-	size               *int64
-	addsize            *int64
-	_type              *string
-	created_at         *time.Time
-	width              *int
-	addwidth           *int
-	height             *int
-	addheight          *int
-	clearedFields      map[string]struct{}
-	uploaded_by        *int
-	cleareduploaded_by bool
-	books              map[int]struct{}
-	removedbooks       map[int]struct{}
-	clearedbooks       bool
-	done               bool
-	oldValue           func(context.Context) (*Image, error)
-	predicates         []predicate.Image
-}
-
-var _ ent.Mutation = (*ImageMutation)(nil)
-
-// imageOption allows management of the mutation configuration using functional options.
-type imageOption func(*ImageMutation)
-
-// newImageMutation creates new mutation for the Image entity.
-func newImageMutation(c config, op Op, opts ...imageOption) *ImageMutation {
-	m := &ImageMutation{
+	op                  Op
+	typ                 string
+	id                  *int
+	refresh_token_hash  *string
+	previous_token_hash *string
+	device              *string
+	ip                  *string
+	user_agent          *string
+	created_at          *time.Time
+	last_used_at        *time.Time
+	expires_at          *time.Time
+	revoked_at          *time.Time
+	clearedFields       map[string]struct{}
+	user                *int
+	cleareduser         bool
+	done                bool
+	oldValue            func(context.Context) (*Session, error)
+	predicates          []predicate.Session
+}
+
+var _ ent.Mutation = (*SessionMutation)(nil)
+
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
+
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeImage,
+		typ:           TypeSession,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4365,20 +14835,20 @@ func newImageMutation(c config, op Op, opts ...imageOption) *ImageMutation {
 	return m
 }
 
-// withImageID sets the ID field of the mutation.
-func withImageID(id int) imageOption {
-	return func(m *ImageMutation) {
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id int) sessionOption {
+	return func(m *SessionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Image
+			value *Session
 		)
-		m.oldValue = func(ctx context.Context) (*Image, error) {
+		m.oldValue = func(ctx context.Context) (*Session, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Image.Get(ctx, id)
+					value, err = m.Client().Session.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4387,10 +14857,10 @@ func withImageID(id int) imageOption {
 	}
 }
 
-// withImage sets the old Image of the mutation.
-func withImage(node *Image) imageOption {
-	return func(m *ImageMutation) {
-		m.oldValue = func(context.Context) (*Image, error) {
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4399,7 +14869,7 @@ func withImage(node *Image) imageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ImageMutation) Client() *Client {
+func (m SessionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4407,7 +14877,7 @@ func (m ImageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ImageMutation) Tx() (*Tx, error) {
+func (m SessionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4418,446 +14888,1048 @@ func (m ImageMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ImageMutation) ID() (id int, exists bool) {
+func (m *SessionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
-	return *m.id, true
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SessionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRefreshTokenHash sets the "refresh_token_hash" field.
+func (m *SessionMutation) SetRefreshTokenHash(s string) {
+	m.refresh_token_hash = &s
+}
+
+// RefreshTokenHash returns the value of the "refresh_token_hash" field in the mutation.
+func (m *SessionMutation) RefreshTokenHash() (r string, exists bool) {
+	v := m.refresh_token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefreshTokenHash returns the old "refresh_token_hash" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldRefreshTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefreshTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefreshTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefreshTokenHash: %w", err)
+	}
+	return oldValue.RefreshTokenHash, nil
+}
+
+// ResetRefreshTokenHash resets all changes to the "refresh_token_hash" field.
+func (m *SessionMutation) ResetRefreshTokenHash() {
+	m.refresh_token_hash = nil
+}
+
+// SetPreviousTokenHash sets the "previous_token_hash" field.
+func (m *SessionMutation) SetPreviousTokenHash(s string) {
+	m.previous_token_hash = &s
+}
+
+// PreviousTokenHash returns the value of the "previous_token_hash" field in the mutation.
+func (m *SessionMutation) PreviousTokenHash() (r string, exists bool) {
+	v := m.previous_token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousTokenHash returns the old "previous_token_hash" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldPreviousTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousTokenHash: %w", err)
+	}
+	return oldValue.PreviousTokenHash, nil
+}
+
+// ClearPreviousTokenHash clears the value of the "previous_token_hash" field.
+func (m *SessionMutation) ClearPreviousTokenHash() {
+	m.previous_token_hash = nil
+	m.clearedFields[session.FieldPreviousTokenHash] = struct{}{}
+}
+
+// PreviousTokenHashCleared returns if the "previous_token_hash" field was cleared in this mutation.
+func (m *SessionMutation) PreviousTokenHashCleared() bool {
+	_, ok := m.clearedFields[session.FieldPreviousTokenHash]
+	return ok
+}
+
+// ResetPreviousTokenHash resets all changes to the "previous_token_hash" field.
+func (m *SessionMutation) ResetPreviousTokenHash() {
+	m.previous_token_hash = nil
+	delete(m.clearedFields, session.FieldPreviousTokenHash)
+}
+
+// SetDevice sets the "device" field.
+func (m *SessionMutation) SetDevice(s string) {
+	m.device = &s
+}
+
+// Device returns the value of the "device" field in the mutation.
+func (m *SessionMutation) Device() (r string, exists bool) {
+	v := m.device
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDevice returns the old "device" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldDevice(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDevice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDevice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDevice: %w", err)
+	}
+	return oldValue.Device, nil
+}
+
+// ClearDevice clears the value of the "device" field.
+func (m *SessionMutation) ClearDevice() {
+	m.device = nil
+	m.clearedFields[session.FieldDevice] = struct{}{}
+}
+
+// DeviceCleared returns if the "device" field was cleared in this mutation.
+func (m *SessionMutation) DeviceCleared() bool {
+	_, ok := m.clearedFields[session.FieldDevice]
+	return ok
+}
+
+// ResetDevice resets all changes to the "device" field.
+func (m *SessionMutation) ResetDevice() {
+	m.device = nil
+	delete(m.clearedFields, session.FieldDevice)
+}
+
+// SetIP sets the "ip" field.
+func (m *SessionMutation) SetIP(s string) {
+	m.ip = &s
+}
+
+// IP returns the value of the "ip" field in the mutation.
+func (m *SessionMutation) IP() (r string, exists bool) {
+	v := m.ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIP returns the old "ip" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+	}
+	return oldValue.IP, nil
+}
+
+// ClearIP clears the value of the "ip" field.
+func (m *SessionMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[session.FieldIP] = struct{}{}
+}
+
+// IPCleared returns if the "ip" field was cleared in this mutation.
+func (m *SessionMutation) IPCleared() bool {
+	_, ok := m.clearedFields[session.FieldIP]
+	return ok
+}
+
+// ResetIP resets all changes to the "ip" field.
+func (m *SessionMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, session.FieldIP)
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *SessionMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *SessionMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *SessionMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[session.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *SessionMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[session.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *SessionMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, session.FieldUserAgent)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SessionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ImageMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Image.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SessionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetFilename sets the "filename" field.
-func (m *ImageMutation) SetFilename(s string) {
-	m.filename = &s
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *SessionMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// Filename returns the value of the "filename" field in the mutation.
-func (m *ImageMutation) Filename() (r string, exists bool) {
-	v := m.filename
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *SessionMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFilename returns the old "filename" field's value of the Image entity.
-// If the Image object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImageMutation) OldFilename(ctx context.Context) (v string, err error) {
+func (m *SessionMutation) OldLastUsedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFilename is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFilename requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFilename: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.Filename, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// ResetFilename resets all changes to the "filename" field.
-func (m *ImageMutation) ResetFilename() {
-	m.filename = nil
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *SessionMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
 }
 
-// SetURL sets the "url" field.
-func (m *ImageMutation) SetURL(s string) {
-	m.url = &s
+// SetExpiresAt sets the "expires_at" field.
+func (m *SessionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *ImageMutation) URL() (r string, exists bool) {
-	v := m.url
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SessionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the Image entity.
-// If the Image object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImageMutation) OldURL(ctx context.Context) (v string, err error) {
+func (m *SessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.URL, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *ImageMutation) ResetURL() {
-	m.url = nil
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SessionMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// SetSize sets the "size" field.
-func (m *ImageMutation) SetSize(i int64) {
-	m.size = &i
-	m.addsize = nil
+// SetRevokedAt sets the "revoked_at" field.
+func (m *SessionMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
 }
 
-// Size returns the value of the "size" field in the mutation.
-func (m *ImageMutation) Size() (r int64, exists bool) {
-	v := m.size
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *SessionMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSize returns the old "size" field's value of the Image entity.
-// If the Image object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedAt returns the old "revoked_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImageMutation) OldSize(ctx context.Context) (v int64, err error) {
+func (m *SessionMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSize requires an ID field in the mutation")
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
 	}
-	return oldValue.Size, nil
+	return oldValue.RevokedAt, nil
 }
 
-// AddSize adds i to the "size" field.
-func (m *ImageMutation) AddSize(i int64) {
-	if m.addsize != nil {
-		*m.addsize += i
-	} else {
-		m.addsize = &i
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *SessionMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[session.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *SessionMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[session.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *SessionMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, session.FieldRevokedAt)
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *SessionMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SessionMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SessionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *SessionMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
+	return
 }
 
-// AddedSize returns the value that was added to the "size" field in this mutation.
-func (m *ImageMutation) AddedSize() (r int64, exists bool) {
-	v := m.addsize
-	if v == nil {
-		return
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// ResetSize resets all changes to the "size" field.
-func (m *ImageMutation) ResetSize() {
-	m.size = nil
-	m.addsize = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *SessionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// SetType sets the "type" field.
-func (m *ImageMutation) SetType(s string) {
-	m._type = &s
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *ImageMutation) GetType() (r string, exists bool) {
-	v := m._type
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldType returns the old "type" field's value of the Image entity.
-// If the Image object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImageMutation) OldType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+// Op returns the operation name.
+func (m *SessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.refresh_token_hash != nil {
+		fields = append(fields, session.FieldRefreshTokenHash)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+	if m.previous_token_hash != nil {
+		fields = append(fields, session.FieldPreviousTokenHash)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	if m.device != nil {
+		fields = append(fields, session.FieldDevice)
 	}
-	return oldValue.Type, nil
+	if m.ip != nil {
+		fields = append(fields, session.FieldIP)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, session.FieldUserAgent)
+	}
+	if m.created_at != nil {
+		fields = append(fields, session.FieldCreatedAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, session.FieldLastUsedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, session.FieldExpiresAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, session.FieldRevokedAt)
+	}
+	return fields
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *ImageMutation) ResetType() {
-	m._type = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case session.FieldRefreshTokenHash:
+		return m.RefreshTokenHash()
+	case session.FieldPreviousTokenHash:
+		return m.PreviousTokenHash()
+	case session.FieldDevice:
+		return m.Device()
+	case session.FieldIP:
+		return m.IP()
+	case session.FieldUserAgent:
+		return m.UserAgent()
+	case session.FieldCreatedAt:
+		return m.CreatedAt()
+	case session.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case session.FieldExpiresAt:
+		return m.ExpiresAt()
+	case session.FieldRevokedAt:
+		return m.RevokedAt()
+	}
+	return nil, false
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ImageMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case session.FieldRefreshTokenHash:
+		return m.OldRefreshTokenHash(ctx)
+	case session.FieldPreviousTokenHash:
+		return m.OldPreviousTokenHash(ctx)
+	case session.FieldDevice:
+		return m.OldDevice(ctx)
+	case session.FieldIP:
+		return m.OldIP(ctx)
+	case session.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case session.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case session.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case session.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case session.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Session field %s", name)
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ImageMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case session.FieldRefreshTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefreshTokenHash(v)
+		return nil
+	case session.FieldPreviousTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousTokenHash(v)
+		return nil
+	case session.FieldDevice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDevice(v)
+		return nil
+	case session.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case session.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case session.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case session.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case session.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case session.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Session field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SessionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown Session numeric field %s", name)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Image entity.
-// If the Image object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(session.FieldPreviousTokenHash) {
+		fields = append(fields, session.FieldPreviousTokenHash)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	if m.FieldCleared(session.FieldDevice) {
+		fields = append(fields, session.FieldDevice)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	if m.FieldCleared(session.FieldIP) {
+		fields = append(fields, session.FieldIP)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ImageMutation) ResetCreatedAt() {
-	m.created_at = nil
+	if m.FieldCleared(session.FieldUserAgent) {
+		fields = append(fields, session.FieldUserAgent)
+	}
+	if m.FieldCleared(session.FieldRevokedAt) {
+		fields = append(fields, session.FieldRevokedAt)
+	}
+	return fields
 }
 
-// SetWidth sets the "width" field.
-func (m *ImageMutation) SetWidth(i int) {
-	m.width = &i
-	m.addwidth = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// Width returns the value of the "width" field in the mutation.
-func (m *ImageMutation) Width() (r int, exists bool) {
-	v := m.width
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SessionMutation) ClearField(name string) error {
+	switch name {
+	case session.FieldPreviousTokenHash:
+		m.ClearPreviousTokenHash()
+		return nil
+	case session.FieldDevice:
+		m.ClearDevice()
+		return nil
+	case session.FieldIP:
+		m.ClearIP()
+		return nil
+	case session.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	case session.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Session nullable field %s", name)
 }
 
-// OldWidth returns the old "width" field's value of the Image entity.
-// If the Image object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImageMutation) OldWidth(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWidth requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SessionMutation) ResetField(name string) error {
+	switch name {
+	case session.FieldRefreshTokenHash:
+		m.ResetRefreshTokenHash()
+		return nil
+	case session.FieldPreviousTokenHash:
+		m.ResetPreviousTokenHash()
+		return nil
+	case session.FieldDevice:
+		m.ResetDevice()
+		return nil
+	case session.FieldIP:
+		m.ResetIP()
+		return nil
+	case session.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case session.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case session.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case session.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case session.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
 	}
-	return oldValue.Width, nil
+	return fmt.Errorf("unknown Session field %s", name)
 }
 
-// AddWidth adds i to the "width" field.
-func (m *ImageMutation) AddWidth(i int) {
-	if m.addwidth != nil {
-		*m.addwidth += i
-	} else {
-		m.addwidth = &i
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, session.EdgeUser)
 	}
+	return edges
 }
 
-// AddedWidth returns the value that was added to the "width" field in this mutation.
-func (m *ImageMutation) AddedWidth() (r int, exists bool) {
-	v := m.addwidth
-	if v == nil {
-		return
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case session.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return *v, true
+	return nil
 }
 
-// ResetWidth resets all changes to the "width" field.
-func (m *ImageMutation) ResetWidth() {
-	m.width = nil
-	m.addwidth = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SetHeight sets the "height" field.
-func (m *ImageMutation) SetHeight(i int) {
-	m.height = &i
-	m.addheight = nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// Height returns the value of the "height" field in the mutation.
-func (m *ImageMutation) Height() (r int, exists bool) {
-	v := m.height
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, session.EdgeUser)
 	}
-	return *v, true
+	return edges
 }
 
-// OldHeight returns the old "height" field's value of the Image entity.
-// If the Image object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ImageMutation) OldHeight(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHeight requires an ID field in the mutation")
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SessionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case session.EdgeUser:
+		return m.cleareduser
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SessionMutation) ClearEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ClearUser()
+		return nil
 	}
-	return oldValue.Height, nil
+	return fmt.Errorf("unknown Session unique edge %s", name)
 }
 
-// AddHeight adds i to the "height" field.
-func (m *ImageMutation) AddHeight(i int) {
-	if m.addheight != nil {
-		*m.addheight += i
-	} else {
-		m.addheight = &i
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SessionMutation) ResetEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ResetUser()
+		return nil
 	}
+	return fmt.Errorf("unknown Session edge %s", name)
 }
 
-// AddedHeight returns the value that was added to the "height" field in this mutation.
-func (m *ImageMutation) AddedHeight() (r int, exists bool) {
-	v := m.addheight
-	if v == nil {
-		return
+// SpamCorpusMutation represents an operation that mutates the SpamCorpus nodes in the graph.
+type SpamCorpusMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	class         *spamcorpus.Class
+	documents     *int
+	adddocuments  *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SpamCorpus, error)
+	predicates    []predicate.SpamCorpus
+}
+
+var _ ent.Mutation = (*SpamCorpusMutation)(nil)
+
+// spamcorpusOption allows management of the mutation configuration using functional options.
+type spamcorpusOption func(*SpamCorpusMutation)
+
+// newSpamCorpusMutation creates new mutation for the SpamCorpus entity.
+func newSpamCorpusMutation(c config, op Op, opts ...spamcorpusOption) *SpamCorpusMutation {
+	m := &SpamCorpusMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSpamCorpus,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ResetHeight resets all changes to the "height" field.
-func (m *ImageMutation) ResetHeight() {
-	m.height = nil
-	m.addheight = nil
+// withSpamCorpusID sets the ID field of the mutation.
+func withSpamCorpusID(id int) spamcorpusOption {
+	return func(m *SpamCorpusMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SpamCorpus
+		)
+		m.oldValue = func(ctx context.Context) (*SpamCorpus, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SpamCorpus.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// SetUploadedByID sets the "uploaded_by" edge to the User entity by id.
-func (m *ImageMutation) SetUploadedByID(id int) {
-	m.uploaded_by = &id
+// withSpamCorpus sets the old SpamCorpus of the mutation.
+func withSpamCorpus(node *SpamCorpus) spamcorpusOption {
+	return func(m *SpamCorpusMutation) {
+		m.oldValue = func(context.Context) (*SpamCorpus, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// ClearUploadedBy clears the "uploaded_by" edge to the User entity.
-func (m *ImageMutation) ClearUploadedBy() {
-	m.cleareduploaded_by = true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SpamCorpusMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// UploadedByCleared reports if the "uploaded_by" edge to the User entity was cleared.
-func (m *ImageMutation) UploadedByCleared() bool {
-	return m.cleareduploaded_by
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SpamCorpusMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// UploadedByID returns the "uploaded_by" edge ID in the mutation.
-func (m *ImageMutation) UploadedByID() (id int, exists bool) {
-	if m.uploaded_by != nil {
-		return *m.uploaded_by, true
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SpamCorpusMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return
+	return *m.id, true
 }
 
-// UploadedByIDs returns the "uploaded_by" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UploadedByID instead. It exists only for internal usage by the builders.
-func (m *ImageMutation) UploadedByIDs() (ids []int) {
-	if id := m.uploaded_by; id != nil {
-		ids = append(ids, *id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SpamCorpusMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SpamCorpus.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// ResetUploadedBy resets all changes to the "uploaded_by" edge.
-func (m *ImageMutation) ResetUploadedBy() {
-	m.uploaded_by = nil
-	m.cleareduploaded_by = false
+// SetClass sets the "class" field.
+func (m *SpamCorpusMutation) SetClass(s spamcorpus.Class) {
+	m.class = &s
 }
 
-// AddBookIDs adds the "books" edge to the Book entity by ids.
-func (m *ImageMutation) AddBookIDs(ids ...int) {
-	if m.books == nil {
-		m.books = make(map[int]struct{})
+// Class returns the value of the "class" field in the mutation.
+func (m *SpamCorpusMutation) Class() (r spamcorpus.Class, exists bool) {
+	v := m.class
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.books[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldClass returns the old "class" field's value of the SpamCorpus entity.
+// If the SpamCorpus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpamCorpusMutation) OldClass(ctx context.Context) (v spamcorpus.Class, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClass is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClass requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClass: %w", err)
 	}
+	return oldValue.Class, nil
 }
 
-// ClearBooks clears the "books" edge to the Book entity.
-func (m *ImageMutation) ClearBooks() {
-	m.clearedbooks = true
+// ResetClass resets all changes to the "class" field.
+func (m *SpamCorpusMutation) ResetClass() {
+	m.class = nil
 }
 
-// BooksCleared reports if the "books" edge to the Book entity was cleared.
-func (m *ImageMutation) BooksCleared() bool {
-	return m.clearedbooks
+// SetDocuments sets the "documents" field.
+func (m *SpamCorpusMutation) SetDocuments(i int) {
+	m.documents = &i
+	m.adddocuments = nil
 }
 
-// RemoveBookIDs removes the "books" edge to the Book entity by IDs.
-func (m *ImageMutation) RemoveBookIDs(ids ...int) {
-	if m.removedbooks == nil {
-		m.removedbooks = make(map[int]struct{})
+// Documents returns the value of the "documents" field in the mutation.
+func (m *SpamCorpusMutation) Documents() (r int, exists bool) {
+	v := m.documents
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.books, ids[i])
-		m.removedbooks[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldDocuments returns the old "documents" field's value of the SpamCorpus entity.
+// If the SpamCorpus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpamCorpusMutation) OldDocuments(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocuments is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocuments requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocuments: %w", err)
 	}
+	return oldValue.Documents, nil
 }
 
-// RemovedBooks returns the removed IDs of the "books" edge to the Book entity.
-func (m *ImageMutation) RemovedBooksIDs() (ids []int) {
-	for id := range m.removedbooks {
-		ids = append(ids, id)
+// AddDocuments adds i to the "documents" field.
+func (m *SpamCorpusMutation) AddDocuments(i int) {
+	if m.adddocuments != nil {
+		*m.adddocuments += i
+	} else {
+		m.adddocuments = &i
 	}
-	return
 }
 
-// BooksIDs returns the "books" edge IDs in the mutation.
-func (m *ImageMutation) BooksIDs() (ids []int) {
-	for id := range m.books {
-		ids = append(ids, id)
+// AddedDocuments returns the value that was added to the "documents" field in this mutation.
+func (m *SpamCorpusMutation) AddedDocuments() (r int, exists bool) {
+	v := m.adddocuments
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetBooks resets all changes to the "books" edge.
-func (m *ImageMutation) ResetBooks() {
-	m.books = nil
-	m.clearedbooks = false
-	m.removedbooks = nil
+// ResetDocuments resets all changes to the "documents" field.
+func (m *SpamCorpusMutation) ResetDocuments() {
+	m.documents = nil
+	m.adddocuments = nil
 }
 
-// Where appends a list predicates to the ImageMutation builder.
-func (m *ImageMutation) Where(ps ...predicate.Image) {
+// Where appends a list predicates to the SpamCorpusMutation builder.
+func (m *SpamCorpusMutation) Where(ps ...predicate.SpamCorpus) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ImageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SpamCorpusMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ImageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Image, len(ps))
+func (m *SpamCorpusMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SpamCorpus, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4865,45 +15937,30 @@ func (m *ImageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ImageMutation) Op() Op {
+func (m *SpamCorpusMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ImageMutation) SetOp(op Op) {
+func (m *SpamCorpusMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Image).
-func (m *ImageMutation) Type() string {
+// Type returns the node type of this mutation (SpamCorpus).
+func (m *SpamCorpusMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ImageMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.filename != nil {
-		fields = append(fields, image.FieldFilename)
-	}
-	if m.url != nil {
-		fields = append(fields, image.FieldURL)
-	}
-	if m.size != nil {
-		fields = append(fields, image.FieldSize)
-	}
-	if m._type != nil {
-		fields = append(fields, image.FieldType)
-	}
-	if m.created_at != nil {
-		fields = append(fields, image.FieldCreatedAt)
-	}
-	if m.width != nil {
-		fields = append(fields, image.FieldWidth)
+func (m *SpamCorpusMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.class != nil {
+		fields = append(fields, spamcorpus.FieldClass)
 	}
-	if m.height != nil {
-		fields = append(fields, image.FieldHeight)
+	if m.documents != nil {
+		fields = append(fields, spamcorpus.FieldDocuments)
 	}
 	return fields
 }
@@ -4911,22 +15968,12 @@ func (m *ImageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ImageMutation) Field(name string) (ent.Value, bool) {
+func (m *SpamCorpusMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case image.FieldFilename:
-		return m.Filename()
-	case image.FieldURL:
-		return m.URL()
-	case image.FieldSize:
-		return m.Size()
-	case image.FieldType:
-		return m.GetType()
-	case image.FieldCreatedAt:
-		return m.CreatedAt()
-	case image.FieldWidth:
-		return m.Width()
-	case image.FieldHeight:
-		return m.Height()
+	case spamcorpus.FieldClass:
+		return m.Class()
+	case spamcorpus.FieldDocuments:
+		return m.Documents()
 	}
 	return nil, false
 }
@@ -4934,96 +15981,45 @@ func (m *ImageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ImageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SpamCorpusMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case image.FieldFilename:
-		return m.OldFilename(ctx)
-	case image.FieldURL:
-		return m.OldURL(ctx)
-	case image.FieldSize:
-		return m.OldSize(ctx)
-	case image.FieldType:
-		return m.OldType(ctx)
-	case image.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case image.FieldWidth:
-		return m.OldWidth(ctx)
-	case image.FieldHeight:
-		return m.OldHeight(ctx)
+	case spamcorpus.FieldClass:
+		return m.OldClass(ctx)
+	case spamcorpus.FieldDocuments:
+		return m.OldDocuments(ctx)
 	}
-	return nil, fmt.Errorf("unknown Image field %s", name)
+	return nil, fmt.Errorf("unknown SpamCorpus field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ImageMutation) SetField(name string, value ent.Value) error {
+func (m *SpamCorpusMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case image.FieldFilename:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFilename(v)
-		return nil
-	case image.FieldURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetURL(v)
-		return nil
-	case image.FieldSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSize(v)
-		return nil
-	case image.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case image.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case spamcorpus.FieldClass:
+		v, ok := value.(spamcorpus.Class)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
-		return nil
-	case image.FieldWidth:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWidth(v)
+		m.SetClass(v)
 		return nil
-	case image.FieldHeight:
+	case spamcorpus.FieldDocuments:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHeight(v)
+		m.SetDocuments(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Image field %s", name)
+	return fmt.Errorf("unknown SpamCorpus field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ImageMutation) AddedFields() []string {
+func (m *SpamCorpusMutation) AddedFields() []string {
 	var fields []string
-	if m.addsize != nil {
-		fields = append(fields, image.FieldSize)
-	}
-	if m.addwidth != nil {
-		fields = append(fields, image.FieldWidth)
-	}
-	if m.addheight != nil {
-		fields = append(fields, image.FieldHeight)
+	if m.adddocuments != nil {
+		fields = append(fields, spamcorpus.FieldDocuments)
 	}
 	return fields
 }
@@ -5031,14 +16027,10 @@ func (m *ImageMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ImageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SpamCorpusMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case image.FieldSize:
-		return m.AddedSize()
-	case image.FieldWidth:
-		return m.AddedWidth()
-	case image.FieldHeight:
-		return m.AddedHeight()
+	case spamcorpus.FieldDocuments:
+		return m.AddedDocuments()
 	}
 	return nil, false
 }
@@ -5046,224 +16038,128 @@ func (m *ImageMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ImageMutation) AddField(name string, value ent.Value) error {
+func (m *SpamCorpusMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case image.FieldSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSize(v)
-		return nil
-	case image.FieldWidth:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddWidth(v)
-		return nil
-	case image.FieldHeight:
+	case spamcorpus.FieldDocuments:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddHeight(v)
+		m.AddDocuments(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Image numeric field %s", name)
+	return fmt.Errorf("unknown SpamCorpus numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ImageMutation) ClearedFields() []string {
+func (m *SpamCorpusMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ImageMutation) FieldCleared(name string) bool {
+func (m *SpamCorpusMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ImageMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Image nullable field %s", name)
+func (m *SpamCorpusMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SpamCorpus nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ImageMutation) ResetField(name string) error {
+func (m *SpamCorpusMutation) ResetField(name string) error {
 	switch name {
-	case image.FieldFilename:
-		m.ResetFilename()
-		return nil
-	case image.FieldURL:
-		m.ResetURL()
-		return nil
-	case image.FieldSize:
-		m.ResetSize()
-		return nil
-	case image.FieldType:
-		m.ResetType()
-		return nil
-	case image.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case spamcorpus.FieldClass:
+		m.ResetClass()
 		return nil
-	case image.FieldWidth:
-		m.ResetWidth()
-		return nil
-	case image.FieldHeight:
-		m.ResetHeight()
+	case spamcorpus.FieldDocuments:
+		m.ResetDocuments()
 		return nil
 	}
-	return fmt.Errorf("unknown Image field %s", name)
+	return fmt.Errorf("unknown SpamCorpus field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ImageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.uploaded_by != nil {
-		edges = append(edges, image.EdgeUploadedBy)
-	}
-	if m.books != nil {
-		edges = append(edges, image.EdgeBooks)
-	}
+func (m *SpamCorpusMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ImageMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case image.EdgeUploadedBy:
-		if id := m.uploaded_by; id != nil {
-			return []ent.Value{*id}
-		}
-	case image.EdgeBooks:
-		ids := make([]ent.Value, 0, len(m.books))
-		for id := range m.books {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SpamCorpusMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ImageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedbooks != nil {
-		edges = append(edges, image.EdgeBooks)
-	}
+func (m *SpamCorpusMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ImageMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case image.EdgeBooks:
-		ids := make([]ent.Value, 0, len(m.removedbooks))
-		for id := range m.removedbooks {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SpamCorpusMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ImageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduploaded_by {
-		edges = append(edges, image.EdgeUploadedBy)
-	}
-	if m.clearedbooks {
-		edges = append(edges, image.EdgeBooks)
-	}
+func (m *SpamCorpusMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ImageMutation) EdgeCleared(name string) bool {
-	switch name {
-	case image.EdgeUploadedBy:
-		return m.cleareduploaded_by
-	case image.EdgeBooks:
-		return m.clearedbooks
-	}
+func (m *SpamCorpusMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ImageMutation) ClearEdge(name string) error {
-	switch name {
-	case image.EdgeUploadedBy:
-		m.ClearUploadedBy()
-		return nil
-	}
-	return fmt.Errorf("unknown Image unique edge %s", name)
+func (m *SpamCorpusMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SpamCorpus unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ImageMutation) ResetEdge(name string) error {
-	switch name {
-	case image.EdgeUploadedBy:
-		m.ResetUploadedBy()
-		return nil
-	case image.EdgeBooks:
-		m.ResetBooks()
-		return nil
-	}
-	return fmt.Errorf("unknown Image edge %s", name)
+func (m *SpamCorpusMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SpamCorpus edge %s", name)
 }
 
-// PostMutation represents an operation that mutates the Post nodes in the graph.
-type PostMutation struct {
+// SpamTokenMutation represents an operation that mutates the SpamToken nodes in the graph.
+type SpamTokenMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	title           *string
-	content         *string
-	excerpt         *string
-	cover_image     *string
-	published       *bool
-	created_at      *time.Time
-	updated_at      *time.Time
-	published_at    *time.Time
-	views           *int
-	addviews        *int
-	author_type     *post.AuthorType
-	author          *string
-	clearedFields   map[string]struct{}
-	comments        map[int]struct{}
-	removedcomments map[int]struct{}
-	clearedcomments bool
-	tags            map[int]struct{}
-	removedtags     map[int]struct{}
-	clearedtags     bool
-	done            bool
-	oldValue        func(context.Context) (*Post, error)
-	predicates      []predicate.Post
+	op            Op
+	typ           string
+	id            *int
+	token         *string
+	spam          *int
+	addspam       *int
+	ham           *int
+	addham        *int
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SpamToken, error)
+	predicates    []predicate.SpamToken
 }
 
-var _ ent.Mutation = (*PostMutation)(nil)
+var _ ent.Mutation = (*SpamTokenMutation)(nil)
 
-// postOption allows management of the mutation configuration using functional options.
-type postOption func(*PostMutation)
+// spamtokenOption allows management of the mutation configuration using functional options.
+type spamtokenOption func(*SpamTokenMutation)
 
-// newPostMutation creates new mutation for the Post entity.
-func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
-	m := &PostMutation{
+// newSpamTokenMutation creates new mutation for the SpamToken entity.
+func newSpamTokenMutation(c config, op Op, opts ...spamtokenOption) *SpamTokenMutation {
+	m := &SpamTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePost,
+		typ:           TypeSpamToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5272,20 +16168,20 @@ func newPostMutation(c config, op Op, opts ...postOption) *PostMutation {
 	return m
 }
 
-// withPostID sets the ID field of the mutation.
-func withPostID(id int) postOption {
-	return func(m *PostMutation) {
+// withSpamTokenID sets the ID field of the mutation.
+func withSpamTokenID(id int) spamtokenOption {
+	return func(m *SpamTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Post
+			value *SpamToken
 		)
-		m.oldValue = func(ctx context.Context) (*Post, error) {
+		m.oldValue = func(ctx context.Context) (*SpamToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Post.Get(ctx, id)
+					value, err = m.Client().SpamToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5294,10 +16190,10 @@ func withPostID(id int) postOption {
 	}
 }
 
-// withPost sets the old Post of the mutation.
-func withPost(node *Post) postOption {
-	return func(m *PostMutation) {
-		m.oldValue = func(context.Context) (*Post, error) {
+// withSpamToken sets the old SpamToken of the mutation.
+func withSpamToken(node *SpamToken) spamtokenOption {
+	return func(m *SpamTokenMutation) {
+		m.oldValue = func(context.Context) (*SpamToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5306,7 +16202,7 @@ func withPost(node *Post) postOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PostMutation) Client() *Client {
+func (m SpamTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5314,7 +16210,7 @@ func (m PostMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PostMutation) Tx() (*Tx, error) {
+func (m SpamTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5325,7 +16221,7 @@ func (m PostMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PostMutation) ID() (id int, exists bool) {
+func (m *SpamTokenMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5336,7 +16232,7 @@ func (m *PostMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PostMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SpamTokenMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5345,558 +16241,782 @@ func (m *PostMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Post.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SpamToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTitle sets the "title" field.
-func (m *PostMutation) SetTitle(s string) {
-	m.title = &s
+// SetToken sets the "token" field.
+func (m *SpamTokenMutation) SetToken(s string) {
+	m.token = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *PostMutation) Title() (r string, exists bool) {
-	v := m.title
+// Token returns the value of the "token" field in the mutation.
+func (m *SpamTokenMutation) Token() (r string, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldToken returns the old "token" field's value of the SpamToken entity.
+// If the SpamToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *SpamTokenMutation) OldToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *SpamTokenMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetSpam sets the "spam" field.
+func (m *SpamTokenMutation) SetSpam(i int) {
+	m.spam = &i
+	m.addspam = nil
+}
+
+// Spam returns the value of the "spam" field in the mutation.
+func (m *SpamTokenMutation) Spam() (r int, exists bool) {
+	v := m.spam
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpam returns the old "spam" field's value of the SpamToken entity.
+// If the SpamToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpamTokenMutation) OldSpam(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpam is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpam requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpam: %w", err)
+	}
+	return oldValue.Spam, nil
+}
+
+// AddSpam adds i to the "spam" field.
+func (m *SpamTokenMutation) AddSpam(i int) {
+	if m.addspam != nil {
+		*m.addspam += i
+	} else {
+		m.addspam = &i
+	}
+}
+
+// AddedSpam returns the value that was added to the "spam" field in this mutation.
+func (m *SpamTokenMutation) AddedSpam() (r int, exists bool) {
+	v := m.addspam
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSpam resets all changes to the "spam" field.
+func (m *SpamTokenMutation) ResetSpam() {
+	m.spam = nil
+	m.addspam = nil
+}
+
+// SetHam sets the "ham" field.
+func (m *SpamTokenMutation) SetHam(i int) {
+	m.ham = &i
+	m.addham = nil
+}
+
+// Ham returns the value of the "ham" field in the mutation.
+func (m *SpamTokenMutation) Ham() (r int, exists bool) {
+	v := m.ham
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHam returns the old "ham" field's value of the SpamToken entity.
+// If the SpamToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpamTokenMutation) OldHam(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHam is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHam requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHam: %w", err)
+	}
+	return oldValue.Ham, nil
+}
+
+// AddHam adds i to the "ham" field.
+func (m *SpamTokenMutation) AddHam(i int) {
+	if m.addham != nil {
+		*m.addham += i
+	} else {
+		m.addham = &i
+	}
+}
+
+// AddedHam returns the value that was added to the "ham" field in this mutation.
+func (m *SpamTokenMutation) AddedHam() (r int, exists bool) {
+	v := m.addham
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHam resets all changes to the "ham" field.
+func (m *SpamTokenMutation) ResetHam() {
+	m.ham = nil
+	m.addham = nil
+}
+
+// Where appends a list predicates to the SpamTokenMutation builder.
+func (m *SpamTokenMutation) Where(ps ...predicate.SpamToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SpamTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SpamTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SpamToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SpamTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SpamTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SpamToken).
+func (m *SpamTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SpamTokenMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.token != nil {
+		fields = append(fields, spamtoken.FieldToken)
+	}
+	if m.spam != nil {
+		fields = append(fields, spamtoken.FieldSpam)
+	}
+	if m.ham != nil {
+		fields = append(fields, spamtoken.FieldHam)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SpamTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case spamtoken.FieldToken:
+		return m.Token()
+	case spamtoken.FieldSpam:
+		return m.Spam()
+	case spamtoken.FieldHam:
+		return m.Ham()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SpamTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case spamtoken.FieldToken:
+		return m.OldToken(ctx)
+	case spamtoken.FieldSpam:
+		return m.OldSpam(ctx)
+	case spamtoken.FieldHam:
+		return m.OldHam(ctx)
+	}
+	return nil, fmt.Errorf("unknown SpamToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SpamTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case spamtoken.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case spamtoken.FieldSpam:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpam(v)
+		return nil
+	case spamtoken.FieldHam:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHam(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SpamToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SpamTokenMutation) AddedFields() []string {
+	var fields []string
+	if m.addspam != nil {
+		fields = append(fields, spamtoken.FieldSpam)
+	}
+	if m.addham != nil {
+		fields = append(fields, spamtoken.FieldHam)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SpamTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case spamtoken.FieldSpam:
+		return m.AddedSpam()
+	case spamtoken.FieldHam:
+		return m.AddedHam()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SpamTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case spamtoken.FieldSpam:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpam(v)
+		return nil
+	case spamtoken.FieldHam:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHam(v)
+		return nil
 	}
-	return oldValue.Title, nil
+	return fmt.Errorf("unknown SpamToken numeric field %s", name)
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *PostMutation) ResetTitle() {
-	m.title = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SpamTokenMutation) ClearedFields() []string {
+	return nil
 }
 
-// SetContent sets the "content" field.
-func (m *PostMutation) SetContent(s string) {
-	m.content = &s
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SpamTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *PostMutation) Content() (r string, exists bool) {
-	v := m.content
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SpamTokenMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SpamToken nullable field %s", name)
 }
 
-// OldContent returns the old "content" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldContent(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SpamTokenMutation) ResetField(name string) error {
+	switch name {
+	case spamtoken.FieldToken:
+		m.ResetToken()
+		return nil
+	case spamtoken.FieldSpam:
+		m.ResetSpam()
+		return nil
+	case spamtoken.FieldHam:
+		m.ResetHam()
+		return nil
 	}
-	return oldValue.Content, nil
+	return fmt.Errorf("unknown SpamToken field %s", name)
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *PostMutation) ResetContent() {
-	m.content = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SpamTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetExcerpt sets the "excerpt" field.
-func (m *PostMutation) SetExcerpt(s string) {
-	m.excerpt = &s
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SpamTokenMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// Excerpt returns the value of the "excerpt" field in the mutation.
-func (m *PostMutation) Excerpt() (r string, exists bool) {
-	v := m.excerpt
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SpamTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldExcerpt returns the old "excerpt" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldExcerpt(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExcerpt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExcerpt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExcerpt: %w", err)
-	}
-	return oldValue.Excerpt, nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SpamTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetExcerpt resets all changes to the "excerpt" field.
-func (m *PostMutation) ResetExcerpt() {
-	m.excerpt = nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SpamTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetCoverImage sets the "cover_image" field.
-func (m *PostMutation) SetCoverImage(s string) {
-	m.cover_image = &s
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SpamTokenMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// CoverImage returns the value of the "cover_image" field in the mutation.
-func (m *PostMutation) CoverImage() (r string, exists bool) {
-	v := m.cover_image
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SpamTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SpamToken unique edge %s", name)
 }
 
-// OldCoverImage returns the old "cover_image" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldCoverImage(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCoverImage is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCoverImage requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCoverImage: %w", err)
-	}
-	return oldValue.CoverImage, nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SpamTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SpamToken edge %s", name)
 }
 
-// ResetCoverImage resets all changes to the "cover_image" field.
-func (m *PostMutation) ResetCoverImage() {
-	m.cover_image = nil
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	slug          *string
+	count         *int
+	addcount      *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	posts         map[int]struct{}
+	removedposts  map[int]struct{}
+	clearedposts  bool
+	done          bool
+	oldValue      func(context.Context) (*Tag, error)
+	predicates    []predicate.Tag
 }
 
-// SetPublished sets the "published" field.
-func (m *PostMutation) SetPublished(b bool) {
-	m.published = &b
-}
+var _ ent.Mutation = (*TagMutation)(nil)
 
-// Published returns the value of the "published" field in the mutation.
-func (m *PostMutation) Published() (r bool, exists bool) {
-	v := m.published
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
 
-// OldPublished returns the old "published" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldPublished(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublished is only allowed on UpdateOne operations")
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTag,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublished requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublished: %w", err)
+	return m
+}
+
+// withTagID sets the ID field of the mutation.
+func withTagID(id int) tagOption {
+	return func(m *TagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tag
+		)
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.Published, nil
 }
 
-// ResetPublished resets all changes to the "published" field.
-func (m *PostMutation) ResetPublished() {
-	m.published = nil
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PostMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PostMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.CreatedAt, nil
+	return *m.id, true
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PostMutation) ResetCreatedAt() {
-	m.created_at = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PostMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetName sets the "name" field.
+func (m *TagMutation) SetName(s string) {
+	m.name = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PostMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Name returns the value of the "name" field in the mutation.
+func (m *TagMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Name, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PostMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetName resets all changes to the "name" field.
+func (m *TagMutation) ResetName() {
+	m.name = nil
 }
 
-// SetPublishedAt sets the "published_at" field.
-func (m *PostMutation) SetPublishedAt(t time.Time) {
-	m.published_at = &t
+// SetSlug sets the "slug" field.
+func (m *TagMutation) SetSlug(s string) {
+	m.slug = &s
 }
 
-// PublishedAt returns the value of the "published_at" field in the mutation.
-func (m *PostMutation) PublishedAt() (r time.Time, exists bool) {
-	v := m.published_at
+// Slug returns the value of the "slug" field in the mutation.
+func (m *TagMutation) Slug() (r string, exists bool) {
+	v := m.slug
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPublishedAt returns the old "published_at" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldSlug returns the old "slug" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldPublishedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *TagMutation) OldSlug(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPublishedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPublishedAt requires an ID field in the mutation")
+		return v, errors.New("OldSlug requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPublishedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
 	}
-	return oldValue.PublishedAt, nil
-}
-
-// ClearPublishedAt clears the value of the "published_at" field.
-func (m *PostMutation) ClearPublishedAt() {
-	m.published_at = nil
-	m.clearedFields[post.FieldPublishedAt] = struct{}{}
-}
-
-// PublishedAtCleared returns if the "published_at" field was cleared in this mutation.
-func (m *PostMutation) PublishedAtCleared() bool {
-	_, ok := m.clearedFields[post.FieldPublishedAt]
-	return ok
+	return oldValue.Slug, nil
 }
 
-// ResetPublishedAt resets all changes to the "published_at" field.
-func (m *PostMutation) ResetPublishedAt() {
-	m.published_at = nil
-	delete(m.clearedFields, post.FieldPublishedAt)
+// ResetSlug resets all changes to the "slug" field.
+func (m *TagMutation) ResetSlug() {
+	m.slug = nil
 }
 
-// SetViews sets the "views" field.
-func (m *PostMutation) SetViews(i int) {
-	m.views = &i
-	m.addviews = nil
+// SetCount sets the "count" field.
+func (m *TagMutation) SetCount(i int) {
+	m.count = &i
+	m.addcount = nil
 }
 
-// Views returns the value of the "views" field in the mutation.
-func (m *PostMutation) Views() (r int, exists bool) {
-	v := m.views
+// Count returns the value of the "count" field in the mutation.
+func (m *TagMutation) Count() (r int, exists bool) {
+	v := m.count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldViews returns the old "views" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldCount returns the old "count" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldViews(ctx context.Context) (v int, err error) {
+func (m *TagMutation) OldCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldViews is only allowed on UpdateOne operations")
+		return v, errors.New("OldCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldViews requires an ID field in the mutation")
+		return v, errors.New("OldCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldViews: %w", err)
+		return v, fmt.Errorf("querying old value for OldCount: %w", err)
 	}
-	return oldValue.Views, nil
+	return oldValue.Count, nil
 }
 
-// AddViews adds i to the "views" field.
-func (m *PostMutation) AddViews(i int) {
-	if m.addviews != nil {
-		*m.addviews += i
+// AddCount adds i to the "count" field.
+func (m *TagMutation) AddCount(i int) {
+	if m.addcount != nil {
+		*m.addcount += i
 	} else {
-		m.addviews = &i
+		m.addcount = &i
 	}
 }
 
-// AddedViews returns the value that was added to the "views" field in this mutation.
-func (m *PostMutation) AddedViews() (r int, exists bool) {
-	v := m.addviews
+// AddedCount returns the value that was added to the "count" field in this mutation.
+func (m *TagMutation) AddedCount() (r int, exists bool) {
+	v := m.addcount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetViews resets all changes to the "views" field.
-func (m *PostMutation) ResetViews() {
-	m.views = nil
-	m.addviews = nil
+// ResetCount resets all changes to the "count" field.
+func (m *TagMutation) ResetCount() {
+	m.count = nil
+	m.addcount = nil
 }
 
-// SetAuthorType sets the "author_type" field.
-func (m *PostMutation) SetAuthorType(pt post.AuthorType) {
-	m.author_type = &pt
+// SetCreatedAt sets the "created_at" field.
+func (m *TagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// AuthorType returns the value of the "author_type" field in the mutation.
-func (m *PostMutation) AuthorType() (r post.AuthorType, exists bool) {
-	v := m.author_type
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthorType returns the old "author_type" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldAuthorType(ctx context.Context) (v post.AuthorType, err error) {
+func (m *TagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthorType is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthorType requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthorType: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.AuthorType, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetAuthorType resets all changes to the "author_type" field.
-func (m *PostMutation) ResetAuthorType() {
-	m.author_type = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TagMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetAuthor sets the "author" field.
-func (m *PostMutation) SetAuthor(s string) {
-	m.author = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TagMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Author returns the value of the "author" field in the mutation.
-func (m *PostMutation) Author() (r string, exists bool) {
-	v := m.author
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TagMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthor returns the old "author" field's value of the Post entity.
-// If the Post object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PostMutation) OldAuthor(ctx context.Context) (v string, err error) {
+func (m *TagMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthor requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
-	}
-	return oldValue.Author, nil
-}
-
-// ResetAuthor resets all changes to the "author" field.
-func (m *PostMutation) ResetAuthor() {
-	m.author = nil
-}
-
-// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
-func (m *PostMutation) AddCommentIDs(ids ...int) {
-	if m.comments == nil {
-		m.comments = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.comments[ids[i]] = struct{}{}
-	}
-}
-
-// ClearComments clears the "comments" edge to the Comment entity.
-func (m *PostMutation) ClearComments() {
-	m.clearedcomments = true
-}
-
-// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
-func (m *PostMutation) CommentsCleared() bool {
-	return m.clearedcomments
-}
-
-// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
-func (m *PostMutation) RemoveCommentIDs(ids ...int) {
-	if m.removedcomments == nil {
-		m.removedcomments = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.comments, ids[i])
-		m.removedcomments[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
-func (m *PostMutation) RemovedCommentsIDs() (ids []int) {
-	for id := range m.removedcomments {
-		ids = append(ids, id)
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return
-}
-
-// CommentsIDs returns the "comments" edge IDs in the mutation.
-func (m *PostMutation) CommentsIDs() (ids []int) {
-	for id := range m.comments {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetComments resets all changes to the "comments" edge.
-func (m *PostMutation) ResetComments() {
-	m.comments = nil
-	m.clearedcomments = false
-	m.removedcomments = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TagMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// AddTagIDs adds the "tags" edge to the Tag entity by ids.
-func (m *PostMutation) AddTagIDs(ids ...int) {
-	if m.tags == nil {
-		m.tags = make(map[int]struct{})
+// AddPostIDs adds the "posts" edge to the Post entity by ids.
+func (m *TagMutation) AddPostIDs(ids ...int) {
+	if m.posts == nil {
+		m.posts = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.tags[ids[i]] = struct{}{}
+		m.posts[ids[i]] = struct{}{}
 	}
 }
 
-// ClearTags clears the "tags" edge to the Tag entity.
-func (m *PostMutation) ClearTags() {
-	m.clearedtags = true
+// ClearPosts clears the "posts" edge to the Post entity.
+func (m *TagMutation) ClearPosts() {
+	m.clearedposts = true
 }
 
-// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
-func (m *PostMutation) TagsCleared() bool {
-	return m.clearedtags
+// PostsCleared reports if the "posts" edge to the Post entity was cleared.
+func (m *TagMutation) PostsCleared() bool {
+	return m.clearedposts
 }
 
-// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
-func (m *PostMutation) RemoveTagIDs(ids ...int) {
-	if m.removedtags == nil {
-		m.removedtags = make(map[int]struct{})
+// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
+func (m *TagMutation) RemovePostIDs(ids ...int) {
+	if m.removedposts == nil {
+		m.removedposts = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.tags, ids[i])
-		m.removedtags[ids[i]] = struct{}{}
+		delete(m.posts, ids[i])
+		m.removedposts[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
-func (m *PostMutation) RemovedTagsIDs() (ids []int) {
-	for id := range m.removedtags {
+// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
+func (m *TagMutation) RemovedPostsIDs() (ids []int) {
+	for id := range m.removedposts {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// TagsIDs returns the "tags" edge IDs in the mutation.
-func (m *PostMutation) TagsIDs() (ids []int) {
-	for id := range m.tags {
+// PostsIDs returns the "posts" edge IDs in the mutation.
+func (m *TagMutation) PostsIDs() (ids []int) {
+	for id := range m.posts {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTags resets all changes to the "tags" edge.
-func (m *PostMutation) ResetTags() {
-	m.tags = nil
-	m.clearedtags = false
-	m.removedtags = nil
+// ResetPosts resets all changes to the "posts" edge.
+func (m *TagMutation) ResetPosts() {
+	m.posts = nil
+	m.clearedposts = false
+	m.removedposts = nil
 }
 
-// Where appends a list predicates to the PostMutation builder.
-func (m *PostMutation) Where(ps ...predicate.Post) {
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PostMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Post, len(ps))
+func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tag, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5904,57 +17024,39 @@ func (m *PostMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PostMutation) Op() Op {
+func (m *TagMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PostMutation) SetOp(op Op) {
+func (m *TagMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Post).
-func (m *PostMutation) Type() string {
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PostMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.title != nil {
-		fields = append(fields, post.FieldTitle)
-	}
-	if m.content != nil {
-		fields = append(fields, post.FieldContent)
-	}
-	if m.excerpt != nil {
-		fields = append(fields, post.FieldExcerpt)
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, tag.FieldName)
 	}
-	if m.cover_image != nil {
-		fields = append(fields, post.FieldCoverImage)
+	if m.slug != nil {
+		fields = append(fields, tag.FieldSlug)
 	}
-	if m.published != nil {
-		fields = append(fields, post.FieldPublished)
+	if m.count != nil {
+		fields = append(fields, tag.FieldCount)
 	}
 	if m.created_at != nil {
-		fields = append(fields, post.FieldCreatedAt)
+		fields = append(fields, tag.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, post.FieldUpdatedAt)
-	}
-	if m.published_at != nil {
-		fields = append(fields, post.FieldPublishedAt)
-	}
-	if m.views != nil {
-		fields = append(fields, post.FieldViews)
-	}
-	if m.author_type != nil {
-		fields = append(fields, post.FieldAuthorType)
-	}
-	if m.author != nil {
-		fields = append(fields, post.FieldAuthor)
+		fields = append(fields, tag.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -5962,30 +17064,18 @@ func (m *PostMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PostMutation) Field(name string) (ent.Value, bool) {
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case post.FieldTitle:
-		return m.Title()
-	case post.FieldContent:
-		return m.Content()
-	case post.FieldExcerpt:
-		return m.Excerpt()
-	case post.FieldCoverImage:
-		return m.CoverImage()
-	case post.FieldPublished:
-		return m.Published()
-	case post.FieldCreatedAt:
+	case tag.FieldName:
+		return m.Name()
+	case tag.FieldSlug:
+		return m.Slug()
+	case tag.FieldCount:
+		return m.Count()
+	case tag.FieldCreatedAt:
 		return m.CreatedAt()
-	case post.FieldUpdatedAt:
+	case tag.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case post.FieldPublishedAt:
-		return m.PublishedAt()
-	case post.FieldViews:
-		return m.Views()
-	case post.FieldAuthorType:
-		return m.AuthorType()
-	case post.FieldAuthor:
-		return m.Author()
 	}
 	return nil, false
 }
@@ -5993,126 +17083,72 @@ func (m *PostMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PostMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case post.FieldTitle:
-		return m.OldTitle(ctx)
-	case post.FieldContent:
-		return m.OldContent(ctx)
-	case post.FieldExcerpt:
-		return m.OldExcerpt(ctx)
-	case post.FieldCoverImage:
-		return m.OldCoverImage(ctx)
-	case post.FieldPublished:
-		return m.OldPublished(ctx)
-	case post.FieldCreatedAt:
+	case tag.FieldName:
+		return m.OldName(ctx)
+	case tag.FieldSlug:
+		return m.OldSlug(ctx)
+	case tag.FieldCount:
+		return m.OldCount(ctx)
+	case tag.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case post.FieldUpdatedAt:
+	case tag.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case post.FieldPublishedAt:
-		return m.OldPublishedAt(ctx)
-	case post.FieldViews:
-		return m.OldViews(ctx)
-	case post.FieldAuthorType:
-		return m.OldAuthorType(ctx)
-	case post.FieldAuthor:
-		return m.OldAuthor(ctx)
 	}
-	return nil, fmt.Errorf("unknown Post field %s", name)
+	return nil, fmt.Errorf("unknown Tag field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostMutation) SetField(name string, value ent.Value) error {
+func (m *TagMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case post.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case post.FieldContent:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetContent(v)
-		return nil
-	case post.FieldExcerpt:
+	case tag.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExcerpt(v)
+		m.SetName(v)
 		return nil
-	case post.FieldCoverImage:
+	case tag.FieldSlug:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCoverImage(v)
+		m.SetSlug(v)
 		return nil
-	case post.FieldPublished:
-		v, ok := value.(bool)
+	case tag.FieldCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPublished(v)
+		m.SetCount(v)
 		return nil
-	case post.FieldCreatedAt:
+	case tag.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case post.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case post.FieldPublishedAt:
+	case tag.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPublishedAt(v)
-		return nil
-	case post.FieldViews:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetViews(v)
-		return nil
-	case post.FieldAuthorType:
-		v, ok := value.(post.AuthorType)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAuthorType(v)
-		return nil
-	case post.FieldAuthor:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAuthor(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Post field %s", name)
+	return fmt.Errorf("unknown Tag field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PostMutation) AddedFields() []string {
+func (m *TagMutation) AddedFields() []string {
 	var fields []string
-	if m.addviews != nil {
-		fields = append(fields, post.FieldViews)
+	if m.addcount != nil {
+		fields = append(fields, tag.FieldCount)
 	}
 	return fields
 }
@@ -6120,10 +17156,10 @@ func (m *PostMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case post.FieldViews:
-		return m.AddedViews()
+	case tag.FieldCount:
+		return m.AddedCount()
 	}
 	return nil, false
 }
@@ -6131,113 +17167,77 @@ func (m *PostMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PostMutation) AddField(name string, value ent.Value) error {
+func (m *TagMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case post.FieldViews:
+	case tag.FieldCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddViews(v)
+		m.AddCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Post numeric field %s", name)
+	return fmt.Errorf("unknown Tag numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PostMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(post.FieldPublishedAt) {
-		fields = append(fields, post.FieldPublishedAt)
-	}
-	return fields
+func (m *TagMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PostMutation) FieldCleared(name string) bool {
+func (m *TagMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PostMutation) ClearField(name string) error {
-	switch name {
-	case post.FieldPublishedAt:
-		m.ClearPublishedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Post nullable field %s", name)
+func (m *TagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Tag nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PostMutation) ResetField(name string) error {
+func (m *TagMutation) ResetField(name string) error {
 	switch name {
-	case post.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case post.FieldContent:
-		m.ResetContent()
-		return nil
-	case post.FieldExcerpt:
-		m.ResetExcerpt()
+	case tag.FieldName:
+		m.ResetName()
 		return nil
-	case post.FieldCoverImage:
-		m.ResetCoverImage()
+	case tag.FieldSlug:
+		m.ResetSlug()
 		return nil
-	case post.FieldPublished:
-		m.ResetPublished()
+	case tag.FieldCount:
+		m.ResetCount()
 		return nil
-	case post.FieldCreatedAt:
+	case tag.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case post.FieldUpdatedAt:
+	case tag.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case post.FieldPublishedAt:
-		m.ResetPublishedAt()
-		return nil
-	case post.FieldViews:
-		m.ResetViews()
-		return nil
-	case post.FieldAuthorType:
-		m.ResetAuthorType()
-		return nil
-	case post.FieldAuthor:
-		m.ResetAuthor()
-		return nil
 	}
-	return fmt.Errorf("unknown Post field %s", name)
+	return fmt.Errorf("unknown Tag field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PostMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.comments != nil {
-		edges = append(edges, post.EdgeComments)
-	}
-	if m.tags != nil {
-		edges = append(edges, post.EdgeTags)
+func (m *TagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.posts != nil {
+		edges = append(edges, tag.EdgePosts)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PostMutation) AddedIDs(name string) []ent.Value {
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case post.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.comments))
-		for id := range m.comments {
-			ids = append(ids, id)
-		}
-		return ids
-	case post.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.tags))
-		for id := range m.tags {
+	case tag.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.posts))
+		for id := range m.posts {
 			ids = append(ids, id)
 		}
 		return ids
@@ -6246,30 +17246,21 @@ func (m *PostMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PostMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedcomments != nil {
-		edges = append(edges, post.EdgeComments)
-	}
-	if m.removedtags != nil {
-		edges = append(edges, post.EdgeTags)
+func (m *TagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedposts != nil {
+		edges = append(edges, tag.EdgePosts)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PostMutation) RemovedIDs(name string) []ent.Value {
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case post.EdgeComments:
-		ids := make([]ent.Value, 0, len(m.removedcomments))
-		for id := range m.removedcomments {
-			ids = append(ids, id)
-		}
-		return ids
-	case post.EdgeTags:
-		ids := make([]ent.Value, 0, len(m.removedtags))
-		for id := range m.removedtags {
+	case tag.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.removedposts))
+		for id := range m.removedposts {
 			ids = append(ids, id)
 		}
 		return ids
@@ -6278,83 +17269,123 @@ func (m *PostMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PostMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedcomments {
-		edges = append(edges, post.EdgeComments)
-	}
-	if m.clearedtags {
-		edges = append(edges, post.EdgeTags)
+func (m *TagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedposts {
+		edges = append(edges, tag.EdgePosts)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PostMutation) EdgeCleared(name string) bool {
+func (m *TagMutation) EdgeCleared(name string) bool {
 	switch name {
-	case post.EdgeComments:
-		return m.clearedcomments
-	case post.EdgeTags:
-		return m.clearedtags
+	case tag.EdgePosts:
+		return m.clearedposts
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PostMutation) ClearEdge(name string) error {
+func (m *TagMutation) ClearEdge(name string) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Post unique edge %s", name)
+	return fmt.Errorf("unknown Tag unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PostMutation) ResetEdge(name string) error {
+func (m *TagMutation) ResetEdge(name string) error {
 	switch name {
-	case post.EdgeComments:
-		m.ResetComments()
-		return nil
-	case post.EdgeTags:
-		m.ResetTags()
+	case tag.EdgePosts:
+		m.ResetPosts()
 		return nil
 	}
-	return fmt.Errorf("unknown Post edge %s", name)
+	return fmt.Errorf("unknown Tag edge %s", name)
 }
 
-// TagMutation represents an operation that mutates the Tag nodes in the graph.
-type TagMutation struct {
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	name          *string
-	slug          *string
-	count         *int
-	addcount      *int
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	posts         map[int]struct{}
-	removedposts  map[int]struct{}
-	clearedposts  bool
-	done          bool
-	oldValue      func(context.Context) (*Tag, error)
-	predicates    []predicate.Tag
+	op                       Op
+	typ                      string
+	id                       *int
+	username                 *string
+	email                    *string
+	email_verified_at        *time.Time
+	password                 *string
+	password_changed_at      *time.Time
+	totp_secret              *string
+	totp_enabled_at          *time.Time
+	totp_last_step           *int64
+	addtotp_last_step        *int64
+	failed_login_count       *int
+	addfailed_login_count    *int
+	last_failed_login_at     *time.Time
+	locked_until             *time.Time
+	role                     *string
+	avatar                   *string
+	nickname                 *string
+	bio                      *string
+	created_at               *time.Time
+	updated_at               *time.Time
+	clearedFields            map[string]struct{}
+	posts                    map[int]struct{}
+	removedposts             map[int]struct{}
+	clearedposts             bool
+	comments                 map[int]struct{}
+	removedcomments          map[int]struct{}
+	clearedcomments          bool
+	tags                     map[int]struct{}
+	removedtags              map[int]struct{}
+	clearedtags              bool
+	images                   map[int]struct{}
+	removedimages            map[int]struct{}
+	clearedimages            bool
+	books                    map[int]struct{}
+	removedbooks             map[int]struct{}
+	clearedbooks             bool
+	comment_reactions        map[int]struct{}
+	removedcomment_reactions map[int]struct{}
+	clearedcomment_reactions bool
+	identities               map[int]struct{}
+	removedidentities        map[int]struct{}
+	clearedidentities        bool
+	invitations              map[int]struct{}
+	removedinvitations       map[int]struct{}
+	clearedinvitations       bool
+	invitation               *int
+	clearedinvitation        bool
+	password_resets          map[int]struct{}
+	removedpassword_resets   map[int]struct{}
+	clearedpassword_resets   bool
+	sessions                 map[int]struct{}
+	removedsessions          map[int]struct{}
+	clearedsessions          bool
+	access_tokens            map[int]struct{}
+	removedaccess_tokens     map[int]struct{}
+	clearedaccess_tokens     bool
+	recovery_codes           map[int]struct{}
+	removedrecovery_codes    map[int]struct{}
+	clearedrecovery_codes    bool
+	done                     bool
+	oldValue                 func(context.Context) (*User, error)
+	predicates               []predicate.User
 }
 
-var _ ent.Mutation = (*TagMutation)(nil)
+var _ ent.Mutation = (*UserMutation)(nil)
 
-// tagOption allows management of the mutation configuration using functional options.
-type tagOption func(*TagMutation)
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
 
-// newTagMutation creates new mutation for the Tag entity.
-func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
-	m := &TagMutation{
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTag,
+		typ:           TypeUser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6363,20 +17394,20 @@ func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
 	return m
 }
 
-// withTagID sets the ID field of the mutation.
-func withTagID(id int) tagOption {
-	return func(m *TagMutation) {
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Tag
+			value *User
 		)
-		m.oldValue = func(ctx context.Context) (*Tag, error) {
+		m.oldValue = func(ctx context.Context) (*User, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Tag.Get(ctx, id)
+					value, err = m.Client().User.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6385,10 +17416,10 @@ func withTagID(id int) tagOption {
 	}
 }
 
-// withTag sets the old Tag of the mutation.
-func withTag(node *Tag) tagOption {
-	return func(m *TagMutation) {
-		m.oldValue = func(context.Context) (*Tag, error) {
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6397,7 +17428,7 @@ func withTag(node *Tag) tagOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TagMutation) Client() *Client {
+func (m UserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6405,7 +17436,7 @@ func (m TagMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TagMutation) Tx() (*Tx, error) {
+func (m UserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6416,7 +17447,7 @@ func (m TagMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TagMutation) ID() (id int, exists bool) {
+func (m *UserMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6427,7 +17458,7 @@ func (m *TagMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TagMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6436,1329 +17467,1466 @@ func (m *TagMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *TagMutation) SetName(s string) {
-	m.name = &s
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TagMutation) Name() (r string, exists bool) {
-	v := m.name
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Tag entity.
-// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Email, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TagMutation) ResetName() {
-	m.name = nil
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
 }
 
-// SetSlug sets the "slug" field.
-func (m *TagMutation) SetSlug(s string) {
-	m.slug = &s
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (m *UserMutation) SetEmailVerifiedAt(t time.Time) {
+	m.email_verified_at = &t
 }
 
-// Slug returns the value of the "slug" field in the mutation.
-func (m *TagMutation) Slug() (r string, exists bool) {
-	v := m.slug
+// EmailVerifiedAt returns the value of the "email_verified_at" field in the mutation.
+func (m *UserMutation) EmailVerifiedAt() (r time.Time, exists bool) {
+	v := m.email_verified_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSlug returns the old "slug" field's value of the Tag entity.
-// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// OldEmailVerifiedAt returns the old "email_verified_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TagMutation) OldSlug(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldEmailVerifiedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmailVerifiedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlug requires an ID field in the mutation")
+		return v, errors.New("OldEmailVerifiedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmailVerifiedAt: %w", err)
 	}
-	return oldValue.Slug, nil
+	return oldValue.EmailVerifiedAt, nil
 }
 
-// ResetSlug resets all changes to the "slug" field.
-func (m *TagMutation) ResetSlug() {
-	m.slug = nil
+// ClearEmailVerifiedAt clears the value of the "email_verified_at" field.
+func (m *UserMutation) ClearEmailVerifiedAt() {
+	m.email_verified_at = nil
+	m.clearedFields[user.FieldEmailVerifiedAt] = struct{}{}
 }
 
-// SetCount sets the "count" field.
-func (m *TagMutation) SetCount(i int) {
-	m.count = &i
-	m.addcount = nil
+// EmailVerifiedAtCleared returns if the "email_verified_at" field was cleared in this mutation.
+func (m *UserMutation) EmailVerifiedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldEmailVerifiedAt]
+	return ok
 }
 
-// Count returns the value of the "count" field in the mutation.
-func (m *TagMutation) Count() (r int, exists bool) {
-	v := m.count
+// ResetEmailVerifiedAt resets all changes to the "email_verified_at" field.
+func (m *UserMutation) ResetEmailVerifiedAt() {
+	m.email_verified_at = nil
+	delete(m.clearedFields, user.FieldEmailVerifiedAt)
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCount returns the old "count" field's value of the Tag entity.
-// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TagMutation) OldCount(ctx context.Context) (v int, err error) {
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCount requires an ID field in the mutation")
+		return v, errors.New("OldPassword requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
 	}
-	return oldValue.Count, nil
+	return oldValue.Password, nil
 }
 
-// AddCount adds i to the "count" field.
-func (m *TagMutation) AddCount(i int) {
-	if m.addcount != nil {
-		*m.addcount += i
-	} else {
-		m.addcount = &i
-	}
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
 }
 
-// AddedCount returns the value that was added to the "count" field in this mutation.
-func (m *TagMutation) AddedCount() (r int, exists bool) {
-	v := m.addcount
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (m *UserMutation) SetPasswordChangedAt(t time.Time) {
+	m.password_changed_at = &t
+}
+
+// PasswordChangedAt returns the value of the "password_changed_at" field in the mutation.
+func (m *UserMutation) PasswordChangedAt() (r time.Time, exists bool) {
+	v := m.password_changed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCount resets all changes to the "count" field.
-func (m *TagMutation) ResetCount() {
-	m.count = nil
-	m.addcount = nil
+// OldPasswordChangedAt returns the old "password_changed_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPasswordChangedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordChangedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordChangedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordChangedAt: %w", err)
+	}
+	return oldValue.PasswordChangedAt, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *TagMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (m *UserMutation) ClearPasswordChangedAt() {
+	m.password_changed_at = nil
+	m.clearedFields[user.FieldPasswordChangedAt] = struct{}{}
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TagMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// PasswordChangedAtCleared returns if the "password_changed_at" field was cleared in this mutation.
+func (m *UserMutation) PasswordChangedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldPasswordChangedAt]
+	return ok
+}
+
+// ResetPasswordChangedAt resets all changes to the "password_changed_at" field.
+func (m *UserMutation) ResetPasswordChangedAt() {
+	m.password_changed_at = nil
+	delete(m.clearedFields, user.FieldPasswordChangedAt)
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (m *UserMutation) SetTotpSecret(s string) {
+	m.totp_secret = &s
+}
+
+// TotpSecret returns the value of the "totp_secret" field in the mutation.
+func (m *UserMutation) TotpSecret() (r string, exists bool) {
+	v := m.totp_secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Tag entity.
-// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// OldTotpSecret returns the old "totp_secret" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldTotpSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotpSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTotpSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotpSecret: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.TotpSecret, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TagMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (m *UserMutation) ClearTotpSecret() {
+	m.totp_secret = nil
+	m.clearedFields[user.FieldTotpSecret] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *TagMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// TotpSecretCleared returns if the "totp_secret" field was cleared in this mutation.
+func (m *UserMutation) TotpSecretCleared() bool {
+	_, ok := m.clearedFields[user.FieldTotpSecret]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *TagMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetTotpSecret resets all changes to the "totp_secret" field.
+func (m *UserMutation) ResetTotpSecret() {
+	m.totp_secret = nil
+	delete(m.clearedFields, user.FieldTotpSecret)
+}
+
+// SetTotpEnabledAt sets the "totp_enabled_at" field.
+func (m *UserMutation) SetTotpEnabledAt(t time.Time) {
+	m.totp_enabled_at = &t
+}
+
+// TotpEnabledAt returns the value of the "totp_enabled_at" field in the mutation.
+func (m *UserMutation) TotpEnabledAt() (r time.Time, exists bool) {
+	v := m.totp_enabled_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Tag entity.
-// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// OldTotpEnabledAt returns the old "totp_enabled_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TagMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldTotpEnabledAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotpEnabledAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTotpEnabledAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotpEnabledAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.TotpEnabledAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *TagMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearTotpEnabledAt clears the value of the "totp_enabled_at" field.
+func (m *UserMutation) ClearTotpEnabledAt() {
+	m.totp_enabled_at = nil
+	m.clearedFields[user.FieldTotpEnabledAt] = struct{}{}
 }
 
-// AddPostIDs adds the "posts" edge to the Post entity by ids.
-func (m *TagMutation) AddPostIDs(ids ...int) {
-	if m.posts == nil {
-		m.posts = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.posts[ids[i]] = struct{}{}
-	}
+// TotpEnabledAtCleared returns if the "totp_enabled_at" field was cleared in this mutation.
+func (m *UserMutation) TotpEnabledAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldTotpEnabledAt]
+	return ok
 }
 
-// ClearPosts clears the "posts" edge to the Post entity.
-func (m *TagMutation) ClearPosts() {
-	m.clearedposts = true
+// ResetTotpEnabledAt resets all changes to the "totp_enabled_at" field.
+func (m *UserMutation) ResetTotpEnabledAt() {
+	m.totp_enabled_at = nil
+	delete(m.clearedFields, user.FieldTotpEnabledAt)
 }
 
-// PostsCleared reports if the "posts" edge to the Post entity was cleared.
-func (m *TagMutation) PostsCleared() bool {
-	return m.clearedposts
+// SetTotpLastStep sets the "totp_last_step" field.
+func (m *UserMutation) SetTotpLastStep(i int64) {
+	m.totp_last_step = &i
+	m.addtotp_last_step = nil
 }
 
-// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
-func (m *TagMutation) RemovePostIDs(ids ...int) {
-	if m.removedposts == nil {
-		m.removedposts = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.posts, ids[i])
-		m.removedposts[ids[i]] = struct{}{}
+// TotpLastStep returns the value of the "totp_last_step" field in the mutation.
+func (m *UserMutation) TotpLastStep() (r int64, exists bool) {
+	v := m.totp_last_step
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
-func (m *TagMutation) RemovedPostsIDs() (ids []int) {
-	for id := range m.removedposts {
-		ids = append(ids, id)
+// OldTotpLastStep returns the old "totp_last_step" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTotpLastStep(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotpLastStep is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// PostsIDs returns the "posts" edge IDs in the mutation.
-func (m *TagMutation) PostsIDs() (ids []int) {
-	for id := range m.posts {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotpLastStep requires an ID field in the mutation")
 	}
-	return
-}
-
-// ResetPosts resets all changes to the "posts" edge.
-func (m *TagMutation) ResetPosts() {
-	m.posts = nil
-	m.clearedposts = false
-	m.removedposts = nil
-}
-
-// Where appends a list predicates to the TagMutation builder.
-func (m *TagMutation) Where(ps ...predicate.Tag) {
-	m.predicates = append(m.predicates, ps...)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotpLastStep: %w", err)
+	}
+	return oldValue.TotpLastStep, nil
 }
 
-// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Tag, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddTotpLastStep adds i to the "totp_last_step" field.
+func (m *UserMutation) AddTotpLastStep(i int64) {
+	if m.addtotp_last_step != nil {
+		*m.addtotp_last_step += i
+	} else {
+		m.addtotp_last_step = &i
 	}
-	m.Where(p...)
 }
 
-// Op returns the operation name.
-func (m *TagMutation) Op() Op {
-	return m.op
+// AddedTotpLastStep returns the value that was added to the "totp_last_step" field in this mutation.
+func (m *UserMutation) AddedTotpLastStep() (r int64, exists bool) {
+	v := m.addtotp_last_step
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetOp allows setting the mutation operation.
-func (m *TagMutation) SetOp(op Op) {
-	m.op = op
+// ResetTotpLastStep resets all changes to the "totp_last_step" field.
+func (m *UserMutation) ResetTotpLastStep() {
+	m.totp_last_step = nil
+	m.addtotp_last_step = nil
 }
 
-// Type returns the node type of this mutation (Tag).
-func (m *TagMutation) Type() string {
-	return m.typ
+// SetFailedLoginCount sets the "failed_login_count" field.
+func (m *UserMutation) SetFailedLoginCount(i int) {
+	m.failed_login_count = &i
+	m.addfailed_login_count = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *TagMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.name != nil {
-		fields = append(fields, tag.FieldName)
-	}
-	if m.slug != nil {
-		fields = append(fields, tag.FieldSlug)
-	}
-	if m.count != nil {
-		fields = append(fields, tag.FieldCount)
+// FailedLoginCount returns the value of the "failed_login_count" field in the mutation.
+func (m *UserMutation) FailedLoginCount() (r int, exists bool) {
+	v := m.failed_login_count
+	if v == nil {
+		return
 	}
-	if m.created_at != nil {
-		fields = append(fields, tag.FieldCreatedAt)
+	return *v, true
+}
+
+// OldFailedLoginCount returns the old "failed_login_count" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldFailedLoginCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailedLoginCount is only allowed on UpdateOne operations")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, tag.FieldUpdatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailedLoginCount requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailedLoginCount: %w", err)
+	}
+	return oldValue.FailedLoginCount, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *TagMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case tag.FieldName:
-		return m.Name()
-	case tag.FieldSlug:
-		return m.Slug()
-	case tag.FieldCount:
-		return m.Count()
-	case tag.FieldCreatedAt:
-		return m.CreatedAt()
-	case tag.FieldUpdatedAt:
-		return m.UpdatedAt()
+// AddFailedLoginCount adds i to the "failed_login_count" field.
+func (m *UserMutation) AddFailedLoginCount(i int) {
+	if m.addfailed_login_count != nil {
+		*m.addfailed_login_count += i
+	} else {
+		m.addfailed_login_count = &i
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case tag.FieldName:
-		return m.OldName(ctx)
-	case tag.FieldSlug:
-		return m.OldSlug(ctx)
-	case tag.FieldCount:
-		return m.OldCount(ctx)
-	case tag.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case tag.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// AddedFailedLoginCount returns the value that was added to the "failed_login_count" field in this mutation.
+func (m *UserMutation) AddedFailedLoginCount() (r int, exists bool) {
+	v := m.addfailed_login_count
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Tag field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TagMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case tag.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case tag.FieldSlug:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSlug(v)
-		return nil
-	case tag.FieldCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCount(v)
-		return nil
-	case tag.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case tag.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Tag field %s", name)
+// ResetFailedLoginCount resets all changes to the "failed_login_count" field.
+func (m *UserMutation) ResetFailedLoginCount() {
+	m.failed_login_count = nil
+	m.addfailed_login_count = nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *TagMutation) AddedFields() []string {
-	var fields []string
-	if m.addcount != nil {
-		fields = append(fields, tag.FieldCount)
-	}
-	return fields
+// SetLastFailedLoginAt sets the "last_failed_login_at" field.
+func (m *UserMutation) SetLastFailedLoginAt(t time.Time) {
+	m.last_failed_login_at = &t
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case tag.FieldCount:
-		return m.AddedCount()
+// LastFailedLoginAt returns the value of the "last_failed_login_at" field in the mutation.
+func (m *UserMutation) LastFailedLoginAt() (r time.Time, exists bool) {
+	v := m.last_failed_login_at
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TagMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case tag.FieldCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCount(v)
-		return nil
+// OldLastFailedLoginAt returns the old "last_failed_login_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastFailedLoginAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastFailedLoginAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Tag numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastFailedLoginAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastFailedLoginAt: %w", err)
+	}
+	return oldValue.LastFailedLoginAt, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *TagMutation) ClearedFields() []string {
-	return nil
+// ClearLastFailedLoginAt clears the value of the "last_failed_login_at" field.
+func (m *UserMutation) ClearLastFailedLoginAt() {
+	m.last_failed_login_at = nil
+	m.clearedFields[user.FieldLastFailedLoginAt] = struct{}{}
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *TagMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// LastFailedLoginAtCleared returns if the "last_failed_login_at" field was cleared in this mutation.
+func (m *UserMutation) LastFailedLoginAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldLastFailedLoginAt]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *TagMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Tag nullable field %s", name)
+// ResetLastFailedLoginAt resets all changes to the "last_failed_login_at" field.
+func (m *UserMutation) ResetLastFailedLoginAt() {
+	m.last_failed_login_at = nil
+	delete(m.clearedFields, user.FieldLastFailedLoginAt)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *TagMutation) ResetField(name string) error {
-	switch name {
-	case tag.FieldName:
-		m.ResetName()
-		return nil
-	case tag.FieldSlug:
-		m.ResetSlug()
-		return nil
-	case tag.FieldCount:
-		m.ResetCount()
-		return nil
-	case tag.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case tag.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Tag field %s", name)
+// SetLockedUntil sets the "locked_until" field.
+func (m *UserMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TagMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.posts != nil {
-		edges = append(edges, tag.EdgePosts)
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *UserMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *TagMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case tag.EdgePosts:
-		ids := make([]ent.Value, 0, len(m.posts))
-		for id := range m.posts {
-			ids = append(ids, id)
-		}
-		return ids
+// OldLockedUntil returns the old "locked_until" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TagMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedposts != nil {
-		edges = append(edges, tag.EdgePosts)
-	}
-	return edges
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *UserMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[user.FieldLockedUntil] = struct{}{}
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *TagMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case tag.EdgePosts:
-		ids := make([]ent.Value, 0, len(m.removedposts))
-		for id := range m.removedposts {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *UserMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[user.FieldLockedUntil]
+	return ok
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TagMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedposts {
-		edges = append(edges, tag.EdgePosts)
-	}
-	return edges
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *UserMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, user.FieldLockedUntil)
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *TagMutation) EdgeCleared(name string) bool {
-	switch name {
-	case tag.EdgePosts:
-		return m.clearedposts
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(s string) {
+	m.role = &s
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r string, exists bool) {
+	v := m.role
+	if v == nil {
+		return
 	}
-	return false
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *TagMutation) ClearEdge(name string) error {
-	switch name {
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRole(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return fmt.Errorf("unknown Tag unique edge %s", name)
+	return oldValue.Role, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *TagMutation) ResetEdge(name string) error {
-	switch name {
-	case tag.EdgePosts:
-		m.ResetPosts()
-		return nil
-	}
-	return fmt.Errorf("unknown Tag edge %s", name)
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
-	config
-	op              Op
-	typ             string
-	id              *int
-	username        *string
-	email           *string
-	password        *string
-	role            *string
-	avatar          *string
-	nickname        *string
-	bio             *string
-	created_at      *time.Time
-	updated_at      *time.Time
-	clearedFields   map[string]struct{}
-	posts           map[int]struct{}
-	removedposts    map[int]struct{}
-	clearedposts    bool
-	comments        map[int]struct{}
-	removedcomments map[int]struct{}
-	clearedcomments bool
-	tags            map[int]struct{}
-	removedtags     map[int]struct{}
-	clearedtags     bool
-	images          map[int]struct{}
-	removedimages   map[int]struct{}
-	clearedimages   bool
-	books           map[int]struct{}
-	removedbooks    map[int]struct{}
-	clearedbooks    bool
-	done            bool
-	oldValue        func(context.Context) (*User, error)
-	predicates      []predicate.User
+// SetAvatar sets the "avatar" field.
+func (m *UserMutation) SetAvatar(s string) {
+	m.avatar = &s
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
-
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// Avatar returns the value of the "avatar" field in the mutation.
+func (m *UserMutation) Avatar() (r string, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeUser,
-		clearedFields: make(map[string]struct{}),
+// OldAvatar returns the old "avatar" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAvatar(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatar requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+	}
+	return oldValue.Avatar, nil
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *User
-		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().User.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ClearAvatar clears the value of the "avatar" field.
+func (m *UserMutation) ClearAvatar() {
+	m.avatar = nil
+	m.clearedFields[user.FieldAvatar] = struct{}{}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// AvatarCleared returns if the "avatar" field was cleared in this mutation.
+func (m *UserMutation) AvatarCleared() bool {
+	_, ok := m.clearedFields[user.FieldAvatar]
+	return ok
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetAvatar resets all changes to the "avatar" field.
+func (m *UserMutation) ResetAvatar() {
+	m.avatar = nil
+	delete(m.clearedFields, user.FieldAvatar)
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetNickname sets the "nickname" field.
+func (m *UserMutation) SetNickname(s string) {
+	m.nickname = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// Nickname returns the value of the "nickname" field in the mutation.
+func (m *UserMutation) Nickname() (r string, exists bool) {
+	v := m.nickname
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldNickname returns the old "nickname" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldNickname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNickname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
 	}
+	return oldValue.Nickname, nil
 }
 
-// SetUsername sets the "username" field.
-func (m *UserMutation) SetUsername(s string) {
-	m.username = &s
+// ClearNickname clears the value of the "nickname" field.
+func (m *UserMutation) ClearNickname() {
+	m.nickname = nil
+	m.clearedFields[user.FieldNickname] = struct{}{}
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *UserMutation) Username() (r string, exists bool) {
-	v := m.username
+// NicknameCleared returns if the "nickname" field was cleared in this mutation.
+func (m *UserMutation) NicknameCleared() bool {
+	_, ok := m.clearedFields[user.FieldNickname]
+	return ok
+}
+
+// ResetNickname resets all changes to the "nickname" field.
+func (m *UserMutation) ResetNickname() {
+	m.nickname = nil
+	delete(m.clearedFields, user.FieldNickname)
+}
+
+// SetBio sets the "bio" field.
+func (m *UserMutation) SetBio(s string) {
+	m.bio = &s
+}
+
+// Bio returns the value of the "bio" field in the mutation.
+func (m *UserMutation) Bio() (r string, exists bool) {
+	v := m.bio
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsername returns the old "username" field's value of the User entity.
+// OldBio returns the old "bio" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldBio(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldBio is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
+		return v, errors.New("OldBio requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldBio: %w", err)
 	}
-	return oldValue.Username, nil
+	return oldValue.Bio, nil
 }
 
-// ResetUsername resets all changes to the "username" field.
-func (m *UserMutation) ResetUsername() {
-	m.username = nil
+// ClearBio clears the value of the "bio" field.
+func (m *UserMutation) ClearBio() {
+	m.bio = nil
+	m.clearedFields[user.FieldBio] = struct{}{}
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// BioCleared returns if the "bio" field was cleared in this mutation.
+func (m *UserMutation) BioCleared() bool {
+	_, ok := m.clearedFields[user.FieldBio]
+	return ok
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// ResetBio resets all changes to the "bio" field.
+func (m *UserMutation) ResetBio() {
+	m.bio = nil
+	delete(m.clearedFields, user.FieldBio)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
 // If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetRole sets the "role" field.
-func (m *UserMutation) SetRole(s string) {
-	m.role = &s
+// AddPostIDs adds the "posts" edge to the Post entity by ids.
+func (m *UserMutation) AddPostIDs(ids ...int) {
+	if m.posts == nil {
+		m.posts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.posts[ids[i]] = struct{}{}
+	}
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *UserMutation) Role() (r string, exists bool) {
-	v := m.role
-	if v == nil {
-		return
+// ClearPosts clears the "posts" edge to the Post entity.
+func (m *UserMutation) ClearPosts() {
+	m.clearedposts = true
+}
+
+// PostsCleared reports if the "posts" edge to the Post entity was cleared.
+func (m *UserMutation) PostsCleared() bool {
+	return m.clearedposts
+}
+
+// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
+func (m *UserMutation) RemovePostIDs(ids ...int) {
+	if m.removedposts == nil {
+		m.removedposts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.posts, ids[i])
+		m.removedposts[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldRole(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
+func (m *UserMutation) RemovedPostsIDs() (ids []int) {
+	for id := range m.removedposts {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+	return
+}
+
+// PostsIDs returns the "posts" edge IDs in the mutation.
+func (m *UserMutation) PostsIDs() (ids []int) {
+	for id := range m.posts {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	return
+}
+
+// ResetPosts resets all changes to the "posts" edge.
+func (m *UserMutation) ResetPosts() {
+	m.posts = nil
+	m.clearedposts = false
+	m.removedposts = nil
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
+func (m *UserMutation) AddCommentIDs(ids ...int) {
+	if m.comments == nil {
+		m.comments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.comments[ids[i]] = struct{}{}
 	}
-	return oldValue.Role, nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *UserMutation) ResetRole() {
-	m.role = nil
+// ClearComments clears the "comments" edge to the Comment entity.
+func (m *UserMutation) ClearComments() {
+	m.clearedcomments = true
 }
 
-// SetAvatar sets the "avatar" field.
-func (m *UserMutation) SetAvatar(s string) {
-	m.avatar = &s
+// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
+func (m *UserMutation) CommentsCleared() bool {
+	return m.clearedcomments
 }
 
-// Avatar returns the value of the "avatar" field in the mutation.
-func (m *UserMutation) Avatar() (r string, exists bool) {
-	v := m.avatar
-	if v == nil {
-		return
+// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
+func (m *UserMutation) RemoveCommentIDs(ids ...int) {
+	if m.removedcomments == nil {
+		m.removedcomments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.comments, ids[i])
+		m.removedcomments[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldAvatar returns the old "avatar" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAvatar(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
+func (m *UserMutation) RemovedCommentsIDs() (ids []int) {
+	for id := range m.removedcomments {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatar requires an ID field in the mutation")
+	return
+}
+
+// CommentsIDs returns the "comments" edge IDs in the mutation.
+func (m *UserMutation) CommentsIDs() (ids []int) {
+	for id := range m.comments {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+	return
+}
+
+// ResetComments resets all changes to the "comments" edge.
+func (m *UserMutation) ResetComments() {
+	m.comments = nil
+	m.clearedcomments = false
+	m.removedcomments = nil
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *UserMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
 	}
-	return oldValue.Avatar, nil
 }
 
-// ClearAvatar clears the value of the "avatar" field.
-func (m *UserMutation) ClearAvatar() {
-	m.avatar = nil
-	m.clearedFields[user.FieldAvatar] = struct{}{}
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *UserMutation) ClearTags() {
+	m.clearedtags = true
 }
 
-// AvatarCleared returns if the "avatar" field was cleared in this mutation.
-func (m *UserMutation) AvatarCleared() bool {
-	_, ok := m.clearedFields[user.FieldAvatar]
-	return ok
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *UserMutation) TagsCleared() bool {
+	return m.clearedtags
 }
 
-// ResetAvatar resets all changes to the "avatar" field.
-func (m *UserMutation) ResetAvatar() {
-	m.avatar = nil
-	delete(m.clearedFields, user.FieldAvatar)
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *UserMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
 }
 
-// SetNickname sets the "nickname" field.
-func (m *UserMutation) SetNickname(s string) {
-	m.nickname = &s
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *UserMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Nickname returns the value of the "nickname" field in the mutation.
-func (m *UserMutation) Nickname() (r string, exists bool) {
-	v := m.nickname
-	if v == nil {
-		return
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *UserMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *UserMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddImageIDs adds the "images" edge to the Image entity by ids.
+func (m *UserMutation) AddImageIDs(ids ...int) {
+	if m.images == nil {
+		m.images = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.images[ids[i]] = struct{}{}
+	}
+}
+
+// ClearImages clears the "images" edge to the Image entity.
+func (m *UserMutation) ClearImages() {
+	m.clearedimages = true
+}
+
+// ImagesCleared reports if the "images" edge to the Image entity was cleared.
+func (m *UserMutation) ImagesCleared() bool {
+	return m.clearedimages
+}
+
+// RemoveImageIDs removes the "images" edge to the Image entity by IDs.
+func (m *UserMutation) RemoveImageIDs(ids ...int) {
+	if m.removedimages == nil {
+		m.removedimages = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.images, ids[i])
+		m.removedimages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedImages returns the removed IDs of the "images" edge to the Image entity.
+func (m *UserMutation) RemovedImagesIDs() (ids []int) {
+	for id := range m.removedimages {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldNickname returns the old "nickname" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldNickname(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
+// ImagesIDs returns the "images" edge IDs in the mutation.
+func (m *UserMutation) ImagesIDs() (ids []int) {
+	for id := range m.images {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNickname requires an ID field in the mutation")
+	return
+}
+
+// ResetImages resets all changes to the "images" edge.
+func (m *UserMutation) ResetImages() {
+	m.images = nil
+	m.clearedimages = false
+	m.removedimages = nil
+}
+
+// AddBookIDs adds the "books" edge to the Book entity by ids.
+func (m *UserMutation) AddBookIDs(ids ...int) {
+	if m.books == nil {
+		m.books = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
+	for i := range ids {
+		m.books[ids[i]] = struct{}{}
 	}
-	return oldValue.Nickname, nil
 }
 
-// ClearNickname clears the value of the "nickname" field.
-func (m *UserMutation) ClearNickname() {
-	m.nickname = nil
-	m.clearedFields[user.FieldNickname] = struct{}{}
+// ClearBooks clears the "books" edge to the Book entity.
+func (m *UserMutation) ClearBooks() {
+	m.clearedbooks = true
 }
 
-// NicknameCleared returns if the "nickname" field was cleared in this mutation.
-func (m *UserMutation) NicknameCleared() bool {
-	_, ok := m.clearedFields[user.FieldNickname]
-	return ok
+// BooksCleared reports if the "books" edge to the Book entity was cleared.
+func (m *UserMutation) BooksCleared() bool {
+	return m.clearedbooks
 }
 
-// ResetNickname resets all changes to the "nickname" field.
-func (m *UserMutation) ResetNickname() {
-	m.nickname = nil
-	delete(m.clearedFields, user.FieldNickname)
+// RemoveBookIDs removes the "books" edge to the Book entity by IDs.
+func (m *UserMutation) RemoveBookIDs(ids ...int) {
+	if m.removedbooks == nil {
+		m.removedbooks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.books, ids[i])
+		m.removedbooks[ids[i]] = struct{}{}
+	}
 }
 
-// SetBio sets the "bio" field.
-func (m *UserMutation) SetBio(s string) {
-	m.bio = &s
+// RemovedBooks returns the removed IDs of the "books" edge to the Book entity.
+func (m *UserMutation) RemovedBooksIDs() (ids []int) {
+	for id := range m.removedbooks {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Bio returns the value of the "bio" field in the mutation.
-func (m *UserMutation) Bio() (r string, exists bool) {
-	v := m.bio
-	if v == nil {
-		return
+// BooksIDs returns the "books" edge IDs in the mutation.
+func (m *UserMutation) BooksIDs() (ids []int) {
+	for id := range m.books {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldBio returns the old "bio" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldBio(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBio is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBio requires an ID field in the mutation")
+// ResetBooks resets all changes to the "books" edge.
+func (m *UserMutation) ResetBooks() {
+	m.books = nil
+	m.clearedbooks = false
+	m.removedbooks = nil
+}
+
+// AddCommentReactionIDs adds the "comment_reactions" edge to the CommentReaction entity by ids.
+func (m *UserMutation) AddCommentReactionIDs(ids ...int) {
+	if m.comment_reactions == nil {
+		m.comment_reactions = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBio: %w", err)
+	for i := range ids {
+		m.comment_reactions[ids[i]] = struct{}{}
 	}
-	return oldValue.Bio, nil
 }
 
-// ClearBio clears the value of the "bio" field.
-func (m *UserMutation) ClearBio() {
-	m.bio = nil
-	m.clearedFields[user.FieldBio] = struct{}{}
+// ClearCommentReactions clears the "comment_reactions" edge to the CommentReaction entity.
+func (m *UserMutation) ClearCommentReactions() {
+	m.clearedcomment_reactions = true
 }
 
-// BioCleared returns if the "bio" field was cleared in this mutation.
-func (m *UserMutation) BioCleared() bool {
-	_, ok := m.clearedFields[user.FieldBio]
-	return ok
+// CommentReactionsCleared reports if the "comment_reactions" edge to the CommentReaction entity was cleared.
+func (m *UserMutation) CommentReactionsCleared() bool {
+	return m.clearedcomment_reactions
 }
 
-// ResetBio resets all changes to the "bio" field.
-func (m *UserMutation) ResetBio() {
-	m.bio = nil
-	delete(m.clearedFields, user.FieldBio)
+// RemoveCommentReactionIDs removes the "comment_reactions" edge to the CommentReaction entity by IDs.
+func (m *UserMutation) RemoveCommentReactionIDs(ids ...int) {
+	if m.removedcomment_reactions == nil {
+		m.removedcomment_reactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.comment_reactions, ids[i])
+		m.removedcomment_reactions[ids[i]] = struct{}{}
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// RemovedCommentReactions returns the removed IDs of the "comment_reactions" edge to the CommentReaction entity.
+func (m *UserMutation) RemovedCommentReactionsIDs() (ids []int) {
+	for id := range m.removedcomment_reactions {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// CommentReactionsIDs returns the "comment_reactions" edge IDs in the mutation.
+func (m *UserMutation) CommentReactionsIDs() (ids []int) {
+	for id := range m.comment_reactions {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// ResetCommentReactions resets all changes to the "comment_reactions" edge.
+func (m *UserMutation) ResetCommentReactions() {
+	m.comment_reactions = nil
+	m.clearedcomment_reactions = false
+	m.removedcomment_reactions = nil
+}
+
+// AddIdentityIDs adds the "identities" edge to the Identity entity by ids.
+func (m *UserMutation) AddIdentityIDs(ids ...int) {
+	if m.identities == nil {
+		m.identities = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	for i := range ids {
+		m.identities[ids[i]] = struct{}{}
 	}
-	return oldValue.CreatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearIdentities clears the "identities" edge to the Identity entity.
+func (m *UserMutation) ClearIdentities() {
+	m.clearedidentities = true
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// IdentitiesCleared reports if the "identities" edge to the Identity entity was cleared.
+func (m *UserMutation) IdentitiesCleared() bool {
+	return m.clearedidentities
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// RemoveIdentityIDs removes the "identities" edge to the Identity entity by IDs.
+func (m *UserMutation) RemoveIdentityIDs(ids ...int) {
+	if m.removedidentities == nil {
+		m.removedidentities = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.identities, ids[i])
+		m.removedidentities[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// RemovedIdentities returns the removed IDs of the "identities" edge to the Identity entity.
+func (m *UserMutation) RemovedIdentitiesIDs() (ids []int) {
+	for id := range m.removedidentities {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	return
+}
+
+// IdentitiesIDs returns the "identities" edge IDs in the mutation.
+func (m *UserMutation) IdentitiesIDs() (ids []int) {
+	for id := range m.identities {
+		ids = append(ids, id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetIdentities resets all changes to the "identities" edge.
+func (m *UserMutation) ResetIdentities() {
+	m.identities = nil
+	m.clearedidentities = false
+	m.removedidentities = nil
 }
 
-// AddPostIDs adds the "posts" edge to the Post entity by ids.
-func (m *UserMutation) AddPostIDs(ids ...int) {
-	if m.posts == nil {
-		m.posts = make(map[int]struct{})
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by ids.
+func (m *UserMutation) AddInvitationIDs(ids ...int) {
+	if m.invitations == nil {
+		m.invitations = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.posts[ids[i]] = struct{}{}
+		m.invitations[ids[i]] = struct{}{}
 	}
 }
 
-// ClearPosts clears the "posts" edge to the Post entity.
-func (m *UserMutation) ClearPosts() {
-	m.clearedposts = true
+// ClearInvitations clears the "invitations" edge to the Invitation entity.
+func (m *UserMutation) ClearInvitations() {
+	m.clearedinvitations = true
 }
 
-// PostsCleared reports if the "posts" edge to the Post entity was cleared.
-func (m *UserMutation) PostsCleared() bool {
-	return m.clearedposts
+// InvitationsCleared reports if the "invitations" edge to the Invitation entity was cleared.
+func (m *UserMutation) InvitationsCleared() bool {
+	return m.clearedinvitations
 }
 
-// RemovePostIDs removes the "posts" edge to the Post entity by IDs.
-func (m *UserMutation) RemovePostIDs(ids ...int) {
-	if m.removedposts == nil {
-		m.removedposts = make(map[int]struct{})
+// RemoveInvitationIDs removes the "invitations" edge to the Invitation entity by IDs.
+func (m *UserMutation) RemoveInvitationIDs(ids ...int) {
+	if m.removedinvitations == nil {
+		m.removedinvitations = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.posts, ids[i])
-		m.removedposts[ids[i]] = struct{}{}
+		delete(m.invitations, ids[i])
+		m.removedinvitations[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedPosts returns the removed IDs of the "posts" edge to the Post entity.
-func (m *UserMutation) RemovedPostsIDs() (ids []int) {
-	for id := range m.removedposts {
+// RemovedInvitations returns the removed IDs of the "invitations" edge to the Invitation entity.
+func (m *UserMutation) RemovedInvitationsIDs() (ids []int) {
+	for id := range m.removedinvitations {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// PostsIDs returns the "posts" edge IDs in the mutation.
-func (m *UserMutation) PostsIDs() (ids []int) {
-	for id := range m.posts {
+// InvitationsIDs returns the "invitations" edge IDs in the mutation.
+func (m *UserMutation) InvitationsIDs() (ids []int) {
+	for id := range m.invitations {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetPosts resets all changes to the "posts" edge.
-func (m *UserMutation) ResetPosts() {
-	m.posts = nil
-	m.clearedposts = false
-	m.removedposts = nil
+// ResetInvitations resets all changes to the "invitations" edge.
+func (m *UserMutation) ResetInvitations() {
+	m.invitations = nil
+	m.clearedinvitations = false
+	m.removedinvitations = nil
 }
 
-// AddCommentIDs adds the "comments" edge to the Comment entity by ids.
-func (m *UserMutation) AddCommentIDs(ids ...int) {
-	if m.comments == nil {
-		m.comments = make(map[int]struct{})
+// SetInvitationID sets the "invitation" edge to the Invitation entity by id.
+func (m *UserMutation) SetInvitationID(id int) {
+	m.invitation = &id
+}
+
+// ClearInvitation clears the "invitation" edge to the Invitation entity.
+func (m *UserMutation) ClearInvitation() {
+	m.clearedinvitation = true
+}
+
+// InvitationCleared reports if the "invitation" edge to the Invitation entity was cleared.
+func (m *UserMutation) InvitationCleared() bool {
+	return m.clearedinvitation
+}
+
+// InvitationID returns the "invitation" edge ID in the mutation.
+func (m *UserMutation) InvitationID() (id int, exists bool) {
+	if m.invitation != nil {
+		return *m.invitation, true
+	}
+	return
+}
+
+// InvitationIDs returns the "invitation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// InvitationID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) InvitationIDs() (ids []int) {
+	if id := m.invitation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetInvitation resets all changes to the "invitation" edge.
+func (m *UserMutation) ResetInvitation() {
+	m.invitation = nil
+	m.clearedinvitation = false
+}
+
+// AddPasswordResetIDs adds the "password_resets" edge to the PasswordReset entity by ids.
+func (m *UserMutation) AddPasswordResetIDs(ids ...int) {
+	if m.password_resets == nil {
+		m.password_resets = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.comments[ids[i]] = struct{}{}
+		m.password_resets[ids[i]] = struct{}{}
 	}
 }
 
-// ClearComments clears the "comments" edge to the Comment entity.
-func (m *UserMutation) ClearComments() {
-	m.clearedcomments = true
+// ClearPasswordResets clears the "password_resets" edge to the PasswordReset entity.
+func (m *UserMutation) ClearPasswordResets() {
+	m.clearedpassword_resets = true
 }
 
-// CommentsCleared reports if the "comments" edge to the Comment entity was cleared.
-func (m *UserMutation) CommentsCleared() bool {
-	return m.clearedcomments
+// PasswordResetsCleared reports if the "password_resets" edge to the PasswordReset entity was cleared.
+func (m *UserMutation) PasswordResetsCleared() bool {
+	return m.clearedpassword_resets
 }
 
-// RemoveCommentIDs removes the "comments" edge to the Comment entity by IDs.
-func (m *UserMutation) RemoveCommentIDs(ids ...int) {
-	if m.removedcomments == nil {
-		m.removedcomments = make(map[int]struct{})
+// RemovePasswordResetIDs removes the "password_resets" edge to the PasswordReset entity by IDs.
+func (m *UserMutation) RemovePasswordResetIDs(ids ...int) {
+	if m.removedpassword_resets == nil {
+		m.removedpassword_resets = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.comments, ids[i])
-		m.removedcomments[ids[i]] = struct{}{}
+		delete(m.password_resets, ids[i])
+		m.removedpassword_resets[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedComments returns the removed IDs of the "comments" edge to the Comment entity.
-func (m *UserMutation) RemovedCommentsIDs() (ids []int) {
-	for id := range m.removedcomments {
+// RemovedPasswordResets returns the removed IDs of the "password_resets" edge to the PasswordReset entity.
+func (m *UserMutation) RemovedPasswordResetsIDs() (ids []int) {
+	for id := range m.removedpassword_resets {
 		ids = append(ids, id)
 	}
 	return
-}
-
-// CommentsIDs returns the "comments" edge IDs in the mutation.
-func (m *UserMutation) CommentsIDs() (ids []int) {
-	for id := range m.comments {
+}
+
+// PasswordResetsIDs returns the "password_resets" edge IDs in the mutation.
+func (m *UserMutation) PasswordResetsIDs() (ids []int) {
+	for id := range m.password_resets {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetComments resets all changes to the "comments" edge.
-func (m *UserMutation) ResetComments() {
-	m.comments = nil
-	m.clearedcomments = false
-	m.removedcomments = nil
+// ResetPasswordResets resets all changes to the "password_resets" edge.
+func (m *UserMutation) ResetPasswordResets() {
+	m.password_resets = nil
+	m.clearedpassword_resets = false
+	m.removedpassword_resets = nil
 }
 
-// AddTagIDs adds the "tags" edge to the Tag entity by ids.
-func (m *UserMutation) AddTagIDs(ids ...int) {
-	if m.tags == nil {
-		m.tags = make(map[int]struct{})
+// AddSessionIDs adds the "sessions" edge to the Session entity by ids.
+func (m *UserMutation) AddSessionIDs(ids ...int) {
+	if m.sessions == nil {
+		m.sessions = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.tags[ids[i]] = struct{}{}
+		m.sessions[ids[i]] = struct{}{}
 	}
 }
 
-// ClearTags clears the "tags" edge to the Tag entity.
-func (m *UserMutation) ClearTags() {
-	m.clearedtags = true
+// ClearSessions clears the "sessions" edge to the Session entity.
+func (m *UserMutation) ClearSessions() {
+	m.clearedsessions = true
 }
 
-// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
-func (m *UserMutation) TagsCleared() bool {
-	return m.clearedtags
+// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
+func (m *UserMutation) SessionsCleared() bool {
+	return m.clearedsessions
 }
 
-// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
-func (m *UserMutation) RemoveTagIDs(ids ...int) {
-	if m.removedtags == nil {
-		m.removedtags = make(map[int]struct{})
+// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
+func (m *UserMutation) RemoveSessionIDs(ids ...int) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.tags, ids[i])
-		m.removedtags[ids[i]] = struct{}{}
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
-func (m *UserMutation) RemovedTagsIDs() (ids []int) {
-	for id := range m.removedtags {
+// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
+func (m *UserMutation) RemovedSessionsIDs() (ids []int) {
+	for id := range m.removedsessions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// TagsIDs returns the "tags" edge IDs in the mutation.
-func (m *UserMutation) TagsIDs() (ids []int) {
-	for id := range m.tags {
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *UserMutation) SessionsIDs() (ids []int) {
+	for id := range m.sessions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTags resets all changes to the "tags" edge.
-func (m *UserMutation) ResetTags() {
-	m.tags = nil
-	m.clearedtags = false
-	m.removedtags = nil
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *UserMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
 }
 
-// AddImageIDs adds the "images" edge to the Image entity by ids.
-func (m *UserMutation) AddImageIDs(ids ...int) {
-	if m.images == nil {
-		m.images = make(map[int]struct{})
+// AddAccessTokenIDs adds the "access_tokens" edge to the AccessToken entity by ids.
+func (m *UserMutation) AddAccessTokenIDs(ids ...int) {
+	if m.access_tokens == nil {
+		m.access_tokens = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.images[ids[i]] = struct{}{}
+		m.access_tokens[ids[i]] = struct{}{}
 	}
 }
 
-// ClearImages clears the "images" edge to the Image entity.
-func (m *UserMutation) ClearImages() {
-	m.clearedimages = true
+// ClearAccessTokens clears the "access_tokens" edge to the AccessToken entity.
+func (m *UserMutation) ClearAccessTokens() {
+	m.clearedaccess_tokens = true
 }
 
-// ImagesCleared reports if the "images" edge to the Image entity was cleared.
-func (m *UserMutation) ImagesCleared() bool {
-	return m.clearedimages
+// AccessTokensCleared reports if the "access_tokens" edge to the AccessToken entity was cleared.
+func (m *UserMutation) AccessTokensCleared() bool {
+	return m.clearedaccess_tokens
 }
 
-// RemoveImageIDs removes the "images" edge to the Image entity by IDs.
-func (m *UserMutation) RemoveImageIDs(ids ...int) {
-	if m.removedimages == nil {
-		m.removedimages = make(map[int]struct{})
+// RemoveAccessTokenIDs removes the "access_tokens" edge to the AccessToken entity by IDs.
+func (m *UserMutation) RemoveAccessTokenIDs(ids ...int) {
+	if m.removedaccess_tokens == nil {
+		m.removedaccess_tokens = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.images, ids[i])
-		m.removedimages[ids[i]] = struct{}{}
+		delete(m.access_tokens, ids[i])
+		m.removedaccess_tokens[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedImages returns the removed IDs of the "images" edge to the Image entity.
-func (m *UserMutation) RemovedImagesIDs() (ids []int) {
-	for id := range m.removedimages {
+// RemovedAccessTokens returns the removed IDs of the "access_tokens" edge to the AccessToken entity.
+func (m *UserMutation) RemovedAccessTokensIDs() (ids []int) {
+	for id := range m.removedaccess_tokens {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ImagesIDs returns the "images" edge IDs in the mutation.
-func (m *UserMutation) ImagesIDs() (ids []int) {
-	for id := range m.images {
+// AccessTokensIDs returns the "access_tokens" edge IDs in the mutation.
+func (m *UserMutation) AccessTokensIDs() (ids []int) {
+	for id := range m.access_tokens {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetImages resets all changes to the "images" edge.
-func (m *UserMutation) ResetImages() {
-	m.images = nil
-	m.clearedimages = false
-	m.removedimages = nil
+// ResetAccessTokens resets all changes to the "access_tokens" edge.
+func (m *UserMutation) ResetAccessTokens() {
+	m.access_tokens = nil
+	m.clearedaccess_tokens = false
+	m.removedaccess_tokens = nil
 }
 
-// AddBookIDs adds the "books" edge to the Book entity by ids.
-func (m *UserMutation) AddBookIDs(ids ...int) {
-	if m.books == nil {
-		m.books = make(map[int]struct{})
+// AddRecoveryCodeIDs adds the "recovery_codes" edge to the RecoveryCode entity by ids.
+func (m *UserMutation) AddRecoveryCodeIDs(ids ...int) {
+	if m.recovery_codes == nil {
+		m.recovery_codes = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.books[ids[i]] = struct{}{}
+		m.recovery_codes[ids[i]] = struct{}{}
 	}
 }
 
-// ClearBooks clears the "books" edge to the Book entity.
-func (m *UserMutation) ClearBooks() {
-	m.clearedbooks = true
+// ClearRecoveryCodes clears the "recovery_codes" edge to the RecoveryCode entity.
+func (m *UserMutation) ClearRecoveryCodes() {
+	m.clearedrecovery_codes = true
 }
 
-// BooksCleared reports if the "books" edge to the Book entity was cleared.
-func (m *UserMutation) BooksCleared() bool {
-	return m.clearedbooks
+// RecoveryCodesCleared reports if the "recovery_codes" edge to the RecoveryCode entity was cleared.
+func (m *UserMutation) RecoveryCodesCleared() bool {
+	return m.clearedrecovery_codes
 }
 
-// RemoveBookIDs removes the "books" edge to the Book entity by IDs.
-func (m *UserMutation) RemoveBookIDs(ids ...int) {
-	if m.removedbooks == nil {
-		m.removedbooks = make(map[int]struct{})
+// RemoveRecoveryCodeIDs removes the "recovery_codes" edge to the RecoveryCode entity by IDs.
+func (m *UserMutation) RemoveRecoveryCodeIDs(ids ...int) {
+	if m.removedrecovery_codes == nil {
+		m.removedrecovery_codes = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.books, ids[i])
-		m.removedbooks[ids[i]] = struct{}{}
+		delete(m.recovery_codes, ids[i])
+		m.removedrecovery_codes[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedBooks returns the removed IDs of the "books" edge to the Book entity.
-func (m *UserMutation) RemovedBooksIDs() (ids []int) {
-	for id := range m.removedbooks {
+// RemovedRecoveryCodes returns the removed IDs of the "recovery_codes" edge to the RecoveryCode entity.
+func (m *UserMutation) RemovedRecoveryCodesIDs() (ids []int) {
+	for id := range m.removedrecovery_codes {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// BooksIDs returns the "books" edge IDs in the mutation.
-func (m *UserMutation) BooksIDs() (ids []int) {
-	for id := range m.books {
+// RecoveryCodesIDs returns the "recovery_codes" edge IDs in the mutation.
+func (m *UserMutation) RecoveryCodesIDs() (ids []int) {
+	for id := range m.recovery_codes {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetBooks resets all changes to the "books" edge.
-func (m *UserMutation) ResetBooks() {
-	m.books = nil
-	m.clearedbooks = false
-	m.removedbooks = nil
+// ResetRecoveryCodes resets all changes to the "recovery_codes" edge.
+func (m *UserMutation) ResetRecoveryCodes() {
+	m.recovery_codes = nil
+	m.clearedrecovery_codes = false
+	m.removedrecovery_codes = nil
 }
 
 // Where appends a list predicates to the UserMutation builder.
@@ -7795,16 +18963,40 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+	fields := make([]string, 0, 17)
 	if m.username != nil {
 		fields = append(fields, user.FieldUsername)
 	}
 	if m.email != nil {
 		fields = append(fields, user.FieldEmail)
 	}
+	if m.email_verified_at != nil {
+		fields = append(fields, user.FieldEmailVerifiedAt)
+	}
 	if m.password != nil {
 		fields = append(fields, user.FieldPassword)
 	}
+	if m.password_changed_at != nil {
+		fields = append(fields, user.FieldPasswordChangedAt)
+	}
+	if m.totp_secret != nil {
+		fields = append(fields, user.FieldTotpSecret)
+	}
+	if m.totp_enabled_at != nil {
+		fields = append(fields, user.FieldTotpEnabledAt)
+	}
+	if m.totp_last_step != nil {
+		fields = append(fields, user.FieldTotpLastStep)
+	}
+	if m.failed_login_count != nil {
+		fields = append(fields, user.FieldFailedLoginCount)
+	}
+	if m.last_failed_login_at != nil {
+		fields = append(fields, user.FieldLastFailedLoginAt)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, user.FieldLockedUntil)
+	}
 	if m.role != nil {
 		fields = append(fields, user.FieldRole)
 	}
@@ -7835,8 +19027,24 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.Username()
 	case user.FieldEmail:
 		return m.Email()
+	case user.FieldEmailVerifiedAt:
+		return m.EmailVerifiedAt()
 	case user.FieldPassword:
 		return m.Password()
+	case user.FieldPasswordChangedAt:
+		return m.PasswordChangedAt()
+	case user.FieldTotpSecret:
+		return m.TotpSecret()
+	case user.FieldTotpEnabledAt:
+		return m.TotpEnabledAt()
+	case user.FieldTotpLastStep:
+		return m.TotpLastStep()
+	case user.FieldFailedLoginCount:
+		return m.FailedLoginCount()
+	case user.FieldLastFailedLoginAt:
+		return m.LastFailedLoginAt()
+	case user.FieldLockedUntil:
+		return m.LockedUntil()
 	case user.FieldRole:
 		return m.Role()
 	case user.FieldAvatar:
@@ -7862,8 +19070,24 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldUsername(ctx)
 	case user.FieldEmail:
 		return m.OldEmail(ctx)
+	case user.FieldEmailVerifiedAt:
+		return m.OldEmailVerifiedAt(ctx)
 	case user.FieldPassword:
 		return m.OldPassword(ctx)
+	case user.FieldPasswordChangedAt:
+		return m.OldPasswordChangedAt(ctx)
+	case user.FieldTotpSecret:
+		return m.OldTotpSecret(ctx)
+	case user.FieldTotpEnabledAt:
+		return m.OldTotpEnabledAt(ctx)
+	case user.FieldTotpLastStep:
+		return m.OldTotpLastStep(ctx)
+	case user.FieldFailedLoginCount:
+		return m.OldFailedLoginCount(ctx)
+	case user.FieldLastFailedLoginAt:
+		return m.OldLastFailedLoginAt(ctx)
+	case user.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
 	case user.FieldRole:
 		return m.OldRole(ctx)
 	case user.FieldAvatar:
@@ -7899,6 +19123,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetEmail(v)
 		return nil
+	case user.FieldEmailVerifiedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmailVerifiedAt(v)
+		return nil
 	case user.FieldPassword:
 		v, ok := value.(string)
 		if !ok {
@@ -7906,6 +19137,55 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPassword(v)
 		return nil
+	case user.FieldPasswordChangedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordChangedAt(v)
+		return nil
+	case user.FieldTotpSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpSecret(v)
+		return nil
+	case user.FieldTotpEnabledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpEnabledAt(v)
+		return nil
+	case user.FieldTotpLastStep:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotpLastStep(v)
+		return nil
+	case user.FieldFailedLoginCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailedLoginCount(v)
+		return nil
+	case user.FieldLastFailedLoginAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastFailedLoginAt(v)
+		return nil
+	case user.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
 	case user.FieldRole:
 		v, ok := value.(string)
 		if !ok {
@@ -7955,13 +19235,26 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *UserMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addtotp_last_step != nil {
+		fields = append(fields, user.FieldTotpLastStep)
+	}
+	if m.addfailed_login_count != nil {
+		fields = append(fields, user.FieldFailedLoginCount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldTotpLastStep:
+		return m.AddedTotpLastStep()
+	case user.FieldFailedLoginCount:
+		return m.AddedFailedLoginCount()
+	}
 	return nil, false
 }
 
@@ -7970,6 +19263,20 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case user.FieldTotpLastStep:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotpLastStep(v)
+		return nil
+	case user.FieldFailedLoginCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailedLoginCount(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User numeric field %s", name)
 }
@@ -7978,6 +19285,24 @@ func (m *UserMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *UserMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(user.FieldEmailVerifiedAt) {
+		fields = append(fields, user.FieldEmailVerifiedAt)
+	}
+	if m.FieldCleared(user.FieldPasswordChangedAt) {
+		fields = append(fields, user.FieldPasswordChangedAt)
+	}
+	if m.FieldCleared(user.FieldTotpSecret) {
+		fields = append(fields, user.FieldTotpSecret)
+	}
+	if m.FieldCleared(user.FieldTotpEnabledAt) {
+		fields = append(fields, user.FieldTotpEnabledAt)
+	}
+	if m.FieldCleared(user.FieldLastFailedLoginAt) {
+		fields = append(fields, user.FieldLastFailedLoginAt)
+	}
+	if m.FieldCleared(user.FieldLockedUntil) {
+		fields = append(fields, user.FieldLockedUntil)
+	}
 	if m.FieldCleared(user.FieldAvatar) {
 		fields = append(fields, user.FieldAvatar)
 	}
@@ -8001,6 +19326,24 @@ func (m *UserMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *UserMutation) ClearField(name string) error {
 	switch name {
+	case user.FieldEmailVerifiedAt:
+		m.ClearEmailVerifiedAt()
+		return nil
+	case user.FieldPasswordChangedAt:
+		m.ClearPasswordChangedAt()
+		return nil
+	case user.FieldTotpSecret:
+		m.ClearTotpSecret()
+		return nil
+	case user.FieldTotpEnabledAt:
+		m.ClearTotpEnabledAt()
+		return nil
+	case user.FieldLastFailedLoginAt:
+		m.ClearLastFailedLoginAt()
+		return nil
+	case user.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
 	case user.FieldAvatar:
 		m.ClearAvatar()
 		return nil
@@ -8024,9 +19367,33 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldEmail:
 		m.ResetEmail()
 		return nil
+	case user.FieldEmailVerifiedAt:
+		m.ResetEmailVerifiedAt()
+		return nil
 	case user.FieldPassword:
 		m.ResetPassword()
 		return nil
+	case user.FieldPasswordChangedAt:
+		m.ResetPasswordChangedAt()
+		return nil
+	case user.FieldTotpSecret:
+		m.ResetTotpSecret()
+		return nil
+	case user.FieldTotpEnabledAt:
+		m.ResetTotpEnabledAt()
+		return nil
+	case user.FieldTotpLastStep:
+		m.ResetTotpLastStep()
+		return nil
+	case user.FieldFailedLoginCount:
+		m.ResetFailedLoginCount()
+		return nil
+	case user.FieldLastFailedLoginAt:
+		m.ResetLastFailedLoginAt()
+		return nil
+	case user.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
 	case user.FieldRole:
 		m.ResetRole()
 		return nil
@@ -8051,7 +19418,7 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 13)
 	if m.posts != nil {
 		edges = append(edges, user.EdgePosts)
 	}
@@ -8067,6 +19434,30 @@ func (m *UserMutation) AddedEdges() []string {
 	if m.books != nil {
 		edges = append(edges, user.EdgeBooks)
 	}
+	if m.comment_reactions != nil {
+		edges = append(edges, user.EdgeCommentReactions)
+	}
+	if m.identities != nil {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.invitations != nil {
+		edges = append(edges, user.EdgeInvitations)
+	}
+	if m.invitation != nil {
+		edges = append(edges, user.EdgeInvitation)
+	}
+	if m.password_resets != nil {
+		edges = append(edges, user.EdgePasswordResets)
+	}
+	if m.sessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.access_tokens != nil {
+		edges = append(edges, user.EdgeAccessTokens)
+	}
+	if m.recovery_codes != nil {
+		edges = append(edges, user.EdgeRecoveryCodes)
+	}
 	return edges
 }
 
@@ -8104,13 +19495,59 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeCommentReactions:
+		ids := make([]ent.Value, 0, len(m.comment_reactions))
+		for id := range m.comment_reactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.identities))
+		for id := range m.identities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeInvitations:
+		ids := make([]ent.Value, 0, len(m.invitations))
+		for id := range m.invitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeInvitation:
+		if id := m.invitation; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgePasswordResets:
+		ids := make([]ent.Value, 0, len(m.password_resets))
+		for id := range m.password_resets {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAccessTokens:
+		ids := make([]ent.Value, 0, len(m.access_tokens))
+		for id := range m.access_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRecoveryCodes:
+		ids := make([]ent.Value, 0, len(m.recovery_codes))
+		for id := range m.recovery_codes {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 13)
 	if m.removedposts != nil {
 		edges = append(edges, user.EdgePosts)
 	}
@@ -8126,6 +19563,27 @@ func (m *UserMutation) RemovedEdges() []string {
 	if m.removedbooks != nil {
 		edges = append(edges, user.EdgeBooks)
 	}
+	if m.removedcomment_reactions != nil {
+		edges = append(edges, user.EdgeCommentReactions)
+	}
+	if m.removedidentities != nil {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.removedinvitations != nil {
+		edges = append(edges, user.EdgeInvitations)
+	}
+	if m.removedpassword_resets != nil {
+		edges = append(edges, user.EdgePasswordResets)
+	}
+	if m.removedsessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.removedaccess_tokens != nil {
+		edges = append(edges, user.EdgeAccessTokens)
+	}
+	if m.removedrecovery_codes != nil {
+		edges = append(edges, user.EdgeRecoveryCodes)
+	}
 	return edges
 }
 
@@ -8163,13 +19621,55 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeCommentReactions:
+		ids := make([]ent.Value, 0, len(m.removedcomment_reactions))
+		for id := range m.removedcomment_reactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeIdentities:
+		ids := make([]ent.Value, 0, len(m.removedidentities))
+		for id := range m.removedidentities {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeInvitations:
+		ids := make([]ent.Value, 0, len(m.removedinvitations))
+		for id := range m.removedinvitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePasswordResets:
+		ids := make([]ent.Value, 0, len(m.removedpassword_resets))
+		for id := range m.removedpassword_resets {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAccessTokens:
+		ids := make([]ent.Value, 0, len(m.removedaccess_tokens))
+		for id := range m.removedaccess_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRecoveryCodes:
+		ids := make([]ent.Value, 0, len(m.removedrecovery_codes))
+		for id := range m.removedrecovery_codes {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 13)
 	if m.clearedposts {
 		edges = append(edges, user.EdgePosts)
 	}
@@ -8185,6 +19685,30 @@ func (m *UserMutation) ClearedEdges() []string {
 	if m.clearedbooks {
 		edges = append(edges, user.EdgeBooks)
 	}
+	if m.clearedcomment_reactions {
+		edges = append(edges, user.EdgeCommentReactions)
+	}
+	if m.clearedidentities {
+		edges = append(edges, user.EdgeIdentities)
+	}
+	if m.clearedinvitations {
+		edges = append(edges, user.EdgeInvitations)
+	}
+	if m.clearedinvitation {
+		edges = append(edges, user.EdgeInvitation)
+	}
+	if m.clearedpassword_resets {
+		edges = append(edges, user.EdgePasswordResets)
+	}
+	if m.clearedsessions {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.clearedaccess_tokens {
+		edges = append(edges, user.EdgeAccessTokens)
+	}
+	if m.clearedrecovery_codes {
+		edges = append(edges, user.EdgeRecoveryCodes)
+	}
 	return edges
 }
 
@@ -8202,6 +19726,22 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedimages
 	case user.EdgeBooks:
 		return m.clearedbooks
+	case user.EdgeCommentReactions:
+		return m.clearedcomment_reactions
+	case user.EdgeIdentities:
+		return m.clearedidentities
+	case user.EdgeInvitations:
+		return m.clearedinvitations
+	case user.EdgeInvitation:
+		return m.clearedinvitation
+	case user.EdgePasswordResets:
+		return m.clearedpassword_resets
+	case user.EdgeSessions:
+		return m.clearedsessions
+	case user.EdgeAccessTokens:
+		return m.clearedaccess_tokens
+	case user.EdgeRecoveryCodes:
+		return m.clearedrecovery_codes
 	}
 	return false
 }
@@ -8210,6 +19750,9 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 // if that edge is not defined in the schema.
 func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
+	case user.EdgeInvitation:
+		m.ClearInvitation()
+		return nil
 	}
 	return fmt.Errorf("unknown User unique edge %s", name)
 }
@@ -8233,6 +19776,30 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeBooks:
 		m.ResetBooks()
 		return nil
+	case user.EdgeCommentReactions:
+		m.ResetCommentReactions()
+		return nil
+	case user.EdgeIdentities:
+		m.ResetIdentities()
+		return nil
+	case user.EdgeInvitations:
+		m.ResetInvitations()
+		return nil
+	case user.EdgeInvitation:
+		m.ResetInvitation()
+		return nil
+	case user.EdgePasswordResets:
+		m.ResetPasswordResets()
+		return nil
+	case user.EdgeSessions:
+		m.ResetSessions()
+		return nil
+	case user.EdgeAccessTokens:
+		m.ResetAccessTokens()
+		return nil
+	case user.EdgeRecoveryCodes:
+		m.ResetRecoveryCodes()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
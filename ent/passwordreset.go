@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/passwordreset"
+	"blog-go/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PasswordReset is the model entity for the PasswordReset schema.
+type PasswordReset struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"-"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// UsedAt holds the value of the "used_at" field.
+	UsedAt *time.Time `json:"used_at,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PasswordResetQuery when eager-loading is set.
+	Edges                PasswordResetEdges `json:"edges"`
+	user_password_resets *int
+	selectValues         sql.SelectValues
+}
+
+// PasswordResetEdges holds the relations/edges for other nodes in the graph.
+type PasswordResetEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PasswordResetEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PasswordReset) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case passwordreset.FieldID:
+			values[i] = new(sql.NullInt64)
+		case passwordreset.FieldTokenHash, passwordreset.FieldIP:
+			values[i] = new(sql.NullString)
+		case passwordreset.FieldExpiresAt, passwordreset.FieldUsedAt, passwordreset.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case passwordreset.ForeignKeys[0]: // user_password_resets
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PasswordReset fields.
+func (pr *PasswordReset) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case passwordreset.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pr.ID = int(value.Int64)
+		case passwordreset.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				pr.TokenHash = value.String
+			}
+		case passwordreset.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				pr.ExpiresAt = value.Time
+			}
+		case passwordreset.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				pr.UsedAt = new(time.Time)
+				*pr.UsedAt = value.Time
+			}
+		case passwordreset.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				pr.IP = value.String
+			}
+		case passwordreset.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		case passwordreset.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_password_resets", value)
+			} else if value.Valid {
+				pr.user_password_resets = new(int)
+				*pr.user_password_resets = int(value.Int64)
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PasswordReset.
+// This includes values selected through modifiers, order, etc.
+func (pr *PasswordReset) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the PasswordReset entity.
+func (pr *PasswordReset) QueryUser() *UserQuery {
+	return NewPasswordResetClient(pr.config).QueryUser(pr)
+}
+
+// Update returns a builder for updating this PasswordReset.
+// Note that you need to call PasswordReset.Unwrap() before calling this method if this PasswordReset
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *PasswordReset) Update() *PasswordResetUpdateOne {
+	return NewPasswordResetClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the PasswordReset entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *PasswordReset) Unwrap() *PasswordReset {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PasswordReset is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *PasswordReset) String() string {
+	var builder strings.Builder
+	builder.WriteString("PasswordReset(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(pr.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := pr.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(pr.IP)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PasswordResets is a parsable slice of PasswordReset.
+type PasswordResets []*PasswordReset
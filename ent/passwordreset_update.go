@@ -0,0 +1,507 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/passwordreset"
+	"blog-go/ent/predicate"
+	"blog-go/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PasswordResetUpdate is the builder for updating PasswordReset entities.
+type PasswordResetUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PasswordResetMutation
+}
+
+// Where appends a list predicates to the PasswordResetUpdate builder.
+func (pru *PasswordResetUpdate) Where(ps ...predicate.PasswordReset) *PasswordResetUpdate {
+	pru.mutation.Where(ps...)
+	return pru
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (pru *PasswordResetUpdate) SetTokenHash(s string) *PasswordResetUpdate {
+	pru.mutation.SetTokenHash(s)
+	return pru
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (pru *PasswordResetUpdate) SetNillableTokenHash(s *string) *PasswordResetUpdate {
+	if s != nil {
+		pru.SetTokenHash(*s)
+	}
+	return pru
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (pru *PasswordResetUpdate) SetExpiresAt(t time.Time) *PasswordResetUpdate {
+	pru.mutation.SetExpiresAt(t)
+	return pru
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (pru *PasswordResetUpdate) SetNillableExpiresAt(t *time.Time) *PasswordResetUpdate {
+	if t != nil {
+		pru.SetExpiresAt(*t)
+	}
+	return pru
+}
+
+// SetUsedAt sets the "used_at" field.
+func (pru *PasswordResetUpdate) SetUsedAt(t time.Time) *PasswordResetUpdate {
+	pru.mutation.SetUsedAt(t)
+	return pru
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (pru *PasswordResetUpdate) SetNillableUsedAt(t *time.Time) *PasswordResetUpdate {
+	if t != nil {
+		pru.SetUsedAt(*t)
+	}
+	return pru
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (pru *PasswordResetUpdate) ClearUsedAt() *PasswordResetUpdate {
+	pru.mutation.ClearUsedAt()
+	return pru
+}
+
+// SetIP sets the "ip" field.
+func (pru *PasswordResetUpdate) SetIP(s string) *PasswordResetUpdate {
+	pru.mutation.SetIP(s)
+	return pru
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (pru *PasswordResetUpdate) SetNillableIP(s *string) *PasswordResetUpdate {
+	if s != nil {
+		pru.SetIP(*s)
+	}
+	return pru
+}
+
+// ClearIP clears the value of the "ip" field.
+func (pru *PasswordResetUpdate) ClearIP() *PasswordResetUpdate {
+	pru.mutation.ClearIP()
+	return pru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pru *PasswordResetUpdate) SetCreatedAt(t time.Time) *PasswordResetUpdate {
+	pru.mutation.SetCreatedAt(t)
+	return pru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pru *PasswordResetUpdate) SetNillableCreatedAt(t *time.Time) *PasswordResetUpdate {
+	if t != nil {
+		pru.SetCreatedAt(*t)
+	}
+	return pru
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (pru *PasswordResetUpdate) SetUserID(id int) *PasswordResetUpdate {
+	pru.mutation.SetUserID(id)
+	return pru
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pru *PasswordResetUpdate) SetUser(u *User) *PasswordResetUpdate {
+	return pru.SetUserID(u.ID)
+}
+
+// Mutation returns the PasswordResetMutation object of the builder.
+func (pru *PasswordResetUpdate) Mutation() *PasswordResetMutation {
+	return pru.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (pru *PasswordResetUpdate) ClearUser() *PasswordResetUpdate {
+	pru.mutation.ClearUser()
+	return pru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *PasswordResetUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pru.sqlSave, pru.mutation, pru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *PasswordResetUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *PasswordResetUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *PasswordResetUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pru *PasswordResetUpdate) check() error {
+	if v, ok := pru.mutation.TokenHash(); ok {
+		if err := passwordreset.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "PasswordReset.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := pru.mutation.UserID(); pru.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PasswordReset.user"`)
+	}
+	return nil
+}
+
+func (pru *PasswordResetUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passwordreset.Table, passwordreset.Columns, sqlgraph.NewFieldSpec(passwordreset.FieldID, field.TypeInt))
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pru.mutation.TokenHash(); ok {
+		_spec.SetField(passwordreset.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := pru.mutation.ExpiresAt(); ok {
+		_spec.SetField(passwordreset.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := pru.mutation.UsedAt(); ok {
+		_spec.SetField(passwordreset.FieldUsedAt, field.TypeTime, value)
+	}
+	if pru.mutation.UsedAtCleared() {
+		_spec.ClearField(passwordreset.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := pru.mutation.IP(); ok {
+		_spec.SetField(passwordreset.FieldIP, field.TypeString, value)
+	}
+	if pru.mutation.IPCleared() {
+		_spec.ClearField(passwordreset.FieldIP, field.TypeString)
+	}
+	if value, ok := pru.mutation.CreatedAt(); ok {
+		_spec.SetField(passwordreset.FieldCreatedAt, field.TypeTime, value)
+	}
+	if pru.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordreset.UserTable,
+			Columns: []string{passwordreset.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pru.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordreset.UserTable,
+			Columns: []string{passwordreset.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passwordreset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pru.mutation.done = true
+	return n, nil
+}
+
+// PasswordResetUpdateOne is the builder for updating a single PasswordReset entity.
+type PasswordResetUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PasswordResetMutation
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (pruo *PasswordResetUpdateOne) SetTokenHash(s string) *PasswordResetUpdateOne {
+	pruo.mutation.SetTokenHash(s)
+	return pruo
+}
+
+// SetNillableTokenHash sets the "token_hash" field if the given value is not nil.
+func (pruo *PasswordResetUpdateOne) SetNillableTokenHash(s *string) *PasswordResetUpdateOne {
+	if s != nil {
+		pruo.SetTokenHash(*s)
+	}
+	return pruo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (pruo *PasswordResetUpdateOne) SetExpiresAt(t time.Time) *PasswordResetUpdateOne {
+	pruo.mutation.SetExpiresAt(t)
+	return pruo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (pruo *PasswordResetUpdateOne) SetNillableExpiresAt(t *time.Time) *PasswordResetUpdateOne {
+	if t != nil {
+		pruo.SetExpiresAt(*t)
+	}
+	return pruo
+}
+
+// SetUsedAt sets the "used_at" field.
+func (pruo *PasswordResetUpdateOne) SetUsedAt(t time.Time) *PasswordResetUpdateOne {
+	pruo.mutation.SetUsedAt(t)
+	return pruo
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (pruo *PasswordResetUpdateOne) SetNillableUsedAt(t *time.Time) *PasswordResetUpdateOne {
+	if t != nil {
+		pruo.SetUsedAt(*t)
+	}
+	return pruo
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (pruo *PasswordResetUpdateOne) ClearUsedAt() *PasswordResetUpdateOne {
+	pruo.mutation.ClearUsedAt()
+	return pruo
+}
+
+// SetIP sets the "ip" field.
+func (pruo *PasswordResetUpdateOne) SetIP(s string) *PasswordResetUpdateOne {
+	pruo.mutation.SetIP(s)
+	return pruo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (pruo *PasswordResetUpdateOne) SetNillableIP(s *string) *PasswordResetUpdateOne {
+	if s != nil {
+		pruo.SetIP(*s)
+	}
+	return pruo
+}
+
+// ClearIP clears the value of the "ip" field.
+func (pruo *PasswordResetUpdateOne) ClearIP() *PasswordResetUpdateOne {
+	pruo.mutation.ClearIP()
+	return pruo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pruo *PasswordResetUpdateOne) SetCreatedAt(t time.Time) *PasswordResetUpdateOne {
+	pruo.mutation.SetCreatedAt(t)
+	return pruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pruo *PasswordResetUpdateOne) SetNillableCreatedAt(t *time.Time) *PasswordResetUpdateOne {
+	if t != nil {
+		pruo.SetCreatedAt(*t)
+	}
+	return pruo
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (pruo *PasswordResetUpdateOne) SetUserID(id int) *PasswordResetUpdateOne {
+	pruo.mutation.SetUserID(id)
+	return pruo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pruo *PasswordResetUpdateOne) SetUser(u *User) *PasswordResetUpdateOne {
+	return pruo.SetUserID(u.ID)
+}
+
+// Mutation returns the PasswordResetMutation object of the builder.
+func (pruo *PasswordResetUpdateOne) Mutation() *PasswordResetMutation {
+	return pruo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (pruo *PasswordResetUpdateOne) ClearUser() *PasswordResetUpdateOne {
+	pruo.mutation.ClearUser()
+	return pruo
+}
+
+// Where appends a list predicates to the PasswordResetUpdate builder.
+func (pruo *PasswordResetUpdateOne) Where(ps ...predicate.PasswordReset) *PasswordResetUpdateOne {
+	pruo.mutation.Where(ps...)
+	return pruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pruo *PasswordResetUpdateOne) Select(field string, fields ...string) *PasswordResetUpdateOne {
+	pruo.fields = append([]string{field}, fields...)
+	return pruo
+}
+
+// Save executes the query and returns the updated PasswordReset entity.
+func (pruo *PasswordResetUpdateOne) Save(ctx context.Context) (*PasswordReset, error) {
+	return withHooks(ctx, pruo.sqlSave, pruo.mutation, pruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pruo *PasswordResetUpdateOne) SaveX(ctx context.Context) *PasswordReset {
+	node, err := pruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pruo *PasswordResetUpdateOne) Exec(ctx context.Context) error {
+	_, err := pruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pruo *PasswordResetUpdateOne) ExecX(ctx context.Context) {
+	if err := pruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pruo *PasswordResetUpdateOne) check() error {
+	if v, ok := pruo.mutation.TokenHash(); ok {
+		if err := passwordreset.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "PasswordReset.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := pruo.mutation.UserID(); pruo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PasswordReset.user"`)
+	}
+	return nil
+}
+
+func (pruo *PasswordResetUpdateOne) sqlSave(ctx context.Context) (_node *PasswordReset, err error) {
+	if err := pruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(passwordreset.Table, passwordreset.Columns, sqlgraph.NewFieldSpec(passwordreset.FieldID, field.TypeInt))
+	id, ok := pruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PasswordReset.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passwordreset.FieldID)
+		for _, f := range fields {
+			if !passwordreset.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != passwordreset.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pruo.mutation.TokenHash(); ok {
+		_spec.SetField(passwordreset.FieldTokenHash, field.TypeString, value)
+	}
+	if value, ok := pruo.mutation.ExpiresAt(); ok {
+		_spec.SetField(passwordreset.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := pruo.mutation.UsedAt(); ok {
+		_spec.SetField(passwordreset.FieldUsedAt, field.TypeTime, value)
+	}
+	if pruo.mutation.UsedAtCleared() {
+		_spec.ClearField(passwordreset.FieldUsedAt, field.TypeTime)
+	}
+	if value, ok := pruo.mutation.IP(); ok {
+		_spec.SetField(passwordreset.FieldIP, field.TypeString, value)
+	}
+	if pruo.mutation.IPCleared() {
+		_spec.ClearField(passwordreset.FieldIP, field.TypeString)
+	}
+	if value, ok := pruo.mutation.CreatedAt(); ok {
+		_spec.SetField(passwordreset.FieldCreatedAt, field.TypeTime, value)
+	}
+	if pruo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordreset.UserTable,
+			Columns: []string{passwordreset.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pruo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   passwordreset.UserTable,
+			Columns: []string{passwordreset.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PasswordReset{config: pruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passwordreset.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pruo.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,9 @@ package ent
 
 import (
 	"blog-go/ent/post"
+	"blog-go/ent/user"
+	"blog-go/models"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -19,14 +22,22 @@ type Post struct {
 	ID int `json:"id,omitempty"`
 	// Title holds the value of the "title" field.
 	Title string `json:"title,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
 	// Content holds the value of the "content" field.
 	Content string `json:"content,omitempty"`
+	// ContentHTML holds the value of the "content_html" field.
+	ContentHTML *string `json:"content_html,omitempty"`
+	// Toc holds the value of the "toc" field.
+	Toc []models.TocItem `json:"toc,omitempty"`
 	// Excerpt holds the value of the "excerpt" field.
 	Excerpt string `json:"excerpt,omitempty"`
 	// CoverImage holds the value of the "cover_image" field.
 	CoverImage string `json:"cover_image,omitempty"`
 	// Published holds the value of the "published" field.
 	Published bool `json:"published,omitempty"`
+	// Scheduled holds the value of the "scheduled" field.
+	Scheduled bool `json:"scheduled,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -48,19 +59,36 @@ type Post struct {
 
 // PostEdges holds the relations/edges for other nodes in the graph.
 type PostEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
 	// Comments holds the value of the comments edge.
 	Comments []*Comment `json:"comments,omitempty"`
 	// Tags holds the value of the tags edge.
 	Tags []*Tag `json:"tags,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*PostRevision `json:"revisions,omitempty"`
+	// SlugHistory holds the value of the slug_history edge.
+	SlugHistory []*PostSlugHistory `json:"slug_history,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [5]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
 }
 
 // CommentsOrErr returns the Comments value or an error if the edge
 // was not loaded in eager-loading.
 func (e PostEdges) CommentsOrErr() ([]*Comment, error) {
-	if e.loadedTypes[0] {
+	if e.loadedTypes[1] {
 		return e.Comments, nil
 	}
 	return nil, &NotLoadedError{edge: "comments"}
@@ -69,22 +97,42 @@ func (e PostEdges) CommentsOrErr() ([]*Comment, error) {
 // TagsOrErr returns the Tags value or an error if the edge
 // was not loaded in eager-loading.
 func (e PostEdges) TagsOrErr() ([]*Tag, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[2] {
 		return e.Tags, nil
 	}
 	return nil, &NotLoadedError{edge: "tags"}
 }
 
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) RevisionsOrErr() ([]*PostRevision, error) {
+	if e.loadedTypes[3] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// SlugHistoryOrErr returns the SlugHistory value or an error if the edge
+// was not loaded in eager-loading.
+func (e PostEdges) SlugHistoryOrErr() ([]*PostSlugHistory, error) {
+	if e.loadedTypes[4] {
+		return e.SlugHistory, nil
+	}
+	return nil, &NotLoadedError{edge: "slug_history"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Post) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case post.FieldPublished:
+		case post.FieldToc:
+			values[i] = new([]byte)
+		case post.FieldPublished, post.FieldScheduled:
 			values[i] = new(sql.NullBool)
 		case post.FieldID, post.FieldViews:
 			values[i] = new(sql.NullInt64)
-		case post.FieldTitle, post.FieldContent, post.FieldExcerpt, post.FieldCoverImage, post.FieldAuthorType, post.FieldAuthor:
+		case post.FieldTitle, post.FieldSlug, post.FieldContent, post.FieldContentHTML, post.FieldExcerpt, post.FieldCoverImage, post.FieldAuthorType, post.FieldAuthor:
 			values[i] = new(sql.NullString)
 		case post.FieldCreatedAt, post.FieldUpdatedAt, post.FieldPublishedAt:
 			values[i] = new(sql.NullTime)
@@ -117,12 +165,33 @@ func (po *Post) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				po.Title = value.String
 			}
+		case post.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				po.Slug = value.String
+			}
 		case post.FieldContent:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field content", values[i])
 			} else if value.Valid {
 				po.Content = value.String
 			}
+		case post.FieldContentHTML:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_html", values[i])
+			} else if value.Valid {
+				po.ContentHTML = new(string)
+				*po.ContentHTML = value.String
+			}
+		case post.FieldToc:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field toc", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &po.Toc); err != nil {
+					return fmt.Errorf("unmarshal field toc: %w", err)
+				}
+			}
 		case post.FieldExcerpt:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field excerpt", values[i])
@@ -141,6 +210,12 @@ func (po *Post) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				po.Published = value.Bool
 			}
+		case post.FieldScheduled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduled", values[i])
+			} else if value.Valid {
+				po.Scheduled = value.Bool
+			}
 		case post.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -198,6 +273,11 @@ func (po *Post) Value(name string) (ent.Value, error) {
 	return po.selectValues.Get(name)
 }
 
+// QueryOwner queries the "owner" edge of the Post entity.
+func (po *Post) QueryOwner() *UserQuery {
+	return NewPostClient(po.config).QueryOwner(po)
+}
+
 // QueryComments queries the "comments" edge of the Post entity.
 func (po *Post) QueryComments() *CommentQuery {
 	return NewPostClient(po.config).QueryComments(po)
@@ -208,6 +288,16 @@ func (po *Post) QueryTags() *TagQuery {
 	return NewPostClient(po.config).QueryTags(po)
 }
 
+// QueryRevisions queries the "revisions" edge of the Post entity.
+func (po *Post) QueryRevisions() *PostRevisionQuery {
+	return NewPostClient(po.config).QueryRevisions(po)
+}
+
+// QuerySlugHistory queries the "slug_history" edge of the Post entity.
+func (po *Post) QuerySlugHistory() *PostSlugHistoryQuery {
+	return NewPostClient(po.config).QuerySlugHistory(po)
+}
+
 // Update returns a builder for updating this Post.
 // Note that you need to call Post.Unwrap() before calling this method if this Post
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -234,9 +324,20 @@ func (po *Post) String() string {
 	builder.WriteString("title=")
 	builder.WriteString(po.Title)
 	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(po.Slug)
+	builder.WriteString(", ")
 	builder.WriteString("content=")
 	builder.WriteString(po.Content)
 	builder.WriteString(", ")
+	if v := po.ContentHTML; v != nil {
+		builder.WriteString("content_html=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("toc=")
+	builder.WriteString(fmt.Sprintf("%v", po.Toc))
+	builder.WriteString(", ")
 	builder.WriteString("excerpt=")
 	builder.WriteString(po.Excerpt)
 	builder.WriteString(", ")
@@ -246,6 +347,9 @@ func (po *Post) String() string {
 	builder.WriteString("published=")
 	builder.WriteString(fmt.Sprintf("%v", po.Published))
 	builder.WriteString(", ")
+	builder.WriteString("scheduled=")
+	builder.WriteString(fmt.Sprintf("%v", po.Scheduled))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(po.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -5,7 +5,11 @@ package ent
 import (
 	"blog-go/ent/comment"
 	"blog-go/ent/post"
+	"blog-go/ent/postrevision"
+	"blog-go/ent/postslughistory"
 	"blog-go/ent/tag"
+	"blog-go/ent/user"
+	"blog-go/models"
 	"context"
 	"errors"
 	"fmt"
@@ -28,12 +32,46 @@ func (pc *PostCreate) SetTitle(s string) *PostCreate {
 	return pc
 }
 
+// SetSlug sets the "slug" field.
+func (pc *PostCreate) SetSlug(s string) *PostCreate {
+	pc.mutation.SetSlug(s)
+	return pc
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (pc *PostCreate) SetNillableSlug(s *string) *PostCreate {
+	if s != nil {
+		pc.SetSlug(*s)
+	}
+	return pc
+}
+
 // SetContent sets the "content" field.
 func (pc *PostCreate) SetContent(s string) *PostCreate {
 	pc.mutation.SetContent(s)
 	return pc
 }
 
+// SetContentHTML sets the "content_html" field.
+func (pc *PostCreate) SetContentHTML(s string) *PostCreate {
+	pc.mutation.SetContentHTML(s)
+	return pc
+}
+
+// SetNillableContentHTML sets the "content_html" field if the given value is not nil.
+func (pc *PostCreate) SetNillableContentHTML(s *string) *PostCreate {
+	if s != nil {
+		pc.SetContentHTML(*s)
+	}
+	return pc
+}
+
+// SetToc sets the "toc" field.
+func (pc *PostCreate) SetToc(mi []models.TocItem) *PostCreate {
+	pc.mutation.SetToc(mi)
+	return pc
+}
+
 // SetExcerpt sets the "excerpt" field.
 func (pc *PostCreate) SetExcerpt(s string) *PostCreate {
 	pc.mutation.SetExcerpt(s)
@@ -68,6 +106,20 @@ func (pc *PostCreate) SetNillablePublished(b *bool) *PostCreate {
 	return pc
 }
 
+// SetScheduled sets the "scheduled" field.
+func (pc *PostCreate) SetScheduled(b bool) *PostCreate {
+	pc.mutation.SetScheduled(b)
+	return pc
+}
+
+// SetNillableScheduled sets the "scheduled" field if the given value is not nil.
+func (pc *PostCreate) SetNillableScheduled(b *bool) *PostCreate {
+	if b != nil {
+		pc.SetScheduled(*b)
+	}
+	return pc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (pc *PostCreate) SetCreatedAt(t time.Time) *PostCreate {
 	pc.mutation.SetCreatedAt(t)
@@ -128,6 +180,25 @@ func (pc *PostCreate) SetAuthor(s string) *PostCreate {
 	return pc
 }
 
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (pc *PostCreate) SetOwnerID(id int) *PostCreate {
+	pc.mutation.SetOwnerID(id)
+	return pc
+}
+
+// SetNillableOwnerID sets the "owner" edge to the User entity by ID if the given value is not nil.
+func (pc *PostCreate) SetNillableOwnerID(id *int) *PostCreate {
+	if id != nil {
+		pc = pc.SetOwnerID(*id)
+	}
+	return pc
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (pc *PostCreate) SetOwner(u *User) *PostCreate {
+	return pc.SetOwnerID(u.ID)
+}
+
 // AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
 func (pc *PostCreate) AddCommentIDs(ids ...int) *PostCreate {
 	pc.mutation.AddCommentIDs(ids...)
@@ -158,6 +229,36 @@ func (pc *PostCreate) AddTags(t ...*Tag) *PostCreate {
 	return pc.AddTagIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (pc *PostCreate) AddRevisionIDs(ids ...int) *PostCreate {
+	pc.mutation.AddRevisionIDs(ids...)
+	return pc
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (pc *PostCreate) AddRevisions(p ...*PostRevision) *PostCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddRevisionIDs(ids...)
+}
+
+// AddSlugHistoryIDs adds the "slug_history" edge to the PostSlugHistory entity by IDs.
+func (pc *PostCreate) AddSlugHistoryIDs(ids ...int) *PostCreate {
+	pc.mutation.AddSlugHistoryIDs(ids...)
+	return pc
+}
+
+// AddSlugHistory adds the "slug_history" edges to the PostSlugHistory entity.
+func (pc *PostCreate) AddSlugHistory(p ...*PostSlugHistory) *PostCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddSlugHistoryIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (pc *PostCreate) Mutation() *PostMutation {
 	return pc.mutation
@@ -201,6 +302,10 @@ func (pc *PostCreate) defaults() {
 		v := post.DefaultPublished
 		pc.mutation.SetPublished(v)
 	}
+	if _, ok := pc.mutation.Scheduled(); !ok {
+		v := post.DefaultScheduled
+		pc.mutation.SetScheduled(v)
+	}
 	if _, ok := pc.mutation.Views(); !ok {
 		v := post.DefaultViews
 		pc.mutation.SetViews(v)
@@ -243,6 +348,9 @@ func (pc *PostCreate) check() error {
 	if _, ok := pc.mutation.Published(); !ok {
 		return &ValidationError{Name: "published", err: errors.New(`ent: missing required field "Post.published"`)}
 	}
+	if _, ok := pc.mutation.Scheduled(); !ok {
+		return &ValidationError{Name: "scheduled", err: errors.New(`ent: missing required field "Post.scheduled"`)}
+	}
 	if _, ok := pc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Post.created_at"`)}
 	}
@@ -298,10 +406,22 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldTitle, field.TypeString, value)
 		_node.Title = value
 	}
+	if value, ok := pc.mutation.Slug(); ok {
+		_spec.SetField(post.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
 	if value, ok := pc.mutation.Content(); ok {
 		_spec.SetField(post.FieldContent, field.TypeString, value)
 		_node.Content = value
 	}
+	if value, ok := pc.mutation.ContentHTML(); ok {
+		_spec.SetField(post.FieldContentHTML, field.TypeString, value)
+		_node.ContentHTML = &value
+	}
+	if value, ok := pc.mutation.Toc(); ok {
+		_spec.SetField(post.FieldToc, field.TypeJSON, value)
+		_node.Toc = value
+	}
 	if value, ok := pc.mutation.Excerpt(); ok {
 		_spec.SetField(post.FieldExcerpt, field.TypeString, value)
 		_node.Excerpt = value
@@ -314,6 +434,10 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldPublished, field.TypeBool, value)
 		_node.Published = value
 	}
+	if value, ok := pc.mutation.Scheduled(); ok {
+		_spec.SetField(post.FieldScheduled, field.TypeBool, value)
+		_node.Scheduled = value
+	}
 	if value, ok := pc.mutation.CreatedAt(); ok {
 		_spec.SetField(post.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -338,6 +462,23 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		_spec.SetField(post.FieldAuthor, field.TypeString, value)
 		_node.Author = value
 	}
+	if nodes := pc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   post.OwnerTable,
+			Columns: []string{post.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_posts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := pc.mutation.CommentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -370,6 +511,38 @@ func (pc *PostCreate) createSpec() (*Post, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.SlugHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SlugHistoryTable,
+			Columns: []string{post.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postslughistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
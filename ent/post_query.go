@@ -5,8 +5,11 @@ package ent
 import (
 	"blog-go/ent/comment"
 	"blog-go/ent/post"
+	"blog-go/ent/postrevision"
+	"blog-go/ent/postslughistory"
 	"blog-go/ent/predicate"
 	"blog-go/ent/tag"
+	"blog-go/ent/user"
 	"context"
 	"database/sql/driver"
 	"fmt"
@@ -20,13 +23,16 @@ import (
 // PostQuery is the builder for querying Post entities.
 type PostQuery struct {
 	config
-	ctx          *QueryContext
-	order        []post.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Post
-	withComments *CommentQuery
-	withTags     *TagQuery
-	withFKs      bool
+	ctx             *QueryContext
+	order           []post.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Post
+	withOwner       *UserQuery
+	withComments    *CommentQuery
+	withTags        *TagQuery
+	withRevisions   *PostRevisionQuery
+	withSlugHistory *PostSlugHistoryQuery
+	withFKs         bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -63,6 +69,28 @@ func (pq *PostQuery) Order(o ...post.OrderOption) *PostQuery {
 	return pq
 }
 
+// QueryOwner chains the current query on the "owner" edge.
+func (pq *PostQuery) QueryOwner() *UserQuery {
+	query := (&UserClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, post.OwnerTable, post.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryComments chains the current query on the "comments" edge.
 func (pq *PostQuery) QueryComments() *CommentQuery {
 	query := (&CommentClient{config: pq.config}).Query()
@@ -107,6 +135,50 @@ func (pq *PostQuery) QueryTags() *TagQuery {
 	return query
 }
 
+// QueryRevisions chains the current query on the "revisions" edge.
+func (pq *PostQuery) QueryRevisions() *PostRevisionQuery {
+	query := (&PostRevisionClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, selector),
+			sqlgraph.To(postrevision.Table, postrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.RevisionsTable, post.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySlugHistory chains the current query on the "slug_history" edge.
+func (pq *PostQuery) QuerySlugHistory() *PostSlugHistoryQuery {
+	query := (&PostSlugHistoryClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(post.Table, post.FieldID, selector),
+			sqlgraph.To(postslughistory.Table, postslughistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, post.SlugHistoryTable, post.SlugHistoryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Post entity from the query.
 // Returns a *NotFoundError when no Post was found.
 func (pq *PostQuery) First(ctx context.Context) (*Post, error) {
@@ -294,19 +366,33 @@ func (pq *PostQuery) Clone() *PostQuery {
 		return nil
 	}
 	return &PostQuery{
-		config:       pq.config,
-		ctx:          pq.ctx.Clone(),
-		order:        append([]post.OrderOption{}, pq.order...),
-		inters:       append([]Interceptor{}, pq.inters...),
-		predicates:   append([]predicate.Post{}, pq.predicates...),
-		withComments: pq.withComments.Clone(),
-		withTags:     pq.withTags.Clone(),
+		config:          pq.config,
+		ctx:             pq.ctx.Clone(),
+		order:           append([]post.OrderOption{}, pq.order...),
+		inters:          append([]Interceptor{}, pq.inters...),
+		predicates:      append([]predicate.Post{}, pq.predicates...),
+		withOwner:       pq.withOwner.Clone(),
+		withComments:    pq.withComments.Clone(),
+		withTags:        pq.withTags.Clone(),
+		withRevisions:   pq.withRevisions.Clone(),
+		withSlugHistory: pq.withSlugHistory.Clone(),
 		// clone intermediate query.
 		sql:  pq.sql.Clone(),
 		path: pq.path,
 	}
 }
 
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PostQuery) WithOwner(opts ...func(*UserQuery)) *PostQuery {
+	query := (&UserClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withOwner = query
+	return pq
+}
+
 // WithComments tells the query-builder to eager-load the nodes that are connected to
 // the "comments" edge. The optional arguments are used to configure the query builder of the edge.
 func (pq *PostQuery) WithComments(opts ...func(*CommentQuery)) *PostQuery {
@@ -329,6 +415,28 @@ func (pq *PostQuery) WithTags(opts ...func(*TagQuery)) *PostQuery {
 	return pq
 }
 
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PostQuery) WithRevisions(opts ...func(*PostRevisionQuery)) *PostQuery {
+	query := (&PostRevisionClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withRevisions = query
+	return pq
+}
+
+// WithSlugHistory tells the query-builder to eager-load the nodes that are connected to
+// the "slug_history" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PostQuery) WithSlugHistory(opts ...func(*PostSlugHistoryQuery)) *PostQuery {
+	query := (&PostSlugHistoryClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withSlugHistory = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -408,11 +516,17 @@ func (pq *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 		nodes       = []*Post{}
 		withFKs     = pq.withFKs
 		_spec       = pq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [5]bool{
+			pq.withOwner != nil,
 			pq.withComments != nil,
 			pq.withTags != nil,
+			pq.withRevisions != nil,
+			pq.withSlugHistory != nil,
 		}
 	)
+	if pq.withOwner != nil {
+		withFKs = true
+	}
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, post.ForeignKeys...)
 	}
@@ -434,6 +548,12 @@ func (pq *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := pq.withOwner; query != nil {
+		if err := pq.loadOwner(ctx, query, nodes, nil,
+			func(n *Post, e *User) { n.Edges.Owner = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := pq.withComments; query != nil {
 		if err := pq.loadComments(ctx, query, nodes,
 			func(n *Post) { n.Edges.Comments = []*Comment{} },
@@ -448,9 +568,55 @@ func (pq *PostQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Post, e
 			return nil, err
 		}
 	}
+	if query := pq.withRevisions; query != nil {
+		if err := pq.loadRevisions(ctx, query, nodes,
+			func(n *Post) { n.Edges.Revisions = []*PostRevision{} },
+			func(n *Post, e *PostRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withSlugHistory; query != nil {
+		if err := pq.loadSlugHistory(ctx, query, nodes,
+			func(n *Post) { n.Edges.SlugHistory = []*PostSlugHistory{} },
+			func(n *Post, e *PostSlugHistory) { n.Edges.SlugHistory = append(n.Edges.SlugHistory, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (pq *PostQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*Post, init func(*Post), assign func(*Post, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Post)
+	for i := range nodes {
+		if nodes[i].user_posts == nil {
+			continue
+		}
+		fk := *nodes[i].user_posts
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_posts" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (pq *PostQuery) loadComments(ctx context.Context, query *CommentQuery, nodes []*Post, init func(*Post), assign func(*Post, *Comment)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*Post)
@@ -543,6 +709,68 @@ func (pq *PostQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Pos
 	}
 	return nil
 }
+func (pq *PostQuery) loadRevisions(ctx context.Context, query *PostRevisionQuery, nodes []*Post, init func(*Post), assign func(*Post, *PostRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Post)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PostRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(post.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.post_revisions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "post_revisions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "post_revisions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *PostQuery) loadSlugHistory(ctx context.Context, query *PostSlugHistoryQuery, nodes []*Post, init func(*Post), assign func(*Post, *PostSlugHistory)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Post)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.PostSlugHistory(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(post.SlugHistoryColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.post_slug_history
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "post_slug_history" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "post_slug_history" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (pq *PostQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
@@ -5,8 +5,12 @@ package ent
 import (
 	"blog-go/ent/comment"
 	"blog-go/ent/post"
+	"blog-go/ent/postrevision"
+	"blog-go/ent/postslughistory"
 	"blog-go/ent/predicate"
 	"blog-go/ent/tag"
+	"blog-go/ent/user"
+	"blog-go/models"
 	"context"
 	"errors"
 	"fmt"
@@ -14,6 +18,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -44,6 +49,26 @@ func (pu *PostUpdate) SetNillableTitle(s *string) *PostUpdate {
 	return pu
 }
 
+// SetSlug sets the "slug" field.
+func (pu *PostUpdate) SetSlug(s string) *PostUpdate {
+	pu.mutation.SetSlug(s)
+	return pu
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableSlug(s *string) *PostUpdate {
+	if s != nil {
+		pu.SetSlug(*s)
+	}
+	return pu
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (pu *PostUpdate) ClearSlug() *PostUpdate {
+	pu.mutation.ClearSlug()
+	return pu
+}
+
 // SetContent sets the "content" field.
 func (pu *PostUpdate) SetContent(s string) *PostUpdate {
 	pu.mutation.SetContent(s)
@@ -58,6 +83,44 @@ func (pu *PostUpdate) SetNillableContent(s *string) *PostUpdate {
 	return pu
 }
 
+// SetContentHTML sets the "content_html" field.
+func (pu *PostUpdate) SetContentHTML(s string) *PostUpdate {
+	pu.mutation.SetContentHTML(s)
+	return pu
+}
+
+// SetNillableContentHTML sets the "content_html" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableContentHTML(s *string) *PostUpdate {
+	if s != nil {
+		pu.SetContentHTML(*s)
+	}
+	return pu
+}
+
+// ClearContentHTML clears the value of the "content_html" field.
+func (pu *PostUpdate) ClearContentHTML() *PostUpdate {
+	pu.mutation.ClearContentHTML()
+	return pu
+}
+
+// SetToc sets the "toc" field.
+func (pu *PostUpdate) SetToc(mi []models.TocItem) *PostUpdate {
+	pu.mutation.SetToc(mi)
+	return pu
+}
+
+// AppendToc appends mi to the "toc" field.
+func (pu *PostUpdate) AppendToc(mi []models.TocItem) *PostUpdate {
+	pu.mutation.AppendToc(mi)
+	return pu
+}
+
+// ClearToc clears the value of the "toc" field.
+func (pu *PostUpdate) ClearToc() *PostUpdate {
+	pu.mutation.ClearToc()
+	return pu
+}
+
 // SetExcerpt sets the "excerpt" field.
 func (pu *PostUpdate) SetExcerpt(s string) *PostUpdate {
 	pu.mutation.SetExcerpt(s)
@@ -100,6 +163,20 @@ func (pu *PostUpdate) SetNillablePublished(b *bool) *PostUpdate {
 	return pu
 }
 
+// SetScheduled sets the "scheduled" field.
+func (pu *PostUpdate) SetScheduled(b bool) *PostUpdate {
+	pu.mutation.SetScheduled(b)
+	return pu
+}
+
+// SetNillableScheduled sets the "scheduled" field if the given value is not nil.
+func (pu *PostUpdate) SetNillableScheduled(b *bool) *PostUpdate {
+	if b != nil {
+		pu.SetScheduled(*b)
+	}
+	return pu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (pu *PostUpdate) SetCreatedAt(t time.Time) *PostUpdate {
 	pu.mutation.SetCreatedAt(t)
@@ -197,6 +274,25 @@ func (pu *PostUpdate) SetNillableAuthor(s *string) *PostUpdate {
 	return pu
 }
 
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (pu *PostUpdate) SetOwnerID(id int) *PostUpdate {
+	pu.mutation.SetOwnerID(id)
+	return pu
+}
+
+// SetNillableOwnerID sets the "owner" edge to the User entity by ID if the given value is not nil.
+func (pu *PostUpdate) SetNillableOwnerID(id *int) *PostUpdate {
+	if id != nil {
+		pu = pu.SetOwnerID(*id)
+	}
+	return pu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (pu *PostUpdate) SetOwner(u *User) *PostUpdate {
+	return pu.SetOwnerID(u.ID)
+}
+
 // AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
 func (pu *PostUpdate) AddCommentIDs(ids ...int) *PostUpdate {
 	pu.mutation.AddCommentIDs(ids...)
@@ -227,11 +323,47 @@ func (pu *PostUpdate) AddTags(t ...*Tag) *PostUpdate {
 	return pu.AddTagIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (pu *PostUpdate) AddRevisionIDs(ids ...int) *PostUpdate {
+	pu.mutation.AddRevisionIDs(ids...)
+	return pu
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (pu *PostUpdate) AddRevisions(p ...*PostRevision) *PostUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddRevisionIDs(ids...)
+}
+
+// AddSlugHistoryIDs adds the "slug_history" edge to the PostSlugHistory entity by IDs.
+func (pu *PostUpdate) AddSlugHistoryIDs(ids ...int) *PostUpdate {
+	pu.mutation.AddSlugHistoryIDs(ids...)
+	return pu
+}
+
+// AddSlugHistory adds the "slug_history" edges to the PostSlugHistory entity.
+func (pu *PostUpdate) AddSlugHistory(p ...*PostSlugHistory) *PostUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddSlugHistoryIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (pu *PostUpdate) Mutation() *PostMutation {
 	return pu.mutation
 }
 
+// ClearOwner clears the "owner" edge to the User entity.
+func (pu *PostUpdate) ClearOwner() *PostUpdate {
+	pu.mutation.ClearOwner()
+	return pu
+}
+
 // ClearComments clears all "comments" edges to the Comment entity.
 func (pu *PostUpdate) ClearComments() *PostUpdate {
 	pu.mutation.ClearComments()
@@ -274,6 +406,48 @@ func (pu *PostUpdate) RemoveTags(t ...*Tag) *PostUpdate {
 	return pu.RemoveTagIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the PostRevision entity.
+func (pu *PostUpdate) ClearRevisions() *PostUpdate {
+	pu.mutation.ClearRevisions()
+	return pu
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to PostRevision entities by IDs.
+func (pu *PostUpdate) RemoveRevisionIDs(ids ...int) *PostUpdate {
+	pu.mutation.RemoveRevisionIDs(ids...)
+	return pu
+}
+
+// RemoveRevisions removes "revisions" edges to PostRevision entities.
+func (pu *PostUpdate) RemoveRevisions(p ...*PostRevision) *PostUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveRevisionIDs(ids...)
+}
+
+// ClearSlugHistory clears all "slug_history" edges to the PostSlugHistory entity.
+func (pu *PostUpdate) ClearSlugHistory() *PostUpdate {
+	pu.mutation.ClearSlugHistory()
+	return pu
+}
+
+// RemoveSlugHistoryIDs removes the "slug_history" edge to PostSlugHistory entities by IDs.
+func (pu *PostUpdate) RemoveSlugHistoryIDs(ids ...int) *PostUpdate {
+	pu.mutation.RemoveSlugHistoryIDs(ids...)
+	return pu
+}
+
+// RemoveSlugHistory removes "slug_history" edges to PostSlugHistory entities.
+func (pu *PostUpdate) RemoveSlugHistory(p ...*PostSlugHistory) *PostUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveSlugHistoryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *PostUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
@@ -346,9 +520,32 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.Title(); ok {
 		_spec.SetField(post.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := pu.mutation.Slug(); ok {
+		_spec.SetField(post.FieldSlug, field.TypeString, value)
+	}
+	if pu.mutation.SlugCleared() {
+		_spec.ClearField(post.FieldSlug, field.TypeString)
+	}
 	if value, ok := pu.mutation.Content(); ok {
 		_spec.SetField(post.FieldContent, field.TypeString, value)
 	}
+	if value, ok := pu.mutation.ContentHTML(); ok {
+		_spec.SetField(post.FieldContentHTML, field.TypeString, value)
+	}
+	if pu.mutation.ContentHTMLCleared() {
+		_spec.ClearField(post.FieldContentHTML, field.TypeString)
+	}
+	if value, ok := pu.mutation.Toc(); ok {
+		_spec.SetField(post.FieldToc, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedToc(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, post.FieldToc, value)
+		})
+	}
+	if pu.mutation.TocCleared() {
+		_spec.ClearField(post.FieldToc, field.TypeJSON)
+	}
 	if value, ok := pu.mutation.Excerpt(); ok {
 		_spec.SetField(post.FieldExcerpt, field.TypeString, value)
 	}
@@ -358,6 +555,9 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.Published(); ok {
 		_spec.SetField(post.FieldPublished, field.TypeBool, value)
 	}
+	if value, ok := pu.mutation.Scheduled(); ok {
+		_spec.SetField(post.FieldScheduled, field.TypeBool, value)
+	}
 	if value, ok := pu.mutation.CreatedAt(); ok {
 		_spec.SetField(post.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -382,6 +582,35 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.Author(); ok {
 		_spec.SetField(post.FieldAuthor, field.TypeString, value)
 	}
+	if pu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   post.OwnerTable,
+			Columns: []string{post.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   post.OwnerTable,
+			Columns: []string{post.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if pu.mutation.CommentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -472,6 +701,96 @@ func (pu *PostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !pu.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.SlugHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SlugHistoryTable,
+			Columns: []string{post.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postslughistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedSlugHistoryIDs(); len(nodes) > 0 && !pu.mutation.SlugHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SlugHistoryTable,
+			Columns: []string{post.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postslughistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.SlugHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SlugHistoryTable,
+			Columns: []string{post.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postslughistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{post.Label}
@@ -506,6 +825,26 @@ func (puo *PostUpdateOne) SetNillableTitle(s *string) *PostUpdateOne {
 	return puo
 }
 
+// SetSlug sets the "slug" field.
+func (puo *PostUpdateOne) SetSlug(s string) *PostUpdateOne {
+	puo.mutation.SetSlug(s)
+	return puo
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableSlug(s *string) *PostUpdateOne {
+	if s != nil {
+		puo.SetSlug(*s)
+	}
+	return puo
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (puo *PostUpdateOne) ClearSlug() *PostUpdateOne {
+	puo.mutation.ClearSlug()
+	return puo
+}
+
 // SetContent sets the "content" field.
 func (puo *PostUpdateOne) SetContent(s string) *PostUpdateOne {
 	puo.mutation.SetContent(s)
@@ -520,6 +859,44 @@ func (puo *PostUpdateOne) SetNillableContent(s *string) *PostUpdateOne {
 	return puo
 }
 
+// SetContentHTML sets the "content_html" field.
+func (puo *PostUpdateOne) SetContentHTML(s string) *PostUpdateOne {
+	puo.mutation.SetContentHTML(s)
+	return puo
+}
+
+// SetNillableContentHTML sets the "content_html" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableContentHTML(s *string) *PostUpdateOne {
+	if s != nil {
+		puo.SetContentHTML(*s)
+	}
+	return puo
+}
+
+// ClearContentHTML clears the value of the "content_html" field.
+func (puo *PostUpdateOne) ClearContentHTML() *PostUpdateOne {
+	puo.mutation.ClearContentHTML()
+	return puo
+}
+
+// SetToc sets the "toc" field.
+func (puo *PostUpdateOne) SetToc(mi []models.TocItem) *PostUpdateOne {
+	puo.mutation.SetToc(mi)
+	return puo
+}
+
+// AppendToc appends mi to the "toc" field.
+func (puo *PostUpdateOne) AppendToc(mi []models.TocItem) *PostUpdateOne {
+	puo.mutation.AppendToc(mi)
+	return puo
+}
+
+// ClearToc clears the value of the "toc" field.
+func (puo *PostUpdateOne) ClearToc() *PostUpdateOne {
+	puo.mutation.ClearToc()
+	return puo
+}
+
 // SetExcerpt sets the "excerpt" field.
 func (puo *PostUpdateOne) SetExcerpt(s string) *PostUpdateOne {
 	puo.mutation.SetExcerpt(s)
@@ -562,6 +939,20 @@ func (puo *PostUpdateOne) SetNillablePublished(b *bool) *PostUpdateOne {
 	return puo
 }
 
+// SetScheduled sets the "scheduled" field.
+func (puo *PostUpdateOne) SetScheduled(b bool) *PostUpdateOne {
+	puo.mutation.SetScheduled(b)
+	return puo
+}
+
+// SetNillableScheduled sets the "scheduled" field if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableScheduled(b *bool) *PostUpdateOne {
+	if b != nil {
+		puo.SetScheduled(*b)
+	}
+	return puo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (puo *PostUpdateOne) SetCreatedAt(t time.Time) *PostUpdateOne {
 	puo.mutation.SetCreatedAt(t)
@@ -659,6 +1050,25 @@ func (puo *PostUpdateOne) SetNillableAuthor(s *string) *PostUpdateOne {
 	return puo
 }
 
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (puo *PostUpdateOne) SetOwnerID(id int) *PostUpdateOne {
+	puo.mutation.SetOwnerID(id)
+	return puo
+}
+
+// SetNillableOwnerID sets the "owner" edge to the User entity by ID if the given value is not nil.
+func (puo *PostUpdateOne) SetNillableOwnerID(id *int) *PostUpdateOne {
+	if id != nil {
+		puo = puo.SetOwnerID(*id)
+	}
+	return puo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (puo *PostUpdateOne) SetOwner(u *User) *PostUpdateOne {
+	return puo.SetOwnerID(u.ID)
+}
+
 // AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
 func (puo *PostUpdateOne) AddCommentIDs(ids ...int) *PostUpdateOne {
 	puo.mutation.AddCommentIDs(ids...)
@@ -689,11 +1099,47 @@ func (puo *PostUpdateOne) AddTags(t ...*Tag) *PostUpdateOne {
 	return puo.AddTagIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the PostRevision entity by IDs.
+func (puo *PostUpdateOne) AddRevisionIDs(ids ...int) *PostUpdateOne {
+	puo.mutation.AddRevisionIDs(ids...)
+	return puo
+}
+
+// AddRevisions adds the "revisions" edges to the PostRevision entity.
+func (puo *PostUpdateOne) AddRevisions(p ...*PostRevision) *PostUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddRevisionIDs(ids...)
+}
+
+// AddSlugHistoryIDs adds the "slug_history" edge to the PostSlugHistory entity by IDs.
+func (puo *PostUpdateOne) AddSlugHistoryIDs(ids ...int) *PostUpdateOne {
+	puo.mutation.AddSlugHistoryIDs(ids...)
+	return puo
+}
+
+// AddSlugHistory adds the "slug_history" edges to the PostSlugHistory entity.
+func (puo *PostUpdateOne) AddSlugHistory(p ...*PostSlugHistory) *PostUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddSlugHistoryIDs(ids...)
+}
+
 // Mutation returns the PostMutation object of the builder.
 func (puo *PostUpdateOne) Mutation() *PostMutation {
 	return puo.mutation
 }
 
+// ClearOwner clears the "owner" edge to the User entity.
+func (puo *PostUpdateOne) ClearOwner() *PostUpdateOne {
+	puo.mutation.ClearOwner()
+	return puo
+}
+
 // ClearComments clears all "comments" edges to the Comment entity.
 func (puo *PostUpdateOne) ClearComments() *PostUpdateOne {
 	puo.mutation.ClearComments()
@@ -736,6 +1182,48 @@ func (puo *PostUpdateOne) RemoveTags(t ...*Tag) *PostUpdateOne {
 	return puo.RemoveTagIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the PostRevision entity.
+func (puo *PostUpdateOne) ClearRevisions() *PostUpdateOne {
+	puo.mutation.ClearRevisions()
+	return puo
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to PostRevision entities by IDs.
+func (puo *PostUpdateOne) RemoveRevisionIDs(ids ...int) *PostUpdateOne {
+	puo.mutation.RemoveRevisionIDs(ids...)
+	return puo
+}
+
+// RemoveRevisions removes "revisions" edges to PostRevision entities.
+func (puo *PostUpdateOne) RemoveRevisions(p ...*PostRevision) *PostUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveRevisionIDs(ids...)
+}
+
+// ClearSlugHistory clears all "slug_history" edges to the PostSlugHistory entity.
+func (puo *PostUpdateOne) ClearSlugHistory() *PostUpdateOne {
+	puo.mutation.ClearSlugHistory()
+	return puo
+}
+
+// RemoveSlugHistoryIDs removes the "slug_history" edge to PostSlugHistory entities by IDs.
+func (puo *PostUpdateOne) RemoveSlugHistoryIDs(ids ...int) *PostUpdateOne {
+	puo.mutation.RemoveSlugHistoryIDs(ids...)
+	return puo
+}
+
+// RemoveSlugHistory removes "slug_history" edges to PostSlugHistory entities.
+func (puo *PostUpdateOne) RemoveSlugHistory(p ...*PostSlugHistory) *PostUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveSlugHistoryIDs(ids...)
+}
+
 // Where appends a list predicates to the PostUpdate builder.
 func (puo *PostUpdateOne) Where(ps ...predicate.Post) *PostUpdateOne {
 	puo.mutation.Where(ps...)
@@ -838,9 +1326,32 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 	if value, ok := puo.mutation.Title(); ok {
 		_spec.SetField(post.FieldTitle, field.TypeString, value)
 	}
+	if value, ok := puo.mutation.Slug(); ok {
+		_spec.SetField(post.FieldSlug, field.TypeString, value)
+	}
+	if puo.mutation.SlugCleared() {
+		_spec.ClearField(post.FieldSlug, field.TypeString)
+	}
 	if value, ok := puo.mutation.Content(); ok {
 		_spec.SetField(post.FieldContent, field.TypeString, value)
 	}
+	if value, ok := puo.mutation.ContentHTML(); ok {
+		_spec.SetField(post.FieldContentHTML, field.TypeString, value)
+	}
+	if puo.mutation.ContentHTMLCleared() {
+		_spec.ClearField(post.FieldContentHTML, field.TypeString)
+	}
+	if value, ok := puo.mutation.Toc(); ok {
+		_spec.SetField(post.FieldToc, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedToc(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, post.FieldToc, value)
+		})
+	}
+	if puo.mutation.TocCleared() {
+		_spec.ClearField(post.FieldToc, field.TypeJSON)
+	}
 	if value, ok := puo.mutation.Excerpt(); ok {
 		_spec.SetField(post.FieldExcerpt, field.TypeString, value)
 	}
@@ -850,6 +1361,9 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 	if value, ok := puo.mutation.Published(); ok {
 		_spec.SetField(post.FieldPublished, field.TypeBool, value)
 	}
+	if value, ok := puo.mutation.Scheduled(); ok {
+		_spec.SetField(post.FieldScheduled, field.TypeBool, value)
+	}
 	if value, ok := puo.mutation.CreatedAt(); ok {
 		_spec.SetField(post.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -874,6 +1388,35 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 	if value, ok := puo.mutation.Author(); ok {
 		_spec.SetField(post.FieldAuthor, field.TypeString, value)
 	}
+	if puo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   post.OwnerTable,
+			Columns: []string{post.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   post.OwnerTable,
+			Columns: []string{post.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if puo.mutation.CommentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -964,6 +1507,96 @@ func (puo *PostUpdateOne) sqlSave(ctx context.Context) (_node *Post, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !puo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.RevisionsTable,
+			Columns: []string{post.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.SlugHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SlugHistoryTable,
+			Columns: []string{post.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postslughistory.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedSlugHistoryIDs(); len(nodes) > 0 && !puo.mutation.SlugHistoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SlugHistoryTable,
+			Columns: []string{post.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postslughistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.SlugHistoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   post.SlugHistoryTable,
+			Columns: []string{post.SlugHistoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(postslughistory.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Post{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/post"
+	"blog-go/ent/postrevision"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PostRevision is the model entity for the PostRevision schema.
+type PostRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Excerpt holds the value of the "excerpt" field.
+	Excerpt string `json:"excerpt,omitempty"`
+	// Editor holds the value of the "editor" field.
+	Editor string `json:"editor,omitempty"`
+	// RestoredFrom holds the value of the "restored_from" field.
+	RestoredFrom *int `json:"restored_from,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PostRevisionQuery when eager-loading is set.
+	Edges          PostRevisionEdges `json:"edges"`
+	post_revisions *int
+	selectValues   sql.SelectValues
+}
+
+// PostRevisionEdges holds the relations/edges for other nodes in the graph.
+type PostRevisionEdges struct {
+	// Post holds the value of the post edge.
+	Post *Post `json:"post,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostRevisionEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PostRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case postrevision.FieldID, postrevision.FieldVersion, postrevision.FieldRestoredFrom:
+			values[i] = new(sql.NullInt64)
+		case postrevision.FieldTitle, postrevision.FieldContent, postrevision.FieldExcerpt, postrevision.FieldEditor:
+			values[i] = new(sql.NullString)
+		case postrevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case postrevision.ForeignKeys[0]: // post_revisions
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PostRevision fields.
+func (pr *PostRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case postrevision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pr.ID = int(value.Int64)
+		case postrevision.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				pr.Version = int(value.Int64)
+			}
+		case postrevision.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				pr.Title = value.String
+			}
+		case postrevision.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				pr.Content = value.String
+			}
+		case postrevision.FieldExcerpt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field excerpt", values[i])
+			} else if value.Valid {
+				pr.Excerpt = value.String
+			}
+		case postrevision.FieldEditor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field editor", values[i])
+			} else if value.Valid {
+				pr.Editor = value.String
+			}
+		case postrevision.FieldRestoredFrom:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field restored_from", values[i])
+			} else if value.Valid {
+				pr.RestoredFrom = new(int)
+				*pr.RestoredFrom = int(value.Int64)
+			}
+		case postrevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		case postrevision.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field post_revisions", value)
+			} else if value.Valid {
+				pr.post_revisions = new(int)
+				*pr.post_revisions = int(value.Int64)
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PostRevision.
+// This includes values selected through modifiers, order, etc.
+func (pr *PostRevision) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// QueryPost queries the "post" edge of the PostRevision entity.
+func (pr *PostRevision) QueryPost() *PostQuery {
+	return NewPostRevisionClient(pr.config).QueryPost(pr)
+}
+
+// Update returns a builder for updating this PostRevision.
+// Note that you need to call PostRevision.Unwrap() before calling this method if this PostRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *PostRevision) Update() *PostRevisionUpdateOne {
+	return NewPostRevisionClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the PostRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *PostRevision) Unwrap() *PostRevision {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PostRevision is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *PostRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("PostRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Version))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(pr.Title)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(pr.Content)
+	builder.WriteString(", ")
+	builder.WriteString("excerpt=")
+	builder.WriteString(pr.Excerpt)
+	builder.WriteString(", ")
+	builder.WriteString("editor=")
+	builder.WriteString(pr.Editor)
+	builder.WriteString(", ")
+	if v := pr.RestoredFrom; v != nil {
+		builder.WriteString("restored_from=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PostRevisions is a parsable slice of PostRevision.
+type PostRevisions []*PostRevision
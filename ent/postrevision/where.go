@@ -0,0 +1,529 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postrevision
+
+import (
+	"blog-go/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldID, id))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldVersion, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldTitle, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldContent, v))
+}
+
+// Excerpt applies equality check predicate on the "excerpt" field. It's identical to ExcerptEQ.
+func Excerpt(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldExcerpt, v))
+}
+
+// Editor applies equality check predicate on the "editor" field. It's identical to EditorEQ.
+func Editor(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldEditor, v))
+}
+
+// RestoredFrom applies equality check predicate on the "restored_from" field. It's identical to RestoredFromEQ.
+func RestoredFrom(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldRestoredFrom, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldVersion, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContainsFold(FieldContent, v))
+}
+
+// ExcerptEQ applies the EQ predicate on the "excerpt" field.
+func ExcerptEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldExcerpt, v))
+}
+
+// ExcerptNEQ applies the NEQ predicate on the "excerpt" field.
+func ExcerptNEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldExcerpt, v))
+}
+
+// ExcerptIn applies the In predicate on the "excerpt" field.
+func ExcerptIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldExcerpt, vs...))
+}
+
+// ExcerptNotIn applies the NotIn predicate on the "excerpt" field.
+func ExcerptNotIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldExcerpt, vs...))
+}
+
+// ExcerptGT applies the GT predicate on the "excerpt" field.
+func ExcerptGT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldExcerpt, v))
+}
+
+// ExcerptGTE applies the GTE predicate on the "excerpt" field.
+func ExcerptGTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldExcerpt, v))
+}
+
+// ExcerptLT applies the LT predicate on the "excerpt" field.
+func ExcerptLT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldExcerpt, v))
+}
+
+// ExcerptLTE applies the LTE predicate on the "excerpt" field.
+func ExcerptLTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldExcerpt, v))
+}
+
+// ExcerptContains applies the Contains predicate on the "excerpt" field.
+func ExcerptContains(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContains(FieldExcerpt, v))
+}
+
+// ExcerptHasPrefix applies the HasPrefix predicate on the "excerpt" field.
+func ExcerptHasPrefix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasPrefix(FieldExcerpt, v))
+}
+
+// ExcerptHasSuffix applies the HasSuffix predicate on the "excerpt" field.
+func ExcerptHasSuffix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasSuffix(FieldExcerpt, v))
+}
+
+// ExcerptEqualFold applies the EqualFold predicate on the "excerpt" field.
+func ExcerptEqualFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEqualFold(FieldExcerpt, v))
+}
+
+// ExcerptContainsFold applies the ContainsFold predicate on the "excerpt" field.
+func ExcerptContainsFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContainsFold(FieldExcerpt, v))
+}
+
+// EditorEQ applies the EQ predicate on the "editor" field.
+func EditorEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldEditor, v))
+}
+
+// EditorNEQ applies the NEQ predicate on the "editor" field.
+func EditorNEQ(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldEditor, v))
+}
+
+// EditorIn applies the In predicate on the "editor" field.
+func EditorIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldEditor, vs...))
+}
+
+// EditorNotIn applies the NotIn predicate on the "editor" field.
+func EditorNotIn(vs ...string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldEditor, vs...))
+}
+
+// EditorGT applies the GT predicate on the "editor" field.
+func EditorGT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldEditor, v))
+}
+
+// EditorGTE applies the GTE predicate on the "editor" field.
+func EditorGTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldEditor, v))
+}
+
+// EditorLT applies the LT predicate on the "editor" field.
+func EditorLT(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldEditor, v))
+}
+
+// EditorLTE applies the LTE predicate on the "editor" field.
+func EditorLTE(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldEditor, v))
+}
+
+// EditorContains applies the Contains predicate on the "editor" field.
+func EditorContains(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContains(FieldEditor, v))
+}
+
+// EditorHasPrefix applies the HasPrefix predicate on the "editor" field.
+func EditorHasPrefix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasPrefix(FieldEditor, v))
+}
+
+// EditorHasSuffix applies the HasSuffix predicate on the "editor" field.
+func EditorHasSuffix(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldHasSuffix(FieldEditor, v))
+}
+
+// EditorIsNil applies the IsNil predicate on the "editor" field.
+func EditorIsNil() predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIsNull(FieldEditor))
+}
+
+// EditorNotNil applies the NotNil predicate on the "editor" field.
+func EditorNotNil() predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotNull(FieldEditor))
+}
+
+// EditorEqualFold applies the EqualFold predicate on the "editor" field.
+func EditorEqualFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEqualFold(FieldEditor, v))
+}
+
+// EditorContainsFold applies the ContainsFold predicate on the "editor" field.
+func EditorContainsFold(v string) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldContainsFold(FieldEditor, v))
+}
+
+// RestoredFromEQ applies the EQ predicate on the "restored_from" field.
+func RestoredFromEQ(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldRestoredFrom, v))
+}
+
+// RestoredFromNEQ applies the NEQ predicate on the "restored_from" field.
+func RestoredFromNEQ(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldRestoredFrom, v))
+}
+
+// RestoredFromIn applies the In predicate on the "restored_from" field.
+func RestoredFromIn(vs ...int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldRestoredFrom, vs...))
+}
+
+// RestoredFromNotIn applies the NotIn predicate on the "restored_from" field.
+func RestoredFromNotIn(vs ...int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldRestoredFrom, vs...))
+}
+
+// RestoredFromGT applies the GT predicate on the "restored_from" field.
+func RestoredFromGT(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldRestoredFrom, v))
+}
+
+// RestoredFromGTE applies the GTE predicate on the "restored_from" field.
+func RestoredFromGTE(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldRestoredFrom, v))
+}
+
+// RestoredFromLT applies the LT predicate on the "restored_from" field.
+func RestoredFromLT(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldRestoredFrom, v))
+}
+
+// RestoredFromLTE applies the LTE predicate on the "restored_from" field.
+func RestoredFromLTE(v int) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldRestoredFrom, v))
+}
+
+// RestoredFromIsNil applies the IsNil predicate on the "restored_from" field.
+func RestoredFromIsNil() predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIsNull(FieldRestoredFrom))
+}
+
+// RestoredFromNotNil applies the NotNil predicate on the "restored_from" field.
+func RestoredFromNotNil() predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotNull(FieldRestoredFrom))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PostRevision {
+	return predicate.PostRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasPost applies the HasEdge predicate on the "post" edge.
+func HasPost() predicate.PostRevision {
+	return predicate.PostRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PostTable, PostColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPostWith applies the HasEdge predicate on the "post" edge with a given conditions (other predicates).
+func HasPostWith(preds ...predicate.Post) predicate.PostRevision {
+	return predicate.PostRevision(func(s *sql.Selector) {
+		step := newPostStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PostRevision) predicate.PostRevision {
+	return predicate.PostRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PostRevision) predicate.PostRevision {
+	return predicate.PostRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PostRevision) predicate.PostRevision {
+	return predicate.PostRevision(sql.NotPredicates(p))
+}
@@ -0,0 +1,324 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/post"
+	"blog-go/ent/postrevision"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PostRevisionCreate is the builder for creating a PostRevision entity.
+type PostRevisionCreate struct {
+	config
+	mutation *PostRevisionMutation
+	hooks    []Hook
+}
+
+// SetVersion sets the "version" field.
+func (prc *PostRevisionCreate) SetVersion(i int) *PostRevisionCreate {
+	prc.mutation.SetVersion(i)
+	return prc
+}
+
+// SetTitle sets the "title" field.
+func (prc *PostRevisionCreate) SetTitle(s string) *PostRevisionCreate {
+	prc.mutation.SetTitle(s)
+	return prc
+}
+
+// SetContent sets the "content" field.
+func (prc *PostRevisionCreate) SetContent(s string) *PostRevisionCreate {
+	prc.mutation.SetContent(s)
+	return prc
+}
+
+// SetExcerpt sets the "excerpt" field.
+func (prc *PostRevisionCreate) SetExcerpt(s string) *PostRevisionCreate {
+	prc.mutation.SetExcerpt(s)
+	return prc
+}
+
+// SetEditor sets the "editor" field.
+func (prc *PostRevisionCreate) SetEditor(s string) *PostRevisionCreate {
+	prc.mutation.SetEditor(s)
+	return prc
+}
+
+// SetNillableEditor sets the "editor" field if the given value is not nil.
+func (prc *PostRevisionCreate) SetNillableEditor(s *string) *PostRevisionCreate {
+	if s != nil {
+		prc.SetEditor(*s)
+	}
+	return prc
+}
+
+// SetRestoredFrom sets the "restored_from" field.
+func (prc *PostRevisionCreate) SetRestoredFrom(i int) *PostRevisionCreate {
+	prc.mutation.SetRestoredFrom(i)
+	return prc
+}
+
+// SetNillableRestoredFrom sets the "restored_from" field if the given value is not nil.
+func (prc *PostRevisionCreate) SetNillableRestoredFrom(i *int) *PostRevisionCreate {
+	if i != nil {
+		prc.SetRestoredFrom(*i)
+	}
+	return prc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (prc *PostRevisionCreate) SetCreatedAt(t time.Time) *PostRevisionCreate {
+	prc.mutation.SetCreatedAt(t)
+	return prc
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (prc *PostRevisionCreate) SetPostID(id int) *PostRevisionCreate {
+	prc.mutation.SetPostID(id)
+	return prc
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (prc *PostRevisionCreate) SetPost(p *Post) *PostRevisionCreate {
+	return prc.SetPostID(p.ID)
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (prc *PostRevisionCreate) Mutation() *PostRevisionMutation {
+	return prc.mutation
+}
+
+// Save creates the PostRevision in the database.
+func (prc *PostRevisionCreate) Save(ctx context.Context) (*PostRevision, error) {
+	return withHooks(ctx, prc.sqlSave, prc.mutation, prc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prc *PostRevisionCreate) SaveX(ctx context.Context) *PostRevision {
+	v, err := prc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prc *PostRevisionCreate) Exec(ctx context.Context) error {
+	_, err := prc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prc *PostRevisionCreate) ExecX(ctx context.Context) {
+	if err := prc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prc *PostRevisionCreate) check() error {
+	if _, ok := prc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "PostRevision.version"`)}
+	}
+	if v, ok := prc.mutation.Version(); ok {
+		if err := postrevision.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "PostRevision.version": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "PostRevision.title"`)}
+	}
+	if v, ok := prc.mutation.Title(); ok {
+		if err := postrevision.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "PostRevision.title": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "PostRevision.content"`)}
+	}
+	if v, ok := prc.mutation.Content(); ok {
+		if err := postrevision.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "PostRevision.content": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.Excerpt(); !ok {
+		return &ValidationError{Name: "excerpt", err: errors.New(`ent: missing required field "PostRevision.excerpt"`)}
+	}
+	if v, ok := prc.mutation.Excerpt(); ok {
+		if err := postrevision.ExcerptValidator(v); err != nil {
+			return &ValidationError{Name: "excerpt", err: fmt.Errorf(`ent: validator failed for field "PostRevision.excerpt": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PostRevision.created_at"`)}
+	}
+	if _, ok := prc.mutation.PostID(); !ok {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "PostRevision.post"`)}
+	}
+	return nil
+}
+
+func (prc *PostRevisionCreate) sqlSave(ctx context.Context) (*PostRevision, error) {
+	if err := prc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := prc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	prc.mutation.id = &_node.ID
+	prc.mutation.done = true
+	return _node, nil
+}
+
+func (prc *PostRevisionCreate) createSpec() (*PostRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PostRevision{config: prc.config}
+		_spec = sqlgraph.NewCreateSpec(postrevision.Table, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt))
+	)
+	if value, ok := prc.mutation.Version(); ok {
+		_spec.SetField(postrevision.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := prc.mutation.Title(); ok {
+		_spec.SetField(postrevision.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := prc.mutation.Content(); ok {
+		_spec.SetField(postrevision.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := prc.mutation.Excerpt(); ok {
+		_spec.SetField(postrevision.FieldExcerpt, field.TypeString, value)
+		_node.Excerpt = value
+	}
+	if value, ok := prc.mutation.Editor(); ok {
+		_spec.SetField(postrevision.FieldEditor, field.TypeString, value)
+		_node.Editor = value
+	}
+	if value, ok := prc.mutation.RestoredFrom(); ok {
+		_spec.SetField(postrevision.FieldRestoredFrom, field.TypeInt, value)
+		_node.RestoredFrom = &value
+	}
+	if value, ok := prc.mutation.CreatedAt(); ok {
+		_spec.SetField(postrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := prc.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.post_revisions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PostRevisionCreateBulk is the builder for creating many PostRevision entities in bulk.
+type PostRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*PostRevisionCreate
+}
+
+// Save creates the PostRevision entities in the database.
+func (prcb *PostRevisionCreateBulk) Save(ctx context.Context) ([]*PostRevision, error) {
+	if prcb.err != nil {
+		return nil, prcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(prcb.builders))
+	nodes := make([]*PostRevision, len(prcb.builders))
+	mutators := make([]Mutator, len(prcb.builders))
+	for i := range prcb.builders {
+		func(i int, root context.Context) {
+			builder := prcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PostRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, prcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, prcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, prcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prcb *PostRevisionCreateBulk) SaveX(ctx context.Context) []*PostRevision {
+	v, err := prcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prcb *PostRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := prcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prcb *PostRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := prcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
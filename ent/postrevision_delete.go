@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/postrevision"
+	"blog-go/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PostRevisionDelete is the builder for deleting a PostRevision entity.
+type PostRevisionDelete struct {
+	config
+	hooks    []Hook
+	mutation *PostRevisionMutation
+}
+
+// Where appends a list predicates to the PostRevisionDelete builder.
+func (prd *PostRevisionDelete) Where(ps ...predicate.PostRevision) *PostRevisionDelete {
+	prd.mutation.Where(ps...)
+	return prd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (prd *PostRevisionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, prd.sqlExec, prd.mutation, prd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prd *PostRevisionDelete) ExecX(ctx context.Context) int {
+	n, err := prd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (prd *PostRevisionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(postrevision.Table, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt))
+	if ps := prd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, prd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	prd.mutation.done = true
+	return affected, err
+}
+
+// PostRevisionDeleteOne is the builder for deleting a single PostRevision entity.
+type PostRevisionDeleteOne struct {
+	prd *PostRevisionDelete
+}
+
+// Where appends a list predicates to the PostRevisionDelete builder.
+func (prdo *PostRevisionDeleteOne) Where(ps ...predicate.PostRevision) *PostRevisionDeleteOne {
+	prdo.prd.mutation.Where(ps...)
+	return prdo
+}
+
+// Exec executes the deletion query.
+func (prdo *PostRevisionDeleteOne) Exec(ctx context.Context) error {
+	n, err := prdo.prd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{postrevision.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prdo *PostRevisionDeleteOne) ExecX(ctx context.Context) {
+	if err := prdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
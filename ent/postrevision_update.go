@@ -0,0 +1,645 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/post"
+	"blog-go/ent/postrevision"
+	"blog-go/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PostRevisionUpdate is the builder for updating PostRevision entities.
+type PostRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PostRevisionMutation
+}
+
+// Where appends a list predicates to the PostRevisionUpdate builder.
+func (pru *PostRevisionUpdate) Where(ps ...predicate.PostRevision) *PostRevisionUpdate {
+	pru.mutation.Where(ps...)
+	return pru
+}
+
+// SetVersion sets the "version" field.
+func (pru *PostRevisionUpdate) SetVersion(i int) *PostRevisionUpdate {
+	pru.mutation.ResetVersion()
+	pru.mutation.SetVersion(i)
+	return pru
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pru *PostRevisionUpdate) SetNillableVersion(i *int) *PostRevisionUpdate {
+	if i != nil {
+		pru.SetVersion(*i)
+	}
+	return pru
+}
+
+// AddVersion adds i to the "version" field.
+func (pru *PostRevisionUpdate) AddVersion(i int) *PostRevisionUpdate {
+	pru.mutation.AddVersion(i)
+	return pru
+}
+
+// SetTitle sets the "title" field.
+func (pru *PostRevisionUpdate) SetTitle(s string) *PostRevisionUpdate {
+	pru.mutation.SetTitle(s)
+	return pru
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (pru *PostRevisionUpdate) SetNillableTitle(s *string) *PostRevisionUpdate {
+	if s != nil {
+		pru.SetTitle(*s)
+	}
+	return pru
+}
+
+// SetContent sets the "content" field.
+func (pru *PostRevisionUpdate) SetContent(s string) *PostRevisionUpdate {
+	pru.mutation.SetContent(s)
+	return pru
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (pru *PostRevisionUpdate) SetNillableContent(s *string) *PostRevisionUpdate {
+	if s != nil {
+		pru.SetContent(*s)
+	}
+	return pru
+}
+
+// SetExcerpt sets the "excerpt" field.
+func (pru *PostRevisionUpdate) SetExcerpt(s string) *PostRevisionUpdate {
+	pru.mutation.SetExcerpt(s)
+	return pru
+}
+
+// SetNillableExcerpt sets the "excerpt" field if the given value is not nil.
+func (pru *PostRevisionUpdate) SetNillableExcerpt(s *string) *PostRevisionUpdate {
+	if s != nil {
+		pru.SetExcerpt(*s)
+	}
+	return pru
+}
+
+// SetEditor sets the "editor" field.
+func (pru *PostRevisionUpdate) SetEditor(s string) *PostRevisionUpdate {
+	pru.mutation.SetEditor(s)
+	return pru
+}
+
+// SetNillableEditor sets the "editor" field if the given value is not nil.
+func (pru *PostRevisionUpdate) SetNillableEditor(s *string) *PostRevisionUpdate {
+	if s != nil {
+		pru.SetEditor(*s)
+	}
+	return pru
+}
+
+// ClearEditor clears the value of the "editor" field.
+func (pru *PostRevisionUpdate) ClearEditor() *PostRevisionUpdate {
+	pru.mutation.ClearEditor()
+	return pru
+}
+
+// SetRestoredFrom sets the "restored_from" field.
+func (pru *PostRevisionUpdate) SetRestoredFrom(i int) *PostRevisionUpdate {
+	pru.mutation.ResetRestoredFrom()
+	pru.mutation.SetRestoredFrom(i)
+	return pru
+}
+
+// SetNillableRestoredFrom sets the "restored_from" field if the given value is not nil.
+func (pru *PostRevisionUpdate) SetNillableRestoredFrom(i *int) *PostRevisionUpdate {
+	if i != nil {
+		pru.SetRestoredFrom(*i)
+	}
+	return pru
+}
+
+// AddRestoredFrom adds i to the "restored_from" field.
+func (pru *PostRevisionUpdate) AddRestoredFrom(i int) *PostRevisionUpdate {
+	pru.mutation.AddRestoredFrom(i)
+	return pru
+}
+
+// ClearRestoredFrom clears the value of the "restored_from" field.
+func (pru *PostRevisionUpdate) ClearRestoredFrom() *PostRevisionUpdate {
+	pru.mutation.ClearRestoredFrom()
+	return pru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pru *PostRevisionUpdate) SetCreatedAt(t time.Time) *PostRevisionUpdate {
+	pru.mutation.SetCreatedAt(t)
+	return pru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pru *PostRevisionUpdate) SetNillableCreatedAt(t *time.Time) *PostRevisionUpdate {
+	if t != nil {
+		pru.SetCreatedAt(*t)
+	}
+	return pru
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (pru *PostRevisionUpdate) SetPostID(id int) *PostRevisionUpdate {
+	pru.mutation.SetPostID(id)
+	return pru
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (pru *PostRevisionUpdate) SetPost(p *Post) *PostRevisionUpdate {
+	return pru.SetPostID(p.ID)
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (pru *PostRevisionUpdate) Mutation() *PostRevisionMutation {
+	return pru.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (pru *PostRevisionUpdate) ClearPost() *PostRevisionUpdate {
+	pru.mutation.ClearPost()
+	return pru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *PostRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pru.sqlSave, pru.mutation, pru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *PostRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *PostRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *PostRevisionUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pru *PostRevisionUpdate) check() error {
+	if v, ok := pru.mutation.Version(); ok {
+		if err := postrevision.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "PostRevision.version": %w`, err)}
+		}
+	}
+	if v, ok := pru.mutation.Title(); ok {
+		if err := postrevision.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "PostRevision.title": %w`, err)}
+		}
+	}
+	if v, ok := pru.mutation.Content(); ok {
+		if err := postrevision.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "PostRevision.content": %w`, err)}
+		}
+	}
+	if v, ok := pru.mutation.Excerpt(); ok {
+		if err := postrevision.ExcerptValidator(v); err != nil {
+			return &ValidationError{Name: "excerpt", err: fmt.Errorf(`ent: validator failed for field "PostRevision.excerpt": %w`, err)}
+		}
+	}
+	if _, ok := pru.mutation.PostID(); pru.mutation.PostCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PostRevision.post"`)
+	}
+	return nil
+}
+
+func (pru *PostRevisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postrevision.Table, postrevision.Columns, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt))
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pru.mutation.Version(); ok {
+		_spec.SetField(postrevision.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.AddedVersion(); ok {
+		_spec.AddField(postrevision.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.Title(); ok {
+		_spec.SetField(postrevision.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := pru.mutation.Content(); ok {
+		_spec.SetField(postrevision.FieldContent, field.TypeString, value)
+	}
+	if value, ok := pru.mutation.Excerpt(); ok {
+		_spec.SetField(postrevision.FieldExcerpt, field.TypeString, value)
+	}
+	if value, ok := pru.mutation.Editor(); ok {
+		_spec.SetField(postrevision.FieldEditor, field.TypeString, value)
+	}
+	if pru.mutation.EditorCleared() {
+		_spec.ClearField(postrevision.FieldEditor, field.TypeString)
+	}
+	if value, ok := pru.mutation.RestoredFrom(); ok {
+		_spec.SetField(postrevision.FieldRestoredFrom, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.AddedRestoredFrom(); ok {
+		_spec.AddField(postrevision.FieldRestoredFrom, field.TypeInt, value)
+	}
+	if pru.mutation.RestoredFromCleared() {
+		_spec.ClearField(postrevision.FieldRestoredFrom, field.TypeInt)
+	}
+	if value, ok := pru.mutation.CreatedAt(); ok {
+		_spec.SetField(postrevision.FieldCreatedAt, field.TypeTime, value)
+	}
+	if pru.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pru.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pru.mutation.done = true
+	return n, nil
+}
+
+// PostRevisionUpdateOne is the builder for updating a single PostRevision entity.
+type PostRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PostRevisionMutation
+}
+
+// SetVersion sets the "version" field.
+func (pruo *PostRevisionUpdateOne) SetVersion(i int) *PostRevisionUpdateOne {
+	pruo.mutation.ResetVersion()
+	pruo.mutation.SetVersion(i)
+	return pruo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (pruo *PostRevisionUpdateOne) SetNillableVersion(i *int) *PostRevisionUpdateOne {
+	if i != nil {
+		pruo.SetVersion(*i)
+	}
+	return pruo
+}
+
+// AddVersion adds i to the "version" field.
+func (pruo *PostRevisionUpdateOne) AddVersion(i int) *PostRevisionUpdateOne {
+	pruo.mutation.AddVersion(i)
+	return pruo
+}
+
+// SetTitle sets the "title" field.
+func (pruo *PostRevisionUpdateOne) SetTitle(s string) *PostRevisionUpdateOne {
+	pruo.mutation.SetTitle(s)
+	return pruo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (pruo *PostRevisionUpdateOne) SetNillableTitle(s *string) *PostRevisionUpdateOne {
+	if s != nil {
+		pruo.SetTitle(*s)
+	}
+	return pruo
+}
+
+// SetContent sets the "content" field.
+func (pruo *PostRevisionUpdateOne) SetContent(s string) *PostRevisionUpdateOne {
+	pruo.mutation.SetContent(s)
+	return pruo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (pruo *PostRevisionUpdateOne) SetNillableContent(s *string) *PostRevisionUpdateOne {
+	if s != nil {
+		pruo.SetContent(*s)
+	}
+	return pruo
+}
+
+// SetExcerpt sets the "excerpt" field.
+func (pruo *PostRevisionUpdateOne) SetExcerpt(s string) *PostRevisionUpdateOne {
+	pruo.mutation.SetExcerpt(s)
+	return pruo
+}
+
+// SetNillableExcerpt sets the "excerpt" field if the given value is not nil.
+func (pruo *PostRevisionUpdateOne) SetNillableExcerpt(s *string) *PostRevisionUpdateOne {
+	if s != nil {
+		pruo.SetExcerpt(*s)
+	}
+	return pruo
+}
+
+// SetEditor sets the "editor" field.
+func (pruo *PostRevisionUpdateOne) SetEditor(s string) *PostRevisionUpdateOne {
+	pruo.mutation.SetEditor(s)
+	return pruo
+}
+
+// SetNillableEditor sets the "editor" field if the given value is not nil.
+func (pruo *PostRevisionUpdateOne) SetNillableEditor(s *string) *PostRevisionUpdateOne {
+	if s != nil {
+		pruo.SetEditor(*s)
+	}
+	return pruo
+}
+
+// ClearEditor clears the value of the "editor" field.
+func (pruo *PostRevisionUpdateOne) ClearEditor() *PostRevisionUpdateOne {
+	pruo.mutation.ClearEditor()
+	return pruo
+}
+
+// SetRestoredFrom sets the "restored_from" field.
+func (pruo *PostRevisionUpdateOne) SetRestoredFrom(i int) *PostRevisionUpdateOne {
+	pruo.mutation.ResetRestoredFrom()
+	pruo.mutation.SetRestoredFrom(i)
+	return pruo
+}
+
+// SetNillableRestoredFrom sets the "restored_from" field if the given value is not nil.
+func (pruo *PostRevisionUpdateOne) SetNillableRestoredFrom(i *int) *PostRevisionUpdateOne {
+	if i != nil {
+		pruo.SetRestoredFrom(*i)
+	}
+	return pruo
+}
+
+// AddRestoredFrom adds i to the "restored_from" field.
+func (pruo *PostRevisionUpdateOne) AddRestoredFrom(i int) *PostRevisionUpdateOne {
+	pruo.mutation.AddRestoredFrom(i)
+	return pruo
+}
+
+// ClearRestoredFrom clears the value of the "restored_from" field.
+func (pruo *PostRevisionUpdateOne) ClearRestoredFrom() *PostRevisionUpdateOne {
+	pruo.mutation.ClearRestoredFrom()
+	return pruo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pruo *PostRevisionUpdateOne) SetCreatedAt(t time.Time) *PostRevisionUpdateOne {
+	pruo.mutation.SetCreatedAt(t)
+	return pruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pruo *PostRevisionUpdateOne) SetNillableCreatedAt(t *time.Time) *PostRevisionUpdateOne {
+	if t != nil {
+		pruo.SetCreatedAt(*t)
+	}
+	return pruo
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (pruo *PostRevisionUpdateOne) SetPostID(id int) *PostRevisionUpdateOne {
+	pruo.mutation.SetPostID(id)
+	return pruo
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (pruo *PostRevisionUpdateOne) SetPost(p *Post) *PostRevisionUpdateOne {
+	return pruo.SetPostID(p.ID)
+}
+
+// Mutation returns the PostRevisionMutation object of the builder.
+func (pruo *PostRevisionUpdateOne) Mutation() *PostRevisionMutation {
+	return pruo.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (pruo *PostRevisionUpdateOne) ClearPost() *PostRevisionUpdateOne {
+	pruo.mutation.ClearPost()
+	return pruo
+}
+
+// Where appends a list predicates to the PostRevisionUpdate builder.
+func (pruo *PostRevisionUpdateOne) Where(ps ...predicate.PostRevision) *PostRevisionUpdateOne {
+	pruo.mutation.Where(ps...)
+	return pruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pruo *PostRevisionUpdateOne) Select(field string, fields ...string) *PostRevisionUpdateOne {
+	pruo.fields = append([]string{field}, fields...)
+	return pruo
+}
+
+// Save executes the query and returns the updated PostRevision entity.
+func (pruo *PostRevisionUpdateOne) Save(ctx context.Context) (*PostRevision, error) {
+	return withHooks(ctx, pruo.sqlSave, pruo.mutation, pruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pruo *PostRevisionUpdateOne) SaveX(ctx context.Context) *PostRevision {
+	node, err := pruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pruo *PostRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := pruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pruo *PostRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := pruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pruo *PostRevisionUpdateOne) check() error {
+	if v, ok := pruo.mutation.Version(); ok {
+		if err := postrevision.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "PostRevision.version": %w`, err)}
+		}
+	}
+	if v, ok := pruo.mutation.Title(); ok {
+		if err := postrevision.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "PostRevision.title": %w`, err)}
+		}
+	}
+	if v, ok := pruo.mutation.Content(); ok {
+		if err := postrevision.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "PostRevision.content": %w`, err)}
+		}
+	}
+	if v, ok := pruo.mutation.Excerpt(); ok {
+		if err := postrevision.ExcerptValidator(v); err != nil {
+			return &ValidationError{Name: "excerpt", err: fmt.Errorf(`ent: validator failed for field "PostRevision.excerpt": %w`, err)}
+		}
+	}
+	if _, ok := pruo.mutation.PostID(); pruo.mutation.PostCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PostRevision.post"`)
+	}
+	return nil
+}
+
+func (pruo *PostRevisionUpdateOne) sqlSave(ctx context.Context) (_node *PostRevision, err error) {
+	if err := pruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postrevision.Table, postrevision.Columns, sqlgraph.NewFieldSpec(postrevision.FieldID, field.TypeInt))
+	id, ok := pruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PostRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, postrevision.FieldID)
+		for _, f := range fields {
+			if !postrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != postrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pruo.mutation.Version(); ok {
+		_spec.SetField(postrevision.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.AddedVersion(); ok {
+		_spec.AddField(postrevision.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.Title(); ok {
+		_spec.SetField(postrevision.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := pruo.mutation.Content(); ok {
+		_spec.SetField(postrevision.FieldContent, field.TypeString, value)
+	}
+	if value, ok := pruo.mutation.Excerpt(); ok {
+		_spec.SetField(postrevision.FieldExcerpt, field.TypeString, value)
+	}
+	if value, ok := pruo.mutation.Editor(); ok {
+		_spec.SetField(postrevision.FieldEditor, field.TypeString, value)
+	}
+	if pruo.mutation.EditorCleared() {
+		_spec.ClearField(postrevision.FieldEditor, field.TypeString)
+	}
+	if value, ok := pruo.mutation.RestoredFrom(); ok {
+		_spec.SetField(postrevision.FieldRestoredFrom, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.AddedRestoredFrom(); ok {
+		_spec.AddField(postrevision.FieldRestoredFrom, field.TypeInt, value)
+	}
+	if pruo.mutation.RestoredFromCleared() {
+		_spec.ClearField(postrevision.FieldRestoredFrom, field.TypeInt)
+	}
+	if value, ok := pruo.mutation.CreatedAt(); ok {
+		_spec.SetField(postrevision.FieldCreatedAt, field.TypeTime, value)
+	}
+	if pruo.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pruo.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postrevision.PostTable,
+			Columns: []string{postrevision.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PostRevision{config: pruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pruo.mutation.done = true
+	return _node, nil
+}
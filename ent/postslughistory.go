@@ -0,0 +1,156 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/post"
+	"blog-go/ent/postslughistory"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PostSlugHistory is the model entity for the PostSlugHistory schema.
+type PostSlugHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PostSlugHistoryQuery when eager-loading is set.
+	Edges             PostSlugHistoryEdges `json:"edges"`
+	post_slug_history *int
+	selectValues      sql.SelectValues
+}
+
+// PostSlugHistoryEdges holds the relations/edges for other nodes in the graph.
+type PostSlugHistoryEdges struct {
+	// Post holds the value of the post edge.
+	Post *Post `json:"post,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PostOrErr returns the Post value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PostSlugHistoryEdges) PostOrErr() (*Post, error) {
+	if e.Post != nil {
+		return e.Post, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: post.Label}
+	}
+	return nil, &NotLoadedError{edge: "post"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PostSlugHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case postslughistory.FieldID:
+			values[i] = new(sql.NullInt64)
+		case postslughistory.FieldSlug:
+			values[i] = new(sql.NullString)
+		case postslughistory.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case postslughistory.ForeignKeys[0]: // post_slug_history
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PostSlugHistory fields.
+func (psh *PostSlugHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case postslughistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			psh.ID = int(value.Int64)
+		case postslughistory.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				psh.Slug = value.String
+			}
+		case postslughistory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				psh.CreatedAt = value.Time
+			}
+		case postslughistory.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field post_slug_history", value)
+			} else if value.Valid {
+				psh.post_slug_history = new(int)
+				*psh.post_slug_history = int(value.Int64)
+			}
+		default:
+			psh.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PostSlugHistory.
+// This includes values selected through modifiers, order, etc.
+func (psh *PostSlugHistory) Value(name string) (ent.Value, error) {
+	return psh.selectValues.Get(name)
+}
+
+// QueryPost queries the "post" edge of the PostSlugHistory entity.
+func (psh *PostSlugHistory) QueryPost() *PostQuery {
+	return NewPostSlugHistoryClient(psh.config).QueryPost(psh)
+}
+
+// Update returns a builder for updating this PostSlugHistory.
+// Note that you need to call PostSlugHistory.Unwrap() before calling this method if this PostSlugHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (psh *PostSlugHistory) Update() *PostSlugHistoryUpdateOne {
+	return NewPostSlugHistoryClient(psh.config).UpdateOne(psh)
+}
+
+// Unwrap unwraps the PostSlugHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (psh *PostSlugHistory) Unwrap() *PostSlugHistory {
+	_tx, ok := psh.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PostSlugHistory is not a transactional entity")
+	}
+	psh.config.driver = _tx.drv
+	return psh
+}
+
+// String implements the fmt.Stringer.
+func (psh *PostSlugHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("PostSlugHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", psh.ID))
+	builder.WriteString("slug=")
+	builder.WriteString(psh.Slug)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(psh.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PostSlugHistories is a parsable slice of PostSlugHistory.
+type PostSlugHistories []*PostSlugHistory
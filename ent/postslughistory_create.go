@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/post"
+	"blog-go/ent/postslughistory"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PostSlugHistoryCreate is the builder for creating a PostSlugHistory entity.
+type PostSlugHistoryCreate struct {
+	config
+	mutation *PostSlugHistoryMutation
+	hooks    []Hook
+}
+
+// SetSlug sets the "slug" field.
+func (pshc *PostSlugHistoryCreate) SetSlug(s string) *PostSlugHistoryCreate {
+	pshc.mutation.SetSlug(s)
+	return pshc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pshc *PostSlugHistoryCreate) SetCreatedAt(t time.Time) *PostSlugHistoryCreate {
+	pshc.mutation.SetCreatedAt(t)
+	return pshc
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (pshc *PostSlugHistoryCreate) SetPostID(id int) *PostSlugHistoryCreate {
+	pshc.mutation.SetPostID(id)
+	return pshc
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (pshc *PostSlugHistoryCreate) SetPost(p *Post) *PostSlugHistoryCreate {
+	return pshc.SetPostID(p.ID)
+}
+
+// Mutation returns the PostSlugHistoryMutation object of the builder.
+func (pshc *PostSlugHistoryCreate) Mutation() *PostSlugHistoryMutation {
+	return pshc.mutation
+}
+
+// Save creates the PostSlugHistory in the database.
+func (pshc *PostSlugHistoryCreate) Save(ctx context.Context) (*PostSlugHistory, error) {
+	return withHooks(ctx, pshc.sqlSave, pshc.mutation, pshc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pshc *PostSlugHistoryCreate) SaveX(ctx context.Context) *PostSlugHistory {
+	v, err := pshc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pshc *PostSlugHistoryCreate) Exec(ctx context.Context) error {
+	_, err := pshc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pshc *PostSlugHistoryCreate) ExecX(ctx context.Context) {
+	if err := pshc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pshc *PostSlugHistoryCreate) check() error {
+	if _, ok := pshc.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "PostSlugHistory.slug"`)}
+	}
+	if v, ok := pshc.mutation.Slug(); ok {
+		if err := postslughistory.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "PostSlugHistory.slug": %w`, err)}
+		}
+	}
+	if _, ok := pshc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PostSlugHistory.created_at"`)}
+	}
+	if _, ok := pshc.mutation.PostID(); !ok {
+		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "PostSlugHistory.post"`)}
+	}
+	return nil
+}
+
+func (pshc *PostSlugHistoryCreate) sqlSave(ctx context.Context) (*PostSlugHistory, error) {
+	if err := pshc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pshc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pshc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pshc.mutation.id = &_node.ID
+	pshc.mutation.done = true
+	return _node, nil
+}
+
+func (pshc *PostSlugHistoryCreate) createSpec() (*PostSlugHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PostSlugHistory{config: pshc.config}
+		_spec = sqlgraph.NewCreateSpec(postslughistory.Table, sqlgraph.NewFieldSpec(postslughistory.FieldID, field.TypeInt))
+	)
+	if value, ok := pshc.mutation.Slug(); ok {
+		_spec.SetField(postslughistory.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if value, ok := pshc.mutation.CreatedAt(); ok {
+		_spec.SetField(postslughistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := pshc.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postslughistory.PostTable,
+			Columns: []string{postslughistory.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.post_slug_history = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PostSlugHistoryCreateBulk is the builder for creating many PostSlugHistory entities in bulk.
+type PostSlugHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*PostSlugHistoryCreate
+}
+
+// Save creates the PostSlugHistory entities in the database.
+func (pshcb *PostSlugHistoryCreateBulk) Save(ctx context.Context) ([]*PostSlugHistory, error) {
+	if pshcb.err != nil {
+		return nil, pshcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pshcb.builders))
+	nodes := make([]*PostSlugHistory, len(pshcb.builders))
+	mutators := make([]Mutator, len(pshcb.builders))
+	for i := range pshcb.builders {
+		func(i int, root context.Context) {
+			builder := pshcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PostSlugHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pshcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pshcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pshcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pshcb *PostSlugHistoryCreateBulk) SaveX(ctx context.Context) []*PostSlugHistory {
+	v, err := pshcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pshcb *PostSlugHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := pshcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pshcb *PostSlugHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := pshcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
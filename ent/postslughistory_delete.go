@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/postslughistory"
+	"blog-go/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PostSlugHistoryDelete is the builder for deleting a PostSlugHistory entity.
+type PostSlugHistoryDelete struct {
+	config
+	hooks    []Hook
+	mutation *PostSlugHistoryMutation
+}
+
+// Where appends a list predicates to the PostSlugHistoryDelete builder.
+func (pshd *PostSlugHistoryDelete) Where(ps ...predicate.PostSlugHistory) *PostSlugHistoryDelete {
+	pshd.mutation.Where(ps...)
+	return pshd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pshd *PostSlugHistoryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pshd.sqlExec, pshd.mutation, pshd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pshd *PostSlugHistoryDelete) ExecX(ctx context.Context) int {
+	n, err := pshd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pshd *PostSlugHistoryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(postslughistory.Table, sqlgraph.NewFieldSpec(postslughistory.FieldID, field.TypeInt))
+	if ps := pshd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pshd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pshd.mutation.done = true
+	return affected, err
+}
+
+// PostSlugHistoryDeleteOne is the builder for deleting a single PostSlugHistory entity.
+type PostSlugHistoryDeleteOne struct {
+	pshd *PostSlugHistoryDelete
+}
+
+// Where appends a list predicates to the PostSlugHistoryDelete builder.
+func (pshdo *PostSlugHistoryDeleteOne) Where(ps ...predicate.PostSlugHistory) *PostSlugHistoryDeleteOne {
+	pshdo.pshd.mutation.Where(ps...)
+	return pshdo
+}
+
+// Exec executes the deletion query.
+func (pshdo *PostSlugHistoryDeleteOne) Exec(ctx context.Context) error {
+	n, err := pshdo.pshd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{postslughistory.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pshdo *PostSlugHistoryDeleteOne) ExecX(ctx context.Context) {
+	if err := pshdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
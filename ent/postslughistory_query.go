@@ -0,0 +1,613 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/post"
+	"blog-go/ent/postslughistory"
+	"blog-go/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PostSlugHistoryQuery is the builder for querying PostSlugHistory entities.
+type PostSlugHistoryQuery struct {
+	config
+	ctx        *QueryContext
+	order      []postslughistory.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PostSlugHistory
+	withPost   *PostQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PostSlugHistoryQuery builder.
+func (pshq *PostSlugHistoryQuery) Where(ps ...predicate.PostSlugHistory) *PostSlugHistoryQuery {
+	pshq.predicates = append(pshq.predicates, ps...)
+	return pshq
+}
+
+// Limit the number of records to be returned by this query.
+func (pshq *PostSlugHistoryQuery) Limit(limit int) *PostSlugHistoryQuery {
+	pshq.ctx.Limit = &limit
+	return pshq
+}
+
+// Offset to start from.
+func (pshq *PostSlugHistoryQuery) Offset(offset int) *PostSlugHistoryQuery {
+	pshq.ctx.Offset = &offset
+	return pshq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pshq *PostSlugHistoryQuery) Unique(unique bool) *PostSlugHistoryQuery {
+	pshq.ctx.Unique = &unique
+	return pshq
+}
+
+// Order specifies how the records should be ordered.
+func (pshq *PostSlugHistoryQuery) Order(o ...postslughistory.OrderOption) *PostSlugHistoryQuery {
+	pshq.order = append(pshq.order, o...)
+	return pshq
+}
+
+// QueryPost chains the current query on the "post" edge.
+func (pshq *PostSlugHistoryQuery) QueryPost() *PostQuery {
+	query := (&PostClient{config: pshq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pshq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pshq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(postslughistory.Table, postslughistory.FieldID, selector),
+			sqlgraph.To(post.Table, post.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, postslughistory.PostTable, postslughistory.PostColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pshq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PostSlugHistory entity from the query.
+// Returns a *NotFoundError when no PostSlugHistory was found.
+func (pshq *PostSlugHistoryQuery) First(ctx context.Context) (*PostSlugHistory, error) {
+	nodes, err := pshq.Limit(1).All(setContextOp(ctx, pshq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{postslughistory.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pshq *PostSlugHistoryQuery) FirstX(ctx context.Context) *PostSlugHistory {
+	node, err := pshq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PostSlugHistory ID from the query.
+// Returns a *NotFoundError when no PostSlugHistory ID was found.
+func (pshq *PostSlugHistoryQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pshq.Limit(1).IDs(setContextOp(ctx, pshq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{postslughistory.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pshq *PostSlugHistoryQuery) FirstIDX(ctx context.Context) int {
+	id, err := pshq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PostSlugHistory entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PostSlugHistory entity is found.
+// Returns a *NotFoundError when no PostSlugHistory entities are found.
+func (pshq *PostSlugHistoryQuery) Only(ctx context.Context) (*PostSlugHistory, error) {
+	nodes, err := pshq.Limit(2).All(setContextOp(ctx, pshq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{postslughistory.Label}
+	default:
+		return nil, &NotSingularError{postslughistory.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pshq *PostSlugHistoryQuery) OnlyX(ctx context.Context) *PostSlugHistory {
+	node, err := pshq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PostSlugHistory ID in the query.
+// Returns a *NotSingularError when more than one PostSlugHistory ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pshq *PostSlugHistoryQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pshq.Limit(2).IDs(setContextOp(ctx, pshq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{postslughistory.Label}
+	default:
+		err = &NotSingularError{postslughistory.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pshq *PostSlugHistoryQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pshq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PostSlugHistories.
+func (pshq *PostSlugHistoryQuery) All(ctx context.Context) ([]*PostSlugHistory, error) {
+	ctx = setContextOp(ctx, pshq.ctx, "All")
+	if err := pshq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PostSlugHistory, *PostSlugHistoryQuery]()
+	return withInterceptors[[]*PostSlugHistory](ctx, pshq, qr, pshq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pshq *PostSlugHistoryQuery) AllX(ctx context.Context) []*PostSlugHistory {
+	nodes, err := pshq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PostSlugHistory IDs.
+func (pshq *PostSlugHistoryQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if pshq.ctx.Unique == nil && pshq.path != nil {
+		pshq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pshq.ctx, "IDs")
+	if err = pshq.Select(postslughistory.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pshq *PostSlugHistoryQuery) IDsX(ctx context.Context) []int {
+	ids, err := pshq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pshq *PostSlugHistoryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pshq.ctx, "Count")
+	if err := pshq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pshq, querierCount[*PostSlugHistoryQuery](), pshq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pshq *PostSlugHistoryQuery) CountX(ctx context.Context) int {
+	count, err := pshq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pshq *PostSlugHistoryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pshq.ctx, "Exist")
+	switch _, err := pshq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pshq *PostSlugHistoryQuery) ExistX(ctx context.Context) bool {
+	exist, err := pshq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PostSlugHistoryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pshq *PostSlugHistoryQuery) Clone() *PostSlugHistoryQuery {
+	if pshq == nil {
+		return nil
+	}
+	return &PostSlugHistoryQuery{
+		config:     pshq.config,
+		ctx:        pshq.ctx.Clone(),
+		order:      append([]postslughistory.OrderOption{}, pshq.order...),
+		inters:     append([]Interceptor{}, pshq.inters...),
+		predicates: append([]predicate.PostSlugHistory{}, pshq.predicates...),
+		withPost:   pshq.withPost.Clone(),
+		// clone intermediate query.
+		sql:  pshq.sql.Clone(),
+		path: pshq.path,
+	}
+}
+
+// WithPost tells the query-builder to eager-load the nodes that are connected to
+// the "post" edge. The optional arguments are used to configure the query builder of the edge.
+func (pshq *PostSlugHistoryQuery) WithPost(opts ...func(*PostQuery)) *PostSlugHistoryQuery {
+	query := (&PostClient{config: pshq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pshq.withPost = query
+	return pshq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Slug string `json:"slug,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PostSlugHistory.Query().
+//		GroupBy(postslughistory.FieldSlug).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pshq *PostSlugHistoryQuery) GroupBy(field string, fields ...string) *PostSlugHistoryGroupBy {
+	pshq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PostSlugHistoryGroupBy{build: pshq}
+	grbuild.flds = &pshq.ctx.Fields
+	grbuild.label = postslughistory.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Slug string `json:"slug,omitempty"`
+//	}
+//
+//	client.PostSlugHistory.Query().
+//		Select(postslughistory.FieldSlug).
+//		Scan(ctx, &v)
+func (pshq *PostSlugHistoryQuery) Select(fields ...string) *PostSlugHistorySelect {
+	pshq.ctx.Fields = append(pshq.ctx.Fields, fields...)
+	sbuild := &PostSlugHistorySelect{PostSlugHistoryQuery: pshq}
+	sbuild.label = postslughistory.Label
+	sbuild.flds, sbuild.scan = &pshq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PostSlugHistorySelect configured with the given aggregations.
+func (pshq *PostSlugHistoryQuery) Aggregate(fns ...AggregateFunc) *PostSlugHistorySelect {
+	return pshq.Select().Aggregate(fns...)
+}
+
+func (pshq *PostSlugHistoryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pshq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pshq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pshq.ctx.Fields {
+		if !postslughistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pshq.path != nil {
+		prev, err := pshq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pshq.sql = prev
+	}
+	return nil
+}
+
+func (pshq *PostSlugHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PostSlugHistory, error) {
+	var (
+		nodes       = []*PostSlugHistory{}
+		withFKs     = pshq.withFKs
+		_spec       = pshq.querySpec()
+		loadedTypes = [1]bool{
+			pshq.withPost != nil,
+		}
+	)
+	if pshq.withPost != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, postslughistory.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PostSlugHistory).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PostSlugHistory{config: pshq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pshq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pshq.withPost; query != nil {
+		if err := pshq.loadPost(ctx, query, nodes, nil,
+			func(n *PostSlugHistory, e *Post) { n.Edges.Post = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pshq *PostSlugHistoryQuery) loadPost(ctx context.Context, query *PostQuery, nodes []*PostSlugHistory, init func(*PostSlugHistory), assign func(*PostSlugHistory, *Post)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PostSlugHistory)
+	for i := range nodes {
+		if nodes[i].post_slug_history == nil {
+			continue
+		}
+		fk := *nodes[i].post_slug_history
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(post.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "post_slug_history" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (pshq *PostSlugHistoryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pshq.querySpec()
+	_spec.Node.Columns = pshq.ctx.Fields
+	if len(pshq.ctx.Fields) > 0 {
+		_spec.Unique = pshq.ctx.Unique != nil && *pshq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pshq.driver, _spec)
+}
+
+func (pshq *PostSlugHistoryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(postslughistory.Table, postslughistory.Columns, sqlgraph.NewFieldSpec(postslughistory.FieldID, field.TypeInt))
+	_spec.From = pshq.sql
+	if unique := pshq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pshq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pshq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, postslughistory.FieldID)
+		for i := range fields {
+			if fields[i] != postslughistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pshq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pshq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pshq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pshq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pshq *PostSlugHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pshq.driver.Dialect())
+	t1 := builder.Table(postslughistory.Table)
+	columns := pshq.ctx.Fields
+	if len(columns) == 0 {
+		columns = postslughistory.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pshq.sql != nil {
+		selector = pshq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pshq.ctx.Unique != nil && *pshq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range pshq.predicates {
+		p(selector)
+	}
+	for _, p := range pshq.order {
+		p(selector)
+	}
+	if offset := pshq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pshq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PostSlugHistoryGroupBy is the group-by builder for PostSlugHistory entities.
+type PostSlugHistoryGroupBy struct {
+	selector
+	build *PostSlugHistoryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pshgb *PostSlugHistoryGroupBy) Aggregate(fns ...AggregateFunc) *PostSlugHistoryGroupBy {
+	pshgb.fns = append(pshgb.fns, fns...)
+	return pshgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pshgb *PostSlugHistoryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pshgb.build.ctx, "GroupBy")
+	if err := pshgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PostSlugHistoryQuery, *PostSlugHistoryGroupBy](ctx, pshgb.build, pshgb, pshgb.build.inters, v)
+}
+
+func (pshgb *PostSlugHistoryGroupBy) sqlScan(ctx context.Context, root *PostSlugHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pshgb.fns))
+	for _, fn := range pshgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pshgb.flds)+len(pshgb.fns))
+		for _, f := range *pshgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pshgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pshgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PostSlugHistorySelect is the builder for selecting fields of PostSlugHistory entities.
+type PostSlugHistorySelect struct {
+	*PostSlugHistoryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pshs *PostSlugHistorySelect) Aggregate(fns ...AggregateFunc) *PostSlugHistorySelect {
+	pshs.fns = append(pshs.fns, fns...)
+	return pshs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pshs *PostSlugHistorySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pshs.ctx, "Select")
+	if err := pshs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PostSlugHistoryQuery, *PostSlugHistorySelect](ctx, pshs.PostSlugHistoryQuery, pshs, pshs.inters, v)
+}
+
+func (pshs *PostSlugHistorySelect) sqlScan(ctx context.Context, root *PostSlugHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pshs.fns))
+	for _, fn := range pshs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pshs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pshs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
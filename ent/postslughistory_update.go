@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/post"
+	"blog-go/ent/postslughistory"
+	"blog-go/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PostSlugHistoryUpdate is the builder for updating PostSlugHistory entities.
+type PostSlugHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PostSlugHistoryMutation
+}
+
+// Where appends a list predicates to the PostSlugHistoryUpdate builder.
+func (pshu *PostSlugHistoryUpdate) Where(ps ...predicate.PostSlugHistory) *PostSlugHistoryUpdate {
+	pshu.mutation.Where(ps...)
+	return pshu
+}
+
+// SetSlug sets the "slug" field.
+func (pshu *PostSlugHistoryUpdate) SetSlug(s string) *PostSlugHistoryUpdate {
+	pshu.mutation.SetSlug(s)
+	return pshu
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (pshu *PostSlugHistoryUpdate) SetNillableSlug(s *string) *PostSlugHistoryUpdate {
+	if s != nil {
+		pshu.SetSlug(*s)
+	}
+	return pshu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pshu *PostSlugHistoryUpdate) SetCreatedAt(t time.Time) *PostSlugHistoryUpdate {
+	pshu.mutation.SetCreatedAt(t)
+	return pshu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pshu *PostSlugHistoryUpdate) SetNillableCreatedAt(t *time.Time) *PostSlugHistoryUpdate {
+	if t != nil {
+		pshu.SetCreatedAt(*t)
+	}
+	return pshu
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (pshu *PostSlugHistoryUpdate) SetPostID(id int) *PostSlugHistoryUpdate {
+	pshu.mutation.SetPostID(id)
+	return pshu
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (pshu *PostSlugHistoryUpdate) SetPost(p *Post) *PostSlugHistoryUpdate {
+	return pshu.SetPostID(p.ID)
+}
+
+// Mutation returns the PostSlugHistoryMutation object of the builder.
+func (pshu *PostSlugHistoryUpdate) Mutation() *PostSlugHistoryMutation {
+	return pshu.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (pshu *PostSlugHistoryUpdate) ClearPost() *PostSlugHistoryUpdate {
+	pshu.mutation.ClearPost()
+	return pshu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pshu *PostSlugHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pshu.sqlSave, pshu.mutation, pshu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pshu *PostSlugHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := pshu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pshu *PostSlugHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := pshu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pshu *PostSlugHistoryUpdate) ExecX(ctx context.Context) {
+	if err := pshu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pshu *PostSlugHistoryUpdate) check() error {
+	if v, ok := pshu.mutation.Slug(); ok {
+		if err := postslughistory.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "PostSlugHistory.slug": %w`, err)}
+		}
+	}
+	if _, ok := pshu.mutation.PostID(); pshu.mutation.PostCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PostSlugHistory.post"`)
+	}
+	return nil
+}
+
+func (pshu *PostSlugHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pshu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postslughistory.Table, postslughistory.Columns, sqlgraph.NewFieldSpec(postslughistory.FieldID, field.TypeInt))
+	if ps := pshu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pshu.mutation.Slug(); ok {
+		_spec.SetField(postslughistory.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := pshu.mutation.CreatedAt(); ok {
+		_spec.SetField(postslughistory.FieldCreatedAt, field.TypeTime, value)
+	}
+	if pshu.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postslughistory.PostTable,
+			Columns: []string{postslughistory.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pshu.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postslughistory.PostTable,
+			Columns: []string{postslughistory.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pshu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postslughistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pshu.mutation.done = true
+	return n, nil
+}
+
+// PostSlugHistoryUpdateOne is the builder for updating a single PostSlugHistory entity.
+type PostSlugHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PostSlugHistoryMutation
+}
+
+// SetSlug sets the "slug" field.
+func (pshuo *PostSlugHistoryUpdateOne) SetSlug(s string) *PostSlugHistoryUpdateOne {
+	pshuo.mutation.SetSlug(s)
+	return pshuo
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (pshuo *PostSlugHistoryUpdateOne) SetNillableSlug(s *string) *PostSlugHistoryUpdateOne {
+	if s != nil {
+		pshuo.SetSlug(*s)
+	}
+	return pshuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pshuo *PostSlugHistoryUpdateOne) SetCreatedAt(t time.Time) *PostSlugHistoryUpdateOne {
+	pshuo.mutation.SetCreatedAt(t)
+	return pshuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pshuo *PostSlugHistoryUpdateOne) SetNillableCreatedAt(t *time.Time) *PostSlugHistoryUpdateOne {
+	if t != nil {
+		pshuo.SetCreatedAt(*t)
+	}
+	return pshuo
+}
+
+// SetPostID sets the "post" edge to the Post entity by ID.
+func (pshuo *PostSlugHistoryUpdateOne) SetPostID(id int) *PostSlugHistoryUpdateOne {
+	pshuo.mutation.SetPostID(id)
+	return pshuo
+}
+
+// SetPost sets the "post" edge to the Post entity.
+func (pshuo *PostSlugHistoryUpdateOne) SetPost(p *Post) *PostSlugHistoryUpdateOne {
+	return pshuo.SetPostID(p.ID)
+}
+
+// Mutation returns the PostSlugHistoryMutation object of the builder.
+func (pshuo *PostSlugHistoryUpdateOne) Mutation() *PostSlugHistoryMutation {
+	return pshuo.mutation
+}
+
+// ClearPost clears the "post" edge to the Post entity.
+func (pshuo *PostSlugHistoryUpdateOne) ClearPost() *PostSlugHistoryUpdateOne {
+	pshuo.mutation.ClearPost()
+	return pshuo
+}
+
+// Where appends a list predicates to the PostSlugHistoryUpdate builder.
+func (pshuo *PostSlugHistoryUpdateOne) Where(ps ...predicate.PostSlugHistory) *PostSlugHistoryUpdateOne {
+	pshuo.mutation.Where(ps...)
+	return pshuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pshuo *PostSlugHistoryUpdateOne) Select(field string, fields ...string) *PostSlugHistoryUpdateOne {
+	pshuo.fields = append([]string{field}, fields...)
+	return pshuo
+}
+
+// Save executes the query and returns the updated PostSlugHistory entity.
+func (pshuo *PostSlugHistoryUpdateOne) Save(ctx context.Context) (*PostSlugHistory, error) {
+	return withHooks(ctx, pshuo.sqlSave, pshuo.mutation, pshuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pshuo *PostSlugHistoryUpdateOne) SaveX(ctx context.Context) *PostSlugHistory {
+	node, err := pshuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pshuo *PostSlugHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := pshuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pshuo *PostSlugHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := pshuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pshuo *PostSlugHistoryUpdateOne) check() error {
+	if v, ok := pshuo.mutation.Slug(); ok {
+		if err := postslughistory.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "PostSlugHistory.slug": %w`, err)}
+		}
+	}
+	if _, ok := pshuo.mutation.PostID(); pshuo.mutation.PostCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PostSlugHistory.post"`)
+	}
+	return nil
+}
+
+func (pshuo *PostSlugHistoryUpdateOne) sqlSave(ctx context.Context) (_node *PostSlugHistory, err error) {
+	if err := pshuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postslughistory.Table, postslughistory.Columns, sqlgraph.NewFieldSpec(postslughistory.FieldID, field.TypeInt))
+	id, ok := pshuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PostSlugHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pshuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, postslughistory.FieldID)
+		for _, f := range fields {
+			if !postslughistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != postslughistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pshuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pshuo.mutation.Slug(); ok {
+		_spec.SetField(postslughistory.FieldSlug, field.TypeString, value)
+	}
+	if value, ok := pshuo.mutation.CreatedAt(); ok {
+		_spec.SetField(postslughistory.FieldCreatedAt, field.TypeTime, value)
+	}
+	if pshuo.mutation.PostCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postslughistory.PostTable,
+			Columns: []string{postslughistory.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pshuo.mutation.PostIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   postslughistory.PostTable,
+			Columns: []string{postslughistory.PostColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(post.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PostSlugHistory{config: pshuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pshuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postslughistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pshuo.mutation.done = true
+	return _node, nil
+}
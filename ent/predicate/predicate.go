@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AccessToken is the predicate function for accesstoken builders.
+type AccessToken func(*sql.Selector)
+
 // Book is the predicate function for book builders.
 type Book func(*sql.Selector)
 
@@ -15,18 +18,57 @@ type Collection func(*sql.Selector)
 // Comment is the predicate function for comment builders.
 type Comment func(*sql.Selector)
 
+// CommentReaction is the predicate function for commentreaction builders.
+type CommentReaction func(*sql.Selector)
+
+// CommentRevision is the predicate function for commentrevision builders.
+type CommentRevision func(*sql.Selector)
+
+// EmailSubscription is the predicate function for emailsubscription builders.
+type EmailSubscription func(*sql.Selector)
+
 // Friend is the predicate function for friend builders.
 type Friend func(*sql.Selector)
 
 // Hitokoto is the predicate function for hitokoto builders.
 type Hitokoto func(*sql.Selector)
 
+// Identity is the predicate function for identity builders.
+type Identity func(*sql.Selector)
+
 // Image is the predicate function for image builders.
 type Image func(*sql.Selector)
 
+// Invitation is the predicate function for invitation builders.
+type Invitation func(*sql.Selector)
+
+// PasswordReset is the predicate function for passwordreset builders.
+type PasswordReset func(*sql.Selector)
+
 // Post is the predicate function for post builders.
 type Post func(*sql.Selector)
 
+// PostRevision is the predicate function for postrevision builders.
+type PostRevision func(*sql.Selector)
+
+// PostSlugHistory is the predicate function for postslughistory builders.
+type PostSlugHistory func(*sql.Selector)
+
+// RecoveryCode is the predicate function for recoverycode builders.
+type RecoveryCode func(*sql.Selector)
+
+// SearchDocument is the predicate function for searchdocument builders.
+type SearchDocument func(*sql.Selector)
+
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
+// SpamCorpus is the predicate function for spamcorpus builders.
+type SpamCorpus func(*sql.Selector)
+
+// SpamToken is the predicate function for spamtoken builders.
+type SpamToken func(*sql.Selector)
+
 // Tag is the predicate function for tag builders.
 type Tag func(*sql.Selector)
 
@@ -3,14 +3,28 @@
 package ent
 
 import (
+	"blog-go/ent/accesstoken"
 	"blog-go/ent/book"
 	"blog-go/ent/collection"
 	"blog-go/ent/comment"
+	"blog-go/ent/commentreaction"
+	"blog-go/ent/commentrevision"
+	"blog-go/ent/emailsubscription"
 	"blog-go/ent/friend"
 	"blog-go/ent/hitokoto"
+	"blog-go/ent/identity"
 	"blog-go/ent/image"
+	"blog-go/ent/invitation"
+	"blog-go/ent/passwordreset"
 	"blog-go/ent/post"
+	"blog-go/ent/postrevision"
+	"blog-go/ent/postslughistory"
+	"blog-go/ent/recoverycode"
 	"blog-go/ent/schema"
+	"blog-go/ent/searchdocument"
+	"blog-go/ent/session"
+	"blog-go/ent/spamcorpus"
+	"blog-go/ent/spamtoken"
 	"blog-go/ent/tag"
 	"blog-go/ent/user"
 	"time"
@@ -20,6 +34,16 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accesstokenFields := schema.AccessToken{}.Fields()
+	_ = accesstokenFields
+	// accesstokenDescName is the schema descriptor for name field.
+	accesstokenDescName := accesstokenFields[0].Descriptor()
+	// accesstoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	accesstoken.NameValidator = accesstokenDescName.Validators[0].(func(string) error)
+	// accesstokenDescTokenHash is the schema descriptor for token_hash field.
+	accesstokenDescTokenHash := accesstokenFields[1].Descriptor()
+	// accesstoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	accesstoken.TokenHashValidator = accesstokenDescTokenHash.Validators[0].(func(string) error)
 	bookFields := schema.Book{}.Fields()
 	_ = bookFields
 	// bookDescTitle is the schema descriptor for title field.
@@ -72,14 +96,36 @@ func init() {
 	commentDescEmail := commentFields[2].Descriptor()
 	// comment.EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	comment.EmailValidator = commentDescEmail.Validators[0].(func(string) error)
-	// commentDescApproved is the schema descriptor for approved field.
-	commentDescApproved := commentFields[4].Descriptor()
-	// comment.DefaultApproved holds the default value on creation for the approved field.
-	comment.DefaultApproved = commentDescApproved.Default.(bool)
 	// commentDescAvatar is the schema descriptor for avatar field.
-	commentDescAvatar := commentFields[7].Descriptor()
+	commentDescAvatar := commentFields[9].Descriptor()
 	// comment.DefaultAvatar holds the default value on creation for the avatar field.
 	comment.DefaultAvatar = commentDescAvatar.Default.(string)
+	commentreactionFields := schema.CommentReaction{}.Fields()
+	_ = commentreactionFields
+	// commentreactionDescEmoji is the schema descriptor for emoji field.
+	commentreactionDescEmoji := commentreactionFields[1].Descriptor()
+	// commentreaction.EmojiValidator is a validator for the "emoji" field. It is called by the builders before save.
+	commentreaction.EmojiValidator = commentreactionDescEmoji.Validators[0].(func(string) error)
+	// commentreactionDescActor is the schema descriptor for actor field.
+	commentreactionDescActor := commentreactionFields[2].Descriptor()
+	// commentreaction.ActorValidator is a validator for the "actor" field. It is called by the builders before save.
+	commentreaction.ActorValidator = commentreactionDescActor.Validators[0].(func(string) error)
+	commentrevisionFields := schema.CommentRevision{}.Fields()
+	_ = commentrevisionFields
+	// commentrevisionDescContent is the schema descriptor for content field.
+	commentrevisionDescContent := commentrevisionFields[0].Descriptor()
+	// commentrevision.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	commentrevision.ContentValidator = commentrevisionDescContent.Validators[0].(func(string) error)
+	emailsubscriptionFields := schema.EmailSubscription{}.Fields()
+	_ = emailsubscriptionFields
+	// emailsubscriptionDescEmail is the schema descriptor for email field.
+	emailsubscriptionDescEmail := emailsubscriptionFields[0].Descriptor()
+	// emailsubscription.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	emailsubscription.EmailValidator = emailsubscriptionDescEmail.Validators[0].(func(string) error)
+	// emailsubscriptionDescUnsubscribed is the schema descriptor for unsubscribed field.
+	emailsubscriptionDescUnsubscribed := emailsubscriptionFields[1].Descriptor()
+	// emailsubscription.DefaultUnsubscribed holds the default value on creation for the unsubscribed field.
+	emailsubscription.DefaultUnsubscribed = emailsubscriptionDescUnsubscribed.Default.(bool)
 	friendFields := schema.Friend{}.Fields()
 	_ = friendFields
 	// friendDescName is the schema descriptor for name field.
@@ -100,6 +146,16 @@ func init() {
 	hitokotoDescContent := hitokotoFields[0].Descriptor()
 	// hitokoto.ContentValidator is a validator for the "content" field. It is called by the builders before save.
 	hitokoto.ContentValidator = hitokotoDescContent.Validators[0].(func(string) error)
+	identityFields := schema.Identity{}.Fields()
+	_ = identityFields
+	// identityDescProvider is the schema descriptor for provider field.
+	identityDescProvider := identityFields[0].Descriptor()
+	// identity.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	identity.ProviderValidator = identityDescProvider.Validators[0].(func(string) error)
+	// identityDescProviderUserID is the schema descriptor for provider_user_id field.
+	identityDescProviderUserID := identityFields[1].Descriptor()
+	// identity.ProviderUserIDValidator is a validator for the "provider_user_id" field. It is called by the builders before save.
+	identity.ProviderUserIDValidator = identityDescProviderUserID.Validators[0].(func(string) error)
 	imageFields := schema.Image{}.Fields()
 	_ = imageFields
 	// imageDescFilename is the schema descriptor for filename field.
@@ -126,6 +182,18 @@ func init() {
 	imageDescHeight := imageFields[6].Descriptor()
 	// image.DefaultHeight holds the default value on creation for the height field.
 	image.DefaultHeight = imageDescHeight.Default.(int)
+	invitationFields := schema.Invitation{}.Fields()
+	_ = invitationFields
+	// invitationDescCodeHash is the schema descriptor for code_hash field.
+	invitationDescCodeHash := invitationFields[0].Descriptor()
+	// invitation.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	invitation.CodeHashValidator = invitationDescCodeHash.Validators[0].(func(string) error)
+	passwordresetFields := schema.PasswordReset{}.Fields()
+	_ = passwordresetFields
+	// passwordresetDescTokenHash is the schema descriptor for token_hash field.
+	passwordresetDescTokenHash := passwordresetFields[0].Descriptor()
+	// passwordreset.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	passwordreset.TokenHashValidator = passwordresetDescTokenHash.Validators[0].(func(string) error)
 	postFields := schema.Post{}.Fields()
 	_ = postFields
 	// postDescTitle is the schema descriptor for title field.
@@ -133,29 +201,95 @@ func init() {
 	// post.TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	post.TitleValidator = postDescTitle.Validators[0].(func(string) error)
 	// postDescContent is the schema descriptor for content field.
-	postDescContent := postFields[1].Descriptor()
+	postDescContent := postFields[2].Descriptor()
 	// post.ContentValidator is a validator for the "content" field. It is called by the builders before save.
 	post.ContentValidator = postDescContent.Validators[0].(func(string) error)
 	// postDescExcerpt is the schema descriptor for excerpt field.
-	postDescExcerpt := postFields[2].Descriptor()
+	postDescExcerpt := postFields[5].Descriptor()
 	// post.ExcerptValidator is a validator for the "excerpt" field. It is called by the builders before save.
 	post.ExcerptValidator = postDescExcerpt.Validators[0].(func(string) error)
 	// postDescCoverImage is the schema descriptor for cover_image field.
-	postDescCoverImage := postFields[3].Descriptor()
+	postDescCoverImage := postFields[6].Descriptor()
 	// post.DefaultCoverImage holds the default value on creation for the cover_image field.
 	post.DefaultCoverImage = postDescCoverImage.Default.(string)
 	// postDescPublished is the schema descriptor for published field.
-	postDescPublished := postFields[4].Descriptor()
+	postDescPublished := postFields[7].Descriptor()
 	// post.DefaultPublished holds the default value on creation for the published field.
 	post.DefaultPublished = postDescPublished.Default.(bool)
+	// postDescScheduled is the schema descriptor for scheduled field.
+	postDescScheduled := postFields[8].Descriptor()
+	// post.DefaultScheduled holds the default value on creation for the scheduled field.
+	post.DefaultScheduled = postDescScheduled.Default.(bool)
 	// postDescViews is the schema descriptor for views field.
-	postDescViews := postFields[8].Descriptor()
+	postDescViews := postFields[12].Descriptor()
 	// post.DefaultViews holds the default value on creation for the views field.
 	post.DefaultViews = postDescViews.Default.(int)
 	// postDescAuthor is the schema descriptor for author field.
-	postDescAuthor := postFields[10].Descriptor()
+	postDescAuthor := postFields[14].Descriptor()
 	// post.AuthorValidator is a validator for the "author" field. It is called by the builders before save.
 	post.AuthorValidator = postDescAuthor.Validators[0].(func(string) error)
+	postrevisionFields := schema.PostRevision{}.Fields()
+	_ = postrevisionFields
+	// postrevisionDescVersion is the schema descriptor for version field.
+	postrevisionDescVersion := postrevisionFields[0].Descriptor()
+	// postrevision.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	postrevision.VersionValidator = postrevisionDescVersion.Validators[0].(func(int) error)
+	// postrevisionDescTitle is the schema descriptor for title field.
+	postrevisionDescTitle := postrevisionFields[1].Descriptor()
+	// postrevision.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	postrevision.TitleValidator = postrevisionDescTitle.Validators[0].(func(string) error)
+	// postrevisionDescContent is the schema descriptor for content field.
+	postrevisionDescContent := postrevisionFields[2].Descriptor()
+	// postrevision.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	postrevision.ContentValidator = postrevisionDescContent.Validators[0].(func(string) error)
+	// postrevisionDescExcerpt is the schema descriptor for excerpt field.
+	postrevisionDescExcerpt := postrevisionFields[3].Descriptor()
+	// postrevision.ExcerptValidator is a validator for the "excerpt" field. It is called by the builders before save.
+	postrevision.ExcerptValidator = postrevisionDescExcerpt.Validators[0].(func(string) error)
+	postslughistoryFields := schema.PostSlugHistory{}.Fields()
+	_ = postslughistoryFields
+	// postslughistoryDescSlug is the schema descriptor for slug field.
+	postslughistoryDescSlug := postslughistoryFields[0].Descriptor()
+	// postslughistory.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	postslughistory.SlugValidator = postslughistoryDescSlug.Validators[0].(func(string) error)
+	recoverycodeFields := schema.RecoveryCode{}.Fields()
+	_ = recoverycodeFields
+	// recoverycodeDescCodeHash is the schema descriptor for code_hash field.
+	recoverycodeDescCodeHash := recoverycodeFields[0].Descriptor()
+	// recoverycode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	recoverycode.CodeHashValidator = recoverycodeDescCodeHash.Validators[0].(func(string) error)
+	searchdocumentFields := schema.SearchDocument{}.Fields()
+	_ = searchdocumentFields
+	// searchdocumentDescVisible is the schema descriptor for visible field.
+	searchdocumentDescVisible := searchdocumentFields[5].Descriptor()
+	// searchdocument.DefaultVisible holds the default value on creation for the visible field.
+	searchdocument.DefaultVisible = searchdocumentDescVisible.Default.(bool)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescRefreshTokenHash is the schema descriptor for refresh_token_hash field.
+	sessionDescRefreshTokenHash := sessionFields[0].Descriptor()
+	// session.RefreshTokenHashValidator is a validator for the "refresh_token_hash" field. It is called by the builders before save.
+	session.RefreshTokenHashValidator = sessionDescRefreshTokenHash.Validators[0].(func(string) error)
+	spamcorpusFields := schema.SpamCorpus{}.Fields()
+	_ = spamcorpusFields
+	// spamcorpusDescDocuments is the schema descriptor for documents field.
+	spamcorpusDescDocuments := spamcorpusFields[1].Descriptor()
+	// spamcorpus.DefaultDocuments holds the default value on creation for the documents field.
+	spamcorpus.DefaultDocuments = spamcorpusDescDocuments.Default.(int)
+	spamtokenFields := schema.SpamToken{}.Fields()
+	_ = spamtokenFields
+	// spamtokenDescToken is the schema descriptor for token field.
+	spamtokenDescToken := spamtokenFields[0].Descriptor()
+	// spamtoken.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	spamtoken.TokenValidator = spamtokenDescToken.Validators[0].(func(string) error)
+	// spamtokenDescSpam is the schema descriptor for spam field.
+	spamtokenDescSpam := spamtokenFields[1].Descriptor()
+	// spamtoken.DefaultSpam holds the default value on creation for the spam field.
+	spamtoken.DefaultSpam = spamtokenDescSpam.Default.(int)
+	// spamtokenDescHam is the schema descriptor for ham field.
+	spamtokenDescHam := spamtokenFields[2].Descriptor()
+	// spamtoken.DefaultHam holds the default value on creation for the ham field.
+	spamtoken.DefaultHam = spamtokenDescHam.Default.(int)
 	tagFields := schema.Tag{}.Fields()
 	_ = tagFields
 	// tagDescName is the schema descriptor for name field.
@@ -181,11 +315,19 @@ func init() {
 	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
 	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
 	// userDescPassword is the schema descriptor for password field.
-	userDescPassword := userFields[2].Descriptor()
+	userDescPassword := userFields[3].Descriptor()
 	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
 	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
+	// userDescTotpLastStep is the schema descriptor for totp_last_step field.
+	userDescTotpLastStep := userFields[7].Descriptor()
+	// user.DefaultTotpLastStep holds the default value on creation for the totp_last_step field.
+	user.DefaultTotpLastStep = userDescTotpLastStep.Default.(int64)
+	// userDescFailedLoginCount is the schema descriptor for failed_login_count field.
+	userDescFailedLoginCount := userFields[8].Descriptor()
+	// user.DefaultFailedLoginCount holds the default value on creation for the failed_login_count field.
+	user.DefaultFailedLoginCount = userDescFailedLoginCount.Default.(int)
 	// userDescRole is the schema descriptor for role field.
-	userDescRole := userFields[3].Descriptor()
+	userDescRole := userFields[11].Descriptor()
 	// user.DefaultRole holds the default value on creation for the role field.
 	user.DefaultRole = userDescRole.Default.(string)
 }
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// AccessToken holds the schema definition for the AccessToken entity.
+//
+// 供脚本和 CI 使用的个人访问令牌，只保存令牌的哈希，权限不超过 scopes 和用户当前角色的交集。
+type AccessToken struct {
+	ent.Schema
+}
+
+// Fields of the AccessToken.
+func (AccessToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty(),
+		field.String("token_hash").NotEmpty().Unique().Sensitive(),
+		// 令牌开头的几个字符，用于在列表中辨认
+		field.String("prefix"),
+		field.Strings("scopes"),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.String("last_used_ip").Optional(),
+		field.Time("revoked_at").Optional().Nillable(),
+		field.Time("created_at"),
+	}
+}
+
+// Edges of the AccessToken.
+func (AccessToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("access_tokens").
+			Unique().
+			Required(),
+	}
+}
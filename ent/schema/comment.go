@@ -2,8 +2,10 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Comment holds the schema definition for the Comment entity.
@@ -18,11 +20,26 @@ func (Comment) Fields() []ent.Field {
 		field.String("author").NotEmpty(),
 		field.String("email").NotEmpty(),
 		field.String("website").Optional(),
-		field.Bool("approved").Default(false),
+		field.Enum("status").
+			Values("pending", "approved", "rejected", "spam", "trashed").
+			Default("pending"),
+		// 上一次状态变更前的状态，用于撤销
+		field.Enum("previous_status").
+			Values("pending", "approved", "rejected", "spam", "trashed").
+			Optional().
+			Nillable(),
 		field.Time("created_at"),
 		field.Time("updated_at"),
+		// 评论者最后一次编辑的时间
+		field.Time("edited_at").Optional().Nillable(),
 		field.String("avatar").Optional().Default("/images/default-avatar.png"),
 		field.Int("parent_id").Optional().Nillable(),
+		field.Enum("moderation_action").Values("approve", "hold", "reject").Optional(),
+		field.String("moderation_reason").Optional(),
+		field.Float("spam_score").Optional().Nillable(),
+		field.Enum("spam_label").Values("spam", "ham").Optional().Nillable(),
+		// 从其他评论系统导入时的来源ID（如 disqus:123），用于重复导入时去重
+		field.String("import_id").Optional().Nillable().Unique(),
 	}
 }
 
@@ -40,5 +57,16 @@ func (Comment) Edges() []ent.Edge {
 			From("parent").
 			Unique().
 			Field("parent_id"),
+		edge.To("revisions", CommentRevision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("reactions", CommentReaction.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Comment.
+func (Comment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "created_at"),
 	}
 }
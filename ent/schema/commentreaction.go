@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CommentReaction holds the schema definition for the CommentReaction entity.
+//
+// actor 为 "user:<用户ID>" 或 "anon:<匿名指纹>"，每个人对同一条评论只保留一个表情。
+type CommentReaction struct {
+	ent.Schema
+}
+
+// Fields of the CommentReaction.
+func (CommentReaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("comment_id"),
+		field.String("emoji").NotEmpty(),
+		field.String("actor").NotEmpty(),
+		field.Time("created_at"),
+	}
+}
+
+// Edges of the CommentReaction.
+func (CommentReaction) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("comment", Comment.Type).
+			Ref("reactions").
+			Field("comment_id").
+			Unique().
+			Required(),
+		edge.From("user", User.Type).
+			Ref("comment_reactions").
+			Unique(),
+	}
+}
+
+// Indexes of the CommentReaction.
+func (CommentReaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("comment_id", "actor").Unique(),
+	}
+}
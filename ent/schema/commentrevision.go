@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// CommentRevision holds the schema definition for the CommentRevision entity.
+//
+// 评论被编辑前的内容，仅管理员可见。
+type CommentRevision struct {
+	ent.Schema
+}
+
+// Fields of the CommentRevision.
+func (CommentRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("content").NotEmpty(),
+		field.Time("created_at"),
+	}
+}
+
+// Edges of the CommentRevision.
+func (CommentRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("comment", Comment.Type).
+			Ref("revisions").
+			Unique().
+			Required(),
+	}
+}
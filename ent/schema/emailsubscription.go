@@ -0,0 +1,29 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// EmailSubscription holds the schema definition for the EmailSubscription entity.
+//
+// 按邮箱记录是否接收评论通知邮件，退订后不再发送。
+type EmailSubscription struct {
+	ent.Schema
+}
+
+// Fields of the EmailSubscription.
+func (EmailSubscription) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("email").NotEmpty().Unique(),
+		field.Bool("unsubscribed").Default(false),
+		field.Time("unsubscribed_at").Optional().Nillable(),
+		field.Time("created_at"),
+		field.Time("updated_at"),
+	}
+}
+
+// Edges of the EmailSubscription.
+func (EmailSubscription) Edges() []ent.Edge {
+	return nil
+}
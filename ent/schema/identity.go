@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Identity holds the schema definition for the Identity entity.
+//
+// 第三方登录账号（provider + provider_user_id）与本站用户的关联，一个用户可以关联多个第三方账号。
+type Identity struct {
+	ent.Schema
+}
+
+// Fields of the Identity.
+func (Identity) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provider").NotEmpty(),
+		// 第三方平台上不会变化的用户ID（而不是可修改的登录名）
+		field.String("provider_user_id").NotEmpty(),
+		field.String("login").Optional(),
+		field.String("email").Optional(),
+		field.String("avatar").Optional(),
+		field.Time("created_at"),
+		field.Time("updated_at"),
+	}
+}
+
+// Edges of the Identity.
+func (Identity) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("identities").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Identity.
+func (Identity) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "provider_user_id").Unique(),
+	}
+}
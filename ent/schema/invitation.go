@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Invitation holds the schema definition for the Invitation entity.
+//
+// 仅邀请注册模式下使用的邀请码，只保存邀请码的哈希，使用一次后失效。
+type Invitation struct {
+	ent.Schema
+}
+
+// Fields of the Invitation.
+func (Invitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code_hash").NotEmpty().Unique().Sensitive(),
+		// 指定邮箱时只能用该邮箱注册
+		field.String("email").Optional(),
+		field.Time("expires_at").Optional().Nillable(),
+		field.Time("used_at").Optional().Nillable(),
+		field.Time("created_at"),
+	}
+}
+
+// Edges of the Invitation.
+func (Invitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("creator", User.Type).
+			Ref("invitations").
+			Unique(),
+		edge.From("invitee", User.Type).
+			Ref("invitation").
+			Unique(),
+	}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// PasswordReset holds the schema definition for the PasswordReset entity.
+//
+// 找回密码的重置令牌，只保存令牌的哈希，过期或使用一次后失效。
+type PasswordReset struct {
+	ent.Schema
+}
+
+// Fields of the PasswordReset.
+func (PasswordReset) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token_hash").NotEmpty().Unique().Sensitive(),
+		field.Time("expires_at"),
+		field.Time("used_at").Optional().Nillable(),
+		// 申请重置的 IP，便于排查滥用
+		field.String("ip").Optional(),
+		field.Time("created_at"),
+	}
+}
+
+// Edges of the PasswordReset.
+func (PasswordReset) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("password_resets").
+			Unique().
+			Required(),
+	}
+}
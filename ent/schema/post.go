@@ -1,9 +1,13 @@
 package schema
 
 import (
+	"blog-go/models"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 // Post holds the schema definition for the Post entity.
@@ -15,10 +19,14 @@ type Post struct {
 func (Post) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("title").NotEmpty(),
+		field.String("slug").Optional().Unique(),
 		field.Text("content").NotEmpty(),
+		field.Text("content_html").Optional().Nillable(),
+		field.JSON("toc", []models.TocItem{}).Optional(),
 		field.String("excerpt").NotEmpty(),
 		field.String("cover_image").Default("/images/post-cover.jpg"),
 		field.Bool("published").Default(false),
+		field.Bool("scheduled").Default(false),
 		field.Time("created_at"),
 		field.Time("updated_at"),
 		field.Time("published_at").Optional().Nillable(),
@@ -31,7 +39,22 @@ func (Post) Fields() []ent.Field {
 // Edges of the Post.
 func (Post) Edges() []ent.Edge {
 	return []ent.Edge{
+		// 创建文章的用户，作者角色只能编辑自己的文章
+		edge.From("owner", User.Type).
+			Ref("posts").
+			Unique(),
 		edge.To("comments", Comment.Type),
 		edge.To("tags", Tag.Type),
+		edge.To("revisions", PostRevision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("slug_history", PostSlugHistory.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Post.
+func (Post) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("scheduled", "published_at"),
 	}
 }
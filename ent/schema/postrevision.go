@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PostRevision holds the schema definition for the PostRevision entity.
+type PostRevision struct {
+	ent.Schema
+}
+
+// Fields of the PostRevision.
+func (PostRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("version").Positive(),
+		field.String("title").NotEmpty(),
+		field.Text("content").NotEmpty(),
+		field.String("excerpt").NotEmpty(),
+		field.String("editor").Optional(),
+		field.Int("restored_from").Optional().Nillable(),
+		field.Time("created_at"),
+	}
+}
+
+// Edges of the PostRevision.
+func (PostRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).
+			Ref("revisions").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the PostRevision.
+func (PostRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("version").
+			Edges("post").
+			Unique(),
+	}
+}
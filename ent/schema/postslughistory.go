@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// PostSlugHistory holds the schema definition for the PostSlugHistory entity.
+type PostSlugHistory struct {
+	ent.Schema
+}
+
+// Fields of the PostSlugHistory.
+func (PostSlugHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("slug").NotEmpty().Unique(),
+		field.Time("created_at"),
+	}
+}
+
+// Edges of the PostSlugHistory.
+func (PostSlugHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("post", Post.Type).
+			Ref("slug_history").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// RecoveryCode holds the schema definition for the RecoveryCode entity.
+//
+// 两步验证的恢复码，丢失验证器时代替动态验证码登录，只保存哈希，每个只能使用一次。
+type RecoveryCode struct {
+	ent.Schema
+}
+
+// Fields of the RecoveryCode.
+func (RecoveryCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code_hash").NotEmpty().Sensitive(),
+		field.Time("used_at").Optional().Nillable(),
+		field.Time("created_at"),
+	}
+}
+
+// Edges of the RecoveryCode.
+func (RecoveryCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("recovery_codes").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SearchDocument holds the schema definition for the SearchDocument entity.
+//
+// 文章、图书和收藏的统一全文检索索引，vector 列由 services 中的原生SQL维护。
+type SearchDocument struct {
+	ent.Schema
+}
+
+// Fields of the SearchDocument.
+func (SearchDocument) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("doc_type").Values("post", "book", "collection"),
+		field.Int("doc_id"),
+		field.String("title"),
+		field.Text("content").Optional(),
+		field.String("vector").
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "tsvector"}),
+		field.Bool("visible").Default(true),
+		field.Time("updated_at"),
+	}
+}
+
+// Indexes of the SearchDocument.
+func (SearchDocument) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("doc_type", "doc_id").Unique(),
+		index.Fields("vector").
+			Annotations(entsql.IndexType("GIN")),
+	}
+}
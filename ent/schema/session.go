@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Session holds the schema definition for the Session entity.
+//
+// 一次登录对应一个会话，保存当前刷新令牌的哈希；每次刷新都会更换刷新令牌，
+// 旧令牌被再次使用说明已泄露，整个会话随即失效。
+type Session struct {
+	ent.Schema
+}
+
+// Fields of the Session.
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("refresh_token_hash").NotEmpty().Unique().Sensitive(),
+		// 上一个刷新令牌的哈希，用于发现令牌被重复使用
+		field.String("previous_token_hash").Optional().Sensitive(),
+		field.String("device").Optional(),
+		field.String("ip").Optional(),
+		field.String("user_agent").Optional(),
+		field.Time("created_at"),
+		field.Time("last_used_at"),
+		field.Time("expires_at"),
+		field.Time("revoked_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the Session.
+func (Session) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("sessions").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Session.
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("previous_token_hash"),
+	}
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SpamCorpus holds the schema definition for the SpamCorpus entity.
+//
+// 分类器各类别已训练的评论数量。
+type SpamCorpus struct {
+	ent.Schema
+}
+
+// Fields of the SpamCorpus.
+func (SpamCorpus) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("class").Values("spam", "ham"),
+		field.Int("documents").Default(0),
+	}
+}
+
+// Indexes of the SpamCorpus.
+func (SpamCorpus) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("class").Unique(),
+	}
+}
+
+// Edges of the SpamCorpus.
+func (SpamCorpus) Edges() []ent.Edge {
+	return nil
+}
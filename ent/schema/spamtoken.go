@@ -0,0 +1,27 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// SpamToken holds the schema definition for the SpamToken entity.
+//
+// 垃圾评论分类器的词频统计，记录每个特征在垃圾/正常评论中出现的文档数。
+type SpamToken struct {
+	ent.Schema
+}
+
+// Fields of the SpamToken.
+func (SpamToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token").NotEmpty().Unique(),
+		field.Int("spam").Default(0),
+		field.Int("ham").Default(0),
+	}
+}
+
+// Edges of the SpamToken.
+func (SpamToken) Edges() []ent.Edge {
+	return nil
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -16,8 +17,22 @@ func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("username").NotEmpty().Unique(),
 		field.String("email").NotEmpty().Unique(),
+		// 邮箱验证时间，未验证的用户不能以登录身份评论
+		field.Time("email_verified_at").Optional().Nillable(),
 		field.String("password").NotEmpty(),
-		field.String("role").Default("user"),
+		// 最近一次重置密码的时间，此前签发的登录令牌全部失效
+		field.Time("password_changed_at").Optional().Nillable(),
+		// 两步验证（TOTP）密钥，启用时间为空表示尚未完成绑定
+		field.String("totp_secret").Optional().Sensitive(),
+		field.Time("totp_enabled_at").Optional().Nillable(),
+		// 最近一次通过验证的时间步，同一个验证码不能重复使用
+		field.Int64("totp_last_step").Default(0),
+		// 连续登录失败次数和临时锁定时间，登录成功或重置密码后清零
+		field.Int("failed_login_count").Default(0),
+		field.Time("last_failed_login_at").Optional().Nillable(),
+		field.Time("locked_until").Optional().Nillable(),
+		// admin / editor / author / commenter，权限见 services/permissions.go
+		field.String("role").Default("commenter"),
 		field.String("avatar").Optional(),
 		field.String("nickname").Optional(),
 		field.String("bio").Optional(),
@@ -34,5 +49,20 @@ func (User) Edges() []ent.Edge {
 		edge.To("tags", Tag.Type),
 		edge.To("images", Image.Type),
 		edge.To("books", Book.Type),
+		edge.To("comment_reactions", CommentReaction.Type),
+		edge.To("identities", Identity.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// 创建的邀请码以及注册时使用的邀请码
+		edge.To("invitations", Invitation.Type),
+		edge.To("invitation", Invitation.Type).
+			Unique(),
+		edge.To("password_resets", PasswordReset.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("sessions", Session.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("access_tokens", AccessToken.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("recovery_codes", RecoveryCode.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/searchdocument"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SearchDocument is the model entity for the SearchDocument schema.
+type SearchDocument struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// DocType holds the value of the "doc_type" field.
+	DocType searchdocument.DocType `json:"doc_type,omitempty"`
+	// DocID holds the value of the "doc_id" field.
+	DocID int `json:"doc_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Vector holds the value of the "vector" field.
+	Vector string `json:"vector,omitempty"`
+	// Visible holds the value of the "visible" field.
+	Visible bool `json:"visible,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SearchDocument) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case searchdocument.FieldVisible:
+			values[i] = new(sql.NullBool)
+		case searchdocument.FieldID, searchdocument.FieldDocID:
+			values[i] = new(sql.NullInt64)
+		case searchdocument.FieldDocType, searchdocument.FieldTitle, searchdocument.FieldContent, searchdocument.FieldVector:
+			values[i] = new(sql.NullString)
+		case searchdocument.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SearchDocument fields.
+func (sd *SearchDocument) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case searchdocument.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sd.ID = int(value.Int64)
+		case searchdocument.FieldDocType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field doc_type", values[i])
+			} else if value.Valid {
+				sd.DocType = searchdocument.DocType(value.String)
+			}
+		case searchdocument.FieldDocID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field doc_id", values[i])
+			} else if value.Valid {
+				sd.DocID = int(value.Int64)
+			}
+		case searchdocument.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				sd.Title = value.String
+			}
+		case searchdocument.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				sd.Content = value.String
+			}
+		case searchdocument.FieldVector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field vector", values[i])
+			} else if value.Valid {
+				sd.Vector = value.String
+			}
+		case searchdocument.FieldVisible:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field visible", values[i])
+			} else if value.Valid {
+				sd.Visible = value.Bool
+			}
+		case searchdocument.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sd.UpdatedAt = value.Time
+			}
+		default:
+			sd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SearchDocument.
+// This includes values selected through modifiers, order, etc.
+func (sd *SearchDocument) Value(name string) (ent.Value, error) {
+	return sd.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SearchDocument.
+// Note that you need to call SearchDocument.Unwrap() before calling this method if this SearchDocument
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sd *SearchDocument) Update() *SearchDocumentUpdateOne {
+	return NewSearchDocumentClient(sd.config).UpdateOne(sd)
+}
+
+// Unwrap unwraps the SearchDocument entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sd *SearchDocument) Unwrap() *SearchDocument {
+	_tx, ok := sd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SearchDocument is not a transactional entity")
+	}
+	sd.config.driver = _tx.drv
+	return sd
+}
+
+// String implements the fmt.Stringer.
+func (sd *SearchDocument) String() string {
+	var builder strings.Builder
+	builder.WriteString("SearchDocument(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sd.ID))
+	builder.WriteString("doc_type=")
+	builder.WriteString(fmt.Sprintf("%v", sd.DocType))
+	builder.WriteString(", ")
+	builder.WriteString("doc_id=")
+	builder.WriteString(fmt.Sprintf("%v", sd.DocID))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(sd.Title)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(sd.Content)
+	builder.WriteString(", ")
+	builder.WriteString("vector=")
+	builder.WriteString(sd.Vector)
+	builder.WriteString(", ")
+	builder.WriteString("visible=")
+	builder.WriteString(fmt.Sprintf("%v", sd.Visible))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sd.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SearchDocuments is a parsable slice of SearchDocument.
+type SearchDocuments []*SearchDocument
@@ -0,0 +1,126 @@
+// Code generated by ent, DO NOT EDIT.
+
+package searchdocument
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the searchdocument type in the database.
+	Label = "search_document"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDocType holds the string denoting the doc_type field in the database.
+	FieldDocType = "doc_type"
+	// FieldDocID holds the string denoting the doc_id field in the database.
+	FieldDocID = "doc_id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldVector holds the string denoting the vector field in the database.
+	FieldVector = "vector"
+	// FieldVisible holds the string denoting the visible field in the database.
+	FieldVisible = "visible"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the searchdocument in the database.
+	Table = "search_documents"
+)
+
+// Columns holds all SQL columns for searchdocument fields.
+var Columns = []string{
+	FieldID,
+	FieldDocType,
+	FieldDocID,
+	FieldTitle,
+	FieldContent,
+	FieldVector,
+	FieldVisible,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultVisible holds the default value on creation for the "visible" field.
+	DefaultVisible bool
+)
+
+// DocType defines the type for the "doc_type" enum field.
+type DocType string
+
+// DocType values.
+const (
+	DocTypePost       DocType = "post"
+	DocTypeBook       DocType = "book"
+	DocTypeCollection DocType = "collection"
+)
+
+func (dt DocType) String() string {
+	return string(dt)
+}
+
+// DocTypeValidator is a validator for the "doc_type" field enum values. It is called by the builders before save.
+func DocTypeValidator(dt DocType) error {
+	switch dt {
+	case DocTypePost, DocTypeBook, DocTypeCollection:
+		return nil
+	default:
+		return fmt.Errorf("searchdocument: invalid enum value for doc_type field: %q", dt)
+	}
+}
+
+// OrderOption defines the ordering options for the SearchDocument queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDocType orders the results by the doc_type field.
+func ByDocType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocType, opts...).ToFunc()
+}
+
+// ByDocID orders the results by the doc_id field.
+func ByDocID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// ByVector orders the results by the vector field.
+func ByVector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVector, opts...).ToFunc()
+}
+
+// ByVisible orders the results by the visible field.
+func ByVisible(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisible, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
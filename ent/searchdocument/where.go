@@ -0,0 +1,425 @@
+// Code generated by ent, DO NOT EDIT.
+
+package searchdocument
+
+import (
+	"blog-go/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldLTE(FieldID, id))
+}
+
+// DocID applies equality check predicate on the "doc_id" field. It's identical to DocIDEQ.
+func DocID(v int) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldEQ(FieldDocID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldEQ(FieldTitle, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldEQ(FieldContent, v))
+}
+
+// Vector applies equality check predicate on the "vector" field. It's identical to VectorEQ.
+func Vector(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldEQ(FieldVector, v))
+}
+
+// Visible applies equality check predicate on the "visible" field. It's identical to VisibleEQ.
+func Visible(v bool) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldEQ(FieldVisible, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DocTypeEQ applies the EQ predicate on the "doc_type" field.
+func DocTypeEQ(v DocType) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldEQ(FieldDocType, v))
+}
+
+// DocTypeNEQ applies the NEQ predicate on the "doc_type" field.
+func DocTypeNEQ(v DocType) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldNEQ(FieldDocType, v))
+}
+
+// DocTypeIn applies the In predicate on the "doc_type" field.
+func DocTypeIn(vs ...DocType) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldIn(FieldDocType, vs...))
+}
+
+// DocTypeNotIn applies the NotIn predicate on the "doc_type" field.
+func DocTypeNotIn(vs ...DocType) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldNotIn(FieldDocType, vs...))
+}
+
+// DocIDEQ applies the EQ predicate on the "doc_id" field.
+func DocIDEQ(v int) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldEQ(FieldDocID, v))
+}
+
+// DocIDNEQ applies the NEQ predicate on the "doc_id" field.
+func DocIDNEQ(v int) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldNEQ(FieldDocID, v))
+}
+
+// DocIDIn applies the In predicate on the "doc_id" field.
+func DocIDIn(vs ...int) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldIn(FieldDocID, vs...))
+}
+
+// DocIDNotIn applies the NotIn predicate on the "doc_id" field.
+func DocIDNotIn(vs ...int) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldNotIn(FieldDocID, vs...))
+}
+
+// DocIDGT applies the GT predicate on the "doc_id" field.
+func DocIDGT(v int) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldGT(FieldDocID, v))
+}
+
+// DocIDGTE applies the GTE predicate on the "doc_id" field.
+func DocIDGTE(v int) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldGTE(FieldDocID, v))
+}
+
+// DocIDLT applies the LT predicate on the "doc_id" field.
+func DocIDLT(v int) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldLT(FieldDocID, v))
+}
+
+// DocIDLTE applies the LTE predicate on the "doc_id" field.
+func DocIDLTE(v int) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldLTE(FieldDocID, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentIsNil applies the IsNil predicate on the "content" field.
+func ContentIsNil() predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldIsNull(FieldContent))
+}
+
+// ContentNotNil applies the NotNil predicate on the "content" field.
+func ContentNotNil() predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldNotNull(FieldContent))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldContainsFold(FieldContent, v))
+}
+
+// VectorEQ applies the EQ predicate on the "vector" field.
+func VectorEQ(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldEQ(FieldVector, v))
+}
+
+// VectorNEQ applies the NEQ predicate on the "vector" field.
+func VectorNEQ(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldNEQ(FieldVector, v))
+}
+
+// VectorIn applies the In predicate on the "vector" field.
+func VectorIn(vs ...string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldIn(FieldVector, vs...))
+}
+
+// VectorNotIn applies the NotIn predicate on the "vector" field.
+func VectorNotIn(vs ...string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldNotIn(FieldVector, vs...))
+}
+
+// VectorGT applies the GT predicate on the "vector" field.
+func VectorGT(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldGT(FieldVector, v))
+}
+
+// VectorGTE applies the GTE predicate on the "vector" field.
+func VectorGTE(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldGTE(FieldVector, v))
+}
+
+// VectorLT applies the LT predicate on the "vector" field.
+func VectorLT(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldLT(FieldVector, v))
+}
+
+// VectorLTE applies the LTE predicate on the "vector" field.
+func VectorLTE(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldLTE(FieldVector, v))
+}
+
+// VectorContains applies the Contains predicate on the "vector" field.
+func VectorContains(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldContains(FieldVector, v))
+}
+
+// VectorHasPrefix applies the HasPrefix predicate on the "vector" field.
+func VectorHasPrefix(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldHasPrefix(FieldVector, v))
+}
+
+// VectorHasSuffix applies the HasSuffix predicate on the "vector" field.
+func VectorHasSuffix(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldHasSuffix(FieldVector, v))
+}
+
+// VectorIsNil applies the IsNil predicate on the "vector" field.
+func VectorIsNil() predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldIsNull(FieldVector))
+}
+
+// VectorNotNil applies the NotNil predicate on the "vector" field.
+func VectorNotNil() predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldNotNull(FieldVector))
+}
+
+// VectorEqualFold applies the EqualFold predicate on the "vector" field.
+func VectorEqualFold(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldEqualFold(FieldVector, v))
+}
+
+// VectorContainsFold applies the ContainsFold predicate on the "vector" field.
+func VectorContainsFold(v string) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldContainsFold(FieldVector, v))
+}
+
+// VisibleEQ applies the EQ predicate on the "visible" field.
+func VisibleEQ(v bool) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldEQ(FieldVisible, v))
+}
+
+// VisibleNEQ applies the NEQ predicate on the "visible" field.
+func VisibleNEQ(v bool) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldNEQ(FieldVisible, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SearchDocument) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SearchDocument) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SearchDocument) predicate.SearchDocument {
+	return predicate.SearchDocument(sql.NotPredicates(p))
+}
@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/searchdocument"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SearchDocumentCreate is the builder for creating a SearchDocument entity.
+type SearchDocumentCreate struct {
+	config
+	mutation *SearchDocumentMutation
+	hooks    []Hook
+}
+
+// SetDocType sets the "doc_type" field.
+func (sdc *SearchDocumentCreate) SetDocType(st searchdocument.DocType) *SearchDocumentCreate {
+	sdc.mutation.SetDocType(st)
+	return sdc
+}
+
+// SetDocID sets the "doc_id" field.
+func (sdc *SearchDocumentCreate) SetDocID(i int) *SearchDocumentCreate {
+	sdc.mutation.SetDocID(i)
+	return sdc
+}
+
+// SetTitle sets the "title" field.
+func (sdc *SearchDocumentCreate) SetTitle(s string) *SearchDocumentCreate {
+	sdc.mutation.SetTitle(s)
+	return sdc
+}
+
+// SetContent sets the "content" field.
+func (sdc *SearchDocumentCreate) SetContent(s string) *SearchDocumentCreate {
+	sdc.mutation.SetContent(s)
+	return sdc
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (sdc *SearchDocumentCreate) SetNillableContent(s *string) *SearchDocumentCreate {
+	if s != nil {
+		sdc.SetContent(*s)
+	}
+	return sdc
+}
+
+// SetVector sets the "vector" field.
+func (sdc *SearchDocumentCreate) SetVector(s string) *SearchDocumentCreate {
+	sdc.mutation.SetVector(s)
+	return sdc
+}
+
+// SetNillableVector sets the "vector" field if the given value is not nil.
+func (sdc *SearchDocumentCreate) SetNillableVector(s *string) *SearchDocumentCreate {
+	if s != nil {
+		sdc.SetVector(*s)
+	}
+	return sdc
+}
+
+// SetVisible sets the "visible" field.
+func (sdc *SearchDocumentCreate) SetVisible(b bool) *SearchDocumentCreate {
+	sdc.mutation.SetVisible(b)
+	return sdc
+}
+
+// SetNillableVisible sets the "visible" field if the given value is not nil.
+func (sdc *SearchDocumentCreate) SetNillableVisible(b *bool) *SearchDocumentCreate {
+	if b != nil {
+		sdc.SetVisible(*b)
+	}
+	return sdc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sdc *SearchDocumentCreate) SetUpdatedAt(t time.Time) *SearchDocumentCreate {
+	sdc.mutation.SetUpdatedAt(t)
+	return sdc
+}
+
+// Mutation returns the SearchDocumentMutation object of the builder.
+func (sdc *SearchDocumentCreate) Mutation() *SearchDocumentMutation {
+	return sdc.mutation
+}
+
+// Save creates the SearchDocument in the database.
+func (sdc *SearchDocumentCreate) Save(ctx context.Context) (*SearchDocument, error) {
+	sdc.defaults()
+	return withHooks(ctx, sdc.sqlSave, sdc.mutation, sdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sdc *SearchDocumentCreate) SaveX(ctx context.Context) *SearchDocument {
+	v, err := sdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sdc *SearchDocumentCreate) Exec(ctx context.Context) error {
+	_, err := sdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdc *SearchDocumentCreate) ExecX(ctx context.Context) {
+	if err := sdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sdc *SearchDocumentCreate) defaults() {
+	if _, ok := sdc.mutation.Visible(); !ok {
+		v := searchdocument.DefaultVisible
+		sdc.mutation.SetVisible(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sdc *SearchDocumentCreate) check() error {
+	if _, ok := sdc.mutation.DocType(); !ok {
+		return &ValidationError{Name: "doc_type", err: errors.New(`ent: missing required field "SearchDocument.doc_type"`)}
+	}
+	if v, ok := sdc.mutation.DocType(); ok {
+		if err := searchdocument.DocTypeValidator(v); err != nil {
+			return &ValidationError{Name: "doc_type", err: fmt.Errorf(`ent: validator failed for field "SearchDocument.doc_type": %w`, err)}
+		}
+	}
+	if _, ok := sdc.mutation.DocID(); !ok {
+		return &ValidationError{Name: "doc_id", err: errors.New(`ent: missing required field "SearchDocument.doc_id"`)}
+	}
+	if _, ok := sdc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "SearchDocument.title"`)}
+	}
+	if _, ok := sdc.mutation.Visible(); !ok {
+		return &ValidationError{Name: "visible", err: errors.New(`ent: missing required field "SearchDocument.visible"`)}
+	}
+	if _, ok := sdc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SearchDocument.updated_at"`)}
+	}
+	return nil
+}
+
+func (sdc *SearchDocumentCreate) sqlSave(ctx context.Context) (*SearchDocument, error) {
+	if err := sdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sdc.mutation.id = &_node.ID
+	sdc.mutation.done = true
+	return _node, nil
+}
+
+func (sdc *SearchDocumentCreate) createSpec() (*SearchDocument, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SearchDocument{config: sdc.config}
+		_spec = sqlgraph.NewCreateSpec(searchdocument.Table, sqlgraph.NewFieldSpec(searchdocument.FieldID, field.TypeInt))
+	)
+	if value, ok := sdc.mutation.DocType(); ok {
+		_spec.SetField(searchdocument.FieldDocType, field.TypeEnum, value)
+		_node.DocType = value
+	}
+	if value, ok := sdc.mutation.DocID(); ok {
+		_spec.SetField(searchdocument.FieldDocID, field.TypeInt, value)
+		_node.DocID = value
+	}
+	if value, ok := sdc.mutation.Title(); ok {
+		_spec.SetField(searchdocument.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := sdc.mutation.Content(); ok {
+		_spec.SetField(searchdocument.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := sdc.mutation.Vector(); ok {
+		_spec.SetField(searchdocument.FieldVector, field.TypeString, value)
+		_node.Vector = value
+	}
+	if value, ok := sdc.mutation.Visible(); ok {
+		_spec.SetField(searchdocument.FieldVisible, field.TypeBool, value)
+		_node.Visible = value
+	}
+	if value, ok := sdc.mutation.UpdatedAt(); ok {
+		_spec.SetField(searchdocument.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// SearchDocumentCreateBulk is the builder for creating many SearchDocument entities in bulk.
+type SearchDocumentCreateBulk struct {
+	config
+	err      error
+	builders []*SearchDocumentCreate
+}
+
+// Save creates the SearchDocument entities in the database.
+func (sdcb *SearchDocumentCreateBulk) Save(ctx context.Context) ([]*SearchDocument, error) {
+	if sdcb.err != nil {
+		return nil, sdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sdcb.builders))
+	nodes := make([]*SearchDocument, len(sdcb.builders))
+	mutators := make([]Mutator, len(sdcb.builders))
+	for i := range sdcb.builders {
+		func(i int, root context.Context) {
+			builder := sdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SearchDocumentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sdcb *SearchDocumentCreateBulk) SaveX(ctx context.Context) []*SearchDocument {
+	v, err := sdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sdcb *SearchDocumentCreateBulk) Exec(ctx context.Context) error {
+	_, err := sdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdcb *SearchDocumentCreateBulk) ExecX(ctx context.Context) {
+	if err := sdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/predicate"
+	"blog-go/ent/searchdocument"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SearchDocumentDelete is the builder for deleting a SearchDocument entity.
+type SearchDocumentDelete struct {
+	config
+	hooks    []Hook
+	mutation *SearchDocumentMutation
+}
+
+// Where appends a list predicates to the SearchDocumentDelete builder.
+func (sdd *SearchDocumentDelete) Where(ps ...predicate.SearchDocument) *SearchDocumentDelete {
+	sdd.mutation.Where(ps...)
+	return sdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sdd *SearchDocumentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sdd.sqlExec, sdd.mutation, sdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdd *SearchDocumentDelete) ExecX(ctx context.Context) int {
+	n, err := sdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sdd *SearchDocumentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(searchdocument.Table, sqlgraph.NewFieldSpec(searchdocument.FieldID, field.TypeInt))
+	if ps := sdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sdd.mutation.done = true
+	return affected, err
+}
+
+// SearchDocumentDeleteOne is the builder for deleting a single SearchDocument entity.
+type SearchDocumentDeleteOne struct {
+	sdd *SearchDocumentDelete
+}
+
+// Where appends a list predicates to the SearchDocumentDelete builder.
+func (sddo *SearchDocumentDeleteOne) Where(ps ...predicate.SearchDocument) *SearchDocumentDeleteOne {
+	sddo.sdd.mutation.Where(ps...)
+	return sddo
+}
+
+// Exec executes the deletion query.
+func (sddo *SearchDocumentDeleteOne) Exec(ctx context.Context) error {
+	n, err := sddo.sdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{searchdocument.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sddo *SearchDocumentDeleteOne) ExecX(ctx context.Context) {
+	if err := sddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
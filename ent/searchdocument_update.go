@@ -0,0 +1,496 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/predicate"
+	"blog-go/ent/searchdocument"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SearchDocumentUpdate is the builder for updating SearchDocument entities.
+type SearchDocumentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SearchDocumentMutation
+}
+
+// Where appends a list predicates to the SearchDocumentUpdate builder.
+func (sdu *SearchDocumentUpdate) Where(ps ...predicate.SearchDocument) *SearchDocumentUpdate {
+	sdu.mutation.Where(ps...)
+	return sdu
+}
+
+// SetDocType sets the "doc_type" field.
+func (sdu *SearchDocumentUpdate) SetDocType(st searchdocument.DocType) *SearchDocumentUpdate {
+	sdu.mutation.SetDocType(st)
+	return sdu
+}
+
+// SetNillableDocType sets the "doc_type" field if the given value is not nil.
+func (sdu *SearchDocumentUpdate) SetNillableDocType(st *searchdocument.DocType) *SearchDocumentUpdate {
+	if st != nil {
+		sdu.SetDocType(*st)
+	}
+	return sdu
+}
+
+// SetDocID sets the "doc_id" field.
+func (sdu *SearchDocumentUpdate) SetDocID(i int) *SearchDocumentUpdate {
+	sdu.mutation.ResetDocID()
+	sdu.mutation.SetDocID(i)
+	return sdu
+}
+
+// SetNillableDocID sets the "doc_id" field if the given value is not nil.
+func (sdu *SearchDocumentUpdate) SetNillableDocID(i *int) *SearchDocumentUpdate {
+	if i != nil {
+		sdu.SetDocID(*i)
+	}
+	return sdu
+}
+
+// AddDocID adds i to the "doc_id" field.
+func (sdu *SearchDocumentUpdate) AddDocID(i int) *SearchDocumentUpdate {
+	sdu.mutation.AddDocID(i)
+	return sdu
+}
+
+// SetTitle sets the "title" field.
+func (sdu *SearchDocumentUpdate) SetTitle(s string) *SearchDocumentUpdate {
+	sdu.mutation.SetTitle(s)
+	return sdu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (sdu *SearchDocumentUpdate) SetNillableTitle(s *string) *SearchDocumentUpdate {
+	if s != nil {
+		sdu.SetTitle(*s)
+	}
+	return sdu
+}
+
+// SetContent sets the "content" field.
+func (sdu *SearchDocumentUpdate) SetContent(s string) *SearchDocumentUpdate {
+	sdu.mutation.SetContent(s)
+	return sdu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (sdu *SearchDocumentUpdate) SetNillableContent(s *string) *SearchDocumentUpdate {
+	if s != nil {
+		sdu.SetContent(*s)
+	}
+	return sdu
+}
+
+// ClearContent clears the value of the "content" field.
+func (sdu *SearchDocumentUpdate) ClearContent() *SearchDocumentUpdate {
+	sdu.mutation.ClearContent()
+	return sdu
+}
+
+// SetVector sets the "vector" field.
+func (sdu *SearchDocumentUpdate) SetVector(s string) *SearchDocumentUpdate {
+	sdu.mutation.SetVector(s)
+	return sdu
+}
+
+// SetNillableVector sets the "vector" field if the given value is not nil.
+func (sdu *SearchDocumentUpdate) SetNillableVector(s *string) *SearchDocumentUpdate {
+	if s != nil {
+		sdu.SetVector(*s)
+	}
+	return sdu
+}
+
+// ClearVector clears the value of the "vector" field.
+func (sdu *SearchDocumentUpdate) ClearVector() *SearchDocumentUpdate {
+	sdu.mutation.ClearVector()
+	return sdu
+}
+
+// SetVisible sets the "visible" field.
+func (sdu *SearchDocumentUpdate) SetVisible(b bool) *SearchDocumentUpdate {
+	sdu.mutation.SetVisible(b)
+	return sdu
+}
+
+// SetNillableVisible sets the "visible" field if the given value is not nil.
+func (sdu *SearchDocumentUpdate) SetNillableVisible(b *bool) *SearchDocumentUpdate {
+	if b != nil {
+		sdu.SetVisible(*b)
+	}
+	return sdu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sdu *SearchDocumentUpdate) SetUpdatedAt(t time.Time) *SearchDocumentUpdate {
+	sdu.mutation.SetUpdatedAt(t)
+	return sdu
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sdu *SearchDocumentUpdate) SetNillableUpdatedAt(t *time.Time) *SearchDocumentUpdate {
+	if t != nil {
+		sdu.SetUpdatedAt(*t)
+	}
+	return sdu
+}
+
+// Mutation returns the SearchDocumentMutation object of the builder.
+func (sdu *SearchDocumentUpdate) Mutation() *SearchDocumentMutation {
+	return sdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sdu *SearchDocumentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sdu.sqlSave, sdu.mutation, sdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sdu *SearchDocumentUpdate) SaveX(ctx context.Context) int {
+	affected, err := sdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sdu *SearchDocumentUpdate) Exec(ctx context.Context) error {
+	_, err := sdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sdu *SearchDocumentUpdate) ExecX(ctx context.Context) {
+	if err := sdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sdu *SearchDocumentUpdate) check() error {
+	if v, ok := sdu.mutation.DocType(); ok {
+		if err := searchdocument.DocTypeValidator(v); err != nil {
+			return &ValidationError{Name: "doc_type", err: fmt.Errorf(`ent: validator failed for field "SearchDocument.doc_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sdu *SearchDocumentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(searchdocument.Table, searchdocument.Columns, sqlgraph.NewFieldSpec(searchdocument.FieldID, field.TypeInt))
+	if ps := sdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sdu.mutation.DocType(); ok {
+		_spec.SetField(searchdocument.FieldDocType, field.TypeEnum, value)
+	}
+	if value, ok := sdu.mutation.DocID(); ok {
+		_spec.SetField(searchdocument.FieldDocID, field.TypeInt, value)
+	}
+	if value, ok := sdu.mutation.AddedDocID(); ok {
+		_spec.AddField(searchdocument.FieldDocID, field.TypeInt, value)
+	}
+	if value, ok := sdu.mutation.Title(); ok {
+		_spec.SetField(searchdocument.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := sdu.mutation.Content(); ok {
+		_spec.SetField(searchdocument.FieldContent, field.TypeString, value)
+	}
+	if sdu.mutation.ContentCleared() {
+		_spec.ClearField(searchdocument.FieldContent, field.TypeString)
+	}
+	if value, ok := sdu.mutation.Vector(); ok {
+		_spec.SetField(searchdocument.FieldVector, field.TypeString, value)
+	}
+	if sdu.mutation.VectorCleared() {
+		_spec.ClearField(searchdocument.FieldVector, field.TypeString)
+	}
+	if value, ok := sdu.mutation.Visible(); ok {
+		_spec.SetField(searchdocument.FieldVisible, field.TypeBool, value)
+	}
+	if value, ok := sdu.mutation.UpdatedAt(); ok {
+		_spec.SetField(searchdocument.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{searchdocument.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sdu.mutation.done = true
+	return n, nil
+}
+
+// SearchDocumentUpdateOne is the builder for updating a single SearchDocument entity.
+type SearchDocumentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SearchDocumentMutation
+}
+
+// SetDocType sets the "doc_type" field.
+func (sduo *SearchDocumentUpdateOne) SetDocType(st searchdocument.DocType) *SearchDocumentUpdateOne {
+	sduo.mutation.SetDocType(st)
+	return sduo
+}
+
+// SetNillableDocType sets the "doc_type" field if the given value is not nil.
+func (sduo *SearchDocumentUpdateOne) SetNillableDocType(st *searchdocument.DocType) *SearchDocumentUpdateOne {
+	if st != nil {
+		sduo.SetDocType(*st)
+	}
+	return sduo
+}
+
+// SetDocID sets the "doc_id" field.
+func (sduo *SearchDocumentUpdateOne) SetDocID(i int) *SearchDocumentUpdateOne {
+	sduo.mutation.ResetDocID()
+	sduo.mutation.SetDocID(i)
+	return sduo
+}
+
+// SetNillableDocID sets the "doc_id" field if the given value is not nil.
+func (sduo *SearchDocumentUpdateOne) SetNillableDocID(i *int) *SearchDocumentUpdateOne {
+	if i != nil {
+		sduo.SetDocID(*i)
+	}
+	return sduo
+}
+
+// AddDocID adds i to the "doc_id" field.
+func (sduo *SearchDocumentUpdateOne) AddDocID(i int) *SearchDocumentUpdateOne {
+	sduo.mutation.AddDocID(i)
+	return sduo
+}
+
+// SetTitle sets the "title" field.
+func (sduo *SearchDocumentUpdateOne) SetTitle(s string) *SearchDocumentUpdateOne {
+	sduo.mutation.SetTitle(s)
+	return sduo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (sduo *SearchDocumentUpdateOne) SetNillableTitle(s *string) *SearchDocumentUpdateOne {
+	if s != nil {
+		sduo.SetTitle(*s)
+	}
+	return sduo
+}
+
+// SetContent sets the "content" field.
+func (sduo *SearchDocumentUpdateOne) SetContent(s string) *SearchDocumentUpdateOne {
+	sduo.mutation.SetContent(s)
+	return sduo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (sduo *SearchDocumentUpdateOne) SetNillableContent(s *string) *SearchDocumentUpdateOne {
+	if s != nil {
+		sduo.SetContent(*s)
+	}
+	return sduo
+}
+
+// ClearContent clears the value of the "content" field.
+func (sduo *SearchDocumentUpdateOne) ClearContent() *SearchDocumentUpdateOne {
+	sduo.mutation.ClearContent()
+	return sduo
+}
+
+// SetVector sets the "vector" field.
+func (sduo *SearchDocumentUpdateOne) SetVector(s string) *SearchDocumentUpdateOne {
+	sduo.mutation.SetVector(s)
+	return sduo
+}
+
+// SetNillableVector sets the "vector" field if the given value is not nil.
+func (sduo *SearchDocumentUpdateOne) SetNillableVector(s *string) *SearchDocumentUpdateOne {
+	if s != nil {
+		sduo.SetVector(*s)
+	}
+	return sduo
+}
+
+// ClearVector clears the value of the "vector" field.
+func (sduo *SearchDocumentUpdateOne) ClearVector() *SearchDocumentUpdateOne {
+	sduo.mutation.ClearVector()
+	return sduo
+}
+
+// SetVisible sets the "visible" field.
+func (sduo *SearchDocumentUpdateOne) SetVisible(b bool) *SearchDocumentUpdateOne {
+	sduo.mutation.SetVisible(b)
+	return sduo
+}
+
+// SetNillableVisible sets the "visible" field if the given value is not nil.
+func (sduo *SearchDocumentUpdateOne) SetNillableVisible(b *bool) *SearchDocumentUpdateOne {
+	if b != nil {
+		sduo.SetVisible(*b)
+	}
+	return sduo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sduo *SearchDocumentUpdateOne) SetUpdatedAt(t time.Time) *SearchDocumentUpdateOne {
+	sduo.mutation.SetUpdatedAt(t)
+	return sduo
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sduo *SearchDocumentUpdateOne) SetNillableUpdatedAt(t *time.Time) *SearchDocumentUpdateOne {
+	if t != nil {
+		sduo.SetUpdatedAt(*t)
+	}
+	return sduo
+}
+
+// Mutation returns the SearchDocumentMutation object of the builder.
+func (sduo *SearchDocumentUpdateOne) Mutation() *SearchDocumentMutation {
+	return sduo.mutation
+}
+
+// Where appends a list predicates to the SearchDocumentUpdate builder.
+func (sduo *SearchDocumentUpdateOne) Where(ps ...predicate.SearchDocument) *SearchDocumentUpdateOne {
+	sduo.mutation.Where(ps...)
+	return sduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sduo *SearchDocumentUpdateOne) Select(field string, fields ...string) *SearchDocumentUpdateOne {
+	sduo.fields = append([]string{field}, fields...)
+	return sduo
+}
+
+// Save executes the query and returns the updated SearchDocument entity.
+func (sduo *SearchDocumentUpdateOne) Save(ctx context.Context) (*SearchDocument, error) {
+	return withHooks(ctx, sduo.sqlSave, sduo.mutation, sduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sduo *SearchDocumentUpdateOne) SaveX(ctx context.Context) *SearchDocument {
+	node, err := sduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sduo *SearchDocumentUpdateOne) Exec(ctx context.Context) error {
+	_, err := sduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sduo *SearchDocumentUpdateOne) ExecX(ctx context.Context) {
+	if err := sduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sduo *SearchDocumentUpdateOne) check() error {
+	if v, ok := sduo.mutation.DocType(); ok {
+		if err := searchdocument.DocTypeValidator(v); err != nil {
+			return &ValidationError{Name: "doc_type", err: fmt.Errorf(`ent: validator failed for field "SearchDocument.doc_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sduo *SearchDocumentUpdateOne) sqlSave(ctx context.Context) (_node *SearchDocument, err error) {
+	if err := sduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(searchdocument.Table, searchdocument.Columns, sqlgraph.NewFieldSpec(searchdocument.FieldID, field.TypeInt))
+	id, ok := sduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SearchDocument.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, searchdocument.FieldID)
+		for _, f := range fields {
+			if !searchdocument.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != searchdocument.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sduo.mutation.DocType(); ok {
+		_spec.SetField(searchdocument.FieldDocType, field.TypeEnum, value)
+	}
+	if value, ok := sduo.mutation.DocID(); ok {
+		_spec.SetField(searchdocument.FieldDocID, field.TypeInt, value)
+	}
+	if value, ok := sduo.mutation.AddedDocID(); ok {
+		_spec.AddField(searchdocument.FieldDocID, field.TypeInt, value)
+	}
+	if value, ok := sduo.mutation.Title(); ok {
+		_spec.SetField(searchdocument.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := sduo.mutation.Content(); ok {
+		_spec.SetField(searchdocument.FieldContent, field.TypeString, value)
+	}
+	if sduo.mutation.ContentCleared() {
+		_spec.ClearField(searchdocument.FieldContent, field.TypeString)
+	}
+	if value, ok := sduo.mutation.Vector(); ok {
+		_spec.SetField(searchdocument.FieldVector, field.TypeString, value)
+	}
+	if sduo.mutation.VectorCleared() {
+		_spec.ClearField(searchdocument.FieldVector, field.TypeString)
+	}
+	if value, ok := sduo.mutation.Visible(); ok {
+		_spec.SetField(searchdocument.FieldVisible, field.TypeBool, value)
+	}
+	if value, ok := sduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(searchdocument.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &SearchDocument{config: sduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{searchdocument.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sduo.mutation.done = true
+	return _node, nil
+}
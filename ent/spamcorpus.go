@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/spamcorpus"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SpamCorpus is the model entity for the SpamCorpus schema.
+type SpamCorpus struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Class holds the value of the "class" field.
+	Class spamcorpus.Class `json:"class,omitempty"`
+	// Documents holds the value of the "documents" field.
+	Documents    int `json:"documents,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SpamCorpus) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case spamcorpus.FieldID, spamcorpus.FieldDocuments:
+			values[i] = new(sql.NullInt64)
+		case spamcorpus.FieldClass:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SpamCorpus fields.
+func (sc *SpamCorpus) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case spamcorpus.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sc.ID = int(value.Int64)
+		case spamcorpus.FieldClass:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field class", values[i])
+			} else if value.Valid {
+				sc.Class = spamcorpus.Class(value.String)
+			}
+		case spamcorpus.FieldDocuments:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field documents", values[i])
+			} else if value.Valid {
+				sc.Documents = int(value.Int64)
+			}
+		default:
+			sc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SpamCorpus.
+// This includes values selected through modifiers, order, etc.
+func (sc *SpamCorpus) Value(name string) (ent.Value, error) {
+	return sc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SpamCorpus.
+// Note that you need to call SpamCorpus.Unwrap() before calling this method if this SpamCorpus
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sc *SpamCorpus) Update() *SpamCorpusUpdateOne {
+	return NewSpamCorpusClient(sc.config).UpdateOne(sc)
+}
+
+// Unwrap unwraps the SpamCorpus entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sc *SpamCorpus) Unwrap() *SpamCorpus {
+	_tx, ok := sc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SpamCorpus is not a transactional entity")
+	}
+	sc.config.driver = _tx.drv
+	return sc
+}
+
+// String implements the fmt.Stringer.
+func (sc *SpamCorpus) String() string {
+	var builder strings.Builder
+	builder.WriteString("SpamCorpus(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sc.ID))
+	builder.WriteString("class=")
+	builder.WriteString(fmt.Sprintf("%v", sc.Class))
+	builder.WriteString(", ")
+	builder.WriteString("documents=")
+	builder.WriteString(fmt.Sprintf("%v", sc.Documents))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SpamCorpusSlice is a parsable slice of SpamCorpus.
+type SpamCorpusSlice []*SpamCorpus
@@ -0,0 +1,85 @@
+// Code generated by ent, DO NOT EDIT.
+
+package spamcorpus
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the spamcorpus type in the database.
+	Label = "spam_corpus"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldClass holds the string denoting the class field in the database.
+	FieldClass = "class"
+	// FieldDocuments holds the string denoting the documents field in the database.
+	FieldDocuments = "documents"
+	// Table holds the table name of the spamcorpus in the database.
+	Table = "spam_corpus"
+)
+
+// Columns holds all SQL columns for spamcorpus fields.
+var Columns = []string{
+	FieldID,
+	FieldClass,
+	FieldDocuments,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDocuments holds the default value on creation for the "documents" field.
+	DefaultDocuments int
+)
+
+// Class defines the type for the "class" enum field.
+type Class string
+
+// Class values.
+const (
+	ClassSpam Class = "spam"
+	ClassHam  Class = "ham"
+)
+
+func (c Class) String() string {
+	return string(c)
+}
+
+// ClassValidator is a validator for the "class" field enum values. It is called by the builders before save.
+func ClassValidator(c Class) error {
+	switch c {
+	case ClassSpam, ClassHam:
+		return nil
+	default:
+		return fmt.Errorf("spamcorpus: invalid enum value for class field: %q", c)
+	}
+}
+
+// OrderOption defines the ordering options for the SpamCorpus queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByClass orders the results by the class field.
+func ByClass(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClass, opts...).ToFunc()
+}
+
+// ByDocuments orders the results by the documents field.
+func ByDocuments(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocuments, opts...).ToFunc()
+}
@@ -0,0 +1,134 @@
+// Code generated by ent, DO NOT EDIT.
+
+package spamcorpus
+
+import (
+	"blog-go/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldLTE(FieldID, id))
+}
+
+// Documents applies equality check predicate on the "documents" field. It's identical to DocumentsEQ.
+func Documents(v int) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldEQ(FieldDocuments, v))
+}
+
+// ClassEQ applies the EQ predicate on the "class" field.
+func ClassEQ(v Class) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldEQ(FieldClass, v))
+}
+
+// ClassNEQ applies the NEQ predicate on the "class" field.
+func ClassNEQ(v Class) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldNEQ(FieldClass, v))
+}
+
+// ClassIn applies the In predicate on the "class" field.
+func ClassIn(vs ...Class) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldIn(FieldClass, vs...))
+}
+
+// ClassNotIn applies the NotIn predicate on the "class" field.
+func ClassNotIn(vs ...Class) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldNotIn(FieldClass, vs...))
+}
+
+// DocumentsEQ applies the EQ predicate on the "documents" field.
+func DocumentsEQ(v int) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldEQ(FieldDocuments, v))
+}
+
+// DocumentsNEQ applies the NEQ predicate on the "documents" field.
+func DocumentsNEQ(v int) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldNEQ(FieldDocuments, v))
+}
+
+// DocumentsIn applies the In predicate on the "documents" field.
+func DocumentsIn(vs ...int) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldIn(FieldDocuments, vs...))
+}
+
+// DocumentsNotIn applies the NotIn predicate on the "documents" field.
+func DocumentsNotIn(vs ...int) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldNotIn(FieldDocuments, vs...))
+}
+
+// DocumentsGT applies the GT predicate on the "documents" field.
+func DocumentsGT(v int) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldGT(FieldDocuments, v))
+}
+
+// DocumentsGTE applies the GTE predicate on the "documents" field.
+func DocumentsGTE(v int) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldGTE(FieldDocuments, v))
+}
+
+// DocumentsLT applies the LT predicate on the "documents" field.
+func DocumentsLT(v int) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldLT(FieldDocuments, v))
+}
+
+// DocumentsLTE applies the LTE predicate on the "documents" field.
+func DocumentsLTE(v int) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.FieldLTE(FieldDocuments, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SpamCorpus) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SpamCorpus) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SpamCorpus) predicate.SpamCorpus {
+	return predicate.SpamCorpus(sql.NotPredicates(p))
+}
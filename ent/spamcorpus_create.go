@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/spamcorpus"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SpamCorpusCreate is the builder for creating a SpamCorpus entity.
+type SpamCorpusCreate struct {
+	config
+	mutation *SpamCorpusMutation
+	hooks    []Hook
+}
+
+// SetClass sets the "class" field.
+func (scc *SpamCorpusCreate) SetClass(s spamcorpus.Class) *SpamCorpusCreate {
+	scc.mutation.SetClass(s)
+	return scc
+}
+
+// SetDocuments sets the "documents" field.
+func (scc *SpamCorpusCreate) SetDocuments(i int) *SpamCorpusCreate {
+	scc.mutation.SetDocuments(i)
+	return scc
+}
+
+// SetNillableDocuments sets the "documents" field if the given value is not nil.
+func (scc *SpamCorpusCreate) SetNillableDocuments(i *int) *SpamCorpusCreate {
+	if i != nil {
+		scc.SetDocuments(*i)
+	}
+	return scc
+}
+
+// Mutation returns the SpamCorpusMutation object of the builder.
+func (scc *SpamCorpusCreate) Mutation() *SpamCorpusMutation {
+	return scc.mutation
+}
+
+// Save creates the SpamCorpus in the database.
+func (scc *SpamCorpusCreate) Save(ctx context.Context) (*SpamCorpus, error) {
+	scc.defaults()
+	return withHooks(ctx, scc.sqlSave, scc.mutation, scc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (scc *SpamCorpusCreate) SaveX(ctx context.Context) *SpamCorpus {
+	v, err := scc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scc *SpamCorpusCreate) Exec(ctx context.Context) error {
+	_, err := scc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scc *SpamCorpusCreate) ExecX(ctx context.Context) {
+	if err := scc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (scc *SpamCorpusCreate) defaults() {
+	if _, ok := scc.mutation.Documents(); !ok {
+		v := spamcorpus.DefaultDocuments
+		scc.mutation.SetDocuments(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scc *SpamCorpusCreate) check() error {
+	if _, ok := scc.mutation.Class(); !ok {
+		return &ValidationError{Name: "class", err: errors.New(`ent: missing required field "SpamCorpus.class"`)}
+	}
+	if v, ok := scc.mutation.Class(); ok {
+		if err := spamcorpus.ClassValidator(v); err != nil {
+			return &ValidationError{Name: "class", err: fmt.Errorf(`ent: validator failed for field "SpamCorpus.class": %w`, err)}
+		}
+	}
+	if _, ok := scc.mutation.Documents(); !ok {
+		return &ValidationError{Name: "documents", err: errors.New(`ent: missing required field "SpamCorpus.documents"`)}
+	}
+	return nil
+}
+
+func (scc *SpamCorpusCreate) sqlSave(ctx context.Context) (*SpamCorpus, error) {
+	if err := scc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := scc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, scc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	scc.mutation.id = &_node.ID
+	scc.mutation.done = true
+	return _node, nil
+}
+
+func (scc *SpamCorpusCreate) createSpec() (*SpamCorpus, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SpamCorpus{config: scc.config}
+		_spec = sqlgraph.NewCreateSpec(spamcorpus.Table, sqlgraph.NewFieldSpec(spamcorpus.FieldID, field.TypeInt))
+	)
+	if value, ok := scc.mutation.Class(); ok {
+		_spec.SetField(spamcorpus.FieldClass, field.TypeEnum, value)
+		_node.Class = value
+	}
+	if value, ok := scc.mutation.Documents(); ok {
+		_spec.SetField(spamcorpus.FieldDocuments, field.TypeInt, value)
+		_node.Documents = value
+	}
+	return _node, _spec
+}
+
+// SpamCorpusCreateBulk is the builder for creating many SpamCorpus entities in bulk.
+type SpamCorpusCreateBulk struct {
+	config
+	err      error
+	builders []*SpamCorpusCreate
+}
+
+// Save creates the SpamCorpus entities in the database.
+func (sccb *SpamCorpusCreateBulk) Save(ctx context.Context) ([]*SpamCorpus, error) {
+	if sccb.err != nil {
+		return nil, sccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sccb.builders))
+	nodes := make([]*SpamCorpus, len(sccb.builders))
+	mutators := make([]Mutator, len(sccb.builders))
+	for i := range sccb.builders {
+		func(i int, root context.Context) {
+			builder := sccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SpamCorpusMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sccb *SpamCorpusCreateBulk) SaveX(ctx context.Context) []*SpamCorpus {
+	v, err := sccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sccb *SpamCorpusCreateBulk) Exec(ctx context.Context) error {
+	_, err := sccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sccb *SpamCorpusCreateBulk) ExecX(ctx context.Context) {
+	if err := sccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
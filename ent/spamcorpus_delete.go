@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/predicate"
+	"blog-go/ent/spamcorpus"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SpamCorpusDelete is the builder for deleting a SpamCorpus entity.
+type SpamCorpusDelete struct {
+	config
+	hooks    []Hook
+	mutation *SpamCorpusMutation
+}
+
+// Where appends a list predicates to the SpamCorpusDelete builder.
+func (scd *SpamCorpusDelete) Where(ps ...predicate.SpamCorpus) *SpamCorpusDelete {
+	scd.mutation.Where(ps...)
+	return scd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (scd *SpamCorpusDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, scd.sqlExec, scd.mutation, scd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scd *SpamCorpusDelete) ExecX(ctx context.Context) int {
+	n, err := scd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (scd *SpamCorpusDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(spamcorpus.Table, sqlgraph.NewFieldSpec(spamcorpus.FieldID, field.TypeInt))
+	if ps := scd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, scd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	scd.mutation.done = true
+	return affected, err
+}
+
+// SpamCorpusDeleteOne is the builder for deleting a single SpamCorpus entity.
+type SpamCorpusDeleteOne struct {
+	scd *SpamCorpusDelete
+}
+
+// Where appends a list predicates to the SpamCorpusDelete builder.
+func (scdo *SpamCorpusDeleteOne) Where(ps ...predicate.SpamCorpus) *SpamCorpusDeleteOne {
+	scdo.scd.mutation.Where(ps...)
+	return scdo
+}
+
+// Exec executes the deletion query.
+func (scdo *SpamCorpusDeleteOne) Exec(ctx context.Context) error {
+	n, err := scdo.scd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{spamcorpus.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scdo *SpamCorpusDeleteOne) ExecX(ctx context.Context) {
+	if err := scdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/predicate"
+	"blog-go/ent/spamcorpus"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SpamCorpusUpdate is the builder for updating SpamCorpus entities.
+type SpamCorpusUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SpamCorpusMutation
+}
+
+// Where appends a list predicates to the SpamCorpusUpdate builder.
+func (scu *SpamCorpusUpdate) Where(ps ...predicate.SpamCorpus) *SpamCorpusUpdate {
+	scu.mutation.Where(ps...)
+	return scu
+}
+
+// SetClass sets the "class" field.
+func (scu *SpamCorpusUpdate) SetClass(s spamcorpus.Class) *SpamCorpusUpdate {
+	scu.mutation.SetClass(s)
+	return scu
+}
+
+// SetNillableClass sets the "class" field if the given value is not nil.
+func (scu *SpamCorpusUpdate) SetNillableClass(s *spamcorpus.Class) *SpamCorpusUpdate {
+	if s != nil {
+		scu.SetClass(*s)
+	}
+	return scu
+}
+
+// SetDocuments sets the "documents" field.
+func (scu *SpamCorpusUpdate) SetDocuments(i int) *SpamCorpusUpdate {
+	scu.mutation.ResetDocuments()
+	scu.mutation.SetDocuments(i)
+	return scu
+}
+
+// SetNillableDocuments sets the "documents" field if the given value is not nil.
+func (scu *SpamCorpusUpdate) SetNillableDocuments(i *int) *SpamCorpusUpdate {
+	if i != nil {
+		scu.SetDocuments(*i)
+	}
+	return scu
+}
+
+// AddDocuments adds i to the "documents" field.
+func (scu *SpamCorpusUpdate) AddDocuments(i int) *SpamCorpusUpdate {
+	scu.mutation.AddDocuments(i)
+	return scu
+}
+
+// Mutation returns the SpamCorpusMutation object of the builder.
+func (scu *SpamCorpusUpdate) Mutation() *SpamCorpusMutation {
+	return scu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (scu *SpamCorpusUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, scu.sqlSave, scu.mutation, scu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scu *SpamCorpusUpdate) SaveX(ctx context.Context) int {
+	affected, err := scu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (scu *SpamCorpusUpdate) Exec(ctx context.Context) error {
+	_, err := scu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scu *SpamCorpusUpdate) ExecX(ctx context.Context) {
+	if err := scu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scu *SpamCorpusUpdate) check() error {
+	if v, ok := scu.mutation.Class(); ok {
+		if err := spamcorpus.ClassValidator(v); err != nil {
+			return &ValidationError{Name: "class", err: fmt.Errorf(`ent: validator failed for field "SpamCorpus.class": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (scu *SpamCorpusUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := scu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(spamcorpus.Table, spamcorpus.Columns, sqlgraph.NewFieldSpec(spamcorpus.FieldID, field.TypeInt))
+	if ps := scu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scu.mutation.Class(); ok {
+		_spec.SetField(spamcorpus.FieldClass, field.TypeEnum, value)
+	}
+	if value, ok := scu.mutation.Documents(); ok {
+		_spec.SetField(spamcorpus.FieldDocuments, field.TypeInt, value)
+	}
+	if value, ok := scu.mutation.AddedDocuments(); ok {
+		_spec.AddField(spamcorpus.FieldDocuments, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, scu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{spamcorpus.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	scu.mutation.done = true
+	return n, nil
+}
+
+// SpamCorpusUpdateOne is the builder for updating a single SpamCorpus entity.
+type SpamCorpusUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SpamCorpusMutation
+}
+
+// SetClass sets the "class" field.
+func (scuo *SpamCorpusUpdateOne) SetClass(s spamcorpus.Class) *SpamCorpusUpdateOne {
+	scuo.mutation.SetClass(s)
+	return scuo
+}
+
+// SetNillableClass sets the "class" field if the given value is not nil.
+func (scuo *SpamCorpusUpdateOne) SetNillableClass(s *spamcorpus.Class) *SpamCorpusUpdateOne {
+	if s != nil {
+		scuo.SetClass(*s)
+	}
+	return scuo
+}
+
+// SetDocuments sets the "documents" field.
+func (scuo *SpamCorpusUpdateOne) SetDocuments(i int) *SpamCorpusUpdateOne {
+	scuo.mutation.ResetDocuments()
+	scuo.mutation.SetDocuments(i)
+	return scuo
+}
+
+// SetNillableDocuments sets the "documents" field if the given value is not nil.
+func (scuo *SpamCorpusUpdateOne) SetNillableDocuments(i *int) *SpamCorpusUpdateOne {
+	if i != nil {
+		scuo.SetDocuments(*i)
+	}
+	return scuo
+}
+
+// AddDocuments adds i to the "documents" field.
+func (scuo *SpamCorpusUpdateOne) AddDocuments(i int) *SpamCorpusUpdateOne {
+	scuo.mutation.AddDocuments(i)
+	return scuo
+}
+
+// Mutation returns the SpamCorpusMutation object of the builder.
+func (scuo *SpamCorpusUpdateOne) Mutation() *SpamCorpusMutation {
+	return scuo.mutation
+}
+
+// Where appends a list predicates to the SpamCorpusUpdate builder.
+func (scuo *SpamCorpusUpdateOne) Where(ps ...predicate.SpamCorpus) *SpamCorpusUpdateOne {
+	scuo.mutation.Where(ps...)
+	return scuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (scuo *SpamCorpusUpdateOne) Select(field string, fields ...string) *SpamCorpusUpdateOne {
+	scuo.fields = append([]string{field}, fields...)
+	return scuo
+}
+
+// Save executes the query and returns the updated SpamCorpus entity.
+func (scuo *SpamCorpusUpdateOne) Save(ctx context.Context) (*SpamCorpus, error) {
+	return withHooks(ctx, scuo.sqlSave, scuo.mutation, scuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scuo *SpamCorpusUpdateOne) SaveX(ctx context.Context) *SpamCorpus {
+	node, err := scuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (scuo *SpamCorpusUpdateOne) Exec(ctx context.Context) error {
+	_, err := scuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scuo *SpamCorpusUpdateOne) ExecX(ctx context.Context) {
+	if err := scuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (scuo *SpamCorpusUpdateOne) check() error {
+	if v, ok := scuo.mutation.Class(); ok {
+		if err := spamcorpus.ClassValidator(v); err != nil {
+			return &ValidationError{Name: "class", err: fmt.Errorf(`ent: validator failed for field "SpamCorpus.class": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (scuo *SpamCorpusUpdateOne) sqlSave(ctx context.Context) (_node *SpamCorpus, err error) {
+	if err := scuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(spamcorpus.Table, spamcorpus.Columns, sqlgraph.NewFieldSpec(spamcorpus.FieldID, field.TypeInt))
+	id, ok := scuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SpamCorpus.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := scuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, spamcorpus.FieldID)
+		for _, f := range fields {
+			if !spamcorpus.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != spamcorpus.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := scuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := scuo.mutation.Class(); ok {
+		_spec.SetField(spamcorpus.FieldClass, field.TypeEnum, value)
+	}
+	if value, ok := scuo.mutation.Documents(); ok {
+		_spec.SetField(spamcorpus.FieldDocuments, field.TypeInt, value)
+	}
+	if value, ok := scuo.mutation.AddedDocuments(); ok {
+		_spec.AddField(spamcorpus.FieldDocuments, field.TypeInt, value)
+	}
+	_node = &SpamCorpus{config: scuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, scuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{spamcorpus.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	scuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,72 @@
+// Code generated by ent, DO NOT EDIT.
+
+package spamtoken
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the spamtoken type in the database.
+	Label = "spam_token"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldToken holds the string denoting the token field in the database.
+	FieldToken = "token"
+	// FieldSpam holds the string denoting the spam field in the database.
+	FieldSpam = "spam"
+	// FieldHam holds the string denoting the ham field in the database.
+	FieldHam = "ham"
+	// Table holds the table name of the spamtoken in the database.
+	Table = "spam_tokens"
+)
+
+// Columns holds all SQL columns for spamtoken fields.
+var Columns = []string{
+	FieldID,
+	FieldToken,
+	FieldSpam,
+	FieldHam,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	TokenValidator func(string) error
+	// DefaultSpam holds the default value on creation for the "spam" field.
+	DefaultSpam int
+	// DefaultHam holds the default value on creation for the "ham" field.
+	DefaultHam int
+)
+
+// OrderOption defines the ordering options for the SpamToken queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByToken orders the results by the token field.
+func ByToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToken, opts...).ToFunc()
+}
+
+// BySpam orders the results by the spam field.
+func BySpam(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpam, opts...).ToFunc()
+}
+
+// ByHam orders the results by the ham field.
+func ByHam(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHam, opts...).ToFunc()
+}
@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package spamtoken
+
+import (
+	"blog-go/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldLTE(FieldID, id))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEQ(FieldToken, v))
+}
+
+// Spam applies equality check predicate on the "spam" field. It's identical to SpamEQ.
+func Spam(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEQ(FieldSpam, v))
+}
+
+// Ham applies equality check predicate on the "ham" field. It's identical to HamEQ.
+func Ham(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEQ(FieldHam, v))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldContainsFold(FieldToken, v))
+}
+
+// SpamEQ applies the EQ predicate on the "spam" field.
+func SpamEQ(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEQ(FieldSpam, v))
+}
+
+// SpamNEQ applies the NEQ predicate on the "spam" field.
+func SpamNEQ(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldNEQ(FieldSpam, v))
+}
+
+// SpamIn applies the In predicate on the "spam" field.
+func SpamIn(vs ...int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldIn(FieldSpam, vs...))
+}
+
+// SpamNotIn applies the NotIn predicate on the "spam" field.
+func SpamNotIn(vs ...int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldNotIn(FieldSpam, vs...))
+}
+
+// SpamGT applies the GT predicate on the "spam" field.
+func SpamGT(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldGT(FieldSpam, v))
+}
+
+// SpamGTE applies the GTE predicate on the "spam" field.
+func SpamGTE(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldGTE(FieldSpam, v))
+}
+
+// SpamLT applies the LT predicate on the "spam" field.
+func SpamLT(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldLT(FieldSpam, v))
+}
+
+// SpamLTE applies the LTE predicate on the "spam" field.
+func SpamLTE(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldLTE(FieldSpam, v))
+}
+
+// HamEQ applies the EQ predicate on the "ham" field.
+func HamEQ(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldEQ(FieldHam, v))
+}
+
+// HamNEQ applies the NEQ predicate on the "ham" field.
+func HamNEQ(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldNEQ(FieldHam, v))
+}
+
+// HamIn applies the In predicate on the "ham" field.
+func HamIn(vs ...int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldIn(FieldHam, vs...))
+}
+
+// HamNotIn applies the NotIn predicate on the "ham" field.
+func HamNotIn(vs ...int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldNotIn(FieldHam, vs...))
+}
+
+// HamGT applies the GT predicate on the "ham" field.
+func HamGT(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldGT(FieldHam, v))
+}
+
+// HamGTE applies the GTE predicate on the "ham" field.
+func HamGTE(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldGTE(FieldHam, v))
+}
+
+// HamLT applies the LT predicate on the "ham" field.
+func HamLT(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldLT(FieldHam, v))
+}
+
+// HamLTE applies the LTE predicate on the "ham" field.
+func HamLTE(v int) predicate.SpamToken {
+	return predicate.SpamToken(sql.FieldLTE(FieldHam, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SpamToken) predicate.SpamToken {
+	return predicate.SpamToken(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SpamToken) predicate.SpamToken {
+	return predicate.SpamToken(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SpamToken) predicate.SpamToken {
+	return predicate.SpamToken(sql.NotPredicates(p))
+}
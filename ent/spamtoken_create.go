@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/spamtoken"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SpamTokenCreate is the builder for creating a SpamToken entity.
+type SpamTokenCreate struct {
+	config
+	mutation *SpamTokenMutation
+	hooks    []Hook
+}
+
+// SetToken sets the "token" field.
+func (stc *SpamTokenCreate) SetToken(s string) *SpamTokenCreate {
+	stc.mutation.SetToken(s)
+	return stc
+}
+
+// SetSpam sets the "spam" field.
+func (stc *SpamTokenCreate) SetSpam(i int) *SpamTokenCreate {
+	stc.mutation.SetSpam(i)
+	return stc
+}
+
+// SetNillableSpam sets the "spam" field if the given value is not nil.
+func (stc *SpamTokenCreate) SetNillableSpam(i *int) *SpamTokenCreate {
+	if i != nil {
+		stc.SetSpam(*i)
+	}
+	return stc
+}
+
+// SetHam sets the "ham" field.
+func (stc *SpamTokenCreate) SetHam(i int) *SpamTokenCreate {
+	stc.mutation.SetHam(i)
+	return stc
+}
+
+// SetNillableHam sets the "ham" field if the given value is not nil.
+func (stc *SpamTokenCreate) SetNillableHam(i *int) *SpamTokenCreate {
+	if i != nil {
+		stc.SetHam(*i)
+	}
+	return stc
+}
+
+// Mutation returns the SpamTokenMutation object of the builder.
+func (stc *SpamTokenCreate) Mutation() *SpamTokenMutation {
+	return stc.mutation
+}
+
+// Save creates the SpamToken in the database.
+func (stc *SpamTokenCreate) Save(ctx context.Context) (*SpamToken, error) {
+	stc.defaults()
+	return withHooks(ctx, stc.sqlSave, stc.mutation, stc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (stc *SpamTokenCreate) SaveX(ctx context.Context) *SpamToken {
+	v, err := stc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (stc *SpamTokenCreate) Exec(ctx context.Context) error {
+	_, err := stc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stc *SpamTokenCreate) ExecX(ctx context.Context) {
+	if err := stc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (stc *SpamTokenCreate) defaults() {
+	if _, ok := stc.mutation.Spam(); !ok {
+		v := spamtoken.DefaultSpam
+		stc.mutation.SetSpam(v)
+	}
+	if _, ok := stc.mutation.Ham(); !ok {
+		v := spamtoken.DefaultHam
+		stc.mutation.SetHam(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stc *SpamTokenCreate) check() error {
+	if _, ok := stc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "SpamToken.token"`)}
+	}
+	if v, ok := stc.mutation.Token(); ok {
+		if err := spamtoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "SpamToken.token": %w`, err)}
+		}
+	}
+	if _, ok := stc.mutation.Spam(); !ok {
+		return &ValidationError{Name: "spam", err: errors.New(`ent: missing required field "SpamToken.spam"`)}
+	}
+	if _, ok := stc.mutation.Ham(); !ok {
+		return &ValidationError{Name: "ham", err: errors.New(`ent: missing required field "SpamToken.ham"`)}
+	}
+	return nil
+}
+
+func (stc *SpamTokenCreate) sqlSave(ctx context.Context) (*SpamToken, error) {
+	if err := stc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := stc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, stc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	stc.mutation.id = &_node.ID
+	stc.mutation.done = true
+	return _node, nil
+}
+
+func (stc *SpamTokenCreate) createSpec() (*SpamToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SpamToken{config: stc.config}
+		_spec = sqlgraph.NewCreateSpec(spamtoken.Table, sqlgraph.NewFieldSpec(spamtoken.FieldID, field.TypeInt))
+	)
+	if value, ok := stc.mutation.Token(); ok {
+		_spec.SetField(spamtoken.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := stc.mutation.Spam(); ok {
+		_spec.SetField(spamtoken.FieldSpam, field.TypeInt, value)
+		_node.Spam = value
+	}
+	if value, ok := stc.mutation.Ham(); ok {
+		_spec.SetField(spamtoken.FieldHam, field.TypeInt, value)
+		_node.Ham = value
+	}
+	return _node, _spec
+}
+
+// SpamTokenCreateBulk is the builder for creating many SpamToken entities in bulk.
+type SpamTokenCreateBulk struct {
+	config
+	err      error
+	builders []*SpamTokenCreate
+}
+
+// Save creates the SpamToken entities in the database.
+func (stcb *SpamTokenCreateBulk) Save(ctx context.Context) ([]*SpamToken, error) {
+	if stcb.err != nil {
+		return nil, stcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(stcb.builders))
+	nodes := make([]*SpamToken, len(stcb.builders))
+	mutators := make([]Mutator, len(stcb.builders))
+	for i := range stcb.builders {
+		func(i int, root context.Context) {
+			builder := stcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SpamTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, stcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, stcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, stcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stcb *SpamTokenCreateBulk) SaveX(ctx context.Context) []*SpamToken {
+	v, err := stcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (stcb *SpamTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := stcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stcb *SpamTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := stcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/predicate"
+	"blog-go/ent/spamtoken"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SpamTokenDelete is the builder for deleting a SpamToken entity.
+type SpamTokenDelete struct {
+	config
+	hooks    []Hook
+	mutation *SpamTokenMutation
+}
+
+// Where appends a list predicates to the SpamTokenDelete builder.
+func (std *SpamTokenDelete) Where(ps ...predicate.SpamToken) *SpamTokenDelete {
+	std.mutation.Where(ps...)
+	return std
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (std *SpamTokenDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, std.sqlExec, std.mutation, std.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (std *SpamTokenDelete) ExecX(ctx context.Context) int {
+	n, err := std.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (std *SpamTokenDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(spamtoken.Table, sqlgraph.NewFieldSpec(spamtoken.FieldID, field.TypeInt))
+	if ps := std.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, std.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	std.mutation.done = true
+	return affected, err
+}
+
+// SpamTokenDeleteOne is the builder for deleting a single SpamToken entity.
+type SpamTokenDeleteOne struct {
+	std *SpamTokenDelete
+}
+
+// Where appends a list predicates to the SpamTokenDelete builder.
+func (stdo *SpamTokenDeleteOne) Where(ps ...predicate.SpamToken) *SpamTokenDeleteOne {
+	stdo.std.mutation.Where(ps...)
+	return stdo
+}
+
+// Exec executes the deletion query.
+func (stdo *SpamTokenDeleteOne) Exec(ctx context.Context) error {
+	n, err := stdo.std.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{spamtoken.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stdo *SpamTokenDeleteOne) ExecX(ctx context.Context) {
+	if err := stdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"blog-go/ent/predicate"
+	"blog-go/ent/spamtoken"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SpamTokenUpdate is the builder for updating SpamToken entities.
+type SpamTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SpamTokenMutation
+}
+
+// Where appends a list predicates to the SpamTokenUpdate builder.
+func (stu *SpamTokenUpdate) Where(ps ...predicate.SpamToken) *SpamTokenUpdate {
+	stu.mutation.Where(ps...)
+	return stu
+}
+
+// SetToken sets the "token" field.
+func (stu *SpamTokenUpdate) SetToken(s string) *SpamTokenUpdate {
+	stu.mutation.SetToken(s)
+	return stu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (stu *SpamTokenUpdate) SetNillableToken(s *string) *SpamTokenUpdate {
+	if s != nil {
+		stu.SetToken(*s)
+	}
+	return stu
+}
+
+// SetSpam sets the "spam" field.
+func (stu *SpamTokenUpdate) SetSpam(i int) *SpamTokenUpdate {
+	stu.mutation.ResetSpam()
+	stu.mutation.SetSpam(i)
+	return stu
+}
+
+// SetNillableSpam sets the "spam" field if the given value is not nil.
+func (stu *SpamTokenUpdate) SetNillableSpam(i *int) *SpamTokenUpdate {
+	if i != nil {
+		stu.SetSpam(*i)
+	}
+	return stu
+}
+
+// AddSpam adds i to the "spam" field.
+func (stu *SpamTokenUpdate) AddSpam(i int) *SpamTokenUpdate {
+	stu.mutation.AddSpam(i)
+	return stu
+}
+
+// SetHam sets the "ham" field.
+func (stu *SpamTokenUpdate) SetHam(i int) *SpamTokenUpdate {
+	stu.mutation.ResetHam()
+	stu.mutation.SetHam(i)
+	return stu
+}
+
+// SetNillableHam sets the "ham" field if the given value is not nil.
+func (stu *SpamTokenUpdate) SetNillableHam(i *int) *SpamTokenUpdate {
+	if i != nil {
+		stu.SetHam(*i)
+	}
+	return stu
+}
+
+// AddHam adds i to the "ham" field.
+func (stu *SpamTokenUpdate) AddHam(i int) *SpamTokenUpdate {
+	stu.mutation.AddHam(i)
+	return stu
+}
+
+// Mutation returns the SpamTokenMutation object of the builder.
+func (stu *SpamTokenUpdate) Mutation() *SpamTokenMutation {
+	return stu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (stu *SpamTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, stu.sqlSave, stu.mutation, stu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stu *SpamTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := stu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (stu *SpamTokenUpdate) Exec(ctx context.Context) error {
+	_, err := stu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stu *SpamTokenUpdate) ExecX(ctx context.Context) {
+	if err := stu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stu *SpamTokenUpdate) check() error {
+	if v, ok := stu.mutation.Token(); ok {
+		if err := spamtoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "SpamToken.token": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (stu *SpamTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := stu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(spamtoken.Table, spamtoken.Columns, sqlgraph.NewFieldSpec(spamtoken.FieldID, field.TypeInt))
+	if ps := stu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := stu.mutation.Token(); ok {
+		_spec.SetField(spamtoken.FieldToken, field.TypeString, value)
+	}
+	if value, ok := stu.mutation.Spam(); ok {
+		_spec.SetField(spamtoken.FieldSpam, field.TypeInt, value)
+	}
+	if value, ok := stu.mutation.AddedSpam(); ok {
+		_spec.AddField(spamtoken.FieldSpam, field.TypeInt, value)
+	}
+	if value, ok := stu.mutation.Ham(); ok {
+		_spec.SetField(spamtoken.FieldHam, field.TypeInt, value)
+	}
+	if value, ok := stu.mutation.AddedHam(); ok {
+		_spec.AddField(spamtoken.FieldHam, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, stu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{spamtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	stu.mutation.done = true
+	return n, nil
+}
+
+// SpamTokenUpdateOne is the builder for updating a single SpamToken entity.
+type SpamTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SpamTokenMutation
+}
+
+// SetToken sets the "token" field.
+func (stuo *SpamTokenUpdateOne) SetToken(s string) *SpamTokenUpdateOne {
+	stuo.mutation.SetToken(s)
+	return stuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (stuo *SpamTokenUpdateOne) SetNillableToken(s *string) *SpamTokenUpdateOne {
+	if s != nil {
+		stuo.SetToken(*s)
+	}
+	return stuo
+}
+
+// SetSpam sets the "spam" field.
+func (stuo *SpamTokenUpdateOne) SetSpam(i int) *SpamTokenUpdateOne {
+	stuo.mutation.ResetSpam()
+	stuo.mutation.SetSpam(i)
+	return stuo
+}
+
+// SetNillableSpam sets the "spam" field if the given value is not nil.
+func (stuo *SpamTokenUpdateOne) SetNillableSpam(i *int) *SpamTokenUpdateOne {
+	if i != nil {
+		stuo.SetSpam(*i)
+	}
+	return stuo
+}
+
+// AddSpam adds i to the "spam" field.
+func (stuo *SpamTokenUpdateOne) AddSpam(i int) *SpamTokenUpdateOne {
+	stuo.mutation.AddSpam(i)
+	return stuo
+}
+
+// SetHam sets the "ham" field.
+func (stuo *SpamTokenUpdateOne) SetHam(i int) *SpamTokenUpdateOne {
+	stuo.mutation.ResetHam()
+	stuo.mutation.SetHam(i)
+	return stuo
+}
+
+// SetNillableHam sets the "ham" field if the given value is not nil.
+func (stuo *SpamTokenUpdateOne) SetNillableHam(i *int) *SpamTokenUpdateOne {
+	if i != nil {
+		stuo.SetHam(*i)
+	}
+	return stuo
+}
+
+// AddHam adds i to the "ham" field.
+func (stuo *SpamTokenUpdateOne) AddHam(i int) *SpamTokenUpdateOne {
+	stuo.mutation.AddHam(i)
+	return stuo
+}
+
+// Mutation returns the SpamTokenMutation object of the builder.
+func (stuo *SpamTokenUpdateOne) Mutation() *SpamTokenMutation {
+	return stuo.mutation
+}
+
+// Where appends a list predicates to the SpamTokenUpdate builder.
+func (stuo *SpamTokenUpdateOne) Where(ps ...predicate.SpamToken) *SpamTokenUpdateOne {
+	stuo.mutation.Where(ps...)
+	return stuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (stuo *SpamTokenUpdateOne) Select(field string, fields ...string) *SpamTokenUpdateOne {
+	stuo.fields = append([]string{field}, fields...)
+	return stuo
+}
+
+// Save executes the query and returns the updated SpamToken entity.
+func (stuo *SpamTokenUpdateOne) Save(ctx context.Context) (*SpamToken, error) {
+	return withHooks(ctx, stuo.sqlSave, stuo.mutation, stuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (stuo *SpamTokenUpdateOne) SaveX(ctx context.Context) *SpamToken {
+	node, err := stuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (stuo *SpamTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := stuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (stuo *SpamTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := stuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (stuo *SpamTokenUpdateOne) check() error {
+	if v, ok := stuo.mutation.Token(); ok {
+		if err := spamtoken.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "SpamToken.token": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (stuo *SpamTokenUpdateOne) sqlSave(ctx context.Context) (_node *SpamToken, err error) {
+	if err := stuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(spamtoken.Table, spamtoken.Columns, sqlgraph.NewFieldSpec(spamtoken.FieldID, field.TypeInt))
+	id, ok := stuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SpamToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := stuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, spamtoken.FieldID)
+		for _, f := range fields {
+			if !spamtoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != spamtoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := stuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := stuo.mutation.Token(); ok {
+		_spec.SetField(spamtoken.FieldToken, field.TypeString, value)
+	}
+	if value, ok := stuo.mutation.Spam(); ok {
+		_spec.SetField(spamtoken.FieldSpam, field.TypeInt, value)
+	}
+	if value, ok := stuo.mutation.AddedSpam(); ok {
+		_spec.AddField(spamtoken.FieldSpam, field.TypeInt, value)
+	}
+	if value, ok := stuo.mutation.Ham(); ok {
+		_spec.SetField(spamtoken.FieldHam, field.TypeInt, value)
+	}
+	if value, ok := stuo.mutation.AddedHam(); ok {
+		_spec.AddField(spamtoken.FieldHam, field.TypeInt, value)
+	}
+	_node = &SpamToken{config: stuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, stuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{spamtoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	stuo.mutation.done = true
+	return _node, nil
+}
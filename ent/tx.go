@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,20 +14,48 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccessToken is the client for interacting with the AccessToken builders.
+	AccessToken *AccessTokenClient
 	// Book is the client for interacting with the Book builders.
 	Book *BookClient
 	// Collection is the client for interacting with the Collection builders.
 	Collection *CollectionClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
+	// CommentReaction is the client for interacting with the CommentReaction builders.
+	CommentReaction *CommentReactionClient
+	// CommentRevision is the client for interacting with the CommentRevision builders.
+	CommentRevision *CommentRevisionClient
+	// EmailSubscription is the client for interacting with the EmailSubscription builders.
+	EmailSubscription *EmailSubscriptionClient
 	// Friend is the client for interacting with the Friend builders.
 	Friend *FriendClient
 	// Hitokoto is the client for interacting with the Hitokoto builders.
 	Hitokoto *HitokotoClient
+	// Identity is the client for interacting with the Identity builders.
+	Identity *IdentityClient
 	// Image is the client for interacting with the Image builders.
 	Image *ImageClient
+	// Invitation is the client for interacting with the Invitation builders.
+	Invitation *InvitationClient
+	// PasswordReset is the client for interacting with the PasswordReset builders.
+	PasswordReset *PasswordResetClient
 	// Post is the client for interacting with the Post builders.
 	Post *PostClient
+	// PostRevision is the client for interacting with the PostRevision builders.
+	PostRevision *PostRevisionClient
+	// PostSlugHistory is the client for interacting with the PostSlugHistory builders.
+	PostSlugHistory *PostSlugHistoryClient
+	// RecoveryCode is the client for interacting with the RecoveryCode builders.
+	RecoveryCode *RecoveryCodeClient
+	// SearchDocument is the client for interacting with the SearchDocument builders.
+	SearchDocument *SearchDocumentClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// SpamCorpus is the client for interacting with the SpamCorpus builders.
+	SpamCorpus *SpamCorpusClient
+	// SpamToken is the client for interacting with the SpamToken builders.
+	SpamToken *SpamTokenClient
 	// Tag is the client for interacting with the Tag builders.
 	Tag *TagClient
 	// User is the client for interacting with the User builders.
@@ -161,13 +191,27 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccessToken = NewAccessTokenClient(tx.config)
 	tx.Book = NewBookClient(tx.config)
 	tx.Collection = NewCollectionClient(tx.config)
 	tx.Comment = NewCommentClient(tx.config)
+	tx.CommentReaction = NewCommentReactionClient(tx.config)
+	tx.CommentRevision = NewCommentRevisionClient(tx.config)
+	tx.EmailSubscription = NewEmailSubscriptionClient(tx.config)
 	tx.Friend = NewFriendClient(tx.config)
 	tx.Hitokoto = NewHitokotoClient(tx.config)
+	tx.Identity = NewIdentityClient(tx.config)
 	tx.Image = NewImageClient(tx.config)
+	tx.Invitation = NewInvitationClient(tx.config)
+	tx.PasswordReset = NewPasswordResetClient(tx.config)
 	tx.Post = NewPostClient(tx.config)
+	tx.PostRevision = NewPostRevisionClient(tx.config)
+	tx.PostSlugHistory = NewPostSlugHistoryClient(tx.config)
+	tx.RecoveryCode = NewRecoveryCodeClient(tx.config)
+	tx.SearchDocument = NewSearchDocumentClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
+	tx.SpamCorpus = NewSpamCorpusClient(tx.config)
+	tx.SpamToken = NewSpamTokenClient(tx.config)
 	tx.Tag = NewTagClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
@@ -179,7 +223,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Book.QueryXXX(), the query will be executed
+// applies a query, for example: AccessToken.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -232,3 +276,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -3,10 +3,17 @@
 package ent
 
 import (
+	"blog-go/ent/accesstoken"
 	"blog-go/ent/book"
 	"blog-go/ent/comment"
+	"blog-go/ent/commentreaction"
+	"blog-go/ent/identity"
 	"blog-go/ent/image"
+	"blog-go/ent/invitation"
+	"blog-go/ent/passwordreset"
 	"blog-go/ent/post"
+	"blog-go/ent/recoverycode"
+	"blog-go/ent/session"
 	"blog-go/ent/tag"
 	"blog-go/ent/user"
 	"context"
@@ -37,12 +44,124 @@ func (uc *UserCreate) SetEmail(s string) *UserCreate {
 	return uc
 }
 
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (uc *UserCreate) SetEmailVerifiedAt(t time.Time) *UserCreate {
+	uc.mutation.SetEmailVerifiedAt(t)
+	return uc
+}
+
+// SetNillableEmailVerifiedAt sets the "email_verified_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableEmailVerifiedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetEmailVerifiedAt(*t)
+	}
+	return uc
+}
+
 // SetPassword sets the "password" field.
 func (uc *UserCreate) SetPassword(s string) *UserCreate {
 	uc.mutation.SetPassword(s)
 	return uc
 }
 
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (uc *UserCreate) SetPasswordChangedAt(t time.Time) *UserCreate {
+	uc.mutation.SetPasswordChangedAt(t)
+	return uc
+}
+
+// SetNillablePasswordChangedAt sets the "password_changed_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePasswordChangedAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetPasswordChangedAt(*t)
+	}
+	return uc
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (uc *UserCreate) SetTotpSecret(s string) *UserCreate {
+	uc.mutation.SetTotpSecret(s)
+	return uc
+}
+
+// SetNillableTotpSecret sets the "totp_secret" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpSecret(s *string) *UserCreate {
+	if s != nil {
+		uc.SetTotpSecret(*s)
+	}
+	return uc
+}
+
+// SetTotpEnabledAt sets the "totp_enabled_at" field.
+func (uc *UserCreate) SetTotpEnabledAt(t time.Time) *UserCreate {
+	uc.mutation.SetTotpEnabledAt(t)
+	return uc
+}
+
+// SetNillableTotpEnabledAt sets the "totp_enabled_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpEnabledAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetTotpEnabledAt(*t)
+	}
+	return uc
+}
+
+// SetTotpLastStep sets the "totp_last_step" field.
+func (uc *UserCreate) SetTotpLastStep(i int64) *UserCreate {
+	uc.mutation.SetTotpLastStep(i)
+	return uc
+}
+
+// SetNillableTotpLastStep sets the "totp_last_step" field if the given value is not nil.
+func (uc *UserCreate) SetNillableTotpLastStep(i *int64) *UserCreate {
+	if i != nil {
+		uc.SetTotpLastStep(*i)
+	}
+	return uc
+}
+
+// SetFailedLoginCount sets the "failed_login_count" field.
+func (uc *UserCreate) SetFailedLoginCount(i int) *UserCreate {
+	uc.mutation.SetFailedLoginCount(i)
+	return uc
+}
+
+// SetNillableFailedLoginCount sets the "failed_login_count" field if the given value is not nil.
+func (uc *UserCreate) SetNillableFailedLoginCount(i *int) *UserCreate {
+	if i != nil {
+		uc.SetFailedLoginCount(*i)
+	}
+	return uc
+}
+
+// SetLastFailedLoginAt sets the "last_failed_login_at" field.
+func (uc *UserCreate) SetLastFailedLoginAt(t time.Time) *UserCreate {
+	uc.mutation.SetLastFailedLoginAt(t)
+	return uc
+}
+
+// SetNillableLastFailedLoginAt sets the "last_failed_login_at" field if the given value is not nil.
+func (uc *UserCreate) SetNillableLastFailedLoginAt(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetLastFailedLoginAt(*t)
+	}
+	return uc
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (uc *UserCreate) SetLockedUntil(t time.Time) *UserCreate {
+	uc.mutation.SetLockedUntil(t)
+	return uc
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (uc *UserCreate) SetNillableLockedUntil(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetLockedUntil(*t)
+	}
+	return uc
+}
+
 // SetRole sets the "role" field.
 func (uc *UserCreate) SetRole(s string) *UserCreate {
 	uc.mutation.SetRole(s)
@@ -186,6 +305,130 @@ func (uc *UserCreate) AddBooks(b ...*Book) *UserCreate {
 	return uc.AddBookIDs(ids...)
 }
 
+// AddCommentReactionIDs adds the "comment_reactions" edge to the CommentReaction entity by IDs.
+func (uc *UserCreate) AddCommentReactionIDs(ids ...int) *UserCreate {
+	uc.mutation.AddCommentReactionIDs(ids...)
+	return uc
+}
+
+// AddCommentReactions adds the "comment_reactions" edges to the CommentReaction entity.
+func (uc *UserCreate) AddCommentReactions(c ...*CommentReaction) *UserCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return uc.AddCommentReactionIDs(ids...)
+}
+
+// AddIdentityIDs adds the "identities" edge to the Identity entity by IDs.
+func (uc *UserCreate) AddIdentityIDs(ids ...int) *UserCreate {
+	uc.mutation.AddIdentityIDs(ids...)
+	return uc
+}
+
+// AddIdentities adds the "identities" edges to the Identity entity.
+func (uc *UserCreate) AddIdentities(i ...*Identity) *UserCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddIdentityIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the Invitation entity by IDs.
+func (uc *UserCreate) AddInvitationIDs(ids ...int) *UserCreate {
+	uc.mutation.AddInvitationIDs(ids...)
+	return uc
+}
+
+// AddInvitations adds the "invitations" edges to the Invitation entity.
+func (uc *UserCreate) AddInvitations(i ...*Invitation) *UserCreate {
+	ids := make([]int, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return uc.AddInvitationIDs(ids...)
+}
+
+// SetInvitationID sets the "invitation" edge to the Invitation entity by ID.
+func (uc *UserCreate) SetInvitationID(id int) *UserCreate {
+	uc.mutation.SetInvitationID(id)
+	return uc
+}
+
+// SetNillableInvitationID sets the "invitation" edge to the Invitation entity by ID if the given value is not nil.
+func (uc *UserCreate) SetNillableInvitationID(id *int) *UserCreate {
+	if id != nil {
+		uc = uc.SetInvitationID(*id)
+	}
+	return uc
+}
+
+// SetInvitation sets the "invitation" edge to the Invitation entity.
+func (uc *UserCreate) SetInvitation(i *Invitation) *UserCreate {
+	return uc.SetInvitationID(i.ID)
+}
+
+// AddPasswordResetIDs adds the "password_resets" edge to the PasswordReset entity by IDs.
+func (uc *UserCreate) AddPasswordResetIDs(ids ...int) *UserCreate {
+	uc.mutation.AddPasswordResetIDs(ids...)
+	return uc
+}
+
+// AddPasswordResets adds the "password_resets" edges to the PasswordReset entity.
+func (uc *UserCreate) AddPasswordResets(p ...*PasswordReset) *UserCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uc.AddPasswordResetIDs(ids...)
+}
+
+// AddSessionIDs adds the "sessions" edge to the Session entity by IDs.
+func (uc *UserCreate) AddSessionIDs(ids ...int) *UserCreate {
+	uc.mutation.AddSessionIDs(ids...)
+	return uc
+}
+
+// AddSessions adds the "sessions" edges to the Session entity.
+func (uc *UserCreate) AddSessions(s ...*Session) *UserCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddSessionIDs(ids...)
+}
+
+// AddAccessTokenIDs adds the "access_tokens" edge to the AccessToken entity by IDs.
+func (uc *UserCreate) AddAccessTokenIDs(ids ...int) *UserCreate {
+	uc.mutation.AddAccessTokenIDs(ids...)
+	return uc
+}
+
+// AddAccessTokens adds the "access_tokens" edges to the AccessToken entity.
+func (uc *UserCreate) AddAccessTokens(a ...*AccessToken) *UserCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddAccessTokenIDs(ids...)
+}
+
+// AddRecoveryCodeIDs adds the "recovery_codes" edge to the RecoveryCode entity by IDs.
+func (uc *UserCreate) AddRecoveryCodeIDs(ids ...int) *UserCreate {
+	uc.mutation.AddRecoveryCodeIDs(ids...)
+	return uc
+}
+
+// AddRecoveryCodes adds the "recovery_codes" edges to the RecoveryCode entity.
+func (uc *UserCreate) AddRecoveryCodes(r ...*RecoveryCode) *UserCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return uc.AddRecoveryCodeIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -221,6 +464,14 @@ func (uc *UserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (uc *UserCreate) defaults() {
+	if _, ok := uc.mutation.TotpLastStep(); !ok {
+		v := user.DefaultTotpLastStep
+		uc.mutation.SetTotpLastStep(v)
+	}
+	if _, ok := uc.mutation.FailedLoginCount(); !ok {
+		v := user.DefaultFailedLoginCount
+		uc.mutation.SetFailedLoginCount(v)
+	}
 	if _, ok := uc.mutation.Role(); !ok {
 		v := user.DefaultRole
 		uc.mutation.SetRole(v)
@@ -253,6 +504,12 @@ func (uc *UserCreate) check() error {
 			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "User.password": %w`, err)}
 		}
 	}
+	if _, ok := uc.mutation.TotpLastStep(); !ok {
+		return &ValidationError{Name: "totp_last_step", err: errors.New(`ent: missing required field "User.totp_last_step"`)}
+	}
+	if _, ok := uc.mutation.FailedLoginCount(); !ok {
+		return &ValidationError{Name: "failed_login_count", err: errors.New(`ent: missing required field "User.failed_login_count"`)}
+	}
 	if _, ok := uc.mutation.Role(); !ok {
 		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
 	}
@@ -296,10 +553,42 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldEmail, field.TypeString, value)
 		_node.Email = value
 	}
+	if value, ok := uc.mutation.EmailVerifiedAt(); ok {
+		_spec.SetField(user.FieldEmailVerifiedAt, field.TypeTime, value)
+		_node.EmailVerifiedAt = &value
+	}
 	if value, ok := uc.mutation.Password(); ok {
 		_spec.SetField(user.FieldPassword, field.TypeString, value)
 		_node.Password = value
 	}
+	if value, ok := uc.mutation.PasswordChangedAt(); ok {
+		_spec.SetField(user.FieldPasswordChangedAt, field.TypeTime, value)
+		_node.PasswordChangedAt = &value
+	}
+	if value, ok := uc.mutation.TotpSecret(); ok {
+		_spec.SetField(user.FieldTotpSecret, field.TypeString, value)
+		_node.TotpSecret = value
+	}
+	if value, ok := uc.mutation.TotpEnabledAt(); ok {
+		_spec.SetField(user.FieldTotpEnabledAt, field.TypeTime, value)
+		_node.TotpEnabledAt = &value
+	}
+	if value, ok := uc.mutation.TotpLastStep(); ok {
+		_spec.SetField(user.FieldTotpLastStep, field.TypeInt64, value)
+		_node.TotpLastStep = value
+	}
+	if value, ok := uc.mutation.FailedLoginCount(); ok {
+		_spec.SetField(user.FieldFailedLoginCount, field.TypeInt, value)
+		_node.FailedLoginCount = value
+	}
+	if value, ok := uc.mutation.LastFailedLoginAt(); ok {
+		_spec.SetField(user.FieldLastFailedLoginAt, field.TypeTime, value)
+		_node.LastFailedLoginAt = &value
+	}
+	if value, ok := uc.mutation.LockedUntil(); ok {
+		_spec.SetField(user.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
 	if value, ok := uc.mutation.Role(); ok {
 		_spec.SetField(user.FieldRole, field.TypeString, value)
 		_node.Role = value
@@ -404,6 +693,134 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.CommentReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.CommentReactionsTable,
+			Columns: []string{user.CommentReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentreaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.IdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.IdentitiesTable,
+			Columns: []string{user.IdentitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(identity.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.InvitationsTable,
+			Columns: []string{user.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.InvitationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.InvitationTable,
+			Columns: []string{user.InvitationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(invitation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.PasswordResetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PasswordResetsTable,
+			Columns: []string{user.PasswordResetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(passwordreset.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SessionsTable,
+			Columns: []string{user.SessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(session.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.AccessTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AccessTokensTable,
+			Columns: []string{user.AccessTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accesstoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.RecoveryCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecoveryCodesTable,
+			Columns: []string{user.RecoveryCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recoverycode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
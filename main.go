@@ -2,6 +2,10 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"flag"
 	"log"
 	"net/http"
 	"os"
@@ -10,7 +14,10 @@ import (
 	"blog-go/ent"
 	"blog-go/middleware"
 	"blog-go/routes"
+	"blog-go/services"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
 )
@@ -37,21 +44,62 @@ func main() {
 	dsn := "postgres://" + user + ":" + password + "@" + host + ":" + port + "/" + dbname + "?sslmode=disable"
 
 	// 初始化数据库和ent客户端
-	client, err = ent.Open("postgres", dsn)
+	db, err := sql.Open("postgres", dsn)
 	if err != nil {
 		log.Fatalf("初始化ent客户端失败: %v", err)
 	}
+	client = ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db)))
 	defer client.Close()
 
+	// 自动迁移前的数据迁移
+	if err := config.MigrateBeforeSchema(context.Background(), db); err != nil {
+		log.Fatalf("数据迁移失败: %v", err)
+	}
+
 	// 运行自动迁移
 	if err := client.Schema.Create(context.Background()); err != nil {
 		log.Fatalf("数据库迁移失败: %v", err)
 	}
 	log.Println("数据库迁移成功")
 
+	// 自动迁移后的数据迁移
+	if err := config.MigrateAfterSchema(context.Background(), db); err != nil {
+		log.Fatalf("数据迁移失败: %v", err)
+	}
+
+	// 为旧文章补全slug
+	if err := services.BackfillPostSlugs(context.Background(), client); err != nil {
+		log.Printf("生成文章slug失败: %v", err)
+	}
+
+	// 命令行导入评论，完成后退出
+	if len(os.Args) > 1 && os.Args[1] == "import-comments" {
+		if err := runImportComments(client, os.Args[2:]); err != nil {
+			log.Fatalf("导入评论失败: %v", err)
+		}
+		return
+	}
+
+	// 为旧文章渲染Markdown缓存
+	if err := services.RenderPendingPosts(context.Background(), client); err != nil {
+		log.Printf("渲染文章HTML失败: %v", err)
+	}
+
+	// 后台重建全文检索索引
+	go func() {
+		if err := services.RebuildSearchIndex(context.Background(), client); err != nil {
+			log.Printf("重建检索索引失败: %v", err)
+		}
+	}()
+
 	// 初始化配置
 	cfg := config.LoadConfig()
 
+	// 启动定时发布任务
+	publishCtx, stopPublisher := context.WithCancel(context.Background())
+	defer stopPublisher()
+	go services.NewPublisher(client, cfg.PublishInterval).Run(publishCtx)
+
 	// 设置Gin模式
 	if cfg.Environment == "production" {
 		gin.SetMode(gin.ReleaseMode)
@@ -60,6 +108,13 @@ func main() {
 	// 创建Gin实例
 	r := gin.Default()
 
+	// 只信任配置的反向代理传来的 X-Forwarded-For，未配置时使用连接的来源地址，
+	// 否则客户端可以伪造IP绕过按IP的限流和登录保护
+	if err := r.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		log.Fatalf("TRUSTED_PROXIES 格式错误: %v", err)
+	}
+	r.TrustedPlatform = cfg.TrustedPlatform
+
 	// 日志中间件
 	r.Use(middleware.RequestLogger())
 
@@ -87,6 +142,9 @@ func main() {
 	apiGroup := r.Group("/api")
 	routes.RegisterRoutes(apiGroup, client)
 
+	// 注册站点路由（订阅源、站点地图）
+	routes.RegisterSiteRoutes(&r.RouterGroup, client)
+
 	// 确保上传目录存在
 	os.MkdirAll("uploads/avatars", 0755)
 	os.MkdirAll("uploads/images", 0755)
@@ -122,3 +180,51 @@ func main() {
 		log.Fatalf("启动服务器失败: %v", err)
 	}
 }
+
+// runImportComments 命令行导入评论
+//
+//	go run . import-comments -format disqus -file export.xml [-map mapping.json] [-dry-run]
+func runImportComments(client *ent.Client, args []string) error {
+	fs := flag.NewFlagSet("import-comments", flag.ExitOnError)
+	format := fs.String("format", "", "导出文件格式：disqus、waline 或 twikoo")
+	file := fs.String("file", "", "导出文件路径")
+	mappingFile := fs.String("map", "", "页面到文章ID的映射文件（JSON 对象）")
+	dryRun := fs.Bool("dry-run", false, "只匹配文章并输出结果，不写入数据库")
+	fs.Parse(args)
+
+	if *format == "" || *file == "" {
+		fs.Usage()
+		return errors.New("需要指定 -format 和 -file")
+	}
+
+	opts := services.CommentImportOptions{DryRun: *dryRun}
+	if *mappingFile != "" {
+		data, err := os.ReadFile(*mappingFile)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, &opts.Mapping); err != nil {
+			return err
+		}
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	comments, err := services.ParseCommentExport(services.ImportFormat(*format), f)
+	if err != nil {
+		return err
+	}
+	report, err := services.ImportComments(context.Background(), client, services.ImportFormat(*format), comments, opts)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(report)
+}
@@ -1,12 +1,17 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"log"
 	"os"
 	"strings"
 	"time"
 
 	"blog-go/ent"
+	"blog-go/ent/user"
+	"blog-go/services"
+	"blog-go/utils"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
@@ -15,32 +20,17 @@ import (
 // CORS 跨域中间件
 func CORS() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// 从环境变量获取允许的域名
-		allowedOrigins := os.Getenv("ALLOWED_ORIGINS")
+		// 从环境变量获取允许的域名（未设置时默认只允许本地开发环境）
 		origin := c.Request.Header.Get("Origin")
 
 		log.Printf("[CORS] Request Origin: %s", origin)
-		log.Printf("[CORS] Allowed Origins: %s", allowedOrigins)
-
-		// 如果环境变量未设置，默认只允许本地开发环境
-		if allowedOrigins == "" {
-			allowedOrigins = "http://localhost:3000,http://127.0.0.1:3000"
-		}
+		log.Printf("[CORS] Allowed Origins: %s", strings.Join(utils.AllowedOrigins(), ","))
 
 		// 检查请求的域名是否在允许列表中
-		origins := strings.Split(allowedOrigins, ",")
-		originAllowed := false
-		for _, allowedOrigin := range origins {
-			allowedOrigin = strings.TrimSpace(allowedOrigin)
-			if origin == allowedOrigin {
-				originAllowed = true
-				c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
-				log.Printf("[CORS] Origin allowed: %s", origin)
-				break
-			}
-		}
-
-		if !originAllowed {
+		if utils.IsAllowedOrigin(origin) {
+			c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
+			log.Printf("[CORS] Origin allowed: %s", origin)
+		} else {
 			log.Printf("[CORS] Origin not allowed: %s", origin)
 		}
 
@@ -61,8 +51,17 @@ func CORS() gin.HandlerFunc {
 	}
 }
 
-// AuthRequired 身份验证中间件
+// AuthRequired 身份验证中间件，只接受登录会话，个人访问令牌只能用于 TokenAuth 的接口
 func AuthRequired() gin.HandlerFunc {
+	return authenticate(false)
+}
+
+// TokenAuth 身份验证中间件，同时接受个人访问令牌；必须后接 RequirePermission，由其校验令牌的 scopes
+func TokenAuth() gin.HandlerFunc {
+	return authenticate(true)
+}
+
+func authenticate(allowAccessToken bool) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// 打印所有 cookie
 		log.Println("[AuthRequired] All cookies:", c.Request.Cookies())
@@ -88,6 +87,16 @@ func AuthRequired() gin.HandlerFunc {
 			log.Println("[AuthRequired] Selected auth_token from cookie:", token)
 		}
 
+		// 个人访问令牌不是 JWT，单独校验；评论、账号资料等没有 scope 约束的接口不接受令牌
+		if services.IsAccessToken(token) {
+			if !allowAccessToken {
+				c.AbortWithStatusJSON(403, gin.H{"code": 403, "message": "访问令牌不能用于此操作，请登录后操作", "data": nil})
+				return
+			}
+			accessTokenAuth(c, token)
+			return
+		}
+
 		// 解析 JWT token
 		claims := jwt.MapClaims{}
 		parsedToken, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
@@ -107,6 +116,7 @@ func AuthRequired() gin.HandlerFunc {
 		}
 
 		// 获取 username
+		var userObj *ent.User
 		username, ok := claims["username"].(string)
 		if !ok {
 			// 尝试获取 userID
@@ -134,7 +144,7 @@ func AuthRequired() gin.HandlerFunc {
 				c.Abort()
 				return
 			}
-			userObj, err := entClient.User.Get(c.Request.Context(), userID)
+			userObj, err = entClient.User.Get(c.Request.Context(), userID)
 			if err != nil {
 				log.Println("[AuthRequired] 用户不存在:", userID)
 				c.JSON(401, gin.H{
@@ -150,13 +160,97 @@ func AuthRequired() gin.HandlerFunc {
 			log.Println("[AuthRequired] username from db:", username)
 		} else {
 			log.Println("[AuthRequired] username from claims:", username, ok)
+			// 第三方登录签发的token同时包含 userID
+			if userIDFloat, ok := claims["userID"].(float64); ok {
+				c.Set("userID", int(userIDFloat))
+			}
+		}
+		// 访问令牌所属的会话已注销，或签发于重置密码之前，均视为失效
+		sid, ok := claims["sid"].(float64)
+		if !ok || sessionRevoked(c.Request.Context(), int(sid)) || tokenRevoked(c.Request.Context(), userObj, username, claims) {
+			c.JSON(401, gin.H{
+				"code":    401,
+				"message": "登录已失效，请重新登录",
+				"data":    nil,
+			})
+			c.Abort()
+			return
 		}
-		// 将 username 注入到 context
+		// 将 username 和会话ID注入到 context
 		c.Set("username", username)
+		c.Set("sessionID", int(sid))
 		c.Next()
 	}
 }
 
+// accessTokenAuth 使用个人访问令牌认证，令牌的 scopes 写入 context 供 RequirePermission 校验
+func accessTokenAuth(c *gin.Context, token string) {
+	entClient := ent.FromContext(c.Request.Context())
+	if entClient == nil {
+		c.JSON(500, gin.H{"code": 500, "message": "服务器内部错误", "data": nil})
+		c.Abort()
+		return
+	}
+	t, err := services.AuthenticateAccessToken(c.Request.Context(), entClient, token, c.ClientIP())
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidAccessToken) {
+			c.JSON(401, gin.H{"code": 401, "message": err.Error(), "data": nil})
+		} else {
+			c.JSON(500, gin.H{"code": 500, "message": "服务器内部错误", "data": nil})
+		}
+		c.Abort()
+		return
+	}
+	c.Set("username", t.Edges.User.Username)
+	c.Set("userID", t.Edges.User.ID)
+	c.Set(CurrentUserKey, t.Edges.User)
+	c.Set(TokenScopesKey, t.Scopes)
+	c.Next()
+}
+
+// sessionRevoked 通过缓存的注销列表判断会话是否已注销
+func sessionRevoked(ctx context.Context, sessionID int) bool {
+	entClient := ent.FromContext(ctx)
+	if entClient == nil {
+		return false
+	}
+	return services.SessionRevoked(ctx, entClient, sessionID)
+}
+
+// tokenRevoked 判断token是否签发于用户最近一次重置密码之前（没有签发时间的旧token同样视为失效）
+func tokenRevoked(ctx context.Context, u *ent.User, username string, claims jwt.MapClaims) bool {
+	if u == nil {
+		entClient := ent.FromContext(ctx)
+		if entClient == nil {
+			return false
+		}
+		var err error
+		u, err = entClient.User.Query().Where(user.UsernameEQ(username)).Only(ctx)
+		if err != nil {
+			return ent.IsNotFound(err)
+		}
+	}
+	if u.PasswordChangedAt == nil {
+		return false
+	}
+	iat, ok := claims["iat"].(float64)
+	return !ok || int64(iat) < u.PasswordChangedAt.Unix()
+}
+
+// OptionalAuth 可选身份验证中间件：携带token时按 AuthRequired 校验并注入 username，未携带时以匿名身份继续
+func OptionalAuth() gin.HandlerFunc {
+	auth := AuthRequired()
+	return func(c *gin.Context) {
+		if !strings.HasPrefix(c.GetHeader("Authorization"), "Bearer ") {
+			if _, err := c.Cookie("auth_token"); err != nil {
+				c.Next()
+				return
+			}
+		}
+		auth(c)
+	}
+}
+
 // Logger 日志中间件
 func Logger() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"blog-go/ent"
+	"blog-go/ent/user"
+	"blog-go/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// CurrentUserKey RequirePermission 查询到的当前用户在 context 中的键
+	CurrentUserKey = "currentUser"
+	// TokenScopesKey 使用个人访问令牌认证时令牌的 scopes 在 context 中的键
+	TokenScopesKey = "tokenScopes"
+)
+
+// RequirePermission 权限校验中间件，需放在 AuthRequired 或 TokenAuth 之后
+//
+// 每次请求都从数据库读取角色，修改角色后立即生效；当前用户写入 context 供处理函数复用。
+func RequirePermission(perm services.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		u, ok := c.Get(CurrentUserKey)
+		if !ok {
+			entClient := ent.FromContext(c.Request.Context())
+			username := c.GetString("username")
+			if entClient == nil || username == "" {
+				c.AbortWithStatusJSON(401, gin.H{"code": 401, "message": "未授权", "data": nil})
+				return
+			}
+			current, err := entClient.User.Query().Where(user.UsernameEQ(username)).Only(c.Request.Context())
+			if err != nil {
+				if ent.IsNotFound(err) {
+					c.AbortWithStatusJSON(401, gin.H{"code": 401, "message": "用户不存在", "data": nil})
+					return
+				}
+				c.AbortWithStatusJSON(500, gin.H{"code": 500, "message": "服务器内部错误", "data": nil})
+				return
+			}
+			c.Set(CurrentUserKey, current)
+			u = current
+		}
+
+		// 必须启用两步验证的账号（REQUIRE_ADMIN_2FA）完成绑定前不能使用任何权限
+		if services.TwoFactorSetupPending(u.(*ent.User)) {
+			c.AbortWithStatusJSON(403, gin.H{"code": 403, "message": "请先启用两步验证", "data": nil})
+			return
+		}
+		if !services.HasPermission(u.(*ent.User).Role, perm) {
+			c.AbortWithStatusJSON(403, gin.H{"code": 403, "message": "没有权限执行此操作", "data": nil})
+			return
+		}
+		// 访问令牌还需要包含该权限
+		if !TokenAllows(c, perm) {
+			c.AbortWithStatusJSON(403, gin.H{"code": 403, "message": "访问令牌没有 " + string(perm) + " 权限", "data": nil})
+			return
+		}
+		c.Next()
+	}
+}
+
+// TokenAllows 使用个人访问令牌认证时判断令牌的 scopes 是否包含该权限，未使用访问令牌时返回 true
+func TokenAllows(c *gin.Context, perm services.Permission) bool {
+	scopes, ok := c.Get(TokenScopesKey)
+	return !ok || hasScope(scopes.([]string), perm)
+}
+
+func hasScope(scopes []string, perm services.Permission) bool {
+	for _, s := range scopes {
+		if s == string(perm) {
+			return true
+		}
+	}
+	return false
+}
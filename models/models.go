@@ -102,3 +102,10 @@ type Image struct {
 	UploadedBy int       `json:"uploadedBy"`
 	CreatedAt  time.Time `json:"createdAt"`
 }
+
+// TocItem 文章目录项
+type TocItem struct {
+	Level int    `json:"level"`
+	Text  string `json:"text"`
+	ID    string `json:"id"`
+}
@@ -6,6 +6,7 @@ import (
 	"blog-go/controllers"
 	"blog-go/ent"
 	"blog-go/middleware"
+	"blog-go/services"
 
 	"github.com/gin-gonic/gin"
 )
@@ -13,30 +14,50 @@ import (
 // RegisterRoutes 注册所有API路由
 func RegisterRoutes(router *gin.RouterGroup, client *ent.Client) {
 	// 创建控制器实例
-	userController := controllers.NewUserController(client, os.Getenv("JWT_SECRET"))
+	notifier := services.NewNotifierFromEnv(client)
+	sessions := services.NewSessionManagerFromEnv(client)
+	userController := controllers.NewUserController(client, os.Getenv("JWT_SECRET"), notifier, sessions)
+	authController := controllers.NewAuthController(client, os.Getenv("JWT_SECRET"), sessions)
 	postController := controllers.NewPostController(client)
 	tagController := controllers.NewTagController(client)
-	commentController := controllers.NewCommentController(client)
+	commentController := controllers.NewCommentController(client, notifier)
+	subscriptionController := controllers.NewSubscriptionController(notifier)
 	friendController := controllers.NewFriendController(client)
 	collectionController := controllers.NewCollectionController(client)
 	bookController := controllers.NewBookController(client)
 	imageController := controllers.NewImageController(client)
+	searchController := controllers.NewSearchController(client)
 
 	// 图片代理接口 - 移到最前面，不需要认证
 	router.GET("/proxy-image", controllers.ProxyImage)
 
+	// 全文搜索
+	router.GET("/search", searchController.Search)
+
+	// 评论通知退订（GET 只显示确认页面，POST 供确认表单和邮件客户端一键退订）
+	router.GET("/unsubscribe", subscriptionController.UnsubscribeConfirm)
+	router.POST("/unsubscribe", subscriptionController.Unsubscribe)
+
 	// 文章相关路由
 	posts := router.Group("/posts")
 	{
-		posts.GET("", postController.GetPosts)
-		posts.GET("/:id", postController.GetPost)
-		posts.POST("", postController.CreatePost)
-		posts.PUT("/:id", postController.UpdatePost)
-		posts.DELETE("/:id", postController.DeletePost)
+		posts.GET("", middleware.OptionalAuth(), postController.GetPosts)
+		posts.GET("/:id", middleware.OptionalAuth(), postController.GetPost)
+		posts.GET("/slug/:slug", middleware.OptionalAuth(), postController.GetPostBySlug)
+		posts.POST("", middleware.TokenAuth(), middleware.RequirePermission(services.PermPostCreate), postController.CreatePost)
+		posts.PUT("/:id", middleware.TokenAuth(), middleware.RequirePermission(services.PermPostEdit), postController.UpdatePost)
+		posts.DELETE("/:id", middleware.TokenAuth(), middleware.RequirePermission(services.PermPostDelete), postController.DeletePost)
+
+		// 文章历史版本
+		posts.GET("/:id/revisions", middleware.TokenAuth(), middleware.RequirePermission(services.PermPostEdit), postController.GetPostRevisions)
+		posts.GET("/:id/revisions/diff", middleware.TokenAuth(), middleware.RequirePermission(services.PermPostEdit), postController.DiffPostRevisions)
+		posts.GET("/:id/revisions/:revisionId", middleware.TokenAuth(), middleware.RequirePermission(services.PermPostEdit), postController.GetPostRevision)
+		posts.POST("/:id/revisions/:revisionId/restore", middleware.TokenAuth(), middleware.RequirePermission(services.PermPostEdit), postController.RestorePostRevision)
 
 		// 文章评论
-		posts.GET("/:id/comments", commentController.GetComments)
-		posts.POST("/:id/comments", commentController.AddComment)
+		posts.GET("/:id/comments", middleware.OptionalAuth(), commentController.GetComments)
+		posts.GET("/:id/comments/tree", middleware.OptionalAuth(), commentController.GetCommentTree)
+		posts.POST("/:id/comments", middleware.OptionalAuth(), commentController.AddComment)
 	}
 
 	// 标签相关路由
@@ -45,26 +66,46 @@ func RegisterRoutes(router *gin.RouterGroup, client *ent.Client) {
 		tags.GET("", tagController.GetTags)
 		tags.GET("/:id", tagController.GetTagByID)
 		tags.GET("/tag-slug/:slug/posts", tagController.GetPostsByTag)
-		tags.POST("", middleware.AuthRequired(), tagController.CreateTag)
-		tags.PUT("/:id", middleware.AuthRequired(), tagController.UpdateTag)
-		tags.DELETE("/:id", middleware.AuthRequired(), tagController.DeleteTag)
+		tags.POST("", middleware.TokenAuth(), middleware.RequirePermission(services.PermTagManage), tagController.CreateTag)
+		tags.PUT("/:id", middleware.TokenAuth(), middleware.RequirePermission(services.PermTagManage), tagController.UpdateTag)
+		tags.DELETE("/:id", middleware.TokenAuth(), middleware.RequirePermission(services.PermTagManage), tagController.DeleteTag)
 	}
 
 	// 评论相关路由
 	comments := router.Group("/comments")
 	{
-		comments.GET("", commentController.GetAllComments)
+		comments.GET("", middleware.OptionalAuth(), commentController.GetAllComments)
+		comments.GET("/:id/replies", middleware.OptionalAuth(), commentController.GetCommentReplies)
+		comments.GET("/reactions", commentController.GetCommentReactionSet)
+		comments.PUT("/:id/reactions", middleware.OptionalAuth(), commentController.SetCommentReaction)
+		comments.DELETE("/:id/reactions", middleware.OptionalAuth(), commentController.RemoveCommentReaction)
+		comments.PUT("/:id", middleware.OptionalAuth(), commentController.EditComment)
+		comments.GET("/:id/revisions", middleware.AuthRequired(), commentController.GetCommentRevisions)
 		comments.DELETE("/:id", middleware.AuthRequired(), commentController.DeleteComment)
-		comments.PUT("/:id/approve", middleware.AuthRequired(), commentController.ApproveComment)
+		comments.PUT("/:id/approve", middleware.TokenAuth(), middleware.RequirePermission(services.PermCommentModerate), commentController.ApproveComment)
+		comments.PUT("/:id/reject", middleware.TokenAuth(), middleware.RequirePermission(services.PermCommentModerate), commentController.RejectComment)
+		comments.PUT("/:id/spam", middleware.TokenAuth(), middleware.RequirePermission(services.PermCommentModerate), commentController.MarkCommentSpam)
+		comments.PUT("/:id/trash", middleware.TokenAuth(), middleware.RequirePermission(services.PermCommentModerate), commentController.TrashComment)
+		comments.PUT("/:id/restore", middleware.TokenAuth(), middleware.RequirePermission(services.PermCommentModerate), commentController.RestoreComment)
+		comments.POST("/bulk", middleware.TokenAuth(), middleware.RequirePermission(services.PermCommentModerate), commentController.BulkModerateComments)
 		comments.POST("/upload-avatar", commentController.UploadCommentAvatar)
 	}
 
 	// 用户相关路由
 	auth := router.Group("/auth")
 	{
+		auth.POST("/register", userController.RegisterUser)
 		auth.POST("/login", userController.LoginUser)
-		auth.GET("/github", commentController.GitHubOAuthLogin)
-		auth.GET("/github/callback", commentController.GitHubOAuthCallback)
+		auth.POST("/login/2fa", userController.LoginTwoFactor)
+		auth.POST("/refresh", userController.RefreshToken)
+		auth.GET("/verify-email", userController.VerifyEmail)
+		auth.POST("/verify-email", userController.VerifyEmail)
+		auth.POST("/verify-email/resend", middleware.AuthRequired(), userController.ResendVerification)
+		auth.POST("/password/forgot", userController.ForgotPassword)
+		auth.POST("/password/reset", userController.ResetPassword)
+		auth.GET("/providers", authController.GetOAuthProviders)
+		auth.GET("/:provider", authController.OAuthLogin)
+		auth.GET("/:provider/callback", authController.OAuthCallback)
 		auth.POST("/logout", userController.LogoutUser)
 	}
 
@@ -74,47 +115,77 @@ func RegisterRoutes(router *gin.RouterGroup, client *ent.Client) {
 		users.GET("/me", middleware.AuthRequired(), userController.GetUserProfile)
 		users.PUT("/me", middleware.AuthRequired(), userController.UpdateUserProfile)
 		users.POST("/me/avatar", middleware.AuthRequired(), userController.UploadAvatar)
+		// 已登录的设备
+		users.GET("/me/sessions", middleware.AuthRequired(), userController.GetSessions)
+		users.DELETE("/me/sessions", middleware.AuthRequired(), userController.RevokeOtherSessions)
+		users.DELETE("/me/sessions/:id", middleware.AuthRequired(), userController.RevokeSession)
+		// 个人访问令牌
+		users.GET("/me/tokens", middleware.AuthRequired(), userController.GetAccessTokens)
+		users.POST("/me/tokens", middleware.AuthRequired(), userController.CreateAccessToken)
+		users.DELETE("/me/tokens/:id", middleware.AuthRequired(), userController.RevokeAccessToken)
+		// 两步验证
+		users.GET("/me/2fa", middleware.AuthRequired(), userController.GetTwoFactorStatus)
+		users.POST("/me/2fa/setup", middleware.AuthRequired(), userController.SetupTwoFactor)
+		users.POST("/me/2fa/confirm", middleware.AuthRequired(), userController.ConfirmTwoFactor)
+		users.POST("/me/2fa/disable", middleware.AuthRequired(), userController.DisableTwoFactor)
+		users.POST("/me/2fa/recovery-codes", middleware.AuthRequired(), userController.RegenerateRecoveryCodes)
 	}
 
 	// 管理后台路由
 	admin := router.Group("/admin")
-	admin.Use(middleware.AuthRequired())
+	admin.Use(middleware.TokenAuth())
 	{
 		// 待审核评论
-		admin.GET("/comments/pending", commentController.GetPendingComments)
+		admin.GET("/comments/pending", middleware.RequirePermission(services.PermCommentModerate), commentController.GetPendingComments)
+		// 从其他评论系统导入评论
+		admin.POST("/comments/import", middleware.RequirePermission(services.PermCommentModerate), commentController.ImportComments)
+		// 定时发布的文章
+		admin.GET("/posts/scheduled", middleware.RequirePermission(services.PermPostPublish), postController.GetScheduledPosts)
+		// 注册邀请码
+		admin.GET("/invitations", middleware.RequirePermission(services.PermUserManage), userController.GetInvitations)
+		admin.POST("/invitations", middleware.RequirePermission(services.PermUserManage), userController.CreateInvitation)
+		admin.DELETE("/invitations/:id", middleware.RequirePermission(services.PermUserManage), userController.DeleteInvitation)
+		// 用户角色
+		admin.GET("/roles", middleware.RequirePermission(services.PermUserManage), userController.GetRoles)
+		admin.GET("/users", middleware.RequirePermission(services.PermUserManage), userController.GetUsers)
+		admin.PUT("/users/:id/role", middleware.RequirePermission(services.PermUserManage), userController.UpdateUserRole)
+		// 登录失败锁定
+		admin.GET("/lockouts", middleware.RequirePermission(services.PermUserManage), userController.GetLoginLockouts)
+		admin.DELETE("/lockouts/users/:id", middleware.RequirePermission(services.PermUserManage), userController.UnlockUser)
+		admin.DELETE("/lockouts/ips/:ip", middleware.RequirePermission(services.PermUserManage), userController.UnlockIP)
 	}
 
 	// 友链相关路由
 	friends := router.Group("/friends")
 	{
 		friends.GET("", friendController.GetFriends)
-		friends.POST("", friendController.CreateFriend)
-		friends.PUT("/:id", friendController.UpdateFriend)
-		friends.DELETE("/:id", friendController.DeleteFriend)
+		friends.POST("", middleware.TokenAuth(), middleware.RequirePermission(services.PermFriendManage), friendController.CreateFriend)
+		friends.PUT("/:id", middleware.TokenAuth(), middleware.RequirePermission(services.PermFriendManage), friendController.UpdateFriend)
+		friends.DELETE("/:id", middleware.TokenAuth(), middleware.RequirePermission(services.PermFriendManage), friendController.DeleteFriend)
 	}
 
 	// 上传相关路由
 	upload := router.Group("/upload")
 	{
-		upload.POST("/friend-avatar", friendController.UploadFriendAvatar)
+		upload.POST("/friend-avatar", middleware.TokenAuth(), middleware.RequirePermission(services.PermFriendManage), friendController.UploadFriendAvatar)
 	}
 
 	// 收藏相关路由
 	collections := router.Group("/collections")
 	{
 		collections.GET("", collectionController.GetCollections)
-		collections.POST("", collectionController.CreateCollection)
-		collections.PUT("/:id", collectionController.UpdateCollection)
-		collections.DELETE("/:id", collectionController.DeleteCollection)
+		collections.POST("", middleware.TokenAuth(), middleware.RequirePermission(services.PermCollectionManage), collectionController.CreateCollection)
+		collections.PUT("/:id", middleware.TokenAuth(), middleware.RequirePermission(services.PermCollectionManage), collectionController.UpdateCollection)
+		collections.DELETE("/:id", middleware.TokenAuth(), middleware.RequirePermission(services.PermCollectionManage), collectionController.DeleteCollection)
 	}
 
 	// 一言相关路由
 	hitokoto := router.Group("/hitokoto")
 	{
 		hitokoto.GET("", controllers.GetHitokoto(nil))
-		hitokoto.POST("", controllers.CreateHitokoto(nil))
-		hitokoto.PUT("/:id", controllers.UpdateHitokoto(nil))
-		hitokoto.DELETE("/:id", controllers.DeleteHitokoto(nil))
+		hitokoto.POST("", middleware.TokenAuth(), middleware.RequirePermission(services.PermHitokotoManage), controllers.CreateHitokoto(nil))
+		hitokoto.PUT("/:id", middleware.TokenAuth(), middleware.RequirePermission(services.PermHitokotoManage), controllers.UpdateHitokoto(nil))
+		hitokoto.DELETE("/:id", middleware.TokenAuth(), middleware.RequirePermission(services.PermHitokotoManage), controllers.DeleteHitokoto(nil))
 	}
 
 	// 图书相关路由
@@ -122,11 +193,11 @@ func RegisterRoutes(router *gin.RouterGroup, client *ent.Client) {
 	{
 		books.GET("", bookController.GetBooks)
 		books.GET("/:id", bookController.GetBook)
-		books.POST("", middleware.AuthRequired(), bookController.CreateBook)
-		books.PUT("/:id", middleware.AuthRequired(), bookController.UpdateBook)
-		books.DELETE("/:id", middleware.AuthRequired(), bookController.DeleteBook)
-		books.POST("/batch-delete", middleware.AuthRequired(), bookController.BatchDeleteBooks)
-		books.POST("/upload-cover", middleware.AuthRequired(), bookController.UploadBookCover)
+		books.POST("", middleware.TokenAuth(), middleware.RequirePermission(services.PermBookManage), bookController.CreateBook)
+		books.PUT("/:id", middleware.TokenAuth(), middleware.RequirePermission(services.PermBookManage), bookController.UpdateBook)
+		books.DELETE("/:id", middleware.TokenAuth(), middleware.RequirePermission(services.PermBookManage), bookController.DeleteBook)
+		books.POST("/batch-delete", middleware.TokenAuth(), middleware.RequirePermission(services.PermBookManage), bookController.BatchDeleteBooks)
+		books.POST("/upload-cover", middleware.TokenAuth(), middleware.RequirePermission(services.PermBookManage), bookController.UploadBookCover)
 	}
 
 	// 图片管理路由
@@ -134,8 +205,29 @@ func RegisterRoutes(router *gin.RouterGroup, client *ent.Client) {
 	{
 		images.GET("", imageController.GetImages)
 		images.GET("/:id", imageController.GetImage)
-		images.POST("", middleware.AuthRequired(), imageController.UploadImage)
-		images.DELETE("/:id", middleware.AuthRequired(), imageController.DeleteImage)
-		images.POST("/batch-delete", middleware.AuthRequired(), imageController.BatchDeleteImages)
+		images.POST("", middleware.TokenAuth(), middleware.RequirePermission(services.PermImageUpload), imageController.UploadImage)
+		images.DELETE("/:id", middleware.TokenAuth(), middleware.RequirePermission(services.PermImageDelete), imageController.DeleteImage)
+		images.POST("/batch-delete", middleware.TokenAuth(), middleware.RequirePermission(services.PermImageDelete), imageController.BatchDeleteImages)
 	}
 }
+
+// RegisterSiteRoutes 注册站点根路径下的公开资源（订阅源、站点地图等）
+func RegisterSiteRoutes(router *gin.RouterGroup, client *ent.Client) {
+	feedController := controllers.NewFeedController(client)
+	sitemapController := controllers.NewSitemapController(client)
+
+	// 订阅源
+	router.GET("/feed.xml", feedController.RSS)
+	router.GET("/atom.xml", feedController.Atom)
+	router.GET("/feed.json", feedController.JSONFeed)
+
+	// 标签订阅源
+	router.GET("/tags/:slug/feed.xml", feedController.TagRSS)
+	router.GET("/tags/:slug/atom.xml", feedController.TagAtom)
+	router.GET("/tags/:slug/feed.json", feedController.TagJSONFeed)
+
+	// 站点地图
+	router.GET("/sitemap.xml", sitemapController.Sitemap)
+	router.GET("/sitemaps/:page", sitemapController.SitemapPage)
+	router.GET("/robots.txt", sitemapController.Robots)
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/accesstoken"
+	"blog-go/utils"
+)
+
+// AccessTokenPrefix 个人访问令牌的前缀，用于和登录会话的 JWT 区分
+const AccessTokenPrefix = "blog_pat_"
+
+// 最后使用时间的更新间隔，避免每个请求都写数据库
+const accessTokenTouchInterval = time.Minute
+
+// ErrInvalidAccessToken 访问令牌无效、已过期或已撤销
+var ErrInvalidAccessToken = errors.New("访问令牌无效或已过期")
+
+// IsAccessToken 判断 Bearer 令牌是否为个人访问令牌
+func IsAccessToken(token string) bool {
+	return strings.HasPrefix(token, AccessTokenPrefix)
+}
+
+// NewAccessToken 生成新的访问令牌，返回令牌原文、哈希和用于展示的前缀
+func NewAccessToken() (token, hash, prefix string, err error) {
+	random, err := utils.RandomToken(32)
+	if err != nil {
+		return "", "", "", err
+	}
+	token = AccessTokenPrefix + random
+	return token, utils.HashToken(token), token[:len(AccessTokenPrefix)+4], nil
+}
+
+// AuthenticateAccessToken 校验访问令牌并返回令牌（包含所属用户），同时记录最后使用时间和 IP
+func AuthenticateAccessToken(ctx context.Context, client *ent.Client, token, ip string) (*ent.AccessToken, error) {
+	now := time.Now()
+	t, err := client.AccessToken.Query().
+		Where(
+			accesstoken.TokenHashEQ(utils.HashToken(token)),
+			accesstoken.RevokedAtIsNil(),
+			accesstoken.Or(accesstoken.ExpiresAtIsNil(), accesstoken.ExpiresAtGT(now)),
+		).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvalidAccessToken
+		}
+		return nil, err
+	}
+
+	if t.LastUsedAt == nil || now.Sub(*t.LastUsedAt) > accessTokenTouchInterval {
+		client.AccessToken.UpdateOne(t).
+			SetLastUsedAt(now).
+			SetLastUsedIP(ip).
+			Exec(ctx)
+	}
+	return t, nil
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"html/template"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/utils"
+)
+
+// 邮箱验证令牌的签名用途
+const emailVerifyPurpose = "email-verify"
+
+// ErrInvalidVerificationToken 邮箱验证链接无效或已过期
+var ErrInvalidVerificationToken = errors.New("验证链接无效或已过期")
+
+var verifyMailTemplate = template.Must(template.New("verify").Parse(`<p>{{.Name}}，你好：</p>
+<p>感谢注册，请在 {{.Expires}} 内点击下面的链接验证邮箱：</p>
+<p><a href="{{.URL}}">{{.URL}}</a></p>
+<p style="color:#999;font-size:12px">如果不是你本人注册，请忽略这封邮件。</p>
+`))
+
+var inviteMailTemplate = template.Must(template.New("invite").Parse(`<p>你好：</p>
+<p>{{.Name}} 邀请你注册账号，请点击下面的链接完成注册：</p>
+<p><a href="{{.URL}}">{{.URL}}</a></p>
+{{if .Expires}}<p>邀请将在 {{.Expires}} 后失效。</p>{{end}}
+`))
+
+var resetMailTemplate = template.Must(template.New("reset").Parse(`<p>{{.Name}}，你好：</p>
+<p>我们收到了重置密码的申请，请在 {{.Expires}} 内点击下面的链接设置新密码（链接只能使用一次）：</p>
+<p><a href="{{.URL}}">{{.URL}}</a></p>
+<p style="color:#999;font-size:12px">如果不是你本人操作，请忽略这封邮件，你的密码不会改变。</p>
+`))
+
+var recoveryUsedMailTemplate = template.Must(template.New("recovery-used").Parse(`<p>{{.Name}}，你好：</p>
+<p>你的账号刚刚使用恢复码完成了两步验证登录，剩余 {{.Remaining}} 个未使用的恢复码。</p>
+<p>如果不是你本人操作，请立即<a href="{{.URL}}">登录</a>修改密码并重新生成恢复码。</p>
+`))
+
+var lockedMailTemplate = template.Must(template.New("locked").Parse(`<p>{{.Name}}，你好：</p>
+<p>你的账号连续多次登录失败，已被临时锁定 {{.Expires}}，到期后可以重新登录。</p>
+<p>如果不是你本人操作，可能有人在尝试猜测你的密码，建议<a href="{{.URL}}">重置密码</a>并启用两步验证。</p>
+`))
+
+type accountMailData struct {
+	Name      string
+	URL       string
+	Expires   string
+	Remaining int
+}
+
+// humanDuration 邮件中显示的有效期，如 24小时、30分钟
+func humanDuration(d time.Duration) string {
+	if d >= time.Hour {
+		return strconv.Itoa(int(d.Round(time.Hour)/time.Hour)) + "小时"
+	}
+	minutes := int(d.Round(time.Minute) / time.Minute)
+	if minutes < 1 {
+		minutes = 1
+	}
+	return strconv.Itoa(minutes) + "分钟"
+}
+
+// sendAccountMail 发送账号相关的事务邮件（不受评论通知退订影响）
+func (n *Notifier) sendAccountMail(to, subject string, tmpl *template.Template, data accountMailData) error {
+	var body bytes.Buffer
+	if err := tmpl.Execute(&body, data); err != nil {
+		return err
+	}
+	n.enqueue(mailJob{mail: Mail{To: to, Subject: subject, HTML: body.String()}, account: true})
+	return nil
+}
+
+// SendEmailVerification 发送带签名和有效期的邮箱验证链接
+//
+// 令牌中包含邮箱，邮箱变更后旧链接失效。
+func (n *Notifier) SendEmailVerification(u *ent.User) error {
+	expires := time.Now().Add(n.verifyTTL).Unix()
+	token := utils.SignValue(n.secret, emailVerifyPurpose,
+		strconv.Itoa(u.ID)+":"+strconv.FormatInt(expires, 10)+":"+strings.ToLower(u.Email))
+	name := u.Nickname
+	if name == "" {
+		name = u.Username
+	}
+	return n.sendAccountMail(u.Email, "请验证你的邮箱", verifyMailTemplate, accountMailData{
+		Name:    name,
+		URL:     n.apiURL + "/api/auth/verify-email?token=" + url.QueryEscape(token),
+		Expires: humanDuration(n.verifyTTL),
+	})
+}
+
+// VerifyEmail 校验邮箱验证令牌并标记用户邮箱已验证
+func (n *Notifier) VerifyEmail(ctx context.Context, token string) (*ent.User, error) {
+	value, ok := utils.VerifySignedValue(n.secret, emailVerifyPurpose, token)
+	if !ok {
+		return nil, ErrInvalidVerificationToken
+	}
+	parts := strings.SplitN(value, ":", 3)
+	if len(parts) != 3 {
+		return nil, ErrInvalidVerificationToken
+	}
+	userID, err1 := strconv.Atoi(parts[0])
+	expires, err2 := strconv.ParseInt(parts[1], 10, 64)
+	if err1 != nil || err2 != nil || time.Now().Unix() > expires {
+		return nil, ErrInvalidVerificationToken
+	}
+
+	u, err := n.client.User.Get(ctx, userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvalidVerificationToken
+		}
+		return nil, err
+	}
+	if strings.ToLower(u.Email) != parts[2] {
+		return nil, ErrInvalidVerificationToken
+	}
+	if u.EmailVerifiedAt != nil {
+		return u, nil
+	}
+	return u.Update().
+		SetEmailVerifiedAt(time.Now()).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+}
+
+// SendInvitation 发送注册邀请邮件，链接指向前端注册页
+func (n *Notifier) SendInvitation(email, code, inviter string, expiresAt *time.Time) error {
+	data := accountMailData{
+		Name: inviter,
+		URL:  n.siteURL + "/register?invite=" + url.QueryEscape(code),
+	}
+	if expiresAt != nil {
+		data.Expires = humanDuration(time.Until(*expiresAt))
+	}
+	return n.sendAccountMail(email, inviter+" 邀请你注册", inviteMailTemplate, data)
+}
+
+// SendPasswordReset 发送重置密码邮件，链接指向前端重置密码页
+func (n *Notifier) SendPasswordReset(u *ent.User, token string, ttl time.Duration) error {
+	name := u.Nickname
+	if name == "" {
+		name = u.Username
+	}
+	return n.sendAccountMail(u.Email, "重置密码", resetMailTemplate, accountMailData{
+		Name:    name,
+		URL:     n.siteURL + "/reset-password?token=" + url.QueryEscape(token),
+		Expires: humanDuration(ttl),
+	})
+}
+
+// SendRecoveryCodeUsed 使用恢复码登录后提醒用户，恢复码泄露时可以及时处理
+func (n *Notifier) SendRecoveryCodeUsed(u *ent.User, remaining int) error {
+	name := u.Nickname
+	if name == "" {
+		name = u.Username
+	}
+	return n.sendAccountMail(u.Email, "恢复码已被使用", recoveryUsedMailTemplate, accountMailData{
+		Name:      name,
+		URL:       n.siteURL + "/login",
+		Remaining: remaining,
+	})
+}
+
+// SendAccountLocked 账号因连续登录失败被锁定时提醒用户
+func (n *Notifier) SendAccountLocked(u *ent.User, d time.Duration) error {
+	name := u.Nickname
+	if name == "" {
+		name = u.Username
+	}
+	return n.sendAccountMail(u.Email, "账号已被临时锁定", lockedMailTemplate, accountMailData{
+		Name:    name,
+		URL:     n.siteURL + "/forgot-password",
+		Expires: humanDuration(d),
+	})
+}
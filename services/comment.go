@@ -0,0 +1,6 @@
+package services
+
+// MaxCommentDepth 评论最大嵌套层级（根评论为第0层）
+//
+// 发表回复、评论树加载和导入时压平过深的回复都以此为准。
+const MaxCommentDepth = 5
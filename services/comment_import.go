@@ -0,0 +1,475 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/comment"
+	"blog-go/ent/post"
+	"blog-go/ent/postslughistory"
+)
+
+// ImportFormat 支持导入的评论系统
+type ImportFormat string
+
+const (
+	ImportDisqus ImportFormat = "disqus"
+	ImportWaline ImportFormat = "waline"
+	ImportTwikoo ImportFormat = "twikoo"
+)
+
+// 导入的评论没有邮箱时使用的占位地址（.invalid 为保留域名，通知时会跳过）
+const ImportedEmailPlaceholder = "anonymous@import.invalid"
+
+// 每批写入的评论数
+const importBatchSize = 500
+
+// ImportedComment 从导出文件中解析出的一条评论
+type ImportedComment struct {
+	ExternalID string
+	// 父评论在原系统中的ID，根评论为空
+	ParentID string
+	// 所属页面的URL或路径
+	Thread string
+	// 原系统中页面的标识（Disqus identifier），同样用于匹配文章
+	ThreadIdentifier string
+	ThreadTitle      string
+	Author           string
+	Email            string
+	Website          string
+	Content          string
+	CreatedAt        time.Time
+	Status           comment.Status
+}
+
+// CommentImportOptions 导入选项
+type CommentImportOptions struct {
+	// 手动指定页面对应的文章ID，优先于自动匹配
+	Mapping map[string]int
+	// 只解析和匹配，不写入数据库
+	DryRun bool
+}
+
+// UnmatchedThread 没有匹配到文章的页面
+type UnmatchedThread struct {
+	Thread   string `json:"thread"`
+	Title    string `json:"title,omitempty"`
+	Comments int    `json:"comments"`
+}
+
+// CommentImportReport 导入结果
+type CommentImportReport struct {
+	Format   ImportFormat `json:"format"`
+	DryRun   bool         `json:"dry_run"`
+	Total    int          `json:"total"`
+	Imported int          `json:"imported"`
+	// 之前已经导入过的评论
+	Duplicates int `json:"duplicates"`
+	// 内容为空等无法导入的评论
+	Invalid int `json:"invalid"`
+	// 父评论不在导出文件中，作为根评论导入
+	Orphans int `json:"orphans"`
+	// 嵌套超过 MaxCommentDepth 层，改为回复第 MaxCommentDepth-1 层祖先的评论
+	Flattened int               `json:"flattened"`
+	Threads   int               `json:"threads"`
+	Unmatched []UnmatchedThread `json:"unmatched"`
+}
+
+// importItem 待导入的评论及其在本站的位置
+type importItem struct {
+	ImportedComment
+	importID string
+	postID   int
+	parent   *importItem
+	// 父评论已在之前的导入中写入
+	parentDBID *int
+	depth      int
+	id         int
+}
+
+// ImportComments 导入评论：按URL或slug匹配文章，保留嵌套关系、原始时间和审核状态
+//
+// 每条评论记录来源ID（格式:原ID），重复导入同一文件时已导入的评论会被跳过。
+// 导入的评论不会发送通知，也不会训练垃圾评论分类器。
+func ImportComments(ctx context.Context, client *ent.Client, format ImportFormat, comments []ImportedComment, opts CommentImportOptions) (*CommentImportReport, error) {
+	report := &CommentImportReport{
+		Format:    format,
+		DryRun:    opts.DryRun,
+		Total:     len(comments),
+		Unmatched: []UnmatchedThread{},
+	}
+
+	// 按页面匹配文章
+	postIDs := map[string]int{}
+	unmatched := map[string]*UnmatchedThread{}
+	var unmatchedOrder []string
+	var items []*importItem
+	for _, c := range comments {
+		key := c.Thread
+		if key == "" {
+			key = c.ThreadIdentifier
+		}
+		postID, ok := postIDs[key]
+		if !ok {
+			id, err := matchImportThread(ctx, client, c, opts.Mapping)
+			if err != nil {
+				return nil, err
+			}
+			postIDs[key] = id
+			postID = id
+			if id != 0 {
+				report.Threads++
+			}
+		}
+		if postID == 0 {
+			u := unmatched[key]
+			if u == nil {
+				u = &UnmatchedThread{Thread: key, Title: c.ThreadTitle}
+				unmatched[key] = u
+				unmatchedOrder = append(unmatchedOrder, key)
+			}
+			u.Comments++
+			continue
+		}
+		if strings.TrimSpace(c.Content) == "" || c.ExternalID == "" {
+			report.Invalid++
+			continue
+		}
+		items = append(items, &importItem{
+			ImportedComment: c,
+			importID:        string(format) + ":" + c.ExternalID,
+			postID:          postID,
+		})
+	}
+	for _, key := range unmatchedOrder {
+		report.Unmatched = append(report.Unmatched, *unmatched[key])
+	}
+
+	// 跳过已导入的评论，并记录其ID供子评论关联
+	existing, err := importedCommentIDs(ctx, client, format, items)
+	if err != nil {
+		return nil, err
+	}
+	byExternal := make(map[string]*importItem, len(items))
+	pending := items[:0]
+	for _, it := range items {
+		if _, ok := existing[it.importID]; ok {
+			report.Duplicates++
+			continue
+		}
+		if _, ok := byExternal[it.ExternalID]; ok {
+			report.Duplicates++
+			continue
+		}
+		byExternal[it.ExternalID] = it
+		pending = append(pending, it)
+	}
+
+	// 关联父评论，父评论缺失或属于其他文章时作为根评论
+	for _, it := range pending {
+		if it.ParentID == "" {
+			continue
+		}
+		if p, ok := byExternal[it.ParentID]; ok && p.postID == it.postID {
+			it.parent = p
+		} else if e, ok := existing[string(format)+":"+it.ParentID]; ok && e.postID == it.postID {
+			id := e.id
+			it.parentDBID = &id
+		} else {
+			report.Orphans++
+		}
+	}
+	// 父评论已在数据库中时，从父评论的层级开始计算
+	chains := map[int][]int{}
+	for _, it := range pending {
+		if it.parent == nil && it.parentDBID != nil {
+			chain, err := commentChain(ctx, client, *it.parentDBID, chains)
+			if err != nil {
+				return nil, err
+			}
+			it.depth = len(chain)
+		}
+	}
+	for _, it := range pending {
+		importDepth(it, map[*importItem]bool{})
+	}
+	report.Flattened, err = flattenImportDepth(ctx, client, pending, chains)
+	if err != nil {
+		return nil, err
+	}
+
+	// 父评论先写入，同一层按时间排序
+	sort.SliceStable(pending, func(i, j int) bool {
+		if pending[i].depth != pending[j].depth {
+			return pending[i].depth < pending[j].depth
+		}
+		return pending[i].CreatedAt.Before(pending[j].CreatedAt)
+	})
+	report.Imported = len(pending)
+	if opts.DryRun || len(pending) == 0 {
+		return report, nil
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for start := 0; start < len(pending); {
+		// 每批只包含同一层的评论，保证父评论的ID已经生成
+		end := start + 1
+		for end < len(pending) && end-start < importBatchSize && pending[end].depth == pending[start].depth {
+			end++
+		}
+		batch := pending[start:end]
+		builders := make([]*ent.CommentCreate, 0, len(batch))
+		for _, it := range batch {
+			builders = append(builders, newImportedCommentCreate(tx, format, it))
+		}
+		created, err := tx.Comment.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		for i, c := range created {
+			batch[i].id = c.ID
+		}
+		start = end
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// importDepth 计算评论在导入数据中的层级，遇到循环引用时断开
+func importDepth(it *importItem, visiting map[*importItem]bool) int {
+	if it.parent == nil || it.depth > 0 {
+		return it.depth
+	}
+	if visiting[it] {
+		it.parent = nil
+		return 0
+	}
+	visiting[it] = true
+	it.depth = importDepth(it.parent, visiting) + 1
+	if it.parent == nil {
+		it.depth = 0
+	}
+	return it.depth
+}
+
+// flattenImportDepth 嵌套超过 MaxCommentDepth 层的回复改为挂在第 MaxCommentDepth-1 层的祖先下，返回调整的评论数
+func flattenImportDepth(ctx context.Context, client *ent.Client, items []*importItem, chains map[int][]int) (int, error) {
+	// 按层级从浅到深处理，深层评论沿已调整过的父评论向上查找
+	sort.SliceStable(items, func(i, j int) bool { return items[i].depth < items[j].depth })
+	flattened := 0
+	for _, it := range items {
+		if it.depth <= MaxCommentDepth {
+			continue
+		}
+		ancestor := it
+		for ancestor.parent != nil && ancestor.depth > MaxCommentDepth-1 {
+			ancestor = ancestor.parent
+		}
+		if ancestor.depth == MaxCommentDepth-1 {
+			it.parent = ancestor
+			it.parentDBID = nil
+		} else {
+			// 祖先链在导入数据中到此为止，继续在数据库中查找
+			chain, err := commentChain(ctx, client, *ancestor.parentDBID, chains)
+			if err != nil {
+				return 0, err
+			}
+			id := chain[MaxCommentDepth-1]
+			it.parent = nil
+			it.parentDBID = &id
+		}
+		it.depth = MaxCommentDepth
+		flattened++
+	}
+	return flattened, nil
+}
+
+// commentChain 返回数据库中评论从根评论到自身的ID链
+func commentChain(ctx context.Context, client *ent.Client, id int, cache map[int][]int) ([]int, error) {
+	if chain, ok := cache[id]; ok {
+		return chain, nil
+	}
+	var chain []int
+	for cur := &id; cur != nil && len(chain) <= 2*MaxCommentDepth+1; {
+		c, err := client.Comment.Query().
+			Where(comment.IDEQ(*cur)).
+			Select(comment.FieldParentID).
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		chain = append([]int{c.ID}, chain...)
+		cur = c.ParentID
+	}
+	cache[id] = chain
+	return chain, nil
+}
+
+func newImportedCommentCreate(tx *ent.Tx, format ImportFormat, it *importItem) *ent.CommentCreate {
+	createdAt := it.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	author := it.Author
+	if author == "" {
+		author = "匿名"
+	}
+	email := it.Email
+	if email == "" {
+		email = ImportedEmailPlaceholder
+	}
+
+	builder := tx.Comment.Create().
+		SetContent(it.Content).
+		SetAuthor(author).
+		SetEmail(email).
+		SetWebsite(it.Website).
+		SetStatus(it.Status).
+		SetPostID(it.postID).
+		SetCreatedAt(createdAt).
+		SetUpdatedAt(createdAt).
+		SetImportID(it.importID).
+		SetModerationReason(fmt.Sprintf("导入自 %s", format))
+	if it.parent != nil {
+		builder.SetParentID(it.parent.id)
+	} else if it.parentDBID != nil {
+		builder.SetParentID(*it.parentDBID)
+	}
+	return builder
+}
+
+// importedComment 已导入评论的ID和所属文章
+type importedComment struct {
+	id     int
+	postID int
+}
+
+func importedCommentIDs(ctx context.Context, client *ent.Client, format ImportFormat, items []*importItem) (map[string]importedComment, error) {
+	ids := make([]string, 0, len(items)*2)
+	for _, it := range items {
+		ids = append(ids, it.importID)
+		if it.ParentID != "" {
+			ids = append(ids, string(format)+":"+it.ParentID)
+		}
+	}
+
+	result := map[string]importedComment{}
+	for start := 0; start < len(ids); start += importBatchSize {
+		end := start + importBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		found, err := client.Comment.Query().
+			Where(comment.ImportIDIn(ids[start:end]...)).
+			WithPost().
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range found {
+			if c.ImportID == nil || c.Edges.Post == nil {
+				continue
+			}
+			result[*c.ImportID] = importedComment{id: c.ID, postID: c.Edges.Post.ID}
+		}
+	}
+	return result, nil
+}
+
+// matchImportThread 匹配评论所属的文章，没有匹配到时返回0
+//
+// 依次尝试手动映射、路径最后一段作为 slug（包括历史slug）和文章ID。
+func matchImportThread(ctx context.Context, client *ent.Client, c ImportedComment, mapping map[string]int) (int, error) {
+	for _, key := range []string{c.Thread, c.ThreadIdentifier} {
+		if id, ok := mapping[key]; ok && key != "" {
+			exists, err := client.Post.Query().Where(post.IDEQ(id)).Exist(ctx)
+			if err != nil {
+				return 0, err
+			}
+			if !exists {
+				return 0, fmt.Errorf("页面 %s 映射的文章 %d 不存在", key, id)
+			}
+			return id, nil
+		}
+	}
+
+	for _, candidate := range importThreadCandidates(c.Thread, c.ThreadIdentifier) {
+		p, err := client.Post.Query().Where(post.SlugEQ(candidate)).Only(ctx)
+		if err == nil {
+			return p.ID, nil
+		}
+		if !ent.IsNotFound(err) {
+			return 0, err
+		}
+
+		h, err := client.PostSlugHistory.Query().
+			Where(postslughistory.SlugEQ(candidate)).
+			WithPost().
+			Only(ctx)
+		if err == nil && h.Edges.Post != nil {
+			return h.Edges.Post.ID, nil
+		}
+		if err != nil && !ent.IsNotFound(err) {
+			return 0, err
+		}
+
+		if id, err := strconv.Atoi(candidate); err == nil {
+			exists, err := client.Post.Query().Where(post.IDEQ(id)).Exist(ctx)
+			if err != nil {
+				return 0, err
+			}
+			if exists {
+				return id, nil
+			}
+		}
+	}
+	return 0, nil
+}
+
+// importThreadCandidates 从页面URL或标识中提取可能的 slug
+//
+// 例如 https://example.com/posts/hello-world/、/2019/05/hello-world.html 都会得到 hello-world。
+func importThreadCandidates(keys ...string) []string {
+	var candidates []string
+	seen := map[string]bool{}
+	add := func(s string) {
+		if s != "" && !seen[s] {
+			seen[s] = true
+			candidates = append(candidates, s)
+		}
+	}
+	for _, key := range keys {
+		p := key
+		if u, err := url.Parse(key); err == nil {
+			p = u.Path
+		}
+		p = strings.TrimSuffix(strings.Trim(p, "/"), "/index.html")
+		if p == "" {
+			continue
+		}
+		last := path.Base(p)
+		if unescaped, err := url.PathUnescape(last); err == nil {
+			last = unescaped
+		}
+		last = strings.TrimSuffix(strings.TrimSuffix(last, ".html"), ".htm")
+		add(last)
+		// Disqus identifier 可能直接就是 slug
+		if !strings.Contains(key, "/") {
+			add(key)
+		}
+	}
+	return candidates
+}
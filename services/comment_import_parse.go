@@ -0,0 +1,331 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"blog-go/ent/comment"
+	"blog-go/utils"
+)
+
+// ParseCommentExport 解析其他评论系统的导出文件
+func ParseCommentExport(format ImportFormat, r io.Reader) ([]ImportedComment, error) {
+	switch format {
+	case ImportDisqus:
+		return parseDisqusExport(r)
+	case ImportWaline:
+		return parseWalineExport(r)
+	case ImportTwikoo:
+		return parseTwikooExport(r)
+	default:
+		return nil, errors.New("不支持的导入格式: " + string(format))
+	}
+}
+
+// Disqus 导出的 XML（https://help.disqus.com/en/articles/1717164-comments-export）
+type disqusExport struct {
+	Threads []disqusThread `xml:"thread"`
+	Posts   []disqusPost   `xml:"post"`
+}
+
+// disqusRef 通过 dsq:id 属性引用其他节点
+type disqusRef struct {
+	Attrs []xml.Attr `xml:",any,attr"`
+}
+
+func (r disqusRef) id() string {
+	for _, a := range r.Attrs {
+		if a.Name.Local == "id" {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+type disqusThread struct {
+	disqusRef
+	Identifier string `xml:"id"`
+	Link       string `xml:"link"`
+	Title      string `xml:"title"`
+}
+
+type disqusPost struct {
+	disqusRef
+	Message   string `xml:"message"`
+	CreatedAt string `xml:"createdAt"`
+	IsDeleted string `xml:"isDeleted"`
+	IsSpam    string `xml:"isSpam"`
+	Author    struct {
+		Name     string `xml:"name"`
+		Email    string `xml:"email"`
+		Username string `xml:"username"`
+	} `xml:"author"`
+	Thread disqusRef  `xml:"thread"`
+	Parent *disqusRef `xml:"parent"`
+}
+
+func parseDisqusExport(r io.Reader) ([]ImportedComment, error) {
+	var export disqusExport
+	if err := xml.NewDecoder(r).Decode(&export); err != nil {
+		return nil, err
+	}
+
+	threads := make(map[string]disqusThread, len(export.Threads))
+	for _, t := range export.Threads {
+		threads[t.id()] = t
+	}
+
+	comments := make([]ImportedComment, 0, len(export.Posts))
+	for _, p := range export.Posts {
+		t := threads[p.Thread.id()]
+		c := ImportedComment{
+			ExternalID:       p.id(),
+			Thread:           strings.TrimSpace(t.Link),
+			ThreadIdentifier: strings.TrimSpace(t.Identifier),
+			ThreadTitle:      strings.TrimSpace(t.Title),
+			Author:           strings.TrimSpace(p.Author.Name),
+			Email:            strings.TrimSpace(p.Author.Email),
+			Content:          utils.SanitizeHTML(strings.TrimSpace(p.Message)),
+			CreatedAt:        parseImportTime(p.CreatedAt),
+			Status:           comment.StatusApproved,
+		}
+		if c.Author == "" {
+			c.Author = p.Author.Username
+		}
+		if c.Thread == "" {
+			c.Thread = c.ThreadIdentifier
+		}
+		if p.Parent != nil {
+			c.ParentID = p.Parent.id()
+		}
+		switch {
+		case p.IsSpam == "true":
+			c.Status = comment.StatusSpam
+		case p.IsDeleted == "true":
+			c.Status = comment.StatusTrashed
+		}
+		comments = append(comments, c)
+	}
+	return comments, nil
+}
+
+// Waline 后台导出的 JSON，评论在 data.Comment 中
+type walineExport struct {
+	Data struct {
+		Comment []walineComment `json:"Comment"`
+	} `json:"data"`
+}
+
+type walineComment struct {
+	ObjectID   flexString `json:"objectId"`
+	ID         flexString `json:"id"`
+	PID        flexString `json:"pid"`
+	Nick       string     `json:"nick"`
+	Mail       string     `json:"mail"`
+	Link       string     `json:"link"`
+	Comment    string     `json:"comment"`
+	URL        string     `json:"url"`
+	Status     string     `json:"status"`
+	InsertedAt flexTime   `json:"insertedAt"`
+	CreatedAt  flexTime   `json:"createdAt"`
+}
+
+func parseWalineExport(r io.Reader) ([]ImportedComment, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var records []walineComment
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		// 直接导出的评论表
+		err = json.Unmarshal(trimmed, &records)
+	} else {
+		var export walineExport
+		err = json.Unmarshal(trimmed, &export)
+		records = export.Data.Comment
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]ImportedComment, 0, len(records))
+	for _, w := range records {
+		c := ImportedComment{
+			ExternalID: string(w.ObjectID),
+			ParentID:   string(w.PID),
+			Thread:     strings.TrimSpace(w.URL),
+			Author:     strings.TrimSpace(w.Nick),
+			Email:      strings.TrimSpace(w.Mail),
+			Website:    strings.TrimSpace(w.Link),
+			// Waline 保存的是评论的 Markdown 原文
+			Content:   strings.TrimSpace(w.Comment),
+			CreatedAt: time.Time(w.InsertedAt),
+			Status:    comment.StatusApproved,
+		}
+		if c.ExternalID == "" {
+			c.ExternalID = string(w.ID)
+		}
+		if c.CreatedAt.IsZero() {
+			c.CreatedAt = time.Time(w.CreatedAt)
+		}
+		switch w.Status {
+		case "waiting":
+			c.Status = comment.StatusPending
+		case "spam":
+			c.Status = comment.StatusSpam
+		}
+		comments = append(comments, c)
+	}
+	return comments, nil
+}
+
+// Twikoo 导出的评论集合，可能是 JSON 数组或每行一条记录（云数据库导出）
+type twikooComment struct {
+	ID      flexString `json:"_id"`
+	PID     flexString `json:"pid"`
+	Nick    string     `json:"nick"`
+	Mail    string     `json:"mail"`
+	Link    string     `json:"link"`
+	Comment string     `json:"comment"`
+	URL     string     `json:"url"`
+	Href    string     `json:"href"`
+	IsSpam  bool       `json:"isSpam"`
+	Created flexTime   `json:"created"`
+}
+
+func parseTwikooExport(r io.Reader) ([]ImportedComment, error) {
+	br := bufio.NewReader(r)
+	var records []twikooComment
+	if b, err := peekNonSpace(br); err != nil {
+		return nil, err
+	} else if b == '[' {
+		if err := json.NewDecoder(br).Decode(&records); err != nil {
+			return nil, err
+		}
+	} else {
+		dec := json.NewDecoder(br)
+		for {
+			var t twikooComment
+			if err := dec.Decode(&t); err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+			records = append(records, t)
+		}
+	}
+
+	comments := make([]ImportedComment, 0, len(records))
+	for _, t := range records {
+		c := ImportedComment{
+			ExternalID: string(t.ID),
+			ParentID:   string(t.PID),
+			Thread:     strings.TrimSpace(t.URL),
+			Author:     strings.TrimSpace(t.Nick),
+			Email:      strings.TrimSpace(t.Mail),
+			Website:    strings.TrimSpace(t.Link),
+			// Twikoo 保存的是渲染后的 HTML
+			Content:   utils.SanitizeHTML(strings.TrimSpace(t.Comment)),
+			CreatedAt: time.Time(t.Created),
+			Status:    comment.StatusApproved,
+		}
+		if c.Thread == "" {
+			c.Thread = strings.TrimSpace(t.Href)
+		}
+		if t.IsSpam {
+			c.Status = comment.StatusSpam
+		}
+		comments = append(comments, c)
+	}
+	return comments, nil
+}
+
+func peekNonSpace(br *bufio.Reader) (byte, error) {
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+		return b, br.UnreadByte()
+	}
+}
+
+// flexString 兼容字符串、数字和 MongoDB 扩展 JSON（{"$oid": "..."}）形式的ID
+type flexString string
+
+func (s *flexString) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch x := v.(type) {
+	case string:
+		*s = flexString(x)
+	case float64:
+		*s = flexString(strconv.FormatFloat(x, 'f', -1, 64))
+	case map[string]interface{}:
+		if oid, ok := x["$oid"].(string); ok {
+			*s = flexString(oid)
+		}
+	}
+	return nil
+}
+
+// flexTime 兼容毫秒时间戳、时间字符串和 MongoDB 扩展 JSON（{"$date": ...}）形式的时间
+type flexTime time.Time
+
+func (t *flexTime) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*t = flexTime(parseFlexTime(v))
+	return nil
+}
+
+func parseFlexTime(v interface{}) time.Time {
+	switch x := v.(type) {
+	case float64:
+		return time.UnixMilli(int64(x))
+	case string:
+		if ms, err := strconv.ParseInt(x, 10, 64); err == nil {
+			return time.UnixMilli(ms)
+		}
+		return parseImportTime(x)
+	case map[string]interface{}:
+		if d, ok := x["$date"]; ok {
+			return parseFlexTime(d)
+		}
+		if n, ok := x["$numberLong"]; ok {
+			return parseFlexTime(n)
+		}
+	}
+	return time.Time{}
+}
+
+// parseImportTime 解析导出文件中常见的时间格式，无法解析时返回零值
+func parseImportTime(s string) time.Time {
+	s = strings.TrimSpace(s)
+	for _, layout := range []string{
+		time.RFC3339Nano,
+		"2006-01-02T15:04:05",
+		"2006-01-02 15:04:05",
+		"2006-01-02T15:04:05.000Z",
+	} {
+		if t, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
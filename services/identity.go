@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/identity"
+	"blog-go/ent/user"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// OAuthProfile 第三方平台返回的用户信息
+type OAuthProfile struct {
+	Provider string
+	// 平台上不会变化的用户ID
+	Subject string
+	// 平台上的登录名，可能被修改或与本站用户名重复
+	Login string
+	Name  string
+	Email string
+	// 平台确认该邮箱属于此用户
+	EmailVerified bool
+	AvatarURL     string
+}
+
+// LinkOAuthIdentity 根据第三方账号查找或创建本站用户
+//
+// 已关联的账号直接返回对应用户；否则在平台确认的邮箱与本站已验证邮箱的用户一致时关联该用户，
+// 其余情况创建新用户。登录名与本站用户名重复时生成新的用户名，不会登录到同名的本地账号。
+func LinkOAuthIdentity(ctx context.Context, client *ent.Client, profile OAuthProfile) (*ent.User, error) {
+	now := time.Now()
+
+	existing, err := client.Identity.Query().
+		Where(
+			identity.ProviderEQ(profile.Provider),
+			identity.ProviderUserIDEQ(profile.Subject),
+		).
+		WithUser().
+		Only(ctx)
+	if err == nil {
+		// 同步平台上的最新资料
+		if err := existing.Update().
+			SetLogin(profile.Login).
+			SetEmail(profile.Email).
+			SetAvatar(profile.AvatarURL).
+			SetUpdatedAt(now).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+		return existing.Edges.User, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := oauthUserFor(ctx, tx, profile, now)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Identity.Create().
+		SetProvider(profile.Provider).
+		SetProviderUserID(profile.Subject).
+		SetLogin(profile.Login).
+		SetEmail(profile.Email).
+		SetAvatar(profile.AvatarURL).
+		SetUser(u).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		Exec(ctx); err != nil {
+		tx.Rollback()
+		// 同一账号并发登录时另一个请求已完成关联
+		if ent.IsConstraintError(err) {
+			return LinkOAuthIdentity(ctx, client, profile)
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// oauthUserFor 为首次登录的第三方账号找到要关联的用户，没有时创建
+func oauthUserFor(ctx context.Context, tx *ent.Tx, profile OAuthProfile, now time.Time) (*ent.User, error) {
+	email := strings.TrimSpace(profile.Email)
+	if email != "" && profile.EmailVerified {
+		// 只关联已验证邮箱的本地用户，避免他人抢先用该邮箱注册后接管第三方账号
+		u, err := tx.User.Query().Where(user.EmailEqualFold(email)).Only(ctx)
+		if err == nil {
+			if u.EmailVerifiedAt != nil {
+				return u, nil
+			}
+			email = ""
+		} else if !ent.IsNotFound(err) {
+			return nil, err
+		}
+	} else if email != "" {
+		// 未经平台确认的邮箱不能用于关联，也不能占用本站用户的邮箱
+		taken, err := tx.User.Query().Where(user.EmailEqualFold(email)).Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if taken {
+			email = ""
+		}
+	}
+	var verifiedAt *time.Time
+	if email == "" {
+		email = profile.Provider + "-" + profile.Subject + "@users.noreply.invalid"
+	} else if profile.EmailVerified {
+		verifiedAt = &now
+	}
+
+	username, err := uniqueOAuthUsername(ctx, tx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	// 第三方登录的用户没有密码，写入随机密码的哈希使其无法通过密码登录
+	password, err := randomPasswordHash()
+	if err != nil {
+		return nil, err
+	}
+
+	nickname := profile.Name
+	if nickname == "" {
+		nickname = profile.Login
+	}
+	return tx.User.Create().
+		SetUsername(username).
+		SetEmail(email).
+		SetPassword(password).
+		SetNickname(nickname).
+		SetAvatar(profile.AvatarURL).
+		SetNillableEmailVerifiedAt(verifiedAt).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		Save(ctx)
+}
+
+// uniqueOAuthUsername 以平台登录名作为用户名，重复时加上平台名和序号
+func uniqueOAuthUsername(ctx context.Context, tx *ent.Tx, profile OAuthProfile) (string, error) {
+	base := strings.TrimSpace(profile.Login)
+	if base == "" {
+		base = profile.Provider + "-" + profile.Subject
+	}
+	candidates := []string{base, base + "-" + profile.Provider}
+	for _, candidate := range candidates {
+		taken, err := tx.User.Query().Where(user.UsernameEqualFold(candidate)).Exist(ctx)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return candidate, nil
+		}
+	}
+	suffix := make([]byte, 3)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", err
+	}
+	return base + "-" + profile.Provider + "-" + hex.EncodeToString(suffix), nil
+}
+
+func randomPasswordHash() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(hex.EncodeToString(secret)), bcrypt.DefaultCost)
+	return string(hash), err
+}
@@ -0,0 +1,213 @@
+package services
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/user"
+	"blog-go/utils"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 最后一次登录失败超过该时长后重新计数
+const loginFailureWindow = 24 * time.Hour
+
+// 内存中最多记录的 IP 和不存在的邮箱数
+const loginTrackerLimit = 10000
+
+// dummyPasswordHash 邮箱不存在时用于比较的哈希，使响应时间与密码错误一致，避免通过耗时判断邮箱是否注册
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("blog-go login timing"), bcrypt.DefaultCost)
+
+// LoginGuard 登录防暴力破解：按账号和 IP 记录连续失败次数，超过阈值后按指数退避临时锁定
+//
+// 账号的失败次数保存在数据库中，重启后仍然有效；IP 以及不存在的邮箱只在内存中计数，
+// 不存在的邮箱与真实账号使用相同的规则，锁定提示不会暴露邮箱是否注册。
+type LoginGuard struct {
+	client   *ent.Client
+	notifier *Notifier
+
+	accountThreshold int
+	base, max        time.Duration
+
+	ips     *utils.FailureTracker
+	unknown *utils.FailureTracker
+}
+
+// NewLoginGuardFromEnv 根据环境变量创建登录保护
+//
+// LOGIN_MAX_FAILURES 为账号连续失败多少次后锁定（默认 5），LOGIN_IP_MAX_FAILURES 为同一 IP（默认 20）；
+// 首次锁定 LOGIN_LOCKOUT（默认 1m），之后每次失败翻倍，最长 LOGIN_LOCKOUT_MAX（默认 1h）。
+func NewLoginGuardFromEnv(client *ent.Client, notifier *Notifier) *LoginGuard {
+	accountThreshold, err := strconv.Atoi(utils.GetEnv("LOGIN_MAX_FAILURES", "5"))
+	if err != nil || accountThreshold <= 0 {
+		accountThreshold = 5
+	}
+	ipThreshold, err := strconv.Atoi(utils.GetEnv("LOGIN_IP_MAX_FAILURES", "20"))
+	if err != nil || ipThreshold <= 0 {
+		ipThreshold = 20
+	}
+	base, err := time.ParseDuration(utils.GetEnv("LOGIN_LOCKOUT", "1m"))
+	if err != nil || base <= 0 {
+		base = time.Minute
+	}
+	max, err := time.ParseDuration(utils.GetEnv("LOGIN_LOCKOUT_MAX", "1h"))
+	if err != nil || max < base {
+		max = base
+	}
+	return &LoginGuard{
+		client:           client,
+		notifier:         notifier,
+		accountThreshold: accountThreshold,
+		base:             base,
+		max:              max,
+		ips:              utils.NewFailureTracker(ipThreshold, base, max, loginFailureWindow, loginTrackerLimit),
+		unknown:          utils.NewFailureTracker(accountThreshold, base, max, loginFailureWindow, loginTrackerLimit),
+	}
+}
+
+// LoginAttempt 已预先计为失败的一次登录尝试，密码校验后调用 Fail 或 Succeed
+type LoginAttempt struct {
+	user  *ent.User
+	email string
+	ip    string
+	// 计入本次尝试后账号的连续失败次数和锁定时长
+	failures int
+	lockout  time.Duration
+}
+
+// Reserve 校验密码前先把本次尝试计为失败，账号或 IP 锁定中时返回剩余锁定时长（取较长者）且不计数
+//
+// 检查锁定和增加计数是原子的，并发请求不能在锁定生效前同时校验密码；u 为 nil 表示邮箱不存在。
+func (g *LoginGuard) Reserve(ctx context.Context, u *ent.User, email, ip string) (*LoginAttempt, time.Duration, error) {
+	if d := g.ips.Reserve(ip); d > 0 {
+		return nil, d, nil
+	}
+	a := &LoginAttempt{user: u, email: normalizeLoginEmail(email), ip: ip}
+	if u == nil {
+		if d := g.unknown.Reserve(a.email); d > 0 {
+			g.ips.Release(ip)
+			return nil, d, nil
+		}
+		return a, 0, nil
+	}
+
+	failures, lockout, locked, err := g.reserveAccount(ctx, u)
+	if err != nil || locked > 0 {
+		g.ips.Release(ip)
+		return nil, locked, err
+	}
+	a.failures, a.lockout = failures, lockout
+	return a, 0, nil
+}
+
+// 并发登录导致条件更新冲突时的最多重试次数
+const loginReserveRetries = 5
+
+// reserveAccount 用条件更新增加账号的失败计数，达到阈值时在同一次更新中设置锁定
+//
+// 返回计入后的失败次数和锁定时长；账号锁定中时 locked 为剩余锁定时长。
+func (g *LoginGuard) reserveAccount(ctx context.Context, u *ent.User) (failures int, lockout, locked time.Duration, err error) {
+	for i := 0; i < loginReserveRetries; i++ {
+		now := time.Now()
+		if u.LockedUntil != nil && u.LockedUntil.After(now) {
+			return 0, 0, u.LockedUntil.Sub(now), nil
+		}
+		failures = u.FailedLoginCount + 1
+		if u.LastFailedLoginAt != nil && now.Sub(*u.LastFailedLoginAt) > loginFailureWindow {
+			failures = 1
+		}
+		update := g.client.User.Update().
+			Where(
+				user.IDEQ(u.ID),
+				user.FailedLoginCountEQ(u.FailedLoginCount),
+				user.Or(user.LockedUntilIsNil(), user.LockedUntilLTE(now)),
+			).
+			SetFailedLoginCount(failures).
+			SetLastFailedLoginAt(now)
+		lockout = utils.BackoffDuration(failures, g.accountThreshold, g.base, g.max)
+		if lockout > 0 {
+			update.SetLockedUntil(now.Add(lockout))
+		}
+		n, err := update.Save(ctx)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		if n > 0 {
+			return failures, lockout, 0, nil
+		}
+		// 计数已被并发请求修改，重新读取后再试
+		if u, err = g.client.User.Get(ctx, u.ID); err != nil {
+			return 0, 0, 0, err
+		}
+	}
+	// 同一账号的并发登录过多，按锁定处理
+	return 0, 0, g.base, nil
+}
+
+// CheckPassword 校验密码；邮箱不存在时同样执行一次 bcrypt 比较
+func (g *LoginGuard) CheckPassword(u *ent.User, password string) bool {
+	if u == nil {
+		bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(password))
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password)) == nil
+}
+
+// Fail 密码错误，保留 Reserve 时的计数；账号首次被锁定时发送提醒邮件
+func (g *LoginGuard) Fail(a *LoginAttempt) {
+	if d := g.ips.BlockedFor(a.ip); d > 0 {
+		log.Printf("[LoginGuard] IP %s 登录失败次数过多，锁定 %s", a.ip, d)
+	}
+	if a.user == nil || a.lockout == 0 {
+		return
+	}
+	log.Printf("[LoginGuard] 账号 %s 连续登录失败 %d 次，锁定 %s", a.user.Username, a.failures, a.lockout)
+	// 只在连续失败刚达到阈值时提醒，之后的退避不再重复发送
+	if a.failures == g.accountThreshold {
+		if err := g.notifier.SendAccountLocked(a.user, a.lockout); err != nil {
+			log.Printf("[LoginGuard] 发送锁定提醒失败: %v", err)
+		}
+	}
+}
+
+// Succeed 登录成功，撤销 Reserve 时 IP 的计数并清除账号的失败计数
+func (g *LoginGuard) Succeed(ctx context.Context, a *LoginAttempt) error {
+	g.ips.Release(a.ip)
+	return g.UnlockAccount(ctx, a.user.ID)
+}
+
+// LockedAccounts 当前被锁定的账号，按解锁时间排序
+func (g *LoginGuard) LockedAccounts(ctx context.Context) ([]*ent.User, error) {
+	return g.client.User.Query().
+		Where(user.LockedUntilGT(time.Now())).
+		Order(ent.Asc(user.FieldLockedUntil)).
+		All(ctx)
+}
+
+// BlockedIPs 当前被锁定的 IP
+func (g *LoginGuard) BlockedIPs() []utils.BlockedKey {
+	return g.ips.Blocked()
+}
+
+// UnlockAccount 解除账号锁定并清除失败计数
+func (g *LoginGuard) UnlockAccount(ctx context.Context, id int) error {
+	return g.client.User.UpdateOneID(id).
+		SetFailedLoginCount(0).
+		ClearLastFailedLoginAt().
+		ClearLockedUntil().
+		Exec(ctx)
+}
+
+// UnlockIP 解除 IP 锁定，返回该 IP 之前是否有失败记录
+func (g *LoginGuard) UnlockIP(ip string) bool {
+	return g.ips.Reset(ip)
+}
+
+func normalizeLoginEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
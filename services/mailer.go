@@ -0,0 +1,206 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"mime"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"blog-go/utils"
+)
+
+// Mail 一封待发送的邮件（HTML正文）
+type Mail struct {
+	To      string
+	Subject string
+	HTML    string
+	Headers map[string]string
+}
+
+// Mailer 邮件发送方式
+type Mailer interface {
+	Send(ctx context.Context, m Mail) error
+}
+
+// NewMailerFromEnv 根据 MAIL_TRANSPORT 创建邮件发送器：smtp、file（写入 MAIL_DIR）或 log（默认，仅打印日志）
+func NewMailerFromEnv() Mailer {
+	from := utils.GetEnv("MAIL_FROM", "noreply@localhost")
+	switch utils.GetEnv("MAIL_TRANSPORT", "log") {
+	case "smtp":
+		return &SMTPMailer{
+			Host:     utils.GetEnv("SMTP_HOST", "localhost"),
+			Port:     utils.GetEnv("SMTP_PORT", "587"),
+			Username: utils.GetEnv("SMTP_USERNAME", ""),
+			Password: utils.GetEnv("SMTP_PASSWORD", ""),
+			From:     from,
+		}
+	case "file":
+		return &FileMailer{Dir: utils.GetEnv("MAIL_DIR", "mails"), From: from}
+	default:
+		return &LogMailer{From: from}
+	}
+}
+
+// buildMessage 生成 RFC 5322 格式的邮件内容
+func buildMessage(from string, m Mail) []byte {
+	var buf bytes.Buffer
+	headers := map[string]string{
+		"From":                      from,
+		"To":                        m.To,
+		"Subject":                   mime.QEncoding.Encode("UTF-8", m.Subject),
+		"Date":                      time.Now().Format(time.RFC1123Z),
+		"Message-ID":                "<" + randomHex(16) + "@" + mailDomain(from) + ">",
+		"MIME-Version":              "1.0",
+		"Content-Type":              "text/html; charset=UTF-8",
+		"Content-Transfer-Encoding": "base64",
+	}
+	for k, v := range m.Headers {
+		headers[k] = v
+	}
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(&buf, "%s: %s\r\n", k, headers[k])
+	}
+	buf.WriteString("\r\n")
+
+	// base64 正文按76字符换行
+	encoded := base64.StdEncoding.EncodeToString([]byte(m.HTML))
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded + "\r\n")
+	return buf.Bytes()
+}
+
+func mailDomain(addr string) string {
+	addr = strings.TrimSuffix(addr, ">")
+	if i := strings.LastIndex(addr, "@"); i >= 0 {
+		return addr[i+1:]
+	}
+	return "localhost"
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// SMTPMailer 通过SMTP发送邮件，465端口使用隐式TLS，其余端口在服务器支持时使用STARTTLS
+type SMTPMailer struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+func (s *SMTPMailer) Send(ctx context.Context, m Mail) error {
+	addr := net.JoinHostPort(s.Host, s.Port)
+	var auth smtp.Auth
+	if s.Username != "" {
+		auth = smtp.PlainAuth("", s.Username, s.Password, s.Host)
+	}
+	msg := buildMessage(s.From, m)
+	sender := envelopeAddress(s.From)
+	tlsConfig := &tls.Config{ServerName: s.Host}
+
+	var conn net.Conn
+	var err error
+	if s.Port == "465" {
+		conn, err = (&tls.Dialer{Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	// 之后的读写同样受 ctx 的截止时间限制，避免无响应的服务器阻塞发送队列
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	c, err := smtp.NewClient(conn, s.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+	if s.Port != "465" {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(tlsConfig); err != nil {
+				return err
+			}
+		}
+	}
+	if auth != nil {
+		if err := c.Auth(auth); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(sender); err != nil {
+		return err
+	}
+	if err := c.Rcpt(m.To); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// envelopeAddress 从 "名称 <地址>" 中取出邮箱地址
+func envelopeAddress(from string) string {
+	if i := strings.LastIndex(from, "<"); i >= 0 {
+		return strings.TrimSuffix(from[i+1:], ">")
+	}
+	return from
+}
+
+// FileMailer 把邮件写入目录中的 .eml 文件，用于本地测试
+type FileMailer struct {
+	Dir  string
+	From string
+}
+
+func (f *FileMailer) Send(ctx context.Context, m Mail) error {
+	if err := os.MkdirAll(f.Dir, 0755); err != nil {
+		return err
+	}
+	name := time.Now().Format("20060102150405") + "_" + randomHex(4) + ".eml"
+	return os.WriteFile(filepath.Join(f.Dir, name), buildMessage(f.From, m), 0644)
+}
+
+// LogMailer 只在日志中打印邮件，不实际发送
+type LogMailer struct {
+	From string
+}
+
+func (l *LogMailer) Send(ctx context.Context, m Mail) error {
+	log.Printf("[Mail] to=%s subject=%s\n%s", m.To, m.Subject, m.HTML)
+	return nil
+}
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+	"log"
+
+	"blog-go/ent"
+	"blog-go/ent/post"
+	"blog-go/utils"
+)
+
+// RenderPendingPosts 为还没有缓存HTML的旧文章渲染Markdown
+func RenderPendingPosts(ctx context.Context, client *ent.Client) error {
+	posts, err := client.Post.Query().
+		Where(post.ContentHTMLIsNil()).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range posts {
+		contentHTML, toc, err := utils.RenderMarkdown(p.Content)
+		if err != nil {
+			log.Printf("渲染文章 %d 失败: %v", p.ID, err)
+			continue
+		}
+		if err := client.Post.UpdateOne(p).
+			SetContentHTML(contentHTML).
+			SetToc(toc).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	if len(posts) > 0 {
+		log.Printf("已为 %d 篇文章渲染HTML", len(posts))
+	}
+	return nil
+}
@@ -0,0 +1,244 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"blog-go/ent"
+	"blog-go/utils"
+)
+
+// ModerationAction 评论审核结论
+type ModerationAction string
+
+const (
+	ModerationApprove ModerationAction = "approve"
+	ModerationHold    ModerationAction = "hold"
+	ModerationReject  ModerationAction = "reject"
+)
+
+// severity 结论的严重程度，链式审核取最严重的结论
+func (a ModerationAction) severity() int {
+	switch a {
+	case ModerationReject:
+		return 2
+	case ModerationHold:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ModerationInput 待审核的评论
+type ModerationInput struct {
+	PostID  int    `json:"post_id"`
+	Content string `json:"content"`
+	Author  string `json:"author"`
+	Email   string `json:"email"`
+	Website string `json:"website"`
+	IP      string `json:"ip"`
+}
+
+// ModerationResult 审核结果，Reason 会保存到评论上供管理员查看，Score 为垃圾评论得分
+type ModerationResult struct {
+	Action ModerationAction `json:"action"`
+	Reason string           `json:"reason"`
+	Score  *float64         `json:"score,omitempty"`
+}
+
+// Moderator 评论审核器
+type Moderator interface {
+	Name() string
+	Moderate(ctx context.Context, input ModerationInput) (ModerationResult, error)
+}
+
+// ChainModerator 依次执行多个审核器
+//
+// 任一审核器拒绝时立即返回；出现待审或审核器出错时继续执行后续审核器，最终返回最严重的结论。
+// 审核器出错按待审处理，不会静默放行或丢弃评论。
+type ChainModerator struct {
+	moderators []Moderator
+	fallback   ModerationAction
+}
+
+// NewChainModerator 创建审核链，fallback 为全部审核器通过时的结论
+func NewChainModerator(fallback ModerationAction, moderators ...Moderator) *ChainModerator {
+	return &ChainModerator{moderators: moderators, fallback: fallback}
+}
+
+func (c *ChainModerator) Name() string {
+	return "chain"
+}
+
+func (c *ChainModerator) Moderate(ctx context.Context, input ModerationInput) (ModerationResult, error) {
+	result := ModerationResult{Action: ModerationApprove}
+	var reasons []string
+	var score *float64
+
+	for _, m := range c.moderators {
+		r, err := m.Moderate(ctx, input)
+		if err != nil {
+			r = ModerationResult{Action: ModerationHold, Reason: "审核服务异常: " + err.Error()}
+		}
+		if r.Score != nil {
+			score = r.Score
+		}
+		if r.Action.severity() == 0 {
+			continue
+		}
+		reasons = append(reasons, fmt.Sprintf("[%s] %s", m.Name(), r.Reason))
+		if r.Action.severity() > result.Action.severity() {
+			result.Action = r.Action
+		}
+		if r.Action == ModerationReject {
+			break
+		}
+	}
+
+	result.Score = score
+	if len(reasons) > 0 {
+		result.Reason = strings.Join(reasons, "; ")
+		return result, nil
+	}
+	if c.fallback == ModerationHold {
+		return ModerationResult{Action: ModerationHold, Reason: "未配置自动审核服务，需人工审核", Score: score}, nil
+	}
+	return result, nil
+}
+
+// KeywordModerator 关键词/正则屏蔽，命中即拒绝
+type KeywordModerator struct {
+	keywords []string
+	patterns []*regexp.Regexp
+}
+
+// NewKeywordModerator 创建屏蔽词审核器，以 re: 开头的规则按正则表达式匹配
+func NewKeywordModerator(rules []string) (*KeywordModerator, error) {
+	m := &KeywordModerator{}
+	for _, rule := range rules {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		if expr, ok := strings.CutPrefix(rule, "re:"); ok {
+			re, err := regexp.Compile(expr)
+			if err != nil {
+				return nil, fmt.Errorf("无效的屏蔽规则 %q: %w", rule, err)
+			}
+			m.patterns = append(m.patterns, re)
+			continue
+		}
+		m.keywords = append(m.keywords, strings.ToLower(rule))
+	}
+	return m, nil
+}
+
+func (m *KeywordModerator) Name() string {
+	return "keyword"
+}
+
+func (m *KeywordModerator) Moderate(ctx context.Context, input ModerationInput) (ModerationResult, error) {
+	// 关键词和正则规则都匹配内容、昵称和网址
+	text := input.Content + "\n" + input.Author + "\n" + input.Website
+	lower := strings.ToLower(text)
+	for _, k := range m.keywords {
+		if strings.Contains(lower, k) {
+			return ModerationResult{Action: ModerationReject, Reason: "命中屏蔽词: " + k}, nil
+		}
+	}
+	for _, re := range m.patterns {
+		if re.MatchString(text) {
+			return ModerationResult{Action: ModerationReject, Reason: "命中屏蔽规则: " + re.String()}, nil
+		}
+	}
+	return ModerationResult{Action: ModerationApprove}, nil
+}
+
+var linkPattern = regexp.MustCompile(`(?i)https?://|www\.`)
+
+// LinkModerator 链接数量超过上限时转人工审核
+type LinkModerator struct {
+	maxLinks int
+}
+
+func NewLinkModerator(maxLinks int) *LinkModerator {
+	return &LinkModerator{maxLinks: maxLinks}
+}
+
+func (m *LinkModerator) Name() string {
+	return "links"
+}
+
+func (m *LinkModerator) Moderate(ctx context.Context, input ModerationInput) (ModerationResult, error) {
+	n := len(linkPattern.FindAllStringIndex(input.Content, -1))
+	if n > m.maxLinks {
+		return ModerationResult{
+			Action: ModerationHold,
+			Reason: fmt.Sprintf("包含%d个链接，超过上限%d", n, m.maxLinks),
+		}, nil
+	}
+	return ModerationResult{Action: ModerationApprove}, nil
+}
+
+// NewModeratorFromEnv 根据环境变量组装评论审核链
+//
+//	COMMENT_BLOCKLIST       屏蔽词，逗号分隔，re: 开头为正则
+//	COMMENT_MAX_LINKS       允许的最大链接数（默认2）
+//	BAIDU_API_KEY/SECRET    配置后启用百度内容审核
+//	COMMENT_MODERATION_URL  配置后启用HTTP审核服务
+//	SPAM_FILTER             是否启用本地贝叶斯分类器（默认true）
+//	SPAM_THRESHOLD          分类器转人工审核的得分阈值（默认0.9）
+//	SPAM_MIN_DOCUMENTS      分类器每个类别至少需要的训练样本数（默认10）
+//	COMMENT_AUTO_APPROVE    未配置任何自动审核服务时是否自动通过（默认false）
+func NewModeratorFromEnv(client *ent.Client) (Moderator, error) {
+	var moderators []Moderator
+
+	if rules := utils.GetEnv("COMMENT_BLOCKLIST", ""); rules != "" {
+		km, err := NewKeywordModerator(strings.Split(rules, ","))
+		if err != nil {
+			return nil, err
+		}
+		moderators = append(moderators, km)
+	}
+
+	maxLinks, err := strconv.Atoi(utils.GetEnv("COMMENT_MAX_LINKS", "2"))
+	if err != nil || maxLinks < 0 {
+		maxLinks = 2
+	}
+	moderators = append(moderators, NewLinkModerator(maxLinks))
+
+	external := false
+	apiKey, secretKey := utils.GetEnv("BAIDU_API_KEY", ""), utils.GetEnv("BAIDU_SECRET_KEY", "")
+	if apiKey != "" && secretKey != "" {
+		moderators = append(moderators, NewBaiduModerator(apiKey, secretKey))
+		external = true
+	}
+	if endpoint := utils.GetEnv("COMMENT_MODERATION_URL", ""); endpoint != "" {
+		moderators = append(moderators, NewHTTPModerator(endpoint))
+		external = true
+	}
+
+	// 本地分类器离线可用；没有外部审核服务时由它代替自动审核
+	if utils.GetEnv("SPAM_FILTER", "true") == "true" {
+		threshold, err := strconv.ParseFloat(utils.GetEnv("SPAM_THRESHOLD", "0.9"), 64)
+		if err != nil || threshold <= 0 || threshold > 1 {
+			threshold = 0.9
+		}
+		minDocs, err := strconv.Atoi(utils.GetEnv("SPAM_MIN_DOCUMENTS", "10"))
+		if err != nil {
+			minDocs = 10
+		}
+		classifier := NewSpamClassifier(client, minDocs)
+		moderators = append(moderators, NewSpamModerator(classifier, threshold, !external))
+		external = true
+	}
+
+	fallback := ModerationApprove
+	if !external && utils.GetEnv("COMMENT_AUTO_APPROVE", "false") != "true" {
+		fallback = ModerationHold
+	}
+	return NewChainModerator(fallback, moderators...), nil
+}
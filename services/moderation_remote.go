@@ -0,0 +1,94 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"blog-go/utils"
+)
+
+// BaiduModerator 百度内容安全文本审核
+type BaiduModerator struct {
+	apiKey    string
+	secretKey string
+}
+
+func NewBaiduModerator(apiKey, secretKey string) *BaiduModerator {
+	return &BaiduModerator{apiKey: apiKey, secretKey: secretKey}
+}
+
+func (m *BaiduModerator) Name() string {
+	return "baidu"
+}
+
+func (m *BaiduModerator) Moderate(ctx context.Context, input ModerationInput) (ModerationResult, error) {
+	conclusion, err := utils.BaiduTextCensor(input.Content, m.apiKey, m.secretKey)
+	if err != nil {
+		return ModerationResult{}, err
+	}
+	switch conclusion {
+	case "合规":
+		return ModerationResult{Action: ModerationApprove}, nil
+	case "不合规":
+		return ModerationResult{Action: ModerationReject, Reason: "百度内容审核不合规"}, nil
+	case "疑似":
+		return ModerationResult{Action: ModerationHold, Reason: "百度内容审核疑似违规"}, nil
+	default:
+		return ModerationResult{Action: ModerationHold, Reason: "百度内容审核返回: " + conclusion}, nil
+	}
+}
+
+// HTTPModerator 调用外部HTTP审核服务（也可用作本地测试替身）
+//
+// 以JSON形式 POST ModerationInput，服务返回 {"action": "approve|hold|reject", "reason": "..."}。
+type HTTPModerator struct {
+	endpoint string
+	client   *http.Client
+}
+
+func NewHTTPModerator(endpoint string) *HTTPModerator {
+	return &HTTPModerator{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+func (m *HTTPModerator) Name() string {
+	return "http"
+}
+
+func (m *HTTPModerator) Moderate(ctx context.Context, input ModerationInput) (ModerationResult, error) {
+	body, err := json.Marshal(input)
+	if err != nil {
+		return ModerationResult{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return ModerationResult{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return ModerationResult{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return ModerationResult{}, fmt.Errorf("审核服务返回状态码 %d", resp.StatusCode)
+	}
+
+	var result ModerationResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return ModerationResult{}, err
+	}
+	switch result.Action {
+	case ModerationApprove, ModerationHold, ModerationReject:
+		return result, nil
+	default:
+		return ModerationResult{}, fmt.Errorf("审核服务返回未知结论 %q", result.Action)
+	}
+}
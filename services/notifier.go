@@ -0,0 +1,319 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"html/template"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/comment"
+	"blog-go/ent/emailsubscription"
+	"blog-go/ent/user"
+	"blog-go/utils"
+)
+
+// 退订令牌的签名用途
+const unsubscribePurpose = "unsubscribe"
+
+// ErrInvalidUnsubscribeToken 退订令牌无效
+var ErrInvalidUnsubscribeToken = errors.New("无效的退订链接")
+
+var replyMailTemplate = template.Must(template.New("reply").Parse(`<p>{{.Recipient}}，你好：</p>
+<p><strong>{{.Author}}</strong> 回复了你在《<a href="{{.PostURL}}">{{.PostTitle}}</a>》中的评论：</p>
+<blockquote style="color:#666;border-left:3px solid #ddd;margin:0;padding-left:12px">{{.ParentContent}}</blockquote>
+<p>{{.Content}}</p>
+<p><a href="{{.CommentURL}}">查看回复</a></p>
+<hr>
+<p style="color:#999;font-size:12px">不想再收到评论通知？<a href="{{.UnsubscribeURL}}">一键退订</a></p>
+`))
+
+var authorMailTemplate = template.Must(template.New("author").Parse(`<p>{{.Recipient}}，你好：</p>
+<p><strong>{{.Author}}</strong> 评论了你的文章《<a href="{{.PostURL}}">{{.PostTitle}}</a>》：</p>
+<p>{{.Content}}</p>
+<p><a href="{{.CommentURL}}">查看评论</a></p>
+<hr>
+<p style="color:#999;font-size:12px">不想再收到评论通知？<a href="{{.UnsubscribeURL}}">一键退订</a></p>
+`))
+
+type commentMailData struct {
+	Recipient      string
+	Author         string
+	Content        string
+	ParentContent  string
+	PostTitle      string
+	PostURL        string
+	CommentURL     string
+	UnsubscribeURL string
+}
+
+type mailJob struct {
+	mail    Mail
+	attempt int
+	// 账号邮件（验证、重置密码、锁定提醒等）使用单独的队列
+	account bool
+}
+
+// 发送评论通知的后台任务数，账号邮件另有一个单独的任务
+const mailWorkers = 2
+
+// Notifier 评论通知和账号相关邮件，在后台队列中异步发送并失败重试
+type Notifier struct {
+	client     *ent.Client
+	mailer     Mailer
+	siteURL    string
+	apiURL     string
+	secret     string
+	maxRetries int
+	retryDelay time.Duration
+	// 邮箱验证链接的有效期
+	verifyTTL time.Duration
+	comments  chan int
+	jobs      chan mailJob
+	// 账号邮件队列，评论通知积压时不受影响
+	accountJobs chan mailJob
+}
+
+// NewNotifierFromEnv 创建通知器并启动后台发送任务
+func NewNotifierFromEnv(client *ent.Client) *Notifier {
+	maxRetries, err := strconv.Atoi(utils.GetEnv("MAIL_MAX_RETRIES", "3"))
+	if err != nil || maxRetries < 0 {
+		maxRetries = 3
+	}
+	verifyTTL, err := time.ParseDuration(utils.GetEnv("EMAIL_VERIFY_TTL", "24h"))
+	if err != nil || verifyTTL <= 0 {
+		verifyTTL = 24 * time.Hour
+	}
+	n := &Notifier{
+		client:      client,
+		mailer:      NewMailerFromEnv(),
+		siteURL:     strings.TrimRight(utils.GetEnv("SITE_URL", "http://localhost:3000"), "/"),
+		apiURL:      strings.TrimRight(utils.GetEnv("API_URL", "http://localhost:8080"), "/"),
+		secret:      utils.GetEnv("MAIL_SECRET", utils.GetEnv("JWT_SECRET", "your-secret-key")),
+		maxRetries:  maxRetries,
+		retryDelay:  10 * time.Second,
+		verifyTTL:   verifyTTL,
+		comments:    make(chan int, 100),
+		jobs:        make(chan mailJob, 100),
+		accountJobs: make(chan mailJob, 100),
+	}
+	go n.run()
+	for i := 0; i < mailWorkers; i++ {
+		go n.sendLoop(n.jobs)
+	}
+	go n.sendLoop(n.accountJobs)
+	return n
+}
+
+// run 生成评论通知邮件并加入发送队列
+func (n *Notifier) run() {
+	for id := range n.comments {
+		if err := n.prepareCommentMails(context.Background(), id); err != nil {
+			log.Printf("[Notifier] 生成评论通知失败: comment=%d err=%v", id, err)
+		}
+	}
+}
+
+// sendLoop 依次发送队列中的邮件
+func (n *Notifier) sendLoop(jobs <-chan mailJob) {
+	for job := range jobs {
+		n.send(job)
+	}
+}
+
+// send 发送邮件，失败时按指数退避重新入队
+func (n *Notifier) send(job mailJob) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	err := n.mailer.Send(ctx, job.mail)
+	cancel()
+	if err == nil {
+		return
+	}
+	if job.attempt >= n.maxRetries {
+		log.Printf("[Notifier] 邮件发送失败，已放弃: to=%s err=%v", job.mail.To, err)
+		return
+	}
+	delay := n.retryDelay << job.attempt
+	log.Printf("[Notifier] 邮件发送失败，%v 后重试: to=%s err=%v", delay, job.mail.To, err)
+	job.attempt++
+	time.AfterFunc(delay, func() { n.enqueue(job) })
+}
+
+func (n *Notifier) enqueue(job mailJob) {
+	jobs := n.jobs
+	if job.account {
+		jobs = n.accountJobs
+	}
+	select {
+	case jobs <- job:
+	default:
+		log.Printf("[Notifier] 邮件队列已满，丢弃邮件: to=%s", job.mail.To)
+	}
+}
+
+// NotifyComment 评论公开后通知被回复者和文章作者（异步）
+func (n *Notifier) NotifyComment(commentID int) {
+	select {
+	case n.comments <- commentID:
+	default:
+		log.Printf("[Notifier] 通知队列已满，跳过评论通知: comment=%d", commentID)
+	}
+}
+
+func (n *Notifier) prepareCommentMails(ctx context.Context, commentID int) error {
+	c, err := n.client.Comment.Query().
+		Where(comment.IDEQ(commentID)).
+		WithPost().
+		WithParent().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	p := c.Edges.Post
+	if p == nil {
+		return nil
+	}
+
+	data := commentMailData{
+		Author:     c.Author,
+		Content:    c.Content,
+		PostTitle:  p.Title,
+		PostURL:    n.siteURL + PostPath(p),
+		CommentURL: n.siteURL + PostPath(p) + "#comment-" + strconv.Itoa(c.ID),
+	}
+	notified := map[string]bool{strings.ToLower(c.Email): true}
+
+	// 通知被回复的评论者
+	if parent := c.Edges.Parent; parent != nil && !notified[strings.ToLower(parent.Email)] {
+		notified[strings.ToLower(parent.Email)] = true
+		d := data
+		d.Recipient = parent.Author
+		d.ParentContent = parent.Content
+		if err := n.queueMail(ctx, parent.Email, c.Author+" 回复了你的评论", replyMailTemplate, d); err != nil {
+			return err
+		}
+	}
+
+	// 通知文章作者
+	author, err := n.client.User.Query().Where(user.UsernameEQ(p.Author)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if notified[strings.ToLower(author.Email)] {
+		return nil
+	}
+	d := data
+	d.Recipient = author.Username
+	if author.Nickname != "" {
+		d.Recipient = author.Nickname
+	}
+	return n.queueMail(ctx, author.Email, "《"+p.Title+"》有新评论", authorMailTemplate, d)
+}
+
+// queueMail 渲染模板并加入发送队列，已退订的邮箱直接跳过
+func (n *Notifier) queueMail(ctx context.Context, to, subject string, tmpl *template.Template, data commentMailData) error {
+	// 导入的评论没有真实邮箱
+	if strings.HasSuffix(strings.ToLower(to), ".invalid") {
+		return nil
+	}
+	subscribed, err := n.ensureSubscription(ctx, to)
+	if err != nil || !subscribed {
+		return err
+	}
+
+	unsubscribeURL := n.UnsubscribeURL(to)
+	data.UnsubscribeURL = unsubscribeURL
+	var body bytes.Buffer
+	if err := tmpl.Execute(&body, data); err != nil {
+		return err
+	}
+	n.enqueue(mailJob{mail: Mail{
+		To:      to,
+		Subject: subject,
+		HTML:    body.String(),
+		Headers: map[string]string{
+			"List-Unsubscribe":      "<" + unsubscribeURL + ">",
+			"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		},
+	}})
+	return nil
+}
+
+// ensureSubscription 返回邮箱是否接收通知，首次通知时创建订阅记录
+func (n *Notifier) ensureSubscription(ctx context.Context, email string) (bool, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	sub, err := n.client.EmailSubscription.Query().
+		Where(emailsubscription.EmailEQ(email)).
+		Only(ctx)
+	if err == nil {
+		return !sub.Unsubscribed, nil
+	}
+	if !ent.IsNotFound(err) {
+		return false, err
+	}
+	now := time.Now()
+	err = n.client.EmailSubscription.Create().
+		SetEmail(email).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		Exec(ctx)
+	if err != nil && !ent.IsConstraintError(err) {
+		return false, err
+	}
+	return true, nil
+}
+
+// UnsubscribeURL 生成带签名的一键退订链接
+func (n *Notifier) UnsubscribeURL(email string) string {
+	token := utils.SignValue(n.secret, unsubscribePurpose, strings.ToLower(strings.TrimSpace(email)))
+	return n.apiURL + "/api/unsubscribe?token=" + url.QueryEscape(token)
+}
+
+// UnsubscribeEmail 校验退订令牌并返回对应邮箱，不修改订阅状态
+func (n *Notifier) UnsubscribeEmail(token string) (string, error) {
+	email, ok := utils.VerifySignedValue(n.secret, unsubscribePurpose, token)
+	if !ok || email == "" {
+		return "", ErrInvalidUnsubscribeToken
+	}
+	return email, nil
+}
+
+// Unsubscribe 校验退订令牌并退订对应邮箱，返回邮箱地址
+func (n *Notifier) Unsubscribe(ctx context.Context, token string) (string, error) {
+	email, err := n.UnsubscribeEmail(token)
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	affected, err := n.client.EmailSubscription.Update().
+		Where(emailsubscription.EmailEQ(email)).
+		SetUnsubscribed(true).
+		SetUnsubscribedAt(now).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		return "", err
+	}
+	if affected == 0 {
+		err = n.client.EmailSubscription.Create().
+			SetEmail(email).
+			SetUnsubscribed(true).
+			SetUnsubscribedAt(now).
+			SetCreatedAt(now).
+			SetUpdatedAt(now).
+			Exec(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+	return email, nil
+}
@@ -0,0 +1,414 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"blog-go/utils"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// 调用第三方平台接口的重试次数
+	oauthMaxRetries = 3
+	// OIDC discovery 失败后，在此时间内直接返回上次的错误，避免每次登录都去请求不可用的平台
+	oidcDiscoveryRetryDelay = 30 * time.Second
+)
+
+// oauthHTTPClient 调用第三方平台接口使用的 HTTP 客户端，http.DefaultClient 没有超时
+var oauthHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// oauthContext 让 oauth2 换取令牌和查询用户信息时同样使用带超时的客户端
+func oauthContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, oauth2.HTTPClient, oauthHTTPClient)
+}
+
+// userInfoMapping 用户信息接口返回字段到 OAuthProfile 的映射
+type userInfoMapping struct {
+	Subject       string
+	Login         string
+	Name          string
+	Email         string
+	EmailVerified string
+	Avatar        string
+	// 平台只公开已验证的邮箱，没有单独的验证字段
+	TrustEmail bool
+}
+
+var (
+	// GitHub、Gitee 的用户接口格式相同
+	gitStyleMapping = userInfoMapping{
+		Subject: "id", Login: "login", Name: "name", Email: "email", Avatar: "avatar_url",
+	}
+	// OIDC 标准声明
+	oidcMapping = userInfoMapping{
+		Subject: "sub", Login: "preferred_username", Name: "name",
+		Email: "email", EmailVerified: "email_verified", Avatar: "picture",
+	}
+)
+
+// OAuthProvider 一个第三方登录平台
+type OAuthProvider struct {
+	Name  string
+	Title string
+
+	config      oauth2.Config
+	userInfoURL string
+	// 用户信息中没有邮箱时查询邮箱列表的接口
+	emailsURL string
+	mapping   userInfoMapping
+
+	// OIDC 平台首次使用时通过 discovery 获取端点
+	issuer     string
+	mu         sync.Mutex
+	discovered bool
+	// 正在进行的 discovery，完成时关闭；并发请求等待同一次结果
+	discovering chan struct{}
+	// 最近一次 discovery 的错误及可以重试的时间
+	discoverErr error
+	retryAt     time.Time
+}
+
+// AuthCodeURL 生成跳转到第三方平台的授权地址（PKCE S256）
+func (p *OAuthProvider) AuthCodeURL(ctx context.Context, state, verifier string) (string, error) {
+	if err := p.discover(ctx); err != nil {
+		return "", err
+	}
+	return p.config.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier)), nil
+}
+
+// Exchange 用授权码换取访问令牌，失败时重试
+func (p *OAuthProvider) Exchange(ctx context.Context, code, verifier string) (*oauth2.Token, error) {
+	if err := p.discover(ctx); err != nil {
+		return nil, err
+	}
+	var token *oauth2.Token
+	err := oauthRetry(p.Name, "获取token", func() error {
+		var err error
+		token, err = p.config.Exchange(oauthContext(ctx), code, oauth2.VerifierOption(verifier))
+		return err
+	})
+	return token, err
+}
+
+// Profile 获取第三方平台的用户信息
+func (p *OAuthProvider) Profile(ctx context.Context, token *oauth2.Token) (OAuthProfile, error) {
+	client := p.config.Client(oauthContext(ctx), token)
+
+	var info map[string]interface{}
+	if err := oauthGetJSON(ctx, client, p.Name, p.userInfoURL, &info); err != nil {
+		return OAuthProfile{}, err
+	}
+
+	m := p.mapping
+	profile := OAuthProfile{
+		Provider:  p.Name,
+		Subject:   claimString(info, m.Subject),
+		Login:     claimString(info, m.Login),
+		Name:      claimString(info, m.Name),
+		Email:     claimString(info, m.Email),
+		AvatarURL: claimString(info, m.Avatar),
+	}
+	if profile.Subject == "" {
+		return OAuthProfile{}, errors.New("第三方平台未返回用户ID")
+	}
+	if m.EmailVerified != "" {
+		profile.EmailVerified = claimBool(info, m.EmailVerified)
+	} else {
+		profile.EmailVerified = m.TrustEmail && profile.Email != ""
+	}
+
+	if profile.Email == "" && p.emailsURL != "" {
+		var emails []map[string]interface{}
+		if err := oauthGetJSON(ctx, client, p.Name, p.emailsURL, &emails); err != nil {
+			return OAuthProfile{}, err
+		}
+		profile.Email, profile.EmailVerified = primaryEmail(emails)
+	}
+	if profile.Login == "" && profile.Email != "" {
+		profile.Login, _, _ = strings.Cut(profile.Email, "@")
+	}
+	return profile, nil
+}
+
+// primaryEmail 从邮箱列表中选出已验证的主邮箱（兼容 GitHub 和 Gitee 的格式）
+func primaryEmail(emails []map[string]interface{}) (string, bool) {
+	for _, e := range emails {
+		primary := claimBool(e, "primary")
+		if scopes, ok := e["scope"].([]interface{}); ok {
+			for _, s := range scopes {
+				primary = primary || s == "primary"
+			}
+		}
+		verified := claimBool(e, "verified") || e["state"] == "confirmed"
+		if primary && verified {
+			return claimString(e, "email"), true
+		}
+	}
+	return "", false
+}
+
+// discover 通过 OIDC discovery 获取授权、令牌和用户信息端点，成功后缓存，失败后短时间内不再重试
+//
+// 请求在锁外进行，同一平台同时只有一个 discovery，其他请求等待其结果或自身的上下文结束。
+func (p *OAuthProvider) discover(ctx context.Context) error {
+	if p.issuer == "" {
+		return nil
+	}
+	for {
+		p.mu.Lock()
+		if p.discovered {
+			p.mu.Unlock()
+			return nil
+		}
+		if p.discoverErr != nil && time.Now().Before(p.retryAt) {
+			err := p.discoverErr
+			p.mu.Unlock()
+			return err
+		}
+		if wait := p.discovering; wait != nil {
+			p.mu.Unlock()
+			select {
+			case <-wait:
+				continue
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		done := make(chan struct{})
+		p.discovering = done
+		p.mu.Unlock()
+
+		endpoint, userInfoURL, err := p.fetchDiscovery(ctx)
+
+		p.mu.Lock()
+		if err == nil {
+			p.config.Endpoint = endpoint
+			p.userInfoURL = userInfoURL
+			p.discovered = true
+		} else if ctx.Err() == nil {
+			// 调用方取消的请求不代表平台不可用，不缓存其错误
+			p.discoverErr = err
+			p.retryAt = time.Now().Add(oidcDiscoveryRetryDelay)
+		}
+		p.discovering = nil
+		close(done)
+		p.mu.Unlock()
+		return err
+	}
+}
+
+// fetchDiscovery 请求 OIDC discovery 文档并校验 issuer 和必要的端点
+func (p *OAuthProvider) fetchDiscovery(ctx context.Context) (oauth2.Endpoint, string, error) {
+	var doc struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	}
+	discoveryURL := p.issuer + "/.well-known/openid-configuration"
+	if err := oauthGetJSON(ctx, oauthHTTPClient, p.Name, discoveryURL, &doc); err != nil {
+		return oauth2.Endpoint{}, "", err
+	}
+	if strings.TrimSuffix(doc.Issuer, "/") != p.issuer {
+		return oauth2.Endpoint{}, "", fmt.Errorf("OIDC issuer 不匹配: %s", doc.Issuer)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.UserinfoEndpoint == "" {
+		return oauth2.Endpoint{}, "", errors.New("OIDC discovery 缺少必要的端点")
+	}
+	return oauth2.Endpoint{
+		AuthURL:  doc.AuthorizationEndpoint,
+		TokenURL: doc.TokenEndpoint,
+	}, doc.UserinfoEndpoint, nil
+}
+
+func oauthGetJSON(ctx context.Context, client *http.Client, provider, url string, v interface{}) error {
+	return oauthRetry(provider, "请求 "+url, func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Accept", "application/json")
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("返回错误状态码: %d", resp.StatusCode)
+		}
+		return json.NewDecoder(resp.Body).Decode(v)
+	})
+}
+
+// oauthRetry 第三方平台接口偶尔超时，网络错误时递增延迟重试
+//
+// 平台返回的错误（如授权码无效的 invalid_grant）重试也不会成功，授权码还可能因此被判定为重放，直接返回。
+func oauthRetry(provider, action string, fn func() error) error {
+	var err error
+	for i := 0; i < oauthMaxRetries; i++ {
+		if err = fn(); err == nil || !oauthRetryable(err) {
+			return err
+		}
+		if i < oauthMaxRetries-1 {
+			log.Printf("[OAuth] %s 第%d次%s失败，准备重试: %v", provider, i+1, action, err)
+			time.Sleep(time.Second * time.Duration(i+1))
+		}
+	}
+	return err
+}
+
+// oauthRetryable 只有请求没有得到响应的网络错误才重试，超时或取消的上下文除外
+func oauthRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+func claimString(m map[string]interface{}, key string) string {
+	switch v := m[key].(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return ""
+}
+
+func claimBool(m map[string]interface{}, key string) bool {
+	switch v := m[key].(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
+
+// OAuthRegistry 已配置的第三方登录平台
+type OAuthRegistry struct {
+	providers map[string]*OAuthProvider
+	order     []string
+}
+
+// Provider 按名称获取平台
+func (r *OAuthRegistry) Provider(name string) (*OAuthProvider, bool) {
+	p, ok := r.providers[name]
+	return p, ok
+}
+
+// Providers 按配置顺序返回所有平台
+func (r *OAuthRegistry) Providers() []*OAuthProvider {
+	list := make([]*OAuthProvider, 0, len(r.order))
+	for _, name := range r.order {
+		list = append(list, r.providers[name])
+	}
+	return list
+}
+
+func (r *OAuthRegistry) register(p *OAuthProvider) {
+	if p.config.ClientID == "" {
+		return
+	}
+	if _, ok := r.providers[p.Name]; !ok {
+		r.order = append(r.order, p.Name)
+	}
+	r.providers[p.Name] = p
+}
+
+// reservedOAuthNames 自定义 OIDC 平台不能使用的名称：内置平台，以及 /auth 下与 /:provider 冲突的固定路由
+var reservedOAuthNames = map[string]bool{
+	"github": true, "gitee": true, "google": true,
+	"providers": true, "register": true, "login": true, "refresh": true, "logout": true,
+	"verify-email": true, "password": true,
+}
+
+// NewOAuthRegistryFromEnv 根据环境变量配置第三方登录平台，未配置 CLIENT_ID 的平台不启用
+//
+// 内置 GitHub、Gitee 和 Google，其他 OIDC 平台通过 OIDC_PROVIDERS 列出名称，
+// 再用 OIDC_<NAME>_ISSUER、OIDC_<NAME>_CLIENT_ID 等变量配置。
+// 回调地址默认为 OAUTH_CALLBACK_BASE/<name>/callback，也可用 <NAME>_REDIRECT_URL 单独指定。
+func NewOAuthRegistryFromEnv() *OAuthRegistry {
+	r := &OAuthRegistry{providers: map[string]*OAuthProvider{}}
+	callbackBase := strings.TrimSuffix(utils.GetEnv("OAUTH_CALLBACK_BASE", "http://localhost:3000/api/auth"), "/")
+	config := func(name, envPrefix string, scopes []string, endpoint oauth2.Endpoint) oauth2.Config {
+		return oauth2.Config{
+			ClientID:     utils.GetEnv(envPrefix+"_CLIENT_ID", ""),
+			ClientSecret: utils.GetEnv(envPrefix+"_CLIENT_SECRET", ""),
+			RedirectURL:  utils.GetEnv(envPrefix+"_REDIRECT_URL", callbackBase+"/"+name+"/callback"),
+			Scopes:       scopes,
+			Endpoint:     endpoint,
+		}
+	}
+
+	github := gitStyleMapping
+	github.TrustEmail = true
+	r.register(&OAuthProvider{
+		Name:  "github",
+		Title: "GitHub",
+		config: config("github", "GITHUB", []string{"read:user", "user:email"}, oauth2.Endpoint{
+			AuthURL:  "https://github.com/login/oauth/authorize",
+			TokenURL: "https://github.com/login/oauth/access_token",
+		}),
+		userInfoURL: "https://api.github.com/user",
+		emailsURL:   "https://api.github.com/user/emails",
+		mapping:     github,
+	})
+
+	r.register(&OAuthProvider{
+		Name:  "gitee",
+		Title: "Gitee",
+		config: config("gitee", "GITEE", []string{"user_info", "emails"}, oauth2.Endpoint{
+			AuthURL:  "https://gitee.com/oauth/authorize",
+			TokenURL: "https://gitee.com/oauth/token",
+		}),
+		userInfoURL: "https://gitee.com/api/v5/user",
+		emailsURL:   "https://gitee.com/api/v5/emails",
+		mapping:     gitStyleMapping,
+	})
+
+	r.register(&OAuthProvider{
+		Name:    "google",
+		Title:   "Google",
+		config:  config("google", "GOOGLE", []string{"openid", "profile", "email"}, oauth2.Endpoint{}),
+		issuer:  "https://accounts.google.com",
+		mapping: oidcMapping,
+	})
+
+	for _, name := range strings.Split(utils.GetEnv("OIDC_PROVIDERS", ""), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if reservedOAuthNames[name] {
+			log.Printf("[OAuth] OIDC_PROVIDERS 中的 %s 与内置平台或登录路由重名，跳过", name)
+			continue
+		}
+		prefix := "OIDC_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+		issuer := strings.TrimSuffix(utils.GetEnv(prefix+"_ISSUER", ""), "/")
+		if issuer == "" {
+			log.Printf("[OAuth] 未配置 %s_ISSUER，跳过 %s", prefix, name)
+			continue
+		}
+		r.register(&OAuthProvider{
+			Name:    name,
+			Title:   utils.GetEnv(prefix+"_TITLE", name),
+			config:  config(name, prefix, strings.Fields(utils.GetEnv(prefix+"_SCOPES", "openid profile email")), oauth2.Endpoint{}),
+			issuer:  issuer,
+			mapping: oidcMapping,
+		})
+	}
+	return r
+}
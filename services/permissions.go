@@ -0,0 +1,104 @@
+package services
+
+import "sort"
+
+// Permission 权限名称，格式为 资源:操作
+type Permission string
+
+const (
+	// 文章：创建、编辑/删除自己的文章、发布，以及编辑/删除所有人的文章
+	PermPostCreate    Permission = "post:create"
+	PermPostEdit      Permission = "post:edit"
+	PermPostEditAny   Permission = "post:edit_any"
+	PermPostDelete    Permission = "post:delete"
+	PermPostDeleteAny Permission = "post:delete_any"
+	PermPostPublish   Permission = "post:publish"
+
+	// 评论：审核（通过、拒绝、标记垃圾、删除任意评论、导入）
+	PermCommentModerate Permission = "comment:moderate"
+
+	PermTagManage        Permission = "tag:manage"
+	PermImageUpload      Permission = "image:upload"
+	PermImageDelete      Permission = "image:delete"
+	PermFriendManage     Permission = "friend:manage"
+	PermCollectionManage Permission = "collection:manage"
+	PermHitokotoManage   Permission = "hitokoto:manage"
+	PermBookManage       Permission = "book:manage"
+
+	// 用户：分配角色、管理邀请码
+	PermUserManage Permission = "user:manage"
+)
+
+// 角色名称
+const (
+	RoleAdmin     = "admin"
+	RoleEditor    = "editor"
+	RoleAuthor    = "author"
+	RoleCommenter = "commenter"
+)
+
+// rolePermissions 各角色拥有的权限，管理员拥有全部权限
+var rolePermissions = map[string][]Permission{
+	RoleEditor: {
+		PermPostCreate, PermPostEdit, PermPostEditAny, PermPostDelete, PermPostDeleteAny, PermPostPublish,
+		PermCommentModerate, PermTagManage, PermImageUpload, PermImageDelete,
+		PermFriendManage, PermCollectionManage, PermHitokotoManage, PermBookManage,
+	},
+	RoleAuthor: {
+		PermPostCreate, PermPostEdit, PermPostDelete, PermPostPublish, PermImageUpload,
+	},
+	RoleCommenter: {},
+}
+
+// Roles 所有角色，按权限从多到少排列
+func Roles() []string {
+	return []string{RoleAdmin, RoleEditor, RoleAuthor, RoleCommenter}
+}
+
+// ValidRole 判断角色名称是否有效
+func ValidRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok || role == RoleAdmin
+}
+
+// ValidPermission 判断权限名称是否存在
+func ValidPermission(perm Permission) bool {
+	for _, p := range RolePermissions(RoleAdmin) {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPermission 判断角色是否拥有权限，未知角色（包括旧的 user）按评论者处理
+func HasPermission(role string, perm Permission) bool {
+	if role == RoleAdmin {
+		return true
+	}
+	for _, p := range rolePermissions[role] {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// RolePermissions 角色拥有的全部权限
+func RolePermissions(role string) []Permission {
+	if role != RoleAdmin {
+		return append([]Permission{}, rolePermissions[role]...)
+	}
+	seen := map[Permission]bool{PermUserManage: true}
+	for _, perms := range rolePermissions {
+		for _, p := range perms {
+			seen[p] = true
+		}
+	}
+	perms := make([]Permission, 0, len(seen))
+	for p := range seen {
+		perms = append(perms, p)
+	}
+	sort.Slice(perms, func(i, j int) bool { return perms[i] < perms[j] })
+	return perms
+}
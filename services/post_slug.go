@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/post"
+	"blog-go/ent/postslughistory"
+	"blog-go/utils"
+)
+
+// 无法从标题生成slug时使用的默认前缀
+const defaultPostSlug = "post"
+
+// UniquePostSlug 以 base 为基础生成一个未被其他文章占用的slug
+//
+// 其他文章当前使用的slug和历史slug都视为已占用，excludeID 为当前文章ID（新建时传0）。
+func UniquePostSlug(ctx context.Context, client *ent.Client, base string, excludeID int) (string, error) {
+	base = utils.Slugify(base)
+	if base == "" {
+		base = defaultPostSlug
+	}
+
+	candidate := base
+	for i := 2; ; i++ {
+		taken, err := postSlugTaken(ctx, client, candidate, excludeID)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return candidate, nil
+		}
+		candidate = fmt.Sprintf("%s-%d", base, i)
+	}
+}
+
+func postSlugTaken(ctx context.Context, client *ent.Client, slug string, excludeID int) (bool, error) {
+	taken, err := client.Post.Query().
+		Where(post.SlugEQ(slug), post.IDNEQ(excludeID)).
+		Exist(ctx)
+	if err != nil || taken {
+		return taken, err
+	}
+	return client.PostSlugHistory.Query().
+		Where(
+			postslughistory.SlugEQ(slug),
+			postslughistory.Not(postslughistory.HasPostWith(post.IDEQ(excludeID))),
+		).
+		Exist(ctx)
+}
+
+// RecordPostSlugChange 文章slug变更前调用：旧slug写入历史，
+// 如果新slug曾是该文章的历史slug则从历史中移除，避免重定向循环
+func RecordPostSlugChange(ctx context.Context, client *ent.Client, p *ent.Post, newSlug string) error {
+	if p.Slug == newSlug {
+		return nil
+	}
+	if _, err := client.PostSlugHistory.Delete().
+		Where(
+			postslughistory.SlugEQ(newSlug),
+			postslughistory.HasPostWith(post.IDEQ(p.ID)),
+		).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if p.Slug == "" {
+		return nil
+	}
+	return client.PostSlugHistory.Create().
+		SetSlug(p.Slug).
+		SetPostID(p.ID).
+		SetCreatedAt(time.Now()).
+		Exec(ctx)
+}
+
+// BackfillPostSlugs 为还没有slug的旧文章生成slug
+func BackfillPostSlugs(ctx context.Context, client *ent.Client) error {
+	posts, err := client.Post.Query().
+		Where(post.Or(post.SlugIsNil(), post.SlugEQ(""))).
+		Order(ent.Asc(post.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range posts {
+		slug, err := UniquePostSlug(ctx, client, p.Title, p.ID)
+		if err != nil {
+			return err
+		}
+		if err := client.Post.UpdateOne(p).SetSlug(slug).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	if len(posts) > 0 {
+		log.Printf("已为 %d 篇文章生成slug", len(posts))
+	}
+	return nil
+}
+
+// PostPath 文章在前端的访问路径，优先使用slug
+func PostPath(p *ent.Post) string {
+	if p.Slug != "" {
+		return "/posts/" + url.PathEscape(p.Slug)
+	}
+	return "/posts/" + strconv.Itoa(p.ID)
+}
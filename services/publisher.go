@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/post"
+)
+
+// Publisher 定时发布后台任务，把到期的定时文章设为已发布
+//
+// 定时状态完全保存在数据库中（scheduled=true 且 published_at 为计划时间），
+// 因此服务重启后会在第一次检查时补发所有已到期的文章。
+type Publisher struct {
+	client   *ent.Client
+	interval time.Duration
+}
+
+// NewPublisher 创建定时发布任务，interval 为最长检查间隔
+func NewPublisher(client *ent.Client, interval time.Duration) *Publisher {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &Publisher{
+		client:   client,
+		interval: interval,
+	}
+}
+
+// Run 阻塞运行直到 ctx 取消
+func (p *Publisher) Run(ctx context.Context) {
+	log.Printf("[Publisher] 定时发布任务已启动，检查间隔 %v", p.interval)
+	for {
+		if _, err := p.PublishDue(ctx); err != nil {
+			log.Printf("[Publisher] 发布到期文章失败: %v", err)
+		}
+
+		timer := time.NewTimer(p.nextWait(ctx))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			log.Println("[Publisher] 定时发布任务已停止")
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// PublishDue 发布所有计划时间已到的文章
+func (p *Publisher) PublishDue(ctx context.Context) ([]*ent.Post, error) {
+	now := time.Now()
+	due, err := p.client.Post.Query().
+		Where(
+			post.ScheduledEQ(true),
+			post.PublishedEQ(false),
+			post.PublishedAtLTE(now),
+		).
+		All(ctx)
+	if err != nil || len(due) == 0 {
+		return nil, err
+	}
+
+	var published []*ent.Post
+	for _, d := range due {
+		// 条件更新，避免与手动修改并发时覆盖
+		n, err := p.client.Post.Update().
+			Where(
+				post.IDEQ(d.ID),
+				post.ScheduledEQ(true),
+				post.PublishedEQ(false),
+			).
+			SetPublished(true).
+			SetScheduled(false).
+			Save(ctx)
+		if err != nil {
+			return published, err
+		}
+		if n == 0 {
+			continue
+		}
+		d.Published = true
+		d.Scheduled = false
+		published = append(published, d)
+		log.Printf("[Publisher] 文章已自动发布: id=%d title=%s", d.ID, d.Title)
+
+		if err := IndexPost(ctx, p.client, d); err != nil {
+			log.Printf("[Search] 更新文章索引失败: id=%d err=%v", d.ID, err)
+		}
+	}
+	if len(published) > 0 {
+		InvalidateSitemap()
+	}
+	return published, nil
+}
+
+// nextWait 计算距离下一篇定时文章的等待时间，不超过检查间隔
+func (p *Publisher) nextWait(ctx context.Context) time.Duration {
+	next, err := p.client.Post.Query().
+		Where(
+			post.ScheduledEQ(true),
+			post.PublishedEQ(false),
+		).
+		Order(ent.Asc(post.FieldPublishedAt)).
+		First(ctx)
+	if err != nil || next.PublishedAt == nil {
+		return p.interval
+	}
+	wait := time.Until(*next.PublishedAt)
+	if wait < time.Second {
+		wait = time.Second
+	}
+	if wait > p.interval {
+		wait = p.interval
+	}
+	return wait
+}
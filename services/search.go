@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/searchdocument"
+	"blog-go/utils"
+)
+
+// 全文检索文档类型
+const (
+	SearchTypePost       = string(searchdocument.DocTypePost)
+	SearchTypeBook       = string(searchdocument.DocTypeBook)
+	SearchTypeCollection = string(searchdocument.DocTypeCollection)
+)
+
+// upsertSearchDocumentSQL 写入或更新检索文档，标题权重为A，正文权重为B
+//
+// 文本在写入前已按 utils.SearchIndexText 切分，因此使用 simple 配置即可。
+const upsertSearchDocumentSQL = `
+INSERT INTO search_documents (doc_type, doc_id, title, content, visible, updated_at, vector)
+VALUES ($1, $2, $3, $4, $5, $6,
+	setweight(to_tsvector('simple', $7), 'A') || setweight(to_tsvector('simple', $8), 'B'))
+ON CONFLICT (doc_type, doc_id) DO UPDATE SET
+	title = EXCLUDED.title,
+	content = EXCLUDED.content,
+	visible = EXCLUDED.visible,
+	updated_at = EXCLUDED.updated_at,
+	vector = EXCLUDED.vector`
+
+// upsertSearchDocument 写入检索文档，body 为参与检索的正文片段
+func upsertSearchDocument(ctx context.Context, client *ent.Client, docType string, docID int, title string, visible bool, body ...string) error {
+	content := strings.Join(body, "\n")
+	_, err := client.ExecContext(ctx, upsertSearchDocumentSQL,
+		docType, docID, title, content, visible, time.Now(),
+		utils.SearchIndexText(title), utils.SearchIndexText(content),
+	)
+	return err
+}
+
+// IndexPost 更新文章的检索文档，未发布的文章不会出现在搜索结果中
+func IndexPost(ctx context.Context, client *ent.Client, p *ent.Post) error {
+	return upsertSearchDocument(ctx, client, SearchTypePost, p.ID, p.Title, p.Published, p.Excerpt, p.Content)
+}
+
+// IndexBook 更新图书的检索文档
+func IndexBook(ctx context.Context, client *ent.Client, b *ent.Book) error {
+	return upsertSearchDocument(ctx, client, SearchTypeBook, b.ID, b.Title, true, b.Author, b.Desc)
+}
+
+// IndexCollection 更新收藏条目的检索文档
+func IndexCollection(ctx context.Context, client *ent.Client, c *ent.Collection) error {
+	return upsertSearchDocument(ctx, client, SearchTypeCollection, c.ID, c.Title, true)
+}
+
+// RemoveSearchDocuments 删除检索文档
+func RemoveSearchDocuments(ctx context.Context, client *ent.Client, docType string, ids ...int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := client.SearchDocument.Delete().
+		Where(
+			searchdocument.DocTypeEQ(searchdocument.DocType(docType)),
+			searchdocument.DocIDIn(ids...),
+		).
+		Exec(ctx)
+	return err
+}
+
+// RebuildSearchIndex 重建全部检索文档
+func RebuildSearchIndex(ctx context.Context, client *ent.Client) error {
+	posts, err := client.Post.Query().All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range posts {
+		if err := IndexPost(ctx, client, p); err != nil {
+			return err
+		}
+	}
+
+	books, err := client.Book.Query().All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, b := range books {
+		if err := IndexBook(ctx, client, b); err != nil {
+			return err
+		}
+	}
+
+	collections, err := client.Collection.Query().All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, c := range collections {
+		if err := IndexCollection(ctx, client, c); err != nil {
+			return err
+		}
+	}
+
+	// 清理源数据已不存在的文档
+	for docType, ids := range map[string][]int{
+		SearchTypePost:       postIDs(posts),
+		SearchTypeBook:       bookIDs(books),
+		SearchTypeCollection: collectionIDs(collections),
+	} {
+		if _, err := client.SearchDocument.Delete().
+			Where(
+				searchdocument.DocTypeEQ(searchdocument.DocType(docType)),
+				searchdocument.DocIDNotIn(ids...),
+			).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[Search] 检索索引重建完成: 文章%d 图书%d 收藏%d", len(posts), len(books), len(collections))
+	return nil
+}
+
+func postIDs(posts []*ent.Post) []int {
+	ids := make([]int, 0, len(posts))
+	for _, p := range posts {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
+
+func bookIDs(books []*ent.Book) []int {
+	ids := make([]int, 0, len(books))
+	for _, b := range books {
+		ids = append(ids, b.ID)
+	}
+	return ids
+}
+
+func collectionIDs(collections []*ent.Collection) []int {
+	ids := make([]int, 0, len(collections))
+	for _, c := range collections {
+		ids = append(ids, c.ID)
+	}
+	return ids
+}
@@ -0,0 +1,343 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/session"
+	"blog-go/ent/user"
+	"blog-go/utils"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrInvalidRefreshToken 刷新令牌无效、已过期或会话已被注销
+var ErrInvalidRefreshToken = errors.New("登录已失效，请重新登录")
+
+// TokenPair 登录或刷新后签发的访问令牌和刷新令牌
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	SessionID    int
+	// 访问令牌和刷新令牌的有效期
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+}
+
+// SessionManager 管理登录会话：签发短期访问令牌，轮换刷新令牌，注销会话
+type SessionManager struct {
+	client     *ent.Client
+	secret     string
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+// NewSessionManagerFromEnv 根据环境变量创建会话管理器
+//
+// ACCESS_TOKEN_TTL 为访问令牌有效期（默认 15m），REFRESH_TOKEN_TTL 为刷新令牌有效期（默认 720h），
+// 每次刷新都会重新计算刷新令牌的有效期，长期不使用的会话自动过期。
+func NewSessionManagerFromEnv(client *ent.Client) *SessionManager {
+	accessTTL, err := time.ParseDuration(utils.GetEnv("ACCESS_TOKEN_TTL", "15m"))
+	if err != nil || accessTTL <= 0 {
+		accessTTL = 15 * time.Minute
+	}
+	refreshTTL, err := time.ParseDuration(utils.GetEnv("REFRESH_TOKEN_TTL", "720h"))
+	if err != nil || refreshTTL <= 0 {
+		refreshTTL = 30 * 24 * time.Hour
+	}
+	return &SessionManager{
+		client:     client,
+		secret:     utils.GetEnv("JWT_SECRET", ""),
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+	}
+}
+
+// Issue 为登录成功的用户创建会话并签发令牌
+func (m *SessionManager) Issue(ctx context.Context, u *ent.User, ip, userAgent string) (*TokenPair, error) {
+	refreshToken, err := utils.RandomToken(32)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	s, err := m.client.Session.Create().
+		SetRefreshTokenHash(utils.HashToken(refreshToken)).
+		SetDevice(DescribeDevice(userAgent)).
+		SetIP(ip).
+		SetUserAgent(userAgent).
+		SetUser(u).
+		SetCreatedAt(now).
+		SetLastUsedAt(now).
+		SetExpiresAt(now.Add(m.refreshTTL)).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return m.tokenPair(u, s.ID, refreshToken)
+}
+
+// Refresh 使用刷新令牌换取新的访问令牌，同时更换刷新令牌
+//
+// 已被更换的旧刷新令牌再次出现说明令牌已泄露，注销整个会话。
+func (m *SessionManager) Refresh(ctx context.Context, refreshToken, ip, userAgent string) (*TokenPair, error) {
+	if refreshToken == "" {
+		return nil, ErrInvalidRefreshToken
+	}
+	hash := utils.HashToken(refreshToken)
+	now := time.Now()
+
+	s, err := m.client.Session.Query().
+		Where(session.RefreshTokenHashEQ(hash)).
+		WithUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		reused, err := m.client.Session.Query().
+			Where(session.PreviousTokenHashEQ(hash), session.RevokedAtIsNil()).
+			Only(ctx)
+		if err == nil {
+			m.Revoke(ctx, reused.ID)
+		}
+		return nil, ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	if s.RevokedAt != nil || now.After(s.ExpiresAt) {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	next, err := utils.RandomToken(32)
+	if err != nil {
+		return nil, err
+	}
+	// 条件更新保证同一个刷新令牌只能成功使用一次
+	n, err := m.client.Session.Update().
+		Where(
+			session.IDEQ(s.ID),
+			session.RefreshTokenHashEQ(hash),
+			session.RevokedAtIsNil(),
+		).
+		SetRefreshTokenHash(utils.HashToken(next)).
+		SetPreviousTokenHash(hash).
+		SetIP(ip).
+		SetUserAgent(userAgent).
+		SetLastUsedAt(now).
+		SetExpiresAt(now.Add(m.refreshTTL)).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrInvalidRefreshToken
+	}
+	return m.tokenPair(s.Edges.User, s.ID, next)
+}
+
+// SessionForRefreshToken 查找刷新令牌对应的有效会话
+func (m *SessionManager) SessionForRefreshToken(ctx context.Context, refreshToken string) (*ent.Session, error) {
+	return m.client.Session.Query().
+		Where(
+			session.RefreshTokenHashEQ(utils.HashToken(refreshToken)),
+			session.RevokedAtIsNil(),
+		).
+		Only(ctx)
+}
+
+// ActiveSessions 获取用户未注销且未过期的会话，最近使用的在前
+func (m *SessionManager) ActiveSessions(ctx context.Context, userID int) ([]*ent.Session, error) {
+	return m.client.Session.Query().
+		Where(
+			session.HasUserWith(user.IDEQ(userID)),
+			session.RevokedAtIsNil(),
+			session.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(session.FieldLastUsedAt)).
+		All(ctx)
+}
+
+// Revoke 注销会话，已签发的访问令牌随即失效
+func (m *SessionManager) Revoke(ctx context.Context, sessionID int) error {
+	if err := m.client.Session.Update().
+		Where(session.IDEQ(sessionID), session.RevokedAtIsNil()).
+		SetRevokedAt(time.Now()).
+		Exec(ctx); err != nil {
+		return err
+	}
+	revokedSessions.mark(sessionID, true)
+	return nil
+}
+
+// RevokeUserSession 注销用户自己的某个会话，会话不属于该用户时返回 NotFound
+func (m *SessionManager) RevokeUserSession(ctx context.Context, userID, sessionID int) error {
+	exists, err := m.client.Session.Query().
+		Where(session.IDEQ(sessionID), session.HasUserWith(user.IDEQ(userID))).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return &ent.NotFoundError{}
+	}
+	return m.Revoke(ctx, sessionID)
+}
+
+// RevokeAll 注销用户的所有会话，except 不为 0 时保留该会话
+func (m *SessionManager) RevokeAll(ctx context.Context, userID, except int) error {
+	ids, err := m.client.Session.Query().
+		Where(
+			session.HasUserWith(user.IDEQ(userID)),
+			session.RevokedAtIsNil(),
+			session.IDNEQ(except),
+		).
+		IDs(ctx)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+	if err := m.client.Session.Update().
+		Where(session.IDIn(ids...)).
+		SetRevokedAt(time.Now()).
+		Exec(ctx); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		revokedSessions.mark(id, true)
+	}
+	return nil
+}
+
+// tokenPair 签发包含会话ID（sid）的短期访问令牌，email、avatar 供 /users/me 直接读取
+func (m *SessionManager) tokenPair(u *ent.User, sessionID int, refreshToken string) (*TokenPair, error) {
+	if m.secret == "" {
+		return nil, errors.New("JWT密钥未设置")
+	}
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"username": u.Username,
+		"userID":   u.ID,
+		"email":    u.Email,
+		"avatar":   u.Avatar,
+		"sid":      sessionID,
+		"iat":      now.Unix(),
+		"exp":      now.Add(m.accessTTL).Unix(),
+	}
+	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(m.secret))
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		SessionID:    sessionID,
+		AccessTTL:    m.accessTTL,
+		RefreshTTL:   m.refreshTTL,
+	}, nil
+}
+
+// 会话注销状态的缓存时间，其他实例注销的会话最多延迟这么久生效
+const sessionCacheTTL = 30 * time.Second
+
+// sessionCache 会话是否已注销的缓存，避免每个请求都查询数据库
+type sessionCache struct {
+	mu      sync.Mutex
+	entries map[int]sessionCacheEntry
+	swept   time.Time
+}
+
+type sessionCacheEntry struct {
+	revoked bool
+	checked time.Time
+}
+
+var revokedSessions = &sessionCache{entries: map[int]sessionCacheEntry{}, swept: time.Now()}
+
+func (c *sessionCache) get(id int) (revoked, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[id]
+	// 已注销的会话不会恢复，无需重新查询
+	if !ok || (!e.revoked && time.Since(e.checked) > sessionCacheTTL) {
+		return false, false
+	}
+	return e.revoked, true
+}
+
+func (c *sessionCache) mark(id int, revoked bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	// 注销记录保留一天，足以覆盖访问令牌的有效期
+	if now.Sub(c.swept) > time.Hour {
+		for k, e := range c.entries {
+			if now.Sub(e.checked) > 24*time.Hour {
+				delete(c.entries, k)
+			}
+		}
+		c.swept = now
+	}
+	c.entries[id] = sessionCacheEntry{revoked: revoked, checked: now}
+}
+
+// SessionRevoked 判断访问令牌所属的会话是否已注销或过期
+func SessionRevoked(ctx context.Context, client *ent.Client, sessionID int) bool {
+	if revoked, ok := revokedSessions.get(sessionID); ok {
+		return revoked
+	}
+	s, err := client.Session.Query().
+		Where(session.IDEQ(sessionID)).
+		Select(session.FieldRevokedAt, session.FieldExpiresAt).
+		Only(ctx)
+	if err != nil {
+		// 查询失败时不缓存，会话不存在视为已注销
+		return ent.IsNotFound(err)
+	}
+	revoked := s.RevokedAt != nil || time.Now().After(s.ExpiresAt)
+	revokedSessions.mark(sessionID, revoked)
+	return revoked
+}
+
+// DescribeDevice 根据 User-Agent 生成便于辨认的设备描述，如 "Chrome · macOS"
+func DescribeDevice(userAgent string) string {
+	ua := strings.ToLower(userAgent)
+	browser := ""
+	for _, b := range []struct{ key, name string }{
+		{"edg/", "Edge"},
+		{"opr/", "Opera"},
+		{"micromessenger", "微信"},
+		{"firefox/", "Firefox"},
+		{"chrome/", "Chrome"},
+		{"safari/", "Safari"},
+		{"curl/", "curl"},
+	} {
+		if strings.Contains(ua, b.key) {
+			browser = b.name
+			break
+		}
+	}
+	system := ""
+	for _, o := range []struct{ key, name string }{
+		{"iphone", "iOS"},
+		{"ipad", "iPadOS"},
+		{"android", "Android"},
+		{"windows", "Windows"},
+		{"mac os x", "macOS"},
+		{"linux", "Linux"},
+	} {
+		if strings.Contains(ua, o.key) {
+			system = o.name
+			break
+		}
+	}
+	switch {
+	case browser != "" && system != "":
+		return browser + " · " + system
+	case browser != "":
+		return browser
+	case system != "":
+		return system
+	}
+	return "未知设备"
+}
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/book"
+	"blog-go/ent/friend"
+	"blog-go/ent/post"
+	"blog-go/ent/tag"
+)
+
+// SitemapMaxURLs 单个 sitemap 文件允许的最大URL数量（sitemaps.org 协议限制）
+const SitemapMaxURLs = 50000
+
+// sitemapTTL 缓存最长有效期，图书、友链等没有主动失效的数据依赖它刷新
+const sitemapTTL = time.Hour
+
+// SitemapURL sitemap 中的一条地址
+type SitemapURL struct {
+	Loc     string
+	LastMod time.Time
+}
+
+// sitemapCache 站点地图缓存，文章创建、更新、删除或定时发布后失效
+var sitemapCache struct {
+	mu      sync.Mutex
+	siteURL string
+	urls    []SitemapURL
+	builtAt time.Time
+}
+
+// InvalidateSitemap 使站点地图缓存失效，下次请求时重新生成
+func InvalidateSitemap() {
+	sitemapCache.mu.Lock()
+	sitemapCache.urls = nil
+	sitemapCache.mu.Unlock()
+}
+
+// SitemapURLs 获取站点地图地址列表，优先使用缓存
+func SitemapURLs(ctx context.Context, client *ent.Client, siteURL string) ([]SitemapURL, time.Time, error) {
+	sitemapCache.mu.Lock()
+	defer sitemapCache.mu.Unlock()
+
+	if sitemapCache.urls != nil && sitemapCache.siteURL == siteURL && time.Since(sitemapCache.builtAt) < sitemapTTL {
+		return sitemapCache.urls, sitemapCache.builtAt, nil
+	}
+
+	urls, err := buildSitemapURLs(ctx, client, siteURL)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	sitemapCache.siteURL = siteURL
+	sitemapCache.urls = urls
+	sitemapCache.builtAt = time.Now().UTC().Truncate(time.Second)
+	return urls, sitemapCache.builtAt, nil
+}
+
+// buildSitemapURLs 从已发布文章、标签、图书和友链页面生成地址列表
+func buildSitemapURLs(ctx context.Context, client *ent.Client, siteURL string) ([]SitemapURL, error) {
+	posts, err := client.Post.Query().
+		Where(post.PublishedEQ(true)).
+		Select(post.FieldID, post.FieldSlug, post.FieldUpdatedAt, post.FieldPublishedAt).
+		Order(ent.Desc(post.FieldUpdatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := client.Tag.Query().
+		Where(tag.HasPostsWith(post.PublishedEQ(true))).
+		Order(ent.Asc(tag.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	latestBook, err := client.Book.Query().Order(ent.Desc(book.FieldUpdatedAt)).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	latestFriend, err := client.Friend.Query().Order(ent.Desc(friend.FieldUpdatedAt)).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	urls := make([]SitemapURL, 0, len(posts)+len(tags)+3)
+
+	// 首页以最近更新的文章时间为准
+	var home time.Time
+	if len(posts) > 0 {
+		home = PostLastModified(posts[0])
+	}
+	urls = append(urls, SitemapURL{Loc: siteURL + "/", LastMod: home})
+
+	for _, p := range posts {
+		urls = append(urls, SitemapURL{Loc: siteURL + PostPath(p), LastMod: PostLastModified(p)})
+	}
+
+	for _, t := range tags {
+		urls = append(urls, SitemapURL{Loc: siteURL + "/tags/" + url.PathEscape(t.Slug), LastMod: t.UpdatedAt})
+	}
+
+	booksPage := SitemapURL{Loc: siteURL + "/books"}
+	if latestBook != nil {
+		booksPage.LastMod = latestBook.UpdatedAt
+	}
+	friendsPage := SitemapURL{Loc: siteURL + "/friends"}
+	if latestFriend != nil {
+		friendsPage.LastMod = latestFriend.UpdatedAt
+	}
+	urls = append(urls, booksPage, friendsPage)
+
+	return urls, nil
+}
+
+// PostLastModified 文章的最后修改时间，不早于发布时间
+func PostLastModified(p *ent.Post) time.Time {
+	if p.PublishedAt != nil && p.PublishedAt.After(p.UpdatedAt) {
+		return *p.PublishedAt
+	}
+	return p.UpdatedAt
+}
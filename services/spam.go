@@ -0,0 +1,229 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+
+	"blog-go/ent"
+	"blog-go/ent/comment"
+	"blog-go/ent/spamcorpus"
+	"blog-go/ent/spamtoken"
+	"blog-go/utils"
+
+	"github.com/lib/pq"
+)
+
+// 单条评论参与训练和打分的最大特征数
+const maxSpamTokens = 500
+
+// addSpamTokensSQL 批量累加特征计数
+const addSpamTokensSQL = `
+INSERT INTO spam_tokens (token, spam, ham)
+SELECT t, $2, $3 FROM unnest($1::text[]) AS t
+ON CONFLICT (token) DO UPDATE SET
+	spam = GREATEST(spam_tokens.spam + EXCLUDED.spam, 0),
+	ham = GREATEST(spam_tokens.ham + EXCLUDED.ham, 0)`
+
+// SpamClassifier 基于数据库词频统计的朴素贝叶斯垃圾评论分类器
+//
+// 特征统计保存在 spam_tokens / spam_corpus 表中，由管理员的审核操作自动训练，不依赖任何外部服务。
+type SpamClassifier struct {
+	client *ent.Client
+	// 每个类别至少需要的训练样本数，不足时不给出判断
+	minDocuments int
+}
+
+func NewSpamClassifier(client *ent.Client, minDocuments int) *SpamClassifier {
+	if minDocuments < 1 {
+		minDocuments = 1
+	}
+	return &SpamClassifier{client: client, minDocuments: minDocuments}
+}
+
+// spamFeatures 提取评论特征：正文分词、作者、邮箱域名、网址域名和链接数量
+func spamFeatures(content, author, email, website string) []string {
+	seen := map[string]bool{}
+	var tokens []string
+	add := func(t string) {
+		if t != "" && !seen[t] && len(tokens) < maxSpamTokens {
+			seen[t] = true
+			tokens = append(tokens, t)
+		}
+	}
+
+	add("author:" + strings.ToLower(strings.TrimSpace(author)))
+	if i := strings.LastIndex(email, "@"); i >= 0 {
+		add("email:" + strings.ToLower(email[i+1:]))
+	}
+	if u, err := url.Parse(website); err == nil && u.Host != "" {
+		add("site:" + strings.ToLower(u.Host))
+	}
+	links := len(linkPattern.FindAllStringIndex(content, -1))
+	if links > 3 {
+		links = 3
+	}
+	add(fmt.Sprintf("links:%d", links))
+
+	for _, t := range utils.SearchTokens(content) {
+		add(t)
+	}
+	return tokens
+}
+
+func commentFeatures(c *ent.Comment) []string {
+	return spamFeatures(c.Content, c.Author, c.Email, c.Website)
+}
+
+// Train 以管理员的审核结果训练分类器；评论已按相反类别训练过时先撤销原训练
+func (s *SpamClassifier) Train(ctx context.Context, c *ent.Comment, spam bool) error {
+	label := comment.SpamLabelHam
+	if spam {
+		label = comment.SpamLabelSpam
+	}
+	if c.SpamLabel != nil && *c.SpamLabel == label {
+		return nil
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	tokens := pq.Array(commentFeatures(c))
+
+	if c.SpamLabel != nil {
+		if err := adjustSpamCounts(ctx, tx, tokens, *c.SpamLabel, -1); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if err := adjustSpamCounts(ctx, tx, tokens, label, 1); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Comment.UpdateOneID(c.ID).SetSpamLabel(label).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	c.SpamLabel = &label
+	return nil
+}
+
+// Untrain 在事务中撤销评论已训练的计数（评论内容修改前调用），调用方负责清除评论的 spam_label
+func (s *SpamClassifier) Untrain(ctx context.Context, tx *ent.Tx, c *ent.Comment) error {
+	if c.SpamLabel == nil {
+		return nil
+	}
+	return adjustSpamCounts(ctx, tx, pq.Array(commentFeatures(c)), *c.SpamLabel, -1)
+}
+
+// adjustSpamCounts 调整特征计数和类别文档数，delta 为 1 或 -1
+func adjustSpamCounts(ctx context.Context, tx *ent.Tx, tokens any, label comment.SpamLabel, delta int) error {
+	spam, ham := 0, 0
+	if label == comment.SpamLabelSpam {
+		spam = delta
+	} else {
+		ham = delta
+	}
+	if _, err := tx.ExecContext(ctx, addSpamTokensSQL, tokens, spam, ham); err != nil {
+		return err
+	}
+
+	class := spamcorpus.Class(label)
+	n, err := tx.SpamCorpus.Update().
+		Where(spamcorpus.ClassEQ(class)).
+		AddDocuments(delta).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 && delta > 0 {
+		return tx.SpamCorpus.Create().SetClass(class).SetDocuments(delta).Exec(ctx)
+	}
+	return nil
+}
+
+// Score 计算评论为垃圾评论的概率，训练样本不足时 ready 为 false
+func (s *SpamClassifier) Score(ctx context.Context, content, author, email, website string) (score float64, ready bool, err error) {
+	corpus, err := s.client.SpamCorpus.Query().All(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+	var spamDocs, hamDocs int
+	for _, c := range corpus {
+		if c.Class == spamcorpus.ClassSpam {
+			spamDocs = c.Documents
+		} else {
+			hamDocs = c.Documents
+		}
+	}
+	if spamDocs < s.minDocuments || hamDocs < s.minDocuments {
+		return 0, false, nil
+	}
+
+	features := spamFeatures(content, author, email, website)
+	stats, err := s.client.SpamToken.Query().
+		Where(spamtoken.TokenIn(features...)).
+		All(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	// 对数空间计算，使用拉普拉斯平滑；未见过的特征不参与计算
+	logSpam := math.Log(float64(spamDocs) / float64(spamDocs+hamDocs))
+	logHam := math.Log(float64(hamDocs) / float64(spamDocs+hamDocs))
+	for _, t := range stats {
+		if t.Spam+t.Ham == 0 {
+			continue
+		}
+		logSpam += math.Log(float64(t.Spam+1) / float64(spamDocs+2))
+		logHam += math.Log(float64(t.Ham+1) / float64(hamDocs+2))
+	}
+	return 1 / (1 + math.Exp(logHam-logSpam)), true, nil
+}
+
+// SpamModerator 使用本地分类器审核评论，得分超过阈值时转人工审核
+type SpamModerator struct {
+	classifier *SpamClassifier
+	threshold  float64
+	// 没有其他自动审核服务时，分类器样本不足也需要人工审核
+	holdWhenUntrained bool
+}
+
+func NewSpamModerator(classifier *SpamClassifier, threshold float64, holdWhenUntrained bool) *SpamModerator {
+	return &SpamModerator{
+		classifier:        classifier,
+		threshold:         threshold,
+		holdWhenUntrained: holdWhenUntrained,
+	}
+}
+
+func (m *SpamModerator) Name() string {
+	return "bayes"
+}
+
+func (m *SpamModerator) Moderate(ctx context.Context, input ModerationInput) (ModerationResult, error) {
+	score, ready, err := m.classifier.Score(ctx, input.Content, input.Author, input.Email, input.Website)
+	if err != nil {
+		return ModerationResult{}, err
+	}
+	if !ready {
+		if m.holdWhenUntrained {
+			return ModerationResult{Action: ModerationHold, Reason: "垃圾评论分类器训练样本不足，需人工审核"}, nil
+		}
+		return ModerationResult{Action: ModerationApprove}, nil
+	}
+	if score >= m.threshold {
+		return ModerationResult{
+			Action: ModerationHold,
+			Reason: fmt.Sprintf("疑似垃圾评论（得分%.2f）", score),
+			Score:  &score,
+		}, nil
+	}
+	return ModerationResult{Action: ModerationApprove, Score: &score}, nil
+}
@@ -0,0 +1,135 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"blog-go/ent"
+	"blog-go/ent/recoverycode"
+	"blog-go/ent/user"
+	"blog-go/utils"
+)
+
+// 每次生成的恢复码数量
+const recoveryCodeCount = 10
+
+// ErrInvalidTwoFactorCode 验证码或恢复码错误、已使用
+var ErrInvalidTwoFactorCode = errors.New("验证码不正确或已使用")
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TwoFactorRequired 判断用户是否必须启用两步验证（REQUIRE_ADMIN_2FA=true 时管理员必须启用）
+func TwoFactorRequired(u *ent.User) bool {
+	return u.Role == RoleAdmin && utils.GetEnv("REQUIRE_ADMIN_2FA", "false") == "true"
+}
+
+// TwoFactorSetupPending 用户必须启用两步验证但尚未完成绑定，此时只能访问不需要权限的接口
+func TwoFactorSetupPending(u *ent.User) bool {
+	return u.TotpEnabledAt == nil && TwoFactorRequired(u)
+}
+
+// UserHasPermission 判断用户是否拥有权限，必须启用两步验证但尚未绑定的用户没有任何权限
+func UserHasPermission(u *ent.User, perm Permission) bool {
+	return !TwoFactorSetupPending(u) && HasPermission(u.Role, perm)
+}
+
+// VerifyTwoFactor 校验动态验证码或恢复码，通过后验证码的时间步或恢复码即被消耗，返回是否使用了恢复码
+func VerifyTwoFactor(ctx context.Context, client *ent.Client, u *ent.User, code string) (bool, error) {
+	if u.TotpEnabledAt == nil || u.TotpSecret == "" {
+		return false, ErrInvalidTwoFactorCode
+	}
+	code = strings.TrimSpace(code)
+	if isDigits(code) {
+		step, ok := utils.VerifyTOTP(u.TotpSecret, code, time.Now(), u.TotpLastStep)
+		if !ok {
+			return false, ErrInvalidTwoFactorCode
+		}
+		// 条件更新保证同一时间步的验证码在并发请求中也只能使用一次
+		n, err := client.User.Update().
+			Where(user.IDEQ(u.ID), user.TotpLastStepLT(step)).
+			SetTotpLastStep(step).
+			Save(ctx)
+		if err != nil {
+			return false, err
+		}
+		if n == 0 {
+			return false, ErrInvalidTwoFactorCode
+		}
+		return false, nil
+	}
+
+	n, err := client.RecoveryCode.Update().
+		Where(
+			recoverycode.CodeHashEQ(utils.HashToken(normalizeRecoveryCode(code))),
+			recoverycode.HasUserWith(user.IDEQ(u.ID)),
+			recoverycode.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	if n == 0 {
+		return false, ErrInvalidTwoFactorCode
+	}
+	return true, nil
+}
+
+// NewRecoveryCodes 为用户重新生成恢复码，旧的恢复码全部作废；返回的原文只展示一次
+func NewRecoveryCodes(ctx context.Context, tx *ent.Tx, userID int) ([]string, error) {
+	if _, err := tx.RecoveryCode.Delete().
+		Where(recoverycode.HasUserWith(user.IDEQ(userID))).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	codes := make([]string, 0, recoveryCodeCount)
+	builders := make([]*ent.RecoveryCodeCreate, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, 10)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		// 16 个字符，按 xxxx-xxxx-xxxx-xxxx 分组便于抄写
+		raw := strings.ToLower(recoveryEncoding.EncodeToString(b))
+		codes = append(codes, raw[0:4]+"-"+raw[4:8]+"-"+raw[8:12]+"-"+raw[12:16])
+		builders = append(builders, tx.RecoveryCode.Create().
+			SetCodeHash(utils.HashToken(raw)).
+			SetUserID(userID).
+			SetCreatedAt(now))
+	}
+	if err := tx.RecoveryCode.CreateBulk(builders...).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// RemainingRecoveryCodes 未使用的恢复码数量
+func RemainingRecoveryCodes(ctx context.Context, client *ent.Client, userID int) (int, error) {
+	return client.RecoveryCode.Query().
+		Where(recoverycode.HasUserWith(user.IDEQ(userID)), recoverycode.UsedAtIsNil()).
+		Count(ctx)
+}
+
+// normalizeRecoveryCode 忽略大小写、空格和分隔符
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
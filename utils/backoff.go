@@ -0,0 +1,177 @@
+package utils
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// BackoffDuration 连续失败 failures 次后的锁定时长：达到 threshold 次时锁定 base，之后每多失败一次翻倍，最长 max
+func BackoffDuration(failures, threshold int, base, max time.Duration) time.Duration {
+	if failures < threshold {
+		return 0
+	}
+	d := base
+	for i := threshold; i < failures && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return d
+}
+
+// FailureTracker 按键（IP、邮箱等）记录连续失败次数并按指数退避临时封禁，计数保存在内存中
+type FailureTracker struct {
+	threshold int
+	base, max time.Duration
+	// 最后一次失败超过 window 后计数清零
+	window time.Duration
+	// 最多记录的键数，写满后淘汰最早失败的记录，避免大量随机键耗尽内存
+	limit int
+
+	mu      sync.Mutex
+	entries map[string]*failureEntry
+	swept   time.Time
+}
+
+type failureEntry struct {
+	failures     int
+	last         time.Time
+	blockedUntil time.Time
+}
+
+// BlockedKey 当前处于封禁状态的键
+type BlockedKey struct {
+	Key          string    `json:"key"`
+	Failures     int       `json:"failures"`
+	LastFailure  time.Time `json:"last_failure"`
+	BlockedUntil time.Time `json:"blocked_until"`
+}
+
+// NewFailureTracker 创建失败计数器，最多记录 limit 个键，其余参数含义见 BackoffDuration
+func NewFailureTracker(threshold int, base, max, window time.Duration, limit int) *FailureTracker {
+	return &FailureTracker{
+		threshold: threshold,
+		base:      base,
+		max:       max,
+		window:    window,
+		limit:     limit,
+		entries:   map[string]*failureEntry{},
+		swept:     time.Now(),
+	}
+}
+
+// BlockedFor 返回键剩余的封禁时长，未封禁时为 0
+func (t *FailureTracker) BlockedFor(key string) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if e, ok := t.entries[key]; ok {
+		if d := time.Until(e.blockedUntil); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// Reserve 在检查前预先记录一次失败：键封禁中时返回剩余时长且不计数，否则计数后返回 0
+//
+// 检查和计数在同一把锁内完成，并发请求不会同时通过检查；验证成功后调用 Release 撤销这次计数。
+func (t *FailureTracker) Reserve(key string) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	if e, ok := t.entries[key]; ok {
+		if d := e.blockedUntil.Sub(now); d > 0 {
+			return d
+		}
+	}
+	if now.Sub(t.swept) > t.window {
+		t.sweep(now)
+	}
+
+	e, ok := t.entries[key]
+	if !ok || now.Sub(e.last) > t.window {
+		if !ok && len(t.entries) >= t.limit {
+			t.evict(now)
+		}
+		e = &failureEntry{}
+		t.entries[key] = e
+	}
+	e.failures++
+	e.last = now
+	if d := BackoffDuration(e.failures, t.threshold, t.base, t.max); d > 0 {
+		e.blockedUntil = now.Add(d)
+	}
+	return 0
+}
+
+// Release 撤销一次 Reserve 的计数，计数低于阈值时同时解除封禁
+func (t *FailureTracker) Release(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	e, ok := t.entries[key]
+	if !ok {
+		return
+	}
+	e.failures--
+	if e.failures <= 0 {
+		delete(t.entries, key)
+		return
+	}
+	if e.failures < t.threshold {
+		e.blockedUntil = time.Time{}
+	}
+}
+
+// sweep 删除已过期且不在封禁中的记录
+func (t *FailureTracker) sweep(now time.Time) {
+	for k, e := range t.entries {
+		if now.Sub(e.last) > t.window && now.After(e.blockedUntil) {
+			delete(t.entries, k)
+		}
+	}
+	t.swept = now
+}
+
+// evict 记录已满时先清理过期记录，仍然满时淘汰最后一次失败最早的记录
+func (t *FailureTracker) evict(now time.Time) {
+	t.sweep(now)
+	for len(t.entries) >= t.limit {
+		var oldest string
+		var oldestEntry *failureEntry
+		for k, e := range t.entries {
+			if oldestEntry == nil || e.last.Before(oldestEntry.last) {
+				oldest, oldestEntry = k, e
+			}
+		}
+		delete(t.entries, oldest)
+	}
+}
+
+// Reset 清除键的失败计数，返回之前是否有记录
+func (t *FailureTracker) Reset(key string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, ok := t.entries[key]
+	delete(t.entries, key)
+	return ok
+}
+
+// Blocked 当前处于封禁状态的键，按解封时间排序
+func (t *FailureTracker) Blocked() []BlockedKey {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	list := []BlockedKey{}
+	for k, e := range t.entries {
+		if e.blockedUntil.After(now) {
+			list = append(list, BlockedKey{Key: k, Failures: e.failures, LastFailure: e.last, BlockedUntil: e.blockedUntil})
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].BlockedUntil.Before(list[j].BlockedUntil) })
+	return list
+}
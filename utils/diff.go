@@ -0,0 +1,104 @@
+package utils
+
+import "strings"
+
+// maxDiffCells LCS 表的最大单元数，超过时退化为整段删除加整段插入，避免大文本占用过多内存
+const maxDiffCells = 1 << 20
+
+// DiffLine 行级差异中的一行
+type DiffLine struct {
+	Type    string `json:"type"` // equal, insert, delete
+	OldLine int    `json:"old_line,omitempty"`
+	NewLine int    `json:"new_line,omitempty"`
+	Text    string `json:"text"`
+}
+
+// DiffLines 对两段文本做行级差异比较（基于最长公共子序列）
+func DiffLines(oldText, newText string) []DiffLine {
+	a := splitLines(oldText)
+	b := splitLines(newText)
+
+	// 去掉公共前缀和后缀，缩小LCS计算规模
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var result []DiffLine
+	for i := 0; i < prefix; i++ {
+		result = append(result, DiffLine{Type: "equal", OldLine: i + 1, NewLine: i + 1, Text: a[i]})
+	}
+
+	midA := a[prefix : len(a)-suffix]
+	midB := b[prefix : len(b)-suffix]
+
+	if len(midA)*len(midB) > maxDiffCells {
+		for i, line := range midA {
+			result = append(result, DiffLine{Type: "delete", OldLine: prefix + i + 1, Text: line})
+		}
+		for j, line := range midB {
+			result = append(result, DiffLine{Type: "insert", NewLine: prefix + j + 1, Text: line})
+		}
+		return appendDiffSuffix(result, a, b, suffix)
+	}
+
+	// lcs[i][j] 表示 midA[i:] 与 midB[j:] 的最长公共子序列长度
+	lcs := make([][]int, len(midA)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(midB)+1)
+	}
+	for i := len(midA) - 1; i >= 0; i-- {
+		for j := len(midB) - 1; j >= 0; j-- {
+			if midA[i] == midB[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(midA) || j < len(midB) {
+		oldLine := prefix + i + 1
+		newLine := prefix + j + 1
+		switch {
+		case i < len(midA) && j < len(midB) && midA[i] == midB[j]:
+			result = append(result, DiffLine{Type: "equal", OldLine: oldLine, NewLine: newLine, Text: midA[i]})
+			i++
+			j++
+		case j < len(midB) && (i == len(midA) || lcs[i][j+1] > lcs[i+1][j]):
+			result = append(result, DiffLine{Type: "insert", NewLine: newLine, Text: midB[j]})
+			j++
+		default:
+			result = append(result, DiffLine{Type: "delete", OldLine: oldLine, Text: midA[i]})
+			i++
+		}
+	}
+
+	return appendDiffSuffix(result, a, b, suffix)
+}
+
+// appendDiffSuffix 追加公共后缀
+func appendDiffSuffix(result []DiffLine, a, b []string, suffix int) []DiffLine {
+	for k := 0; k < suffix; k++ {
+		oldIdx := len(a) - suffix + k
+		newIdx := len(b) - suffix + k
+		result = append(result, DiffLine{Type: "equal", OldLine: oldIdx + 1, NewLine: newIdx + 1, Text: a[oldIdx]})
+	}
+	return result
+}
+
+// splitLines 按行切分文本，统一换行符
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
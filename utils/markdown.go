@@ -0,0 +1,137 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"blog-go/models"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+)
+
+var markdown = goldmark.New(
+	goldmark.WithExtensions(
+		extension.GFM,
+		extension.Footnote,
+		extension.CJK,
+	),
+	goldmark.WithParserOptions(
+		parser.WithAutoHeadingID(),
+	),
+	goldmark.WithRendererOptions(
+		// 允许原始HTML，统一交给 sanitizer 过滤
+		html.WithUnsafe(),
+	),
+)
+
+var htmlPolicy = newHTMLPolicy()
+
+// newHTMLPolicy 在UGC策略基础上放行标题锚点、代码语言和脚注所需的属性
+func newHTMLPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("class").
+		Matching(regexp.MustCompile(`^language-[\w+#.-]+$`)).
+		OnElements("code")
+	p.AllowAttrs("class").
+		Matching(regexp.MustCompile(`^footnote(s|-ref|-backref)$`)).
+		OnElements("a", "div")
+	p.AllowAttrs("role").
+		Matching(regexp.MustCompile(`^doc-(noteref|backlink|endnotes)$`)).
+		OnElements("a", "div")
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").OnElements("input")
+	return p
+}
+
+// RenderMarkdown 将Markdown渲染为过滤后的HTML，并生成目录
+func RenderMarkdown(source string) (string, []models.TocItem, error) {
+	src := []byte(source)
+	pc := parser.NewContext(parser.WithIDs(newHeadingIDs()))
+	doc := markdown.Parser().Parse(text.NewReader(src), parser.WithContext(pc))
+
+	toc := []models.TocItem{}
+	err := ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		heading, ok := n.(*ast.Heading)
+		if !ok || !entering {
+			return ast.WalkContinue, nil
+		}
+		item := models.TocItem{
+			Level: heading.Level,
+			Text:  strings.TrimSpace(nodeText(heading, src)),
+		}
+		if id, ok := heading.AttributeString("id"); ok {
+			if b, ok := id.([]byte); ok {
+				item.ID = string(b)
+			}
+		}
+		toc = append(toc, item)
+		return ast.WalkSkipChildren, nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := markdown.Renderer().Render(&buf, src, doc); err != nil {
+		return "", nil, err
+	}
+	return htmlPolicy.Sanitize(buf.String()), toc, nil
+}
+
+// nodeText 提取节点内的纯文本
+func nodeText(n ast.Node, src []byte) string {
+	var sb strings.Builder
+	for c := n.FirstChild(); c != nil; c = c.NextSibling() {
+		switch t := c.(type) {
+		case *ast.Text:
+			sb.Write(t.Segment.Value(src))
+			if t.SoftLineBreak() || t.HardLineBreak() {
+				sb.WriteByte(' ')
+			}
+		case *ast.String:
+			sb.Write(t.Value)
+		default:
+			sb.WriteString(nodeText(c, src))
+		}
+	}
+	return sb.String()
+}
+
+// headingIDs 使用 Slugify 生成标题锚点，中文标题转为拼音
+type headingIDs struct {
+	values map[string]bool
+}
+
+func newHeadingIDs() *headingIDs {
+	return &headingIDs{values: map[string]bool{}}
+}
+
+func (s *headingIDs) Generate(value []byte, kind ast.NodeKind) []byte {
+	id := Slugify(string(value))
+	if id == "" {
+		id = "heading"
+	}
+	result := id
+	for i := 1; s.values[result]; i++ {
+		result = fmt.Sprintf("%s-%d", id, i)
+	}
+	s.values[result] = true
+	return []byte(result)
+}
+
+func (s *headingIDs) Put(value []byte) {
+	s.values[string(value)] = true
+}
+
+// SanitizeHTML 使用与文章相同的策略过滤外部来源的HTML
+func SanitizeHTML(source string) string {
+	return htmlPolicy.Sanitize(source)
+}
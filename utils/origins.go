@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"net/url"
+	"strings"
+)
+
+// 未配置 ALLOWED_ORIGINS 时只允许本地开发环境
+const defaultAllowedOrigins = "http://localhost:3000,http://127.0.0.1:3000"
+
+// AllowedOrigins 允许访问的前端域名（ALLOWED_ORIGINS，逗号分隔）
+func AllowedOrigins() []string {
+	var origins []string
+	for _, origin := range strings.Split(GetEnv("ALLOWED_ORIGINS", defaultAllowedOrigins), ",") {
+		if origin = strings.TrimSuffix(strings.TrimSpace(origin), "/"); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
+// IsAllowedOrigin 判断 origin（scheme://host[:port]）是否在允许列表中
+func IsAllowedOrigin(origin string) bool {
+	for _, allowed := range AllowedOrigins() {
+		if origin == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// SafeRedirectURL 校验登录后的跳转地址，只允许跳转到允许列表中的前端域名
+//
+// 以 / 开头的相对路径拼接到第一个允许的域名；空地址返回该域名首页。
+func SafeRedirectURL(raw string) (string, bool) {
+	origins := AllowedOrigins()
+	if len(origins) == 0 {
+		return "", false
+	}
+	if raw == "" {
+		return origins[0] + "/", true
+	}
+	// 拒绝 //evil.com、/\evil.com 这类会被浏览器当作其他域名的路径
+	if strings.HasPrefix(raw, "/") && !strings.HasPrefix(raw, "//") && !strings.HasPrefix(raw, "/\\") {
+		raw = origins[0] + raw
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || u.User != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", false
+	}
+	if !IsAllowedOrigin(u.Scheme + "://" + u.Host) {
+		return "", false
+	}
+	return u.String(), true
+}
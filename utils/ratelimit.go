@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+// RateLimiter 按任意键（IP、邮箱等）计数的固定窗口限流器，计数保存在内存中
+type RateLimiter struct {
+	limit  int
+	window time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*rateEntry
+	// 上次清理过期计数的时间
+	swept time.Time
+}
+
+type rateEntry struct {
+	start time.Time
+	count int
+}
+
+// NewRateLimiter 创建限流器，每个键在 window 内最多允许 limit 次
+func NewRateLimiter(limit int, window time.Duration) *RateLimiter {
+	return &RateLimiter{
+		limit:   limit,
+		window:  window,
+		entries: map[string]*rateEntry{},
+		swept:   time.Now(),
+	}
+}
+
+// Allow 记录一次访问，超过限制时返回 false
+func (l *RateLimiter) Allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.swept) > l.window {
+		for k, e := range l.entries {
+			if now.Sub(e.start) > l.window {
+				delete(l.entries, k)
+			}
+		}
+		l.swept = now
+	}
+
+	e, ok := l.entries[key]
+	if !ok || now.Sub(e.start) > l.window {
+		l.entries[key] = &rateEntry{start: now, count: 1}
+		return true
+	}
+	if e.count >= l.limit {
+		return false
+	}
+	e.count++
+	return true
+}
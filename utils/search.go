@@ -0,0 +1,190 @@
+package utils
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// isCJK 判断是否为中日韩文字（这些文字没有空格分词，需要做n-gram切分）
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) ||
+		unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) ||
+		unicode.Is(unicode.Hangul, r)
+}
+
+// SearchTokens 将查询文本切分为检索词
+//
+// 拉丁文字按单词切分并转为小写；中日韩文字连续片段按二元组（bigram）切分，
+// 单个字保留为一元组。配合 PostgreSQL 的 simple 配置即可支持中文检索。
+func SearchTokens(text string) []string {
+	return searchTokens(text, false)
+}
+
+// SearchIndexText 返回写入索引的切分后文本
+//
+// 除二元组外还为每个中日韩文字写入一元组，单字查询（如“猫”）才能命中“小猫咪”这样的片段。
+func SearchIndexText(text string) string {
+	return strings.Join(searchTokens(text, true), " ")
+}
+
+// searchTokens 切分文本，unigrams 为 true 时中日韩文字片段额外输出每个字
+func searchTokens(text string, unigrams bool) []string {
+	var tokens []string
+	var word strings.Builder
+	var cjk []rune
+
+	flushWord := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, word.String())
+			word.Reset()
+		}
+	}
+	flushCJK := func() {
+		switch len(cjk) {
+		case 0:
+		case 1:
+			tokens = append(tokens, string(cjk))
+		default:
+			for i := 0; i+1 < len(cjk); i++ {
+				tokens = append(tokens, string(cjk[i:i+2]))
+			}
+			if unigrams {
+				for _, r := range cjk {
+					tokens = append(tokens, string(r))
+				}
+			}
+		}
+		cjk = cjk[:0]
+	}
+
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word.WriteRune(unicode.ToLower(r))
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+	return tokens
+}
+
+// SearchText 返回用于 plainto_tsquery 的切分后文本
+func SearchText(text string) string {
+	return strings.Join(SearchTokens(text), " ")
+}
+
+// SearchTerms 从查询语句中提取用于高亮的关键词（原文片段，不做n-gram切分）
+func SearchTerms(query string) []string {
+	var terms []string
+	seen := map[string]bool{}
+	for _, f := range strings.FieldsFunc(query, func(r rune) bool {
+		return !(unicode.IsLetter(r) || unicode.IsDigit(r))
+	}) {
+		// 中文片段同时加入其二元组，保证部分命中时也能高亮
+		for _, t := range append([]string{strings.ToLower(f)}, SearchTokens(f)...) {
+			if !seen[t] {
+				seen[t] = true
+				terms = append(terms, t)
+			}
+		}
+	}
+	// 长词优先匹配
+	sort.Slice(terms, func(i, j int) bool { return len(terms[i]) > len(terms[j]) })
+	return terms
+}
+
+// Highlight 转义文本并用 <mark> 标记所有关键词（忽略大小写）
+func Highlight(text string, terms []string) string {
+	var sb strings.Builder
+	last := 0
+	for i := 0; i < len(text); {
+		matched := 0
+		for _, t := range terms {
+			if t != "" && i+len(t) <= len(text) && strings.EqualFold(text[i:i+len(t)], t) {
+				matched = len(t)
+				break
+			}
+		}
+		if matched == 0 {
+			_, size := utf8.DecodeRuneInString(text[i:])
+			i += size
+			continue
+		}
+		sb.WriteString(html.EscapeString(text[last:i]))
+		sb.WriteString("<mark>")
+		sb.WriteString(html.EscapeString(text[i : i+matched]))
+		sb.WriteString("</mark>")
+		i += matched
+		last = i
+	}
+	sb.WriteString(html.EscapeString(text[last:]))
+	return sb.String()
+}
+
+// Snippet 截取第一个关键词附近的片段并高亮，radius 为关键词前后保留的字符数
+func Snippet(text string, terms []string, radius int) string {
+	text = strings.Join(strings.Fields(text), " ")
+	runes := []rune(text)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	pos := -1
+	for _, t := range terms {
+		if idx := indexRunes(lower, []rune(t)); idx >= 0 && (pos < 0 || idx < pos) {
+			pos = idx
+		}
+	}
+	if pos < 0 {
+		pos = 0
+	}
+
+	start := pos - radius
+	if start < 0 {
+		start = 0
+	}
+	end := pos + radius
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	snippet := Highlight(string(runes[start:end]), terms)
+	if start > 0 {
+		snippet = "…" + snippet
+	}
+	if end < len(runes) {
+		snippet += "…"
+	}
+	return snippet
+}
+
+func indexRunes(s, sub []rune) int {
+	if len(sub) == 0 {
+		return -1
+	}
+	for i := 0; i+len(sub) <= len(s); i++ {
+		match := true
+		for j := range sub {
+			if s[i+j] != sub[j] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+)
+
+// SignValue 使用 HMAC-SHA256 对内容签名，返回 "内容.签名"（均为 base64url 编码）
+//
+// purpose 用于区分不同用途的签名，避免一种令牌被用在另一处。
+func SignValue(secret, purpose, value string) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(value))
+	return payload + "." + signPayload(secret, purpose, payload)
+}
+
+// VerifySignedValue 校验 SignValue 生成的令牌并返回原始内容
+func VerifySignedValue(secret, purpose, token string) (string, bool) {
+	payload, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return "", false
+	}
+	expected := signPayload(secret, purpose, payload)
+	if !hmac.Equal([]byte(sig), []byte(expected)) {
+		return "", false
+	}
+	value, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return "", false
+	}
+	return string(value), true
+}
+
+func signPayload(secret, purpose, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(purpose))
+	mac.Write([]byte{0})
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/mozillazg/go-pinyin"
+)
+
+// 生成slug的最大长度
+const maxSlugLength = 80
+
+var pinyinArgs = pinyin.NewArgs()
+
+// Slugify 根据标题生成URL友好的slug
+//
+// 英文字母和数字转为小写保留，汉字转换为不带声调的拼音，
+// 其他字符统一视为分隔符。结果为空时返回空字符串，由调用方决定兜底值。
+func Slugify(title string) string {
+	var words []string
+	var current strings.Builder
+
+	flush := func() {
+		if current.Len() > 0 {
+			words = append(words, current.String())
+			current.Reset()
+		}
+	}
+
+	for _, r := range title {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			current.WriteRune(unicode.ToLower(r))
+		case unicode.Is(unicode.Han, r):
+			flush()
+			if py := pinyin.SinglePinyin(r, pinyinArgs); len(py) > 0 {
+				words = append(words, py[0])
+			}
+		default:
+			flush()
+		}
+	}
+	flush()
+
+	slug := strings.Join(words, "-")
+	if len(slug) > maxSlugLength {
+		// 尽量在单词边界截断
+		slug = slug[:maxSlugLength]
+		if idx := strings.LastIndex(slug, "-"); idx > 0 {
+			slug = slug[:idx]
+		}
+	}
+	return slug
+}
@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+// RFC 6238 附录 B 的 SHA1 测试向量（8 位验证码取后 6 位）
+var totpVectors = []struct {
+	unix int64
+	code string
+}{
+	{59, "287082"},
+	{1111111109, "081804"},
+	{1111111111, "050471"},
+	{1234567890, "005924"},
+	{2000000000, "279037"},
+	{20000000000, "353130"},
+}
+
+var totpTestKey = []byte("12345678901234567890")
+
+func TestTOTPCode(t *testing.T) {
+	for _, v := range totpVectors {
+		if got := totpCode(totpTestKey, v.unix/totpPeriod); got != v.code {
+			t.Errorf("T=%d: got %s, want %s", v.unix, got, v.code)
+		}
+	}
+}
+
+func TestVerifyTOTP(t *testing.T) {
+	secret := totpEncoding.EncodeToString(totpTestKey)
+	for _, v := range totpVectors {
+		now := time.Unix(v.unix, 0)
+		step, ok := VerifyTOTP(secret, v.code, now, 0)
+		if !ok || step != v.unix/totpPeriod {
+			t.Errorf("T=%d: got (%d, %v), want (%d, true)", v.unix, step, ok, v.unix/totpPeriod)
+			continue
+		}
+		// 已使用的时间步不能重放
+		if _, ok := VerifyTOTP(secret, v.code, now, step); ok {
+			t.Errorf("T=%d: replayed code accepted", v.unix)
+		}
+	}
+}
+
+func TestVerifyTOTPSkew(t *testing.T) {
+	secret := totpEncoding.EncodeToString(totpTestKey)
+	code := totpCode(totpTestKey, 1111111111/totpPeriod)
+	for _, tc := range []struct {
+		offset int64
+		ok     bool
+	}{
+		{-totpPeriod, true},
+		{totpPeriod, true},
+		{-2 * totpPeriod, false},
+		{2 * totpPeriod, false},
+	} {
+		now := time.Unix(1111111111+tc.offset, 0)
+		if _, ok := VerifyTOTP(secret, code, now, 0); ok != tc.ok {
+			t.Errorf("offset %ds: got %v, want %v", tc.offset, ok, tc.ok)
+		}
+	}
+}
+
+func TestVerifyTOTPRejectsMalformed(t *testing.T) {
+	secret := totpEncoding.EncodeToString(totpTestKey)
+	now := time.Unix(59, 0)
+	for _, code := range []string{"", "28708", "2870820", "94287082"} {
+		if _, ok := VerifyTOTP(secret, code, now, 0); ok {
+			t.Errorf("code %q accepted", code)
+		}
+	}
+	if _, ok := VerifyTOTP("not base32!", "287082", now, 0); ok {
+		t.Error("invalid secret accepted")
+	}
+}